@@ -0,0 +1,2270 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package rewardchain
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_LiquidityThresholds                 protoreflect.MessageDescriptor
+	fd_LiquidityThresholds_partner_id      protoreflect.FieldDescriptor
+	fd_LiquidityThresholds_warning         protoreflect.FieldDescriptor
+	fd_LiquidityThresholds_critical        protoreflect.FieldDescriptor
+	fd_LiquidityThresholds_auto_pause_earn protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rewardchain_rewardchain_liquidity_proto_init()
+	md_LiquidityThresholds = File_rewardchain_rewardchain_liquidity_proto.Messages().ByName("LiquidityThresholds")
+	fd_LiquidityThresholds_partner_id = md_LiquidityThresholds.Fields().ByName("partner_id")
+	fd_LiquidityThresholds_warning = md_LiquidityThresholds.Fields().ByName("warning")
+	fd_LiquidityThresholds_critical = md_LiquidityThresholds.Fields().ByName("critical")
+	fd_LiquidityThresholds_auto_pause_earn = md_LiquidityThresholds.Fields().ByName("auto_pause_earn")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidityThresholds)(nil)
+
+type fastReflection_LiquidityThresholds LiquidityThresholds
+
+func (x *LiquidityThresholds) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LiquidityThresholds)(x)
+}
+
+func (x *LiquidityThresholds) slowProtoReflect() protoreflect.Message {
+	mi := &file_rewardchain_rewardchain_liquidity_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LiquidityThresholds_messageType fastReflection_LiquidityThresholds_messageType
+var _ protoreflect.MessageType = fastReflection_LiquidityThresholds_messageType{}
+
+type fastReflection_LiquidityThresholds_messageType struct{}
+
+func (x fastReflection_LiquidityThresholds_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LiquidityThresholds)(nil)
+}
+func (x fastReflection_LiquidityThresholds_messageType) New() protoreflect.Message {
+	return new(fastReflection_LiquidityThresholds)
+}
+func (x fastReflection_LiquidityThresholds_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityThresholds
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LiquidityThresholds) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityThresholds
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LiquidityThresholds) Type() protoreflect.MessageType {
+	return _fastReflection_LiquidityThresholds_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LiquidityThresholds) New() protoreflect.Message {
+	return new(fastReflection_LiquidityThresholds)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LiquidityThresholds) Interface() protoreflect.ProtoMessage {
+	return (*LiquidityThresholds)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LiquidityThresholds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PartnerId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PartnerId)
+		if !f(fd_LiquidityThresholds_partner_id, value) {
+			return
+		}
+	}
+	if x.Warning != "" {
+		value := protoreflect.ValueOfString(x.Warning)
+		if !f(fd_LiquidityThresholds_warning, value) {
+			return
+		}
+	}
+	if x.Critical != "" {
+		value := protoreflect.ValueOfString(x.Critical)
+		if !f(fd_LiquidityThresholds_critical, value) {
+			return
+		}
+	}
+	if x.AutoPauseEarn != false {
+		value := protoreflect.ValueOfBool(x.AutoPauseEarn)
+		if !f(fd_LiquidityThresholds_auto_pause_earn, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LiquidityThresholds) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityThresholds.partner_id":
+		return x.PartnerId != uint64(0)
+	case "rewardchain.rewardchain.LiquidityThresholds.warning":
+		return x.Warning != ""
+	case "rewardchain.rewardchain.LiquidityThresholds.critical":
+		return x.Critical != ""
+	case "rewardchain.rewardchain.LiquidityThresholds.auto_pause_earn":
+		return x.AutoPauseEarn != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityThresholds"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityThresholds does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityThresholds) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityThresholds.partner_id":
+		x.PartnerId = uint64(0)
+	case "rewardchain.rewardchain.LiquidityThresholds.warning":
+		x.Warning = ""
+	case "rewardchain.rewardchain.LiquidityThresholds.critical":
+		x.Critical = ""
+	case "rewardchain.rewardchain.LiquidityThresholds.auto_pause_earn":
+		x.AutoPauseEarn = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityThresholds"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityThresholds does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LiquidityThresholds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rewardchain.rewardchain.LiquidityThresholds.partner_id":
+		value := x.PartnerId
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.LiquidityThresholds.warning":
+		value := x.Warning
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.LiquidityThresholds.critical":
+		value := x.Critical
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.LiquidityThresholds.auto_pause_earn":
+		value := x.AutoPauseEarn
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityThresholds"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityThresholds does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityThresholds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityThresholds.partner_id":
+		x.PartnerId = value.Uint()
+	case "rewardchain.rewardchain.LiquidityThresholds.warning":
+		x.Warning = value.Interface().(string)
+	case "rewardchain.rewardchain.LiquidityThresholds.critical":
+		x.Critical = value.Interface().(string)
+	case "rewardchain.rewardchain.LiquidityThresholds.auto_pause_earn":
+		x.AutoPauseEarn = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityThresholds"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityThresholds does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityThresholds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityThresholds.partner_id":
+		panic(fmt.Errorf("field partner_id of message rewardchain.rewardchain.LiquidityThresholds is not mutable"))
+	case "rewardchain.rewardchain.LiquidityThresholds.warning":
+		panic(fmt.Errorf("field warning of message rewardchain.rewardchain.LiquidityThresholds is not mutable"))
+	case "rewardchain.rewardchain.LiquidityThresholds.critical":
+		panic(fmt.Errorf("field critical of message rewardchain.rewardchain.LiquidityThresholds is not mutable"))
+	case "rewardchain.rewardchain.LiquidityThresholds.auto_pause_earn":
+		panic(fmt.Errorf("field auto_pause_earn of message rewardchain.rewardchain.LiquidityThresholds is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityThresholds"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityThresholds does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LiquidityThresholds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityThresholds.partner_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.LiquidityThresholds.warning":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.LiquidityThresholds.critical":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.LiquidityThresholds.auto_pause_earn":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityThresholds"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityThresholds does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LiquidityThresholds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.LiquidityThresholds", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LiquidityThresholds) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityThresholds) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LiquidityThresholds) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LiquidityThresholds) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LiquidityThresholds)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PartnerId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PartnerId))
+		}
+		l = len(x.Warning)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Critical)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AutoPauseEarn {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityThresholds)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoPauseEarn {
+			i--
+			if x.AutoPauseEarn {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Critical) > 0 {
+			i -= len(x.Critical)
+			copy(dAtA[i:], x.Critical)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Critical)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Warning) > 0 {
+			i -= len(x.Warning)
+			copy(dAtA[i:], x.Warning)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Warning)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PartnerId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PartnerId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityThresholds)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityThresholds: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+				}
+				x.PartnerId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PartnerId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Warning = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Critical", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Critical = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoPauseEarn", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoPauseEarn = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LiquidityStatus             protoreflect.MessageDescriptor
+	fd_LiquidityStatus_partner_id  protoreflect.FieldDescriptor
+	fd_LiquidityStatus_level       protoreflect.FieldDescriptor
+	fd_LiquidityStatus_earn_paused protoreflect.FieldDescriptor
+	fd_LiquidityStatus_height      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rewardchain_rewardchain_liquidity_proto_init()
+	md_LiquidityStatus = File_rewardchain_rewardchain_liquidity_proto.Messages().ByName("LiquidityStatus")
+	fd_LiquidityStatus_partner_id = md_LiquidityStatus.Fields().ByName("partner_id")
+	fd_LiquidityStatus_level = md_LiquidityStatus.Fields().ByName("level")
+	fd_LiquidityStatus_earn_paused = md_LiquidityStatus.Fields().ByName("earn_paused")
+	fd_LiquidityStatus_height = md_LiquidityStatus.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidityStatus)(nil)
+
+type fastReflection_LiquidityStatus LiquidityStatus
+
+func (x *LiquidityStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LiquidityStatus)(x)
+}
+
+func (x *LiquidityStatus) slowProtoReflect() protoreflect.Message {
+	mi := &file_rewardchain_rewardchain_liquidity_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LiquidityStatus_messageType fastReflection_LiquidityStatus_messageType
+var _ protoreflect.MessageType = fastReflection_LiquidityStatus_messageType{}
+
+type fastReflection_LiquidityStatus_messageType struct{}
+
+func (x fastReflection_LiquidityStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LiquidityStatus)(nil)
+}
+func (x fastReflection_LiquidityStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_LiquidityStatus)
+}
+func (x fastReflection_LiquidityStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityStatus
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LiquidityStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityStatus
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LiquidityStatus) Type() protoreflect.MessageType {
+	return _fastReflection_LiquidityStatus_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LiquidityStatus) New() protoreflect.Message {
+	return new(fastReflection_LiquidityStatus)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LiquidityStatus) Interface() protoreflect.ProtoMessage {
+	return (*LiquidityStatus)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LiquidityStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PartnerId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PartnerId)
+		if !f(fd_LiquidityStatus_partner_id, value) {
+			return
+		}
+	}
+	if x.Level != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Level))
+		if !f(fd_LiquidityStatus_level, value) {
+			return
+		}
+	}
+	if x.EarnPaused != false {
+		value := protoreflect.ValueOfBool(x.EarnPaused)
+		if !f(fd_LiquidityStatus_earn_paused, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_LiquidityStatus_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LiquidityStatus) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityStatus.partner_id":
+		return x.PartnerId != uint64(0)
+	case "rewardchain.rewardchain.LiquidityStatus.level":
+		return x.Level != 0
+	case "rewardchain.rewardchain.LiquidityStatus.earn_paused":
+		return x.EarnPaused != false
+	case "rewardchain.rewardchain.LiquidityStatus.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityStatus"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityStatus) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityStatus.partner_id":
+		x.PartnerId = uint64(0)
+	case "rewardchain.rewardchain.LiquidityStatus.level":
+		x.Level = 0
+	case "rewardchain.rewardchain.LiquidityStatus.earn_paused":
+		x.EarnPaused = false
+	case "rewardchain.rewardchain.LiquidityStatus.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityStatus"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LiquidityStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rewardchain.rewardchain.LiquidityStatus.partner_id":
+		value := x.PartnerId
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.LiquidityStatus.level":
+		value := x.Level
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "rewardchain.rewardchain.LiquidityStatus.earn_paused":
+		value := x.EarnPaused
+		return protoreflect.ValueOfBool(value)
+	case "rewardchain.rewardchain.LiquidityStatus.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityStatus"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityStatus does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityStatus.partner_id":
+		x.PartnerId = value.Uint()
+	case "rewardchain.rewardchain.LiquidityStatus.level":
+		x.Level = (LiquidityLevel)(value.Enum())
+	case "rewardchain.rewardchain.LiquidityStatus.earn_paused":
+		x.EarnPaused = value.Bool()
+	case "rewardchain.rewardchain.LiquidityStatus.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityStatus"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityStatus.partner_id":
+		panic(fmt.Errorf("field partner_id of message rewardchain.rewardchain.LiquidityStatus is not mutable"))
+	case "rewardchain.rewardchain.LiquidityStatus.level":
+		panic(fmt.Errorf("field level of message rewardchain.rewardchain.LiquidityStatus is not mutable"))
+	case "rewardchain.rewardchain.LiquidityStatus.earn_paused":
+		panic(fmt.Errorf("field earn_paused of message rewardchain.rewardchain.LiquidityStatus is not mutable"))
+	case "rewardchain.rewardchain.LiquidityStatus.height":
+		panic(fmt.Errorf("field height of message rewardchain.rewardchain.LiquidityStatus is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityStatus"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LiquidityStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityStatus.partner_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.LiquidityStatus.level":
+		return protoreflect.ValueOfEnum(0)
+	case "rewardchain.rewardchain.LiquidityStatus.earn_paused":
+		return protoreflect.ValueOfBool(false)
+	case "rewardchain.rewardchain.LiquidityStatus.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityStatus"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityStatus does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LiquidityStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.LiquidityStatus", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LiquidityStatus) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityStatus) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LiquidityStatus) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LiquidityStatus) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LiquidityStatus)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PartnerId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PartnerId))
+		}
+		if x.Level != 0 {
+			n += 1 + runtime.Sov(uint64(x.Level))
+		}
+		if x.EarnPaused {
+			n += 2
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityStatus)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.EarnPaused {
+			i--
+			if x.EarnPaused {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Level != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Level))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PartnerId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PartnerId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityStatus)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityStatus: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+				}
+				x.PartnerId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PartnerId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				}
+				x.Level = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Level |= LiquidityLevel(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarnPaused", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EarnPaused = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LiquidityCheckpoint                     protoreflect.MessageDescriptor
+	fd_LiquidityCheckpoint_partner_id          protoreflect.FieldDescriptor
+	fd_LiquidityCheckpoint_height              protoreflect.FieldDescriptor
+	fd_LiquidityCheckpoint_time                protoreflect.FieldDescriptor
+	fd_LiquidityCheckpoint_total_liquidity     protoreflect.FieldDescriptor
+	fd_LiquidityCheckpoint_available_liquidity protoreflect.FieldDescriptor
+	fd_LiquidityCheckpoint_on_hold_liquidity   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rewardchain_rewardchain_liquidity_proto_init()
+	md_LiquidityCheckpoint = File_rewardchain_rewardchain_liquidity_proto.Messages().ByName("LiquidityCheckpoint")
+	fd_LiquidityCheckpoint_partner_id = md_LiquidityCheckpoint.Fields().ByName("partner_id")
+	fd_LiquidityCheckpoint_height = md_LiquidityCheckpoint.Fields().ByName("height")
+	fd_LiquidityCheckpoint_time = md_LiquidityCheckpoint.Fields().ByName("time")
+	fd_LiquidityCheckpoint_total_liquidity = md_LiquidityCheckpoint.Fields().ByName("total_liquidity")
+	fd_LiquidityCheckpoint_available_liquidity = md_LiquidityCheckpoint.Fields().ByName("available_liquidity")
+	fd_LiquidityCheckpoint_on_hold_liquidity = md_LiquidityCheckpoint.Fields().ByName("on_hold_liquidity")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidityCheckpoint)(nil)
+
+type fastReflection_LiquidityCheckpoint LiquidityCheckpoint
+
+func (x *LiquidityCheckpoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LiquidityCheckpoint)(x)
+}
+
+func (x *LiquidityCheckpoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_rewardchain_rewardchain_liquidity_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LiquidityCheckpoint_messageType fastReflection_LiquidityCheckpoint_messageType
+var _ protoreflect.MessageType = fastReflection_LiquidityCheckpoint_messageType{}
+
+type fastReflection_LiquidityCheckpoint_messageType struct{}
+
+func (x fastReflection_LiquidityCheckpoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LiquidityCheckpoint)(nil)
+}
+func (x fastReflection_LiquidityCheckpoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_LiquidityCheckpoint)
+}
+func (x fastReflection_LiquidityCheckpoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityCheckpoint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LiquidityCheckpoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidityCheckpoint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LiquidityCheckpoint) Type() protoreflect.MessageType {
+	return _fastReflection_LiquidityCheckpoint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LiquidityCheckpoint) New() protoreflect.Message {
+	return new(fastReflection_LiquidityCheckpoint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LiquidityCheckpoint) Interface() protoreflect.ProtoMessage {
+	return (*LiquidityCheckpoint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LiquidityCheckpoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PartnerId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PartnerId)
+		if !f(fd_LiquidityCheckpoint_partner_id, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_LiquidityCheckpoint_height, value) {
+			return
+		}
+	}
+	if x.Time != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Time)
+		if !f(fd_LiquidityCheckpoint_time, value) {
+			return
+		}
+	}
+	if x.TotalLiquidity != "" {
+		value := protoreflect.ValueOfString(x.TotalLiquidity)
+		if !f(fd_LiquidityCheckpoint_total_liquidity, value) {
+			return
+		}
+	}
+	if x.AvailableLiquidity != "" {
+		value := protoreflect.ValueOfString(x.AvailableLiquidity)
+		if !f(fd_LiquidityCheckpoint_available_liquidity, value) {
+			return
+		}
+	}
+	if x.OnHoldLiquidity != "" {
+		value := protoreflect.ValueOfString(x.OnHoldLiquidity)
+		if !f(fd_LiquidityCheckpoint_on_hold_liquidity, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LiquidityCheckpoint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityCheckpoint.partner_id":
+		return x.PartnerId != uint64(0)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.height":
+		return x.Height != int64(0)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.time":
+		return x.Time != int64(0)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.total_liquidity":
+		return x.TotalLiquidity != ""
+	case "rewardchain.rewardchain.LiquidityCheckpoint.available_liquidity":
+		return x.AvailableLiquidity != ""
+	case "rewardchain.rewardchain.LiquidityCheckpoint.on_hold_liquidity":
+		return x.OnHoldLiquidity != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityCheckpoint"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityCheckpoint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityCheckpoint.partner_id":
+		x.PartnerId = uint64(0)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.height":
+		x.Height = int64(0)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.time":
+		x.Time = int64(0)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.total_liquidity":
+		x.TotalLiquidity = ""
+	case "rewardchain.rewardchain.LiquidityCheckpoint.available_liquidity":
+		x.AvailableLiquidity = ""
+	case "rewardchain.rewardchain.LiquidityCheckpoint.on_hold_liquidity":
+		x.OnHoldLiquidity = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityCheckpoint"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LiquidityCheckpoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rewardchain.rewardchain.LiquidityCheckpoint.partner_id":
+		value := x.PartnerId
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.time":
+		value := x.Time
+		return protoreflect.ValueOfInt64(value)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.total_liquidity":
+		value := x.TotalLiquidity
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.available_liquidity":
+		value := x.AvailableLiquidity
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.on_hold_liquidity":
+		value := x.OnHoldLiquidity
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityCheckpoint"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityCheckpoint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityCheckpoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityCheckpoint.partner_id":
+		x.PartnerId = value.Uint()
+	case "rewardchain.rewardchain.LiquidityCheckpoint.height":
+		x.Height = value.Int()
+	case "rewardchain.rewardchain.LiquidityCheckpoint.time":
+		x.Time = value.Int()
+	case "rewardchain.rewardchain.LiquidityCheckpoint.total_liquidity":
+		x.TotalLiquidity = value.Interface().(string)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.available_liquidity":
+		x.AvailableLiquidity = value.Interface().(string)
+	case "rewardchain.rewardchain.LiquidityCheckpoint.on_hold_liquidity":
+		x.OnHoldLiquidity = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityCheckpoint"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityCheckpoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityCheckpoint.partner_id":
+		panic(fmt.Errorf("field partner_id of message rewardchain.rewardchain.LiquidityCheckpoint is not mutable"))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.height":
+		panic(fmt.Errorf("field height of message rewardchain.rewardchain.LiquidityCheckpoint is not mutable"))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.time":
+		panic(fmt.Errorf("field time of message rewardchain.rewardchain.LiquidityCheckpoint is not mutable"))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.total_liquidity":
+		panic(fmt.Errorf("field total_liquidity of message rewardchain.rewardchain.LiquidityCheckpoint is not mutable"))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.available_liquidity":
+		panic(fmt.Errorf("field available_liquidity of message rewardchain.rewardchain.LiquidityCheckpoint is not mutable"))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.on_hold_liquidity":
+		panic(fmt.Errorf("field on_hold_liquidity of message rewardchain.rewardchain.LiquidityCheckpoint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityCheckpoint"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LiquidityCheckpoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.LiquidityCheckpoint.partner_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "rewardchain.rewardchain.LiquidityCheckpoint.total_liquidity":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.LiquidityCheckpoint.available_liquidity":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.LiquidityCheckpoint.on_hold_liquidity":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.LiquidityCheckpoint"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.LiquidityCheckpoint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LiquidityCheckpoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.LiquidityCheckpoint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LiquidityCheckpoint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidityCheckpoint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LiquidityCheckpoint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LiquidityCheckpoint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LiquidityCheckpoint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PartnerId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PartnerId))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != 0 {
+			n += 1 + runtime.Sov(uint64(x.Time))
+		}
+		l = len(x.TotalLiquidity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AvailableLiquidity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OnHoldLiquidity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityCheckpoint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OnHoldLiquidity) > 0 {
+			i -= len(x.OnHoldLiquidity)
+			copy(dAtA[i:], x.OnHoldLiquidity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OnHoldLiquidity)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.AvailableLiquidity) > 0 {
+			i -= len(x.AvailableLiquidity)
+			copy(dAtA[i:], x.AvailableLiquidity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AvailableLiquidity)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.TotalLiquidity) > 0 {
+			i -= len(x.TotalLiquidity)
+			copy(dAtA[i:], x.TotalLiquidity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalLiquidity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Time != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Time))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.PartnerId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PartnerId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidityCheckpoint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityCheckpoint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidityCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+				}
+				x.PartnerId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PartnerId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				x.Time = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Time |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalLiquidity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AvailableLiquidity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AvailableLiquidity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OnHoldLiquidity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OnHoldLiquidity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: rewardchain/rewardchain/liquidity.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LiquidityLevel is where a partner's available liquidity stands against its
+// thresholds.
+type LiquidityLevel int32
+
+const (
+	LiquidityLevel_LIQUIDITY_LEVEL_NORMAL   LiquidityLevel = 0
+	LiquidityLevel_LIQUIDITY_LEVEL_WARNING  LiquidityLevel = 1
+	LiquidityLevel_LIQUIDITY_LEVEL_CRITICAL LiquidityLevel = 2
+)
+
+// Enum value maps for LiquidityLevel.
+var (
+	LiquidityLevel_name = map[int32]string{
+		0: "LIQUIDITY_LEVEL_NORMAL",
+		1: "LIQUIDITY_LEVEL_WARNING",
+		2: "LIQUIDITY_LEVEL_CRITICAL",
+	}
+	LiquidityLevel_value = map[string]int32{
+		"LIQUIDITY_LEVEL_NORMAL":   0,
+		"LIQUIDITY_LEVEL_WARNING":  1,
+		"LIQUIDITY_LEVEL_CRITICAL": 2,
+	}
+)
+
+func (x LiquidityLevel) Enum() *LiquidityLevel {
+	p := new(LiquidityLevel)
+	*p = x
+	return p
+}
+
+func (x LiquidityLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LiquidityLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_rewardchain_rewardchain_liquidity_proto_enumTypes[0].Descriptor()
+}
+
+func (LiquidityLevel) Type() protoreflect.EnumType {
+	return &file_rewardchain_rewardchain_liquidity_proto_enumTypes[0]
+}
+
+func (x LiquidityLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LiquidityLevel.Descriptor instead.
+func (LiquidityLevel) EnumDescriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_liquidity_proto_rawDescGZIP(), []int{0}
+}
+
+// LiquidityThresholds are the available liquidity levels below which a
+// partner is warned. Empty or zero disables a threshold.
+type LiquidityThresholds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Warning   string `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	Critical  string `protobuf:"bytes,3,opt,name=critical,proto3" json:"critical,omitempty"`
+	// auto_pause_earn pauses earning with the partner while its liquidity is
+	// critical. Redeeming stays possible.
+	AutoPauseEarn bool `protobuf:"varint,4,opt,name=auto_pause_earn,json=autoPauseEarn,proto3" json:"auto_pause_earn,omitempty"`
+}
+
+func (x *LiquidityThresholds) Reset() {
+	*x = LiquidityThresholds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rewardchain_rewardchain_liquidity_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityThresholds) ProtoMessage() {}
+
+// Deprecated: Use LiquidityThresholds.ProtoReflect.Descriptor instead.
+func (*LiquidityThresholds) Descriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_liquidity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LiquidityThresholds) GetPartnerId() uint64 {
+	if x != nil {
+		return x.PartnerId
+	}
+	return 0
+}
+
+func (x *LiquidityThresholds) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+func (x *LiquidityThresholds) GetCritical() string {
+	if x != nil {
+		return x.Critical
+	}
+	return ""
+}
+
+func (x *LiquidityThresholds) GetAutoPauseEarn() bool {
+	if x != nil {
+		return x.AutoPauseEarn
+	}
+	return false
+}
+
+// LiquidityStatus is the last liquidity level recorded for a partner.
+type LiquidityStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartnerId  uint64         `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Level      LiquidityLevel `protobuf:"varint,2,opt,name=level,proto3,enum=rewardchain.rewardchain.LiquidityLevel" json:"level,omitempty"`
+	EarnPaused bool           `protobuf:"varint,3,opt,name=earn_paused,json=earnPaused,proto3" json:"earn_paused,omitempty"`
+	// height is the block at which the level last changed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *LiquidityStatus) Reset() {
+	*x = LiquidityStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rewardchain_rewardchain_liquidity_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityStatus) ProtoMessage() {}
+
+// Deprecated: Use LiquidityStatus.ProtoReflect.Descriptor instead.
+func (*LiquidityStatus) Descriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_liquidity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LiquidityStatus) GetPartnerId() uint64 {
+	if x != nil {
+		return x.PartnerId
+	}
+	return 0
+}
+
+func (x *LiquidityStatus) GetLevel() LiquidityLevel {
+	if x != nil {
+		return x.Level
+	}
+	return LiquidityLevel_LIQUIDITY_LEVEL_NORMAL
+}
+
+func (x *LiquidityStatus) GetEarnPaused() bool {
+	if x != nil {
+		return x.EarnPaused
+	}
+	return false
+}
+
+func (x *LiquidityStatus) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// LiquidityCheckpoint is a partner's liquidity as of the end of a block.
+type LiquidityCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Height    int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time in unix seconds.
+	Time               int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	TotalLiquidity     string `protobuf:"bytes,4,opt,name=total_liquidity,json=totalLiquidity,proto3" json:"total_liquidity,omitempty"`
+	AvailableLiquidity string `protobuf:"bytes,5,opt,name=available_liquidity,json=availableLiquidity,proto3" json:"available_liquidity,omitempty"`
+	OnHoldLiquidity    string `protobuf:"bytes,6,opt,name=on_hold_liquidity,json=onHoldLiquidity,proto3" json:"on_hold_liquidity,omitempty"`
+}
+
+func (x *LiquidityCheckpoint) Reset() {
+	*x = LiquidityCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rewardchain_rewardchain_liquidity_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidityCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidityCheckpoint) ProtoMessage() {}
+
+// Deprecated: Use LiquidityCheckpoint.ProtoReflect.Descriptor instead.
+func (*LiquidityCheckpoint) Descriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_liquidity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LiquidityCheckpoint) GetPartnerId() uint64 {
+	if x != nil {
+		return x.PartnerId
+	}
+	return 0
+}
+
+func (x *LiquidityCheckpoint) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *LiquidityCheckpoint) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LiquidityCheckpoint) GetTotalLiquidity() string {
+	if x != nil {
+		return x.TotalLiquidity
+	}
+	return ""
+}
+
+func (x *LiquidityCheckpoint) GetAvailableLiquidity() string {
+	if x != nil {
+		return x.AvailableLiquidity
+	}
+	return ""
+}
+
+func (x *LiquidityCheckpoint) GetOnHoldLiquidity() string {
+	if x != nil {
+		return x.OnHoldLiquidity
+	}
+	return ""
+}
+
+var File_rewardchain_rewardchain_liquidity_proto protoreflect.FileDescriptor
+
+var file_rewardchain_rewardchain_liquidity_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x69,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x61, 0x75, 0x74, 0x6f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x61, 0x72, 0x6e, 0x22, 0xa8, 0x01,
+	0x0a, 0x0f, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x3d, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x61, 0x72, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x61, 0x72, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe6, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6f, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x2a, 0x6d, 0x0a, 0x0e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59,
+	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x4c, 0x49, 0x51, 0x55, 0x49, 0x44, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0xd3, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x42, 0x0e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x27, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x52, 0x52,
+	0x58, 0xaa, 0x02, 0x17, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x17, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x23, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rewardchain_rewardchain_liquidity_proto_rawDescOnce sync.Once
+	file_rewardchain_rewardchain_liquidity_proto_rawDescData = file_rewardchain_rewardchain_liquidity_proto_rawDesc
+)
+
+func file_rewardchain_rewardchain_liquidity_proto_rawDescGZIP() []byte {
+	file_rewardchain_rewardchain_liquidity_proto_rawDescOnce.Do(func() {
+		file_rewardchain_rewardchain_liquidity_proto_rawDescData = protoimpl.X.CompressGZIP(file_rewardchain_rewardchain_liquidity_proto_rawDescData)
+	})
+	return file_rewardchain_rewardchain_liquidity_proto_rawDescData
+}
+
+var file_rewardchain_rewardchain_liquidity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_rewardchain_rewardchain_liquidity_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rewardchain_rewardchain_liquidity_proto_goTypes = []interface{}{
+	(LiquidityLevel)(0),         // 0: rewardchain.rewardchain.LiquidityLevel
+	(*LiquidityThresholds)(nil), // 1: rewardchain.rewardchain.LiquidityThresholds
+	(*LiquidityStatus)(nil),     // 2: rewardchain.rewardchain.LiquidityStatus
+	(*LiquidityCheckpoint)(nil), // 3: rewardchain.rewardchain.LiquidityCheckpoint
+}
+var file_rewardchain_rewardchain_liquidity_proto_depIdxs = []int32{
+	0, // 0: rewardchain.rewardchain.LiquidityStatus.level:type_name -> rewardchain.rewardchain.LiquidityLevel
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rewardchain_rewardchain_liquidity_proto_init() }
+func file_rewardchain_rewardchain_liquidity_proto_init() {
+	if File_rewardchain_rewardchain_liquidity_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rewardchain_rewardchain_liquidity_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityThresholds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rewardchain_rewardchain_liquidity_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rewardchain_rewardchain_liquidity_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidityCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rewardchain_rewardchain_liquidity_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rewardchain_rewardchain_liquidity_proto_goTypes,
+		DependencyIndexes: file_rewardchain_rewardchain_liquidity_proto_depIdxs,
+		EnumInfos:         file_rewardchain_rewardchain_liquidity_proto_enumTypes,
+		MessageInfos:      file_rewardchain_rewardchain_liquidity_proto_msgTypes,
+	}.Build()
+	File_rewardchain_rewardchain_liquidity_proto = out.File
+	file_rewardchain_rewardchain_liquidity_proto_rawDesc = nil
+	file_rewardchain_rewardchain_liquidity_proto_goTypes = nil
+	file_rewardchain_rewardchain_liquidity_proto_depIdxs = nil
+}
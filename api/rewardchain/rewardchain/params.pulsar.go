@@ -15,72 +15,36 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_Params_1_list)(nil)
-
-type _Params_1_list struct {
-	list *[]string
-}
-
-func (x *_Params_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Params_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Params_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Params_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AdminAddresses as it is not of Message kind"))
-}
-
-func (x *_Params_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_Params_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Params                 protoreflect.MessageDescriptor
-	fd_Params_admin_addresses protoreflect.FieldDescriptor
+	md_PauseMatrix                      protoreflect.MessageDescriptor
+	fd_PauseMatrix_create_partner       protoreflect.FieldDescriptor
+	fd_PauseMatrix_add_liquidity        protoreflect.FieldDescriptor
+	fd_PauseMatrix_swap_points_to_token protoreflect.FieldDescriptor
+	fd_PauseMatrix_swap_token_to_points protoreflect.FieldDescriptor
+	fd_PauseMatrix_earn                 protoreflect.FieldDescriptor
+	fd_PauseMatrix_redeem               protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_rewardchain_rewardchain_params_proto_init()
-	md_Params = File_rewardchain_rewardchain_params_proto.Messages().ByName("Params")
-	fd_Params_admin_addresses = md_Params.Fields().ByName("admin_addresses")
+	md_PauseMatrix = File_rewardchain_rewardchain_params_proto.Messages().ByName("PauseMatrix")
+	fd_PauseMatrix_create_partner = md_PauseMatrix.Fields().ByName("create_partner")
+	fd_PauseMatrix_add_liquidity = md_PauseMatrix.Fields().ByName("add_liquidity")
+	fd_PauseMatrix_swap_points_to_token = md_PauseMatrix.Fields().ByName("swap_points_to_token")
+	fd_PauseMatrix_swap_token_to_points = md_PauseMatrix.Fields().ByName("swap_token_to_points")
+	fd_PauseMatrix_earn = md_PauseMatrix.Fields().ByName("earn")
+	fd_PauseMatrix_redeem = md_PauseMatrix.Fields().ByName("redeem")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_PauseMatrix)(nil)
 
-type fastReflection_Params Params
+type fastReflection_PauseMatrix PauseMatrix
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *PauseMatrix) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PauseMatrix)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *PauseMatrix) slowProtoReflect() protoreflect.Message {
 	mi := &file_rewardchain_rewardchain_params_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -92,43 +56,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_PauseMatrix_messageType fastReflection_PauseMatrix_messageType
+var _ protoreflect.MessageType = fastReflection_PauseMatrix_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_PauseMatrix_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_PauseMatrix_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PauseMatrix)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_PauseMatrix_messageType) New() protoreflect.Message {
+	return new(fastReflection_PauseMatrix)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_PauseMatrix_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseMatrix
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_PauseMatrix) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauseMatrix
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_PauseMatrix) Type() protoreflect.MessageType {
+	return _fastReflection_PauseMatrix_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_PauseMatrix) New() protoreflect.Message {
+	return new(fastReflection_PauseMatrix)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_PauseMatrix) Interface() protoreflect.ProtoMessage {
+	return (*PauseMatrix)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -136,10 +100,40 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AdminAddresses) != 0 {
-		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.AdminAddresses})
-		if !f(fd_Params_admin_addresses, value) {
+func (x *fastReflection_PauseMatrix) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CreatePartner != false {
+		value := protoreflect.ValueOfBool(x.CreatePartner)
+		if !f(fd_PauseMatrix_create_partner, value) {
+			return
+		}
+	}
+	if x.AddLiquidity != false {
+		value := protoreflect.ValueOfBool(x.AddLiquidity)
+		if !f(fd_PauseMatrix_add_liquidity, value) {
+			return
+		}
+	}
+	if x.SwapPointsToToken != false {
+		value := protoreflect.ValueOfBool(x.SwapPointsToToken)
+		if !f(fd_PauseMatrix_swap_points_to_token, value) {
+			return
+		}
+	}
+	if x.SwapTokenToPoints != false {
+		value := protoreflect.ValueOfBool(x.SwapTokenToPoints)
+		if !f(fd_PauseMatrix_swap_token_to_points, value) {
+			return
+		}
+	}
+	if x.Earn != false {
+		value := protoreflect.ValueOfBool(x.Earn)
+		if !f(fd_PauseMatrix_earn, value) {
+			return
+		}
+	}
+	if x.Redeem != false {
+		value := protoreflect.ValueOfBool(x.Redeem)
+		if !f(fd_PauseMatrix_redeem, value) {
 			return
 		}
 	}
@@ -156,15 +150,25 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PauseMatrix) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "rewardchain.rewardchain.Params.admin_addresses":
-		return len(x.AdminAddresses) != 0
+	case "rewardchain.rewardchain.PauseMatrix.create_partner":
+		return x.CreatePartner != false
+	case "rewardchain.rewardchain.PauseMatrix.add_liquidity":
+		return x.AddLiquidity != false
+	case "rewardchain.rewardchain.PauseMatrix.swap_points_to_token":
+		return x.SwapPointsToToken != false
+	case "rewardchain.rewardchain.PauseMatrix.swap_token_to_points":
+		return x.SwapTokenToPoints != false
+	case "rewardchain.rewardchain.PauseMatrix.earn":
+		return x.Earn != false
+	case "rewardchain.rewardchain.PauseMatrix.redeem":
+		return x.Redeem != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.PauseMatrix"))
 		}
-		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message rewardchain.rewardchain.PauseMatrix does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -174,15 +178,25 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PauseMatrix) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "rewardchain.rewardchain.Params.admin_addresses":
-		x.AdminAddresses = nil
+	case "rewardchain.rewardchain.PauseMatrix.create_partner":
+		x.CreatePartner = false
+	case "rewardchain.rewardchain.PauseMatrix.add_liquidity":
+		x.AddLiquidity = false
+	case "rewardchain.rewardchain.PauseMatrix.swap_points_to_token":
+		x.SwapPointsToToken = false
+	case "rewardchain.rewardchain.PauseMatrix.swap_token_to_points":
+		x.SwapTokenToPoints = false
+	case "rewardchain.rewardchain.PauseMatrix.earn":
+		x.Earn = false
+	case "rewardchain.rewardchain.PauseMatrix.redeem":
+		x.Redeem = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.PauseMatrix"))
 		}
-		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message rewardchain.rewardchain.PauseMatrix does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -192,19 +206,31 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauseMatrix) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "rewardchain.rewardchain.Params.admin_addresses":
-		if len(x.AdminAddresses) == 0 {
-			return protoreflect.ValueOfList(&_Params_1_list{})
-		}
-		listValue := &_Params_1_list{list: &x.AdminAddresses}
-		return protoreflect.ValueOfList(listValue)
+	case "rewardchain.rewardchain.PauseMatrix.create_partner":
+		value := x.CreatePartner
+		return protoreflect.ValueOfBool(value)
+	case "rewardchain.rewardchain.PauseMatrix.add_liquidity":
+		value := x.AddLiquidity
+		return protoreflect.ValueOfBool(value)
+	case "rewardchain.rewardchain.PauseMatrix.swap_points_to_token":
+		value := x.SwapPointsToToken
+		return protoreflect.ValueOfBool(value)
+	case "rewardchain.rewardchain.PauseMatrix.swap_token_to_points":
+		value := x.SwapTokenToPoints
+		return protoreflect.ValueOfBool(value)
+	case "rewardchain.rewardchain.PauseMatrix.earn":
+		value := x.Earn
+		return protoreflect.ValueOfBool(value)
+	case "rewardchain.rewardchain.PauseMatrix.redeem":
+		value := x.Redeem
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.PauseMatrix"))
 		}
-		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message rewardchain.rewardchain.PauseMatrix does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -218,17 +244,25 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PauseMatrix) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "rewardchain.rewardchain.Params.admin_addresses":
-		lv := value.List()
-		clv := lv.(*_Params_1_list)
-		x.AdminAddresses = *clv.list
+	case "rewardchain.rewardchain.PauseMatrix.create_partner":
+		x.CreatePartner = value.Bool()
+	case "rewardchain.rewardchain.PauseMatrix.add_liquidity":
+		x.AddLiquidity = value.Bool()
+	case "rewardchain.rewardchain.PauseMatrix.swap_points_to_token":
+		x.SwapPointsToToken = value.Bool()
+	case "rewardchain.rewardchain.PauseMatrix.swap_token_to_points":
+		x.SwapTokenToPoints = value.Bool()
+	case "rewardchain.rewardchain.PauseMatrix.earn":
+		x.Earn = value.Bool()
+	case "rewardchain.rewardchain.PauseMatrix.redeem":
+		x.Redeem = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.PauseMatrix"))
 		}
-		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message rewardchain.rewardchain.PauseMatrix does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -242,45 +276,60 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauseMatrix) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "rewardchain.rewardchain.Params.admin_addresses":
-		if x.AdminAddresses == nil {
-			x.AdminAddresses = []string{}
-		}
-		value := &_Params_1_list{list: &x.AdminAddresses}
-		return protoreflect.ValueOfList(value)
+	case "rewardchain.rewardchain.PauseMatrix.create_partner":
+		panic(fmt.Errorf("field create_partner of message rewardchain.rewardchain.PauseMatrix is not mutable"))
+	case "rewardchain.rewardchain.PauseMatrix.add_liquidity":
+		panic(fmt.Errorf("field add_liquidity of message rewardchain.rewardchain.PauseMatrix is not mutable"))
+	case "rewardchain.rewardchain.PauseMatrix.swap_points_to_token":
+		panic(fmt.Errorf("field swap_points_to_token of message rewardchain.rewardchain.PauseMatrix is not mutable"))
+	case "rewardchain.rewardchain.PauseMatrix.swap_token_to_points":
+		panic(fmt.Errorf("field swap_token_to_points of message rewardchain.rewardchain.PauseMatrix is not mutable"))
+	case "rewardchain.rewardchain.PauseMatrix.earn":
+		panic(fmt.Errorf("field earn of message rewardchain.rewardchain.PauseMatrix is not mutable"))
+	case "rewardchain.rewardchain.PauseMatrix.redeem":
+		panic(fmt.Errorf("field redeem of message rewardchain.rewardchain.PauseMatrix is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.PauseMatrix"))
 		}
-		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message rewardchain.rewardchain.PauseMatrix does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauseMatrix) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "rewardchain.rewardchain.Params.admin_addresses":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "rewardchain.rewardchain.PauseMatrix.create_partner":
+		return protoreflect.ValueOfBool(false)
+	case "rewardchain.rewardchain.PauseMatrix.add_liquidity":
+		return protoreflect.ValueOfBool(false)
+	case "rewardchain.rewardchain.PauseMatrix.swap_points_to_token":
+		return protoreflect.ValueOfBool(false)
+	case "rewardchain.rewardchain.PauseMatrix.swap_token_to_points":
+		return protoreflect.ValueOfBool(false)
+	case "rewardchain.rewardchain.PauseMatrix.earn":
+		return protoreflect.ValueOfBool(false)
+	case "rewardchain.rewardchain.PauseMatrix.redeem":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.PauseMatrix"))
 		}
-		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message rewardchain.rewardchain.PauseMatrix does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PauseMatrix) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.PauseMatrix", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -288,7 +337,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PauseMatrix) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -299,7 +348,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PauseMatrix) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -311,7 +360,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_PauseMatrix) IsValid() bool {
 	return x != nil
 }
 
@@ -321,9 +370,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PauseMatrix) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PauseMatrix)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -335,11 +384,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.AdminAddresses) > 0 {
-			for _, s := range x.AdminAddresses {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.CreatePartner {
+			n += 2
+		}
+		if x.AddLiquidity {
+			n += 2
+		}
+		if x.SwapPointsToToken {
+			n += 2
+		}
+		if x.SwapTokenToPoints {
+			n += 2
+		}
+		if x.Earn {
+			n += 2
+		}
+		if x.Redeem {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -351,7 +412,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PauseMatrix)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -370,14 +431,65 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AdminAddresses) > 0 {
-			for iNdEx := len(x.AdminAddresses) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AdminAddresses[iNdEx])
-				copy(dAtA[i:], x.AdminAddresses[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdminAddresses[iNdEx])))
-				i--
-				dAtA[i] = 0xa
+		if x.Redeem {
+			i--
+			if x.Redeem {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Earn {
+			i--
+			if x.Earn {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SwapTokenToPoints {
+			i--
+			if x.SwapTokenToPoints {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SwapPointsToToken {
+			i--
+			if x.SwapPointsToToken {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.AddLiquidity {
+			i--
+			if x.AddLiquidity {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.CreatePartner {
+			i--
+			if x.CreatePartner {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -390,7 +502,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*PauseMatrix)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -422,17 +534,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseMatrix: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauseMatrix: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatePartner", wireType)
 				}
-				var stringLen uint64
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -442,24 +554,112 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.CreatePartner = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddLiquidity", wireType)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.AddLiquidity = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapPointsToToken", wireType)
 				}
-				x.AdminAddresses = append(x.AdminAddresses, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SwapPointsToToken = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapTokenToPoints", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SwapTokenToPoints = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Earn", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Earn = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Redeem", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Redeem = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -495,12 +695,2309 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: rewardchain/rewardchain/params.proto
-
+var (
+	md_DenomCurrency          protoreflect.MessageDescriptor
+	fd_DenomCurrency_denom    protoreflect.FieldDescriptor
+	fd_DenomCurrency_currency protoreflect.FieldDescriptor
+	fd_DenomCurrency_exponent protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rewardchain_rewardchain_params_proto_init()
+	md_DenomCurrency = File_rewardchain_rewardchain_params_proto.Messages().ByName("DenomCurrency")
+	fd_DenomCurrency_denom = md_DenomCurrency.Fields().ByName("denom")
+	fd_DenomCurrency_currency = md_DenomCurrency.Fields().ByName("currency")
+	fd_DenomCurrency_exponent = md_DenomCurrency.Fields().ByName("exponent")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomCurrency)(nil)
+
+type fastReflection_DenomCurrency DenomCurrency
+
+func (x *DenomCurrency) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomCurrency)(x)
+}
+
+func (x *DenomCurrency) slowProtoReflect() protoreflect.Message {
+	mi := &file_rewardchain_rewardchain_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomCurrency_messageType fastReflection_DenomCurrency_messageType
+var _ protoreflect.MessageType = fastReflection_DenomCurrency_messageType{}
+
+type fastReflection_DenomCurrency_messageType struct{}
+
+func (x fastReflection_DenomCurrency_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomCurrency)(nil)
+}
+func (x fastReflection_DenomCurrency_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomCurrency)
+}
+func (x fastReflection_DenomCurrency_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomCurrency
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomCurrency) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomCurrency
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomCurrency) Type() protoreflect.MessageType {
+	return _fastReflection_DenomCurrency_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomCurrency) New() protoreflect.Message {
+	return new(fastReflection_DenomCurrency)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomCurrency) Interface() protoreflect.ProtoMessage {
+	return (*DenomCurrency)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomCurrency) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomCurrency_denom, value) {
+			return
+		}
+	}
+	if x.Currency != "" {
+		value := protoreflect.ValueOfString(x.Currency)
+		if !f(fd_DenomCurrency_currency, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_DenomCurrency_exponent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomCurrency) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.DenomCurrency.denom":
+		return x.Denom != ""
+	case "rewardchain.rewardchain.DenomCurrency.currency":
+		return x.Currency != ""
+	case "rewardchain.rewardchain.DenomCurrency.exponent":
+		return x.Exponent != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.DenomCurrency"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.DenomCurrency does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomCurrency) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.DenomCurrency.denom":
+		x.Denom = ""
+	case "rewardchain.rewardchain.DenomCurrency.currency":
+		x.Currency = ""
+	case "rewardchain.rewardchain.DenomCurrency.exponent":
+		x.Exponent = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.DenomCurrency"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.DenomCurrency does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomCurrency) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rewardchain.rewardchain.DenomCurrency.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.DenomCurrency.currency":
+		value := x.Currency
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.DenomCurrency.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.DenomCurrency"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.DenomCurrency does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomCurrency) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.DenomCurrency.denom":
+		x.Denom = value.Interface().(string)
+	case "rewardchain.rewardchain.DenomCurrency.currency":
+		x.Currency = value.Interface().(string)
+	case "rewardchain.rewardchain.DenomCurrency.exponent":
+		x.Exponent = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.DenomCurrency"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.DenomCurrency does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomCurrency) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.DenomCurrency.denom":
+		panic(fmt.Errorf("field denom of message rewardchain.rewardchain.DenomCurrency is not mutable"))
+	case "rewardchain.rewardchain.DenomCurrency.currency":
+		panic(fmt.Errorf("field currency of message rewardchain.rewardchain.DenomCurrency is not mutable"))
+	case "rewardchain.rewardchain.DenomCurrency.exponent":
+		panic(fmt.Errorf("field exponent of message rewardchain.rewardchain.DenomCurrency is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.DenomCurrency"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.DenomCurrency does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomCurrency) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.DenomCurrency.denom":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.DenomCurrency.currency":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.DenomCurrency.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.DenomCurrency"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.DenomCurrency does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomCurrency) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.DenomCurrency", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomCurrency) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomCurrency) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomCurrency) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomCurrency) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomCurrency)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Currency)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomCurrency)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Currency) > 0 {
+			i -= len(x.Currency)
+			copy(dAtA[i:], x.Currency)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Currency)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomCurrency)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomCurrency: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomCurrency: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Currency = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_1_list)(nil)
+
+type _Params_1_list struct {
+	list *[]string
+}
+
+func (x *_Params_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AdminAddresses as it is not of Message kind"))
+}
+
+func (x *_Params_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field OracleFeeders as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_14_list)(nil)
+
+type _Params_14_list struct {
+	list *[]string
+}
+
+func (x *_Params_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field Attestors as it is not of Message kind"))
+}
+
+func (x *_Params_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_14_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]string
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field EmergencyAdmins as it is not of Message kind"))
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_17_list)(nil)
+
+type _Params_17_list struct {
+	list *[]*DenomCurrency
+}
+
+func (x *_Params_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomCurrency)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomCurrency)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_17_list) AppendMutable() protoreflect.Value {
+	v := new(DenomCurrency)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_17_list) NewElement() protoreflect.Value {
+	v := new(DenomCurrency)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                      protoreflect.MessageDescriptor
+	fd_Params_admin_addresses                      protoreflect.FieldDescriptor
+	fd_Params_max_batch_earn_entries               protoreflect.FieldDescriptor
+	fd_Params_client_ref_ttl_blocks                protoreflect.FieldDescriptor
+	fd_Params_settlement_epoch_blocks              protoreflect.FieldDescriptor
+	fd_Params_swap_fee_rate                        protoreflect.FieldDescriptor
+	fd_Params_redeem_fee_rate                      protoreflect.FieldDescriptor
+	fd_Params_fee_denom                            protoreflect.FieldDescriptor
+	fd_Params_fee_recipient                        protoreflect.FieldDescriptor
+	fd_Params_fee_treasury                         protoreflect.FieldDescriptor
+	fd_Params_oracle_feeders                       protoreflect.FieldDescriptor
+	fd_Params_oracle_window_blocks                 protoreflect.FieldDescriptor
+	fd_Params_oracle_min_submissions               protoreflect.FieldDescriptor
+	fd_Params_oracle_max_rate_age_blocks           protoreflect.FieldDescriptor
+	fd_Params_attestors                            protoreflect.FieldDescriptor
+	fd_Params_paused                               protoreflect.FieldDescriptor
+	fd_Params_emergency_admins                     protoreflect.FieldDescriptor
+	fd_Params_transfer_denoms                      protoreflect.FieldDescriptor
+	fd_Params_liquidity_checkpoint_interval_blocks protoreflect.FieldDescriptor
+	fd_Params_liquidity_checkpoint_retention       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rewardchain_rewardchain_params_proto_init()
+	md_Params = File_rewardchain_rewardchain_params_proto.Messages().ByName("Params")
+	fd_Params_admin_addresses = md_Params.Fields().ByName("admin_addresses")
+	fd_Params_max_batch_earn_entries = md_Params.Fields().ByName("max_batch_earn_entries")
+	fd_Params_client_ref_ttl_blocks = md_Params.Fields().ByName("client_ref_ttl_blocks")
+	fd_Params_settlement_epoch_blocks = md_Params.Fields().ByName("settlement_epoch_blocks")
+	fd_Params_swap_fee_rate = md_Params.Fields().ByName("swap_fee_rate")
+	fd_Params_redeem_fee_rate = md_Params.Fields().ByName("redeem_fee_rate")
+	fd_Params_fee_denom = md_Params.Fields().ByName("fee_denom")
+	fd_Params_fee_recipient = md_Params.Fields().ByName("fee_recipient")
+	fd_Params_fee_treasury = md_Params.Fields().ByName("fee_treasury")
+	fd_Params_oracle_feeders = md_Params.Fields().ByName("oracle_feeders")
+	fd_Params_oracle_window_blocks = md_Params.Fields().ByName("oracle_window_blocks")
+	fd_Params_oracle_min_submissions = md_Params.Fields().ByName("oracle_min_submissions")
+	fd_Params_oracle_max_rate_age_blocks = md_Params.Fields().ByName("oracle_max_rate_age_blocks")
+	fd_Params_attestors = md_Params.Fields().ByName("attestors")
+	fd_Params_paused = md_Params.Fields().ByName("paused")
+	fd_Params_emergency_admins = md_Params.Fields().ByName("emergency_admins")
+	fd_Params_transfer_denoms = md_Params.Fields().ByName("transfer_denoms")
+	fd_Params_liquidity_checkpoint_interval_blocks = md_Params.Fields().ByName("liquidity_checkpoint_interval_blocks")
+	fd_Params_liquidity_checkpoint_retention = md_Params.Fields().ByName("liquidity_checkpoint_retention")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_rewardchain_rewardchain_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AdminAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.AdminAddresses})
+		if !f(fd_Params_admin_addresses, value) {
+			return
+		}
+	}
+	if x.MaxBatchEarnEntries != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxBatchEarnEntries)
+		if !f(fd_Params_max_batch_earn_entries, value) {
+			return
+		}
+	}
+	if x.ClientRefTtlBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ClientRefTtlBlocks)
+		if !f(fd_Params_client_ref_ttl_blocks, value) {
+			return
+		}
+	}
+	if x.SettlementEpochBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SettlementEpochBlocks)
+		if !f(fd_Params_settlement_epoch_blocks, value) {
+			return
+		}
+	}
+	if x.SwapFeeRate != "" {
+		value := protoreflect.ValueOfString(x.SwapFeeRate)
+		if !f(fd_Params_swap_fee_rate, value) {
+			return
+		}
+	}
+	if x.RedeemFeeRate != "" {
+		value := protoreflect.ValueOfString(x.RedeemFeeRate)
+		if !f(fd_Params_redeem_fee_rate, value) {
+			return
+		}
+	}
+	if x.FeeDenom != "" {
+		value := protoreflect.ValueOfString(x.FeeDenom)
+		if !f(fd_Params_fee_denom, value) {
+			return
+		}
+	}
+	if x.FeeRecipient != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.FeeRecipient))
+		if !f(fd_Params_fee_recipient, value) {
+			return
+		}
+	}
+	if x.FeeTreasury != "" {
+		value := protoreflect.ValueOfString(x.FeeTreasury)
+		if !f(fd_Params_fee_treasury, value) {
+			return
+		}
+	}
+	if len(x.OracleFeeders) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.OracleFeeders})
+		if !f(fd_Params_oracle_feeders, value) {
+			return
+		}
+	}
+	if x.OracleWindowBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleWindowBlocks)
+		if !f(fd_Params_oracle_window_blocks, value) {
+			return
+		}
+	}
+	if x.OracleMinSubmissions != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OracleMinSubmissions)
+		if !f(fd_Params_oracle_min_submissions, value) {
+			return
+		}
+	}
+	if x.OracleMaxRateAgeBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.OracleMaxRateAgeBlocks)
+		if !f(fd_Params_oracle_max_rate_age_blocks, value) {
+			return
+		}
+	}
+	if len(x.Attestors) != 0 {
+		value := protoreflect.ValueOfList(&_Params_14_list{list: &x.Attestors})
+		if !f(fd_Params_attestors, value) {
+			return
+		}
+	}
+	if x.Paused != nil {
+		value := protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
+		if !f(fd_Params_paused, value) {
+			return
+		}
+	}
+	if len(x.EmergencyAdmins) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.EmergencyAdmins})
+		if !f(fd_Params_emergency_admins, value) {
+			return
+		}
+	}
+	if len(x.TransferDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_17_list{list: &x.TransferDenoms})
+		if !f(fd_Params_transfer_denoms, value) {
+			return
+		}
+	}
+	if x.LiquidityCheckpointIntervalBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LiquidityCheckpointIntervalBlocks)
+		if !f(fd_Params_liquidity_checkpoint_interval_blocks, value) {
+			return
+		}
+	}
+	if x.LiquidityCheckpointRetention != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.LiquidityCheckpointRetention)
+		if !f(fd_Params_liquidity_checkpoint_retention, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.Params.admin_addresses":
+		return len(x.AdminAddresses) != 0
+	case "rewardchain.rewardchain.Params.max_batch_earn_entries":
+		return x.MaxBatchEarnEntries != uint32(0)
+	case "rewardchain.rewardchain.Params.client_ref_ttl_blocks":
+		return x.ClientRefTtlBlocks != uint64(0)
+	case "rewardchain.rewardchain.Params.settlement_epoch_blocks":
+		return x.SettlementEpochBlocks != uint64(0)
+	case "rewardchain.rewardchain.Params.swap_fee_rate":
+		return x.SwapFeeRate != ""
+	case "rewardchain.rewardchain.Params.redeem_fee_rate":
+		return x.RedeemFeeRate != ""
+	case "rewardchain.rewardchain.Params.fee_denom":
+		return x.FeeDenom != ""
+	case "rewardchain.rewardchain.Params.fee_recipient":
+		return x.FeeRecipient != 0
+	case "rewardchain.rewardchain.Params.fee_treasury":
+		return x.FeeTreasury != ""
+	case "rewardchain.rewardchain.Params.oracle_feeders":
+		return len(x.OracleFeeders) != 0
+	case "rewardchain.rewardchain.Params.oracle_window_blocks":
+		return x.OracleWindowBlocks != uint64(0)
+	case "rewardchain.rewardchain.Params.oracle_min_submissions":
+		return x.OracleMinSubmissions != uint32(0)
+	case "rewardchain.rewardchain.Params.oracle_max_rate_age_blocks":
+		return x.OracleMaxRateAgeBlocks != uint64(0)
+	case "rewardchain.rewardchain.Params.attestors":
+		return len(x.Attestors) != 0
+	case "rewardchain.rewardchain.Params.paused":
+		return x.Paused != nil
+	case "rewardchain.rewardchain.Params.emergency_admins":
+		return len(x.EmergencyAdmins) != 0
+	case "rewardchain.rewardchain.Params.transfer_denoms":
+		return len(x.TransferDenoms) != 0
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_interval_blocks":
+		return x.LiquidityCheckpointIntervalBlocks != uint64(0)
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_retention":
+		return x.LiquidityCheckpointRetention != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.Params.admin_addresses":
+		x.AdminAddresses = nil
+	case "rewardchain.rewardchain.Params.max_batch_earn_entries":
+		x.MaxBatchEarnEntries = uint32(0)
+	case "rewardchain.rewardchain.Params.client_ref_ttl_blocks":
+		x.ClientRefTtlBlocks = uint64(0)
+	case "rewardchain.rewardchain.Params.settlement_epoch_blocks":
+		x.SettlementEpochBlocks = uint64(0)
+	case "rewardchain.rewardchain.Params.swap_fee_rate":
+		x.SwapFeeRate = ""
+	case "rewardchain.rewardchain.Params.redeem_fee_rate":
+		x.RedeemFeeRate = ""
+	case "rewardchain.rewardchain.Params.fee_denom":
+		x.FeeDenom = ""
+	case "rewardchain.rewardchain.Params.fee_recipient":
+		x.FeeRecipient = 0
+	case "rewardchain.rewardchain.Params.fee_treasury":
+		x.FeeTreasury = ""
+	case "rewardchain.rewardchain.Params.oracle_feeders":
+		x.OracleFeeders = nil
+	case "rewardchain.rewardchain.Params.oracle_window_blocks":
+		x.OracleWindowBlocks = uint64(0)
+	case "rewardchain.rewardchain.Params.oracle_min_submissions":
+		x.OracleMinSubmissions = uint32(0)
+	case "rewardchain.rewardchain.Params.oracle_max_rate_age_blocks":
+		x.OracleMaxRateAgeBlocks = uint64(0)
+	case "rewardchain.rewardchain.Params.attestors":
+		x.Attestors = nil
+	case "rewardchain.rewardchain.Params.paused":
+		x.Paused = nil
+	case "rewardchain.rewardchain.Params.emergency_admins":
+		x.EmergencyAdmins = nil
+	case "rewardchain.rewardchain.Params.transfer_denoms":
+		x.TransferDenoms = nil
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_interval_blocks":
+		x.LiquidityCheckpointIntervalBlocks = uint64(0)
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_retention":
+		x.LiquidityCheckpointRetention = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rewardchain.rewardchain.Params.admin_addresses":
+		if len(x.AdminAddresses) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.AdminAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "rewardchain.rewardchain.Params.max_batch_earn_entries":
+		value := x.MaxBatchEarnEntries
+		return protoreflect.ValueOfUint32(value)
+	case "rewardchain.rewardchain.Params.client_ref_ttl_blocks":
+		value := x.ClientRefTtlBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.Params.settlement_epoch_blocks":
+		value := x.SettlementEpochBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.Params.swap_fee_rate":
+		value := x.SwapFeeRate
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.Params.redeem_fee_rate":
+		value := x.RedeemFeeRate
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.Params.fee_denom":
+		value := x.FeeDenom
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.Params.fee_recipient":
+		value := x.FeeRecipient
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "rewardchain.rewardchain.Params.fee_treasury":
+		value := x.FeeTreasury
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.Params.oracle_feeders":
+		if len(x.OracleFeeders) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.OracleFeeders}
+		return protoreflect.ValueOfList(listValue)
+	case "rewardchain.rewardchain.Params.oracle_window_blocks":
+		value := x.OracleWindowBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.Params.oracle_min_submissions":
+		value := x.OracleMinSubmissions
+		return protoreflect.ValueOfUint32(value)
+	case "rewardchain.rewardchain.Params.oracle_max_rate_age_blocks":
+		value := x.OracleMaxRateAgeBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.Params.attestors":
+		if len(x.Attestors) == 0 {
+			return protoreflect.ValueOfList(&_Params_14_list{})
+		}
+		listValue := &_Params_14_list{list: &x.Attestors}
+		return protoreflect.ValueOfList(listValue)
+	case "rewardchain.rewardchain.Params.paused":
+		value := x.Paused
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "rewardchain.rewardchain.Params.emergency_admins":
+		if len(x.EmergencyAdmins) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.EmergencyAdmins}
+		return protoreflect.ValueOfList(listValue)
+	case "rewardchain.rewardchain.Params.transfer_denoms":
+		if len(x.TransferDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_17_list{})
+		}
+		listValue := &_Params_17_list{list: &x.TransferDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_interval_blocks":
+		value := x.LiquidityCheckpointIntervalBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_retention":
+		value := x.LiquidityCheckpointRetention
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.Params.admin_addresses":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.AdminAddresses = *clv.list
+	case "rewardchain.rewardchain.Params.max_batch_earn_entries":
+		x.MaxBatchEarnEntries = uint32(value.Uint())
+	case "rewardchain.rewardchain.Params.client_ref_ttl_blocks":
+		x.ClientRefTtlBlocks = value.Uint()
+	case "rewardchain.rewardchain.Params.settlement_epoch_blocks":
+		x.SettlementEpochBlocks = value.Uint()
+	case "rewardchain.rewardchain.Params.swap_fee_rate":
+		x.SwapFeeRate = value.Interface().(string)
+	case "rewardchain.rewardchain.Params.redeem_fee_rate":
+		x.RedeemFeeRate = value.Interface().(string)
+	case "rewardchain.rewardchain.Params.fee_denom":
+		x.FeeDenom = value.Interface().(string)
+	case "rewardchain.rewardchain.Params.fee_recipient":
+		x.FeeRecipient = (FeeRecipient)(value.Enum())
+	case "rewardchain.rewardchain.Params.fee_treasury":
+		x.FeeTreasury = value.Interface().(string)
+	case "rewardchain.rewardchain.Params.oracle_feeders":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.OracleFeeders = *clv.list
+	case "rewardchain.rewardchain.Params.oracle_window_blocks":
+		x.OracleWindowBlocks = value.Uint()
+	case "rewardchain.rewardchain.Params.oracle_min_submissions":
+		x.OracleMinSubmissions = uint32(value.Uint())
+	case "rewardchain.rewardchain.Params.oracle_max_rate_age_blocks":
+		x.OracleMaxRateAgeBlocks = value.Uint()
+	case "rewardchain.rewardchain.Params.attestors":
+		lv := value.List()
+		clv := lv.(*_Params_14_list)
+		x.Attestors = *clv.list
+	case "rewardchain.rewardchain.Params.paused":
+		x.Paused = value.Message().Interface().(*PauseMatrix)
+	case "rewardchain.rewardchain.Params.emergency_admins":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.EmergencyAdmins = *clv.list
+	case "rewardchain.rewardchain.Params.transfer_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_17_list)
+		x.TransferDenoms = *clv.list
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_interval_blocks":
+		x.LiquidityCheckpointIntervalBlocks = value.Uint()
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_retention":
+		x.LiquidityCheckpointRetention = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.Params.admin_addresses":
+		if x.AdminAddresses == nil {
+			x.AdminAddresses = []string{}
+		}
+		value := &_Params_1_list{list: &x.AdminAddresses}
+		return protoreflect.ValueOfList(value)
+	case "rewardchain.rewardchain.Params.oracle_feeders":
+		if x.OracleFeeders == nil {
+			x.OracleFeeders = []string{}
+		}
+		value := &_Params_10_list{list: &x.OracleFeeders}
+		return protoreflect.ValueOfList(value)
+	case "rewardchain.rewardchain.Params.attestors":
+		if x.Attestors == nil {
+			x.Attestors = []string{}
+		}
+		value := &_Params_14_list{list: &x.Attestors}
+		return protoreflect.ValueOfList(value)
+	case "rewardchain.rewardchain.Params.paused":
+		if x.Paused == nil {
+			x.Paused = new(PauseMatrix)
+		}
+		return protoreflect.ValueOfMessage(x.Paused.ProtoReflect())
+	case "rewardchain.rewardchain.Params.emergency_admins":
+		if x.EmergencyAdmins == nil {
+			x.EmergencyAdmins = []string{}
+		}
+		value := &_Params_16_list{list: &x.EmergencyAdmins}
+		return protoreflect.ValueOfList(value)
+	case "rewardchain.rewardchain.Params.transfer_denoms":
+		if x.TransferDenoms == nil {
+			x.TransferDenoms = []*DenomCurrency{}
+		}
+		value := &_Params_17_list{list: &x.TransferDenoms}
+		return protoreflect.ValueOfList(value)
+	case "rewardchain.rewardchain.Params.max_batch_earn_entries":
+		panic(fmt.Errorf("field max_batch_earn_entries of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.client_ref_ttl_blocks":
+		panic(fmt.Errorf("field client_ref_ttl_blocks of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.settlement_epoch_blocks":
+		panic(fmt.Errorf("field settlement_epoch_blocks of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.swap_fee_rate":
+		panic(fmt.Errorf("field swap_fee_rate of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.redeem_fee_rate":
+		panic(fmt.Errorf("field redeem_fee_rate of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.fee_denom":
+		panic(fmt.Errorf("field fee_denom of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.fee_recipient":
+		panic(fmt.Errorf("field fee_recipient of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.fee_treasury":
+		panic(fmt.Errorf("field fee_treasury of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.oracle_window_blocks":
+		panic(fmt.Errorf("field oracle_window_blocks of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.oracle_min_submissions":
+		panic(fmt.Errorf("field oracle_min_submissions of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.oracle_max_rate_age_blocks":
+		panic(fmt.Errorf("field oracle_max_rate_age_blocks of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_interval_blocks":
+		panic(fmt.Errorf("field liquidity_checkpoint_interval_blocks of message rewardchain.rewardchain.Params is not mutable"))
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_retention":
+		panic(fmt.Errorf("field liquidity_checkpoint_retention of message rewardchain.rewardchain.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.Params.admin_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "rewardchain.rewardchain.Params.max_batch_earn_entries":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "rewardchain.rewardchain.Params.client_ref_ttl_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.Params.settlement_epoch_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.Params.swap_fee_rate":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.Params.redeem_fee_rate":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.Params.fee_denom":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.Params.fee_recipient":
+		return protoreflect.ValueOfEnum(0)
+	case "rewardchain.rewardchain.Params.fee_treasury":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.Params.oracle_feeders":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "rewardchain.rewardchain.Params.oracle_window_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.Params.oracle_min_submissions":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "rewardchain.rewardchain.Params.oracle_max_rate_age_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.Params.attestors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_14_list{list: &list})
+	case "rewardchain.rewardchain.Params.paused":
+		m := new(PauseMatrix)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "rewardchain.rewardchain.Params.emergency_admins":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "rewardchain.rewardchain.Params.transfer_denoms":
+		list := []*DenomCurrency{}
+		return protoreflect.ValueOfList(&_Params_17_list{list: &list})
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_interval_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.Params.liquidity_checkpoint_retention":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.Params"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.AdminAddresses) > 0 {
+			for _, s := range x.AdminAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxBatchEarnEntries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBatchEarnEntries))
+		}
+		if x.ClientRefTtlBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClientRefTtlBlocks))
+		}
+		if x.SettlementEpochBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettlementEpochBlocks))
+		}
+		l = len(x.SwapFeeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RedeemFeeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeRecipient != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeRecipient))
+		}
+		l = len(x.FeeTreasury)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OracleFeeders) > 0 {
+			for _, s := range x.OracleFeeders {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OracleWindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleWindowBlocks))
+		}
+		if x.OracleMinSubmissions != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleMinSubmissions))
+		}
+		if x.OracleMaxRateAgeBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.OracleMaxRateAgeBlocks))
+		}
+		if len(x.Attestors) > 0 {
+			for _, s := range x.Attestors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Paused != nil {
+			l = options.Size(x.Paused)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EmergencyAdmins) > 0 {
+			for _, s := range x.EmergencyAdmins {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TransferDenoms) > 0 {
+			for _, e := range x.TransferDenoms {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LiquidityCheckpointIntervalBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.LiquidityCheckpointIntervalBlocks))
+		}
+		if x.LiquidityCheckpointRetention != 0 {
+			n += 2 + runtime.Sov(uint64(x.LiquidityCheckpointRetention))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LiquidityCheckpointRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LiquidityCheckpointRetention))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.LiquidityCheckpointIntervalBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LiquidityCheckpointIntervalBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.TransferDenoms) > 0 {
+			for iNdEx := len(x.TransferDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransferDenoms[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.EmergencyAdmins) > 0 {
+			for iNdEx := len(x.EmergencyAdmins) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.EmergencyAdmins[iNdEx])
+				copy(dAtA[i:], x.EmergencyAdmins[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EmergencyAdmins[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.Paused != nil {
+			encoded, err := options.Marshal(x.Paused)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.Attestors) > 0 {
+			for iNdEx := len(x.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Attestors[iNdEx])
+				copy(dAtA[i:], x.Attestors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attestors[iNdEx])))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.OracleMaxRateAgeBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleMaxRateAgeBlocks))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.OracleMinSubmissions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleMinSubmissions))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.OracleWindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OracleWindowBlocks))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.OracleFeeders) > 0 {
+			for iNdEx := len(x.OracleFeeders) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OracleFeeders[iNdEx])
+				copy(dAtA[i:], x.OracleFeeders[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OracleFeeders[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.FeeTreasury) > 0 {
+			i -= len(x.FeeTreasury)
+			copy(dAtA[i:], x.FeeTreasury)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeTreasury)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.FeeRecipient != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeRecipient))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.FeeDenom) > 0 {
+			i -= len(x.FeeDenom)
+			copy(dAtA[i:], x.FeeDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeDenom)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.RedeemFeeRate) > 0 {
+			i -= len(x.RedeemFeeRate)
+			copy(dAtA[i:], x.RedeemFeeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RedeemFeeRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SwapFeeRate) > 0 {
+			i -= len(x.SwapFeeRate)
+			copy(dAtA[i:], x.SwapFeeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SwapFeeRate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SettlementEpochBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettlementEpochBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ClientRefTtlBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClientRefTtlBlocks))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxBatchEarnEntries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBatchEarnEntries))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.AdminAddresses) > 0 {
+			for iNdEx := len(x.AdminAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AdminAddresses[iNdEx])
+				copy(dAtA[i:], x.AdminAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AdminAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AdminAddresses = append(x.AdminAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBatchEarnEntries", wireType)
+				}
+				x.MaxBatchEarnEntries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBatchEarnEntries |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClientRefTtlBlocks", wireType)
+				}
+				x.ClientRefTtlBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClientRefTtlBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettlementEpochBlocks", wireType)
+				}
+				x.SettlementEpochBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettlementEpochBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SwapFeeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedeemFeeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RedeemFeeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+				}
+				x.FeeRecipient = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeRecipient |= FeeRecipient(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeTreasury", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeTreasury = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleFeeders", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OracleFeeders = append(x.OracleFeeders, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleWindowBlocks", wireType)
+				}
+				x.OracleWindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleWindowBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleMinSubmissions", wireType)
+				}
+				x.OracleMinSubmissions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleMinSubmissions |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OracleMaxRateAgeBlocks", wireType)
+				}
+				x.OracleMaxRateAgeBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OracleMaxRateAgeBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attestors = append(x.Attestors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Paused == nil {
+					x.Paused = &PauseMatrix{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Paused); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EmergencyAdmins", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EmergencyAdmins = append(x.EmergencyAdmins, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferDenoms", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferDenoms = append(x.TransferDenoms, &DenomCurrency{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransferDenoms[len(x.TransferDenoms)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidityCheckpointIntervalBlocks", wireType)
+				}
+				x.LiquidityCheckpointIntervalBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LiquidityCheckpointIntervalBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidityCheckpointRetention", wireType)
+				}
+				x.LiquidityCheckpointRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LiquidityCheckpointRetention |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: rewardchain/rewardchain/params.proto
+
 const (
 	// Verify that this generated code is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
@@ -508,6 +3005,255 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FeeRecipient selects where swap and redeem fees go.
+type FeeRecipient int32
+
+const (
+	// FEE_RECIPIENT_UNSPECIFIED behaves like FEE_RECIPIENT_COMMUNITY_POOL.
+	FeeRecipient_FEE_RECIPIENT_UNSPECIFIED    FeeRecipient = 0
+	FeeRecipient_FEE_RECIPIENT_COMMUNITY_POOL FeeRecipient = 1
+	FeeRecipient_FEE_RECIPIENT_BURN           FeeRecipient = 2
+	FeeRecipient_FEE_RECIPIENT_TREASURY       FeeRecipient = 3
+)
+
+// Enum value maps for FeeRecipient.
+var (
+	FeeRecipient_name = map[int32]string{
+		0: "FEE_RECIPIENT_UNSPECIFIED",
+		1: "FEE_RECIPIENT_COMMUNITY_POOL",
+		2: "FEE_RECIPIENT_BURN",
+		3: "FEE_RECIPIENT_TREASURY",
+	}
+	FeeRecipient_value = map[string]int32{
+		"FEE_RECIPIENT_UNSPECIFIED":    0,
+		"FEE_RECIPIENT_COMMUNITY_POOL": 1,
+		"FEE_RECIPIENT_BURN":           2,
+		"FEE_RECIPIENT_TREASURY":       3,
+	}
+)
+
+func (x FeeRecipient) Enum() *FeeRecipient {
+	p := new(FeeRecipient)
+	*p = x
+	return p
+}
+
+func (x FeeRecipient) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeRecipient) Descriptor() protoreflect.EnumDescriptor {
+	return file_rewardchain_rewardchain_params_proto_enumTypes[0].Descriptor()
+}
+
+func (FeeRecipient) Type() protoreflect.EnumType {
+	return &file_rewardchain_rewardchain_params_proto_enumTypes[0]
+}
+
+func (x FeeRecipient) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeRecipient.Descriptor instead.
+func (FeeRecipient) EnumDescriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_params_proto_rawDescGZIP(), []int{0}
+}
+
+// Operation is a rewardchain operation that can be paused on its own.
+type Operation int32
+
+const (
+	Operation_OPERATION_UNSPECIFIED          Operation = 0
+	Operation_OPERATION_CREATE_PARTNER       Operation = 1
+	Operation_OPERATION_ADD_LIQUIDITY        Operation = 2
+	Operation_OPERATION_SWAP_POINTS_TO_TOKEN Operation = 3
+	Operation_OPERATION_SWAP_TOKEN_TO_POINTS Operation = 4
+	Operation_OPERATION_EARN                 Operation = 5
+	Operation_OPERATION_REDEEM               Operation = 6
+)
+
+// Enum value maps for Operation.
+var (
+	Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_CREATE_PARTNER",
+		2: "OPERATION_ADD_LIQUIDITY",
+		3: "OPERATION_SWAP_POINTS_TO_TOKEN",
+		4: "OPERATION_SWAP_TOKEN_TO_POINTS",
+		5: "OPERATION_EARN",
+		6: "OPERATION_REDEEM",
+	}
+	Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED":          0,
+		"OPERATION_CREATE_PARTNER":       1,
+		"OPERATION_ADD_LIQUIDITY":        2,
+		"OPERATION_SWAP_POINTS_TO_TOKEN": 3,
+		"OPERATION_SWAP_TOKEN_TO_POINTS": 4,
+		"OPERATION_EARN":                 5,
+		"OPERATION_REDEEM":               6,
+	}
+)
+
+func (x Operation) Enum() *Operation {
+	p := new(Operation)
+	*p = x
+	return p
+}
+
+func (x Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_rewardchain_rewardchain_params_proto_enumTypes[1].Descriptor()
+}
+
+func (Operation) Type() protoreflect.EnumType {
+	return &file_rewardchain_rewardchain_params_proto_enumTypes[1]
+}
+
+func (x Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation.Descriptor instead.
+func (Operation) EnumDescriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_params_proto_rawDescGZIP(), []int{1}
+}
+
+// PauseMatrix records which operations are paused.
+type PauseMatrix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatePartner     bool `protobuf:"varint,1,opt,name=create_partner,json=createPartner,proto3" json:"create_partner,omitempty"`
+	AddLiquidity      bool `protobuf:"varint,2,opt,name=add_liquidity,json=addLiquidity,proto3" json:"add_liquidity,omitempty"`
+	SwapPointsToToken bool `protobuf:"varint,3,opt,name=swap_points_to_token,json=swapPointsToToken,proto3" json:"swap_points_to_token,omitempty"`
+	SwapTokenToPoints bool `protobuf:"varint,4,opt,name=swap_token_to_points,json=swapTokenToPoints,proto3" json:"swap_token_to_points,omitempty"`
+	// earn covers batch earns and receipt claims.
+	Earn   bool `protobuf:"varint,5,opt,name=earn,proto3" json:"earn,omitempty"`
+	Redeem bool `protobuf:"varint,6,opt,name=redeem,proto3" json:"redeem,omitempty"`
+}
+
+func (x *PauseMatrix) Reset() {
+	*x = PauseMatrix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rewardchain_rewardchain_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseMatrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMatrix) ProtoMessage() {}
+
+// Deprecated: Use PauseMatrix.ProtoReflect.Descriptor instead.
+func (*PauseMatrix) Descriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PauseMatrix) GetCreatePartner() bool {
+	if x != nil {
+		return x.CreatePartner
+	}
+	return false
+}
+
+func (x *PauseMatrix) GetAddLiquidity() bool {
+	if x != nil {
+		return x.AddLiquidity
+	}
+	return false
+}
+
+func (x *PauseMatrix) GetSwapPointsToToken() bool {
+	if x != nil {
+		return x.SwapPointsToToken
+	}
+	return false
+}
+
+func (x *PauseMatrix) GetSwapTokenToPoints() bool {
+	if x != nil {
+		return x.SwapTokenToPoints
+	}
+	return false
+}
+
+func (x *PauseMatrix) GetEarn() bool {
+	if x != nil {
+		return x.Earn
+	}
+	return false
+}
+
+func (x *PauseMatrix) GetRedeem() bool {
+	if x != nil {
+		return x.Redeem
+	}
+	return false
+}
+
+// DenomCurrency maps a token received over ICS-20 to the currency it is
+// worth, so transfer memos can fund partners with it.
+type DenomCurrency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the local denom of the token, e.g. ibc/27394FB0...
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// exponent is the number of decimals between the denom and one unit of
+	// currency, e.g. 6 for uusdc.
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *DenomCurrency) Reset() {
+	*x = DenomCurrency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rewardchain_rewardchain_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomCurrency) ProtoMessage() {}
+
+// Deprecated: Use DenomCurrency.ProtoReflect.Descriptor instead.
+func (*DenomCurrency) Descriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DenomCurrency) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomCurrency) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DenomCurrency) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -516,12 +3262,58 @@ type Params struct {
 
 	// admin_addresses is the allowlist of accounts permitted to create/disable/update partners.
 	AdminAddresses []string `protobuf:"bytes,1,rep,name=admin_addresses,json=adminAddresses,proto3" json:"admin_addresses,omitempty"`
+	// max_batch_earn_entries caps the entries of a MsgBatchEarnPoints.
+	MaxBatchEarnEntries uint32 `protobuf:"varint,2,opt,name=max_batch_earn_entries,json=maxBatchEarnEntries,proto3" json:"max_batch_earn_entries,omitempty"`
+	// client_ref_ttl_blocks is how long client_ref outcomes are kept.
+	ClientRefTtlBlocks uint64 `protobuf:"varint,3,opt,name=client_ref_ttl_blocks,json=clientRefTtlBlocks,proto3" json:"client_ref_ttl_blocks,omitempty"`
+	// settlement_epoch_blocks is how often obligations are netted into
+	// settlements.
+	SettlementEpochBlocks uint64 `protobuf:"varint,4,opt,name=settlement_epoch_blocks,json=settlementEpochBlocks,proto3" json:"settlement_epoch_blocks,omitempty"`
+	// swap_fee_rate and redeem_fee_rate are the fractions of the swapped or
+	// redeemed points value charged as a fee. Empty means no fee.
+	SwapFeeRate   string `protobuf:"bytes,5,opt,name=swap_fee_rate,json=swapFeeRate,proto3" json:"swap_fee_rate,omitempty"`
+	RedeemFeeRate string `protobuf:"bytes,6,opt,name=redeem_fee_rate,json=redeemFeeRate,proto3" json:"redeem_fee_rate,omitempty"`
+	// fee_denom is the coin fees are paid in. A partner's
+	// redeem_cost_per_point is read as the fee_denom price of one point.
+	FeeDenom     string       `protobuf:"bytes,7,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	FeeRecipient FeeRecipient `protobuf:"varint,8,opt,name=fee_recipient,json=feeRecipient,proto3,enum=rewardchain.rewardchain.FeeRecipient" json:"fee_recipient,omitempty"`
+	// fee_treasury receives fees when fee_recipient is FEE_RECIPIENT_TREASURY.
+	FeeTreasury string `protobuf:"bytes,9,opt,name=fee_treasury,json=feeTreasury,proto3" json:"fee_treasury,omitempty"`
+	// oracle_feeders may submit exchange rates.
+	OracleFeeders []string `protobuf:"bytes,10,rep,name=oracle_feeders,json=oracleFeeders,proto3" json:"oracle_feeders,omitempty"`
+	// oracle_window_blocks is how often submissions are aggregated. Only
+	// submissions made within the window count.
+	OracleWindowBlocks uint64 `protobuf:"varint,11,opt,name=oracle_window_blocks,json=oracleWindowBlocks,proto3" json:"oracle_window_blocks,omitempty"`
+	// oracle_min_submissions is the fewest feeders a pair needs in a window
+	// for a new rate to be set.
+	OracleMinSubmissions uint32 `protobuf:"varint,12,opt,name=oracle_min_submissions,json=oracleMinSubmissions,proto3" json:"oracle_min_submissions,omitempty"`
+	// oracle_max_rate_age_blocks is how long an aggregated rate may be used.
+	OracleMaxRateAgeBlocks uint64 `protobuf:"varint,13,opt,name=oracle_max_rate_age_blocks,json=oracleMaxRateAgeBlocks,proto3" json:"oracle_max_rate_age_blocks,omitempty"`
+	// attestors are the accredited addresses that may record KYC attestations.
+	Attestors []string `protobuf:"bytes,14,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	// paused is the pause matrix. Emergency admins can pause operations with
+	// MsgSetPaused; only governance can resume them.
+	Paused *PauseMatrix `protobuf:"bytes,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	// emergency_admins are the admins allowed to pause operations.
+	EmergencyAdmins []string `protobuf:"bytes,16,rep,name=emergency_admins,json=emergencyAdmins,proto3" json:"emergency_admins,omitempty"`
+	// transfer_denoms are the tokens ICS-20 transfer memos may spend on partner
+	// liquidity or points, and the tokens settlements may be paid with from
+	// escrow.
+	TransferDenoms []*DenomCurrency `protobuf:"bytes,17,rep,name=transfer_denoms,json=transferDenoms,proto3" json:"transfer_denoms,omitempty"`
+	// liquidity_checkpoint_interval_blocks is how often every partner's
+	// liquidity is checkpointed. Zero turns checkpoints off; checkpoints
+	// imported from genesis are then still kept, in the slots of the default
+	// interval of 14400 blocks.
+	LiquidityCheckpointIntervalBlocks uint64 `protobuf:"varint,18,opt,name=liquidity_checkpoint_interval_blocks,json=liquidityCheckpointIntervalBlocks,proto3" json:"liquidity_checkpoint_interval_blocks,omitempty"`
+	// liquidity_checkpoint_retention is how many checkpoints are kept per
+	// partner. Newer checkpoints overwrite the oldest.
+	LiquidityCheckpointRetention uint32 `protobuf:"varint,19,opt,name=liquidity_checkpoint_retention,json=liquidityCheckpointRetention,proto3" json:"liquidity_checkpoint_retention,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rewardchain_rewardchain_params_proto_msgTypes[0]
+		mi := &file_rewardchain_rewardchain_params_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -535,7 +3327,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_rewardchain_rewardchain_params_proto_rawDescGZIP(), []int{0}
+	return file_rewardchain_rewardchain_params_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Params) GetAdminAddresses() []string {
@@ -545,6 +3337,132 @@ func (x *Params) GetAdminAddresses() []string {
 	return nil
 }
 
+func (x *Params) GetMaxBatchEarnEntries() uint32 {
+	if x != nil {
+		return x.MaxBatchEarnEntries
+	}
+	return 0
+}
+
+func (x *Params) GetClientRefTtlBlocks() uint64 {
+	if x != nil {
+		return x.ClientRefTtlBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetSettlementEpochBlocks() uint64 {
+	if x != nil {
+		return x.SettlementEpochBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetSwapFeeRate() string {
+	if x != nil {
+		return x.SwapFeeRate
+	}
+	return ""
+}
+
+func (x *Params) GetRedeemFeeRate() string {
+	if x != nil {
+		return x.RedeemFeeRate
+	}
+	return ""
+}
+
+func (x *Params) GetFeeDenom() string {
+	if x != nil {
+		return x.FeeDenom
+	}
+	return ""
+}
+
+func (x *Params) GetFeeRecipient() FeeRecipient {
+	if x != nil {
+		return x.FeeRecipient
+	}
+	return FeeRecipient_FEE_RECIPIENT_UNSPECIFIED
+}
+
+func (x *Params) GetFeeTreasury() string {
+	if x != nil {
+		return x.FeeTreasury
+	}
+	return ""
+}
+
+func (x *Params) GetOracleFeeders() []string {
+	if x != nil {
+		return x.OracleFeeders
+	}
+	return nil
+}
+
+func (x *Params) GetOracleWindowBlocks() uint64 {
+	if x != nil {
+		return x.OracleWindowBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetOracleMinSubmissions() uint32 {
+	if x != nil {
+		return x.OracleMinSubmissions
+	}
+	return 0
+}
+
+func (x *Params) GetOracleMaxRateAgeBlocks() uint64 {
+	if x != nil {
+		return x.OracleMaxRateAgeBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetAttestors() []string {
+	if x != nil {
+		return x.Attestors
+	}
+	return nil
+}
+
+func (x *Params) GetPaused() *PauseMatrix {
+	if x != nil {
+		return x.Paused
+	}
+	return nil
+}
+
+func (x *Params) GetEmergencyAdmins() []string {
+	if x != nil {
+		return x.EmergencyAdmins
+	}
+	return nil
+}
+
+func (x *Params) GetTransferDenoms() []*DenomCurrency {
+	if x != nil {
+		return x.TransferDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetLiquidityCheckpointIntervalBlocks() uint64 {
+	if x != nil {
+		return x.LiquidityCheckpointIntervalBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetLiquidityCheckpointRetention() uint32 {
+	if x != nil {
+		return x.LiquidityCheckpointRetention
+	}
+	return 0
+}
+
 var File_rewardchain_rewardchain_params_proto protoreflect.FileDescriptor
 
 var file_rewardchain_rewardchain_params_proto_rawDesc = []byte{
@@ -556,28 +3474,138 @@ var file_rewardchain_rewardchain_params_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x76, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a,
-	0x0f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x3a, 0x29, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xd0, 0x01, 0x0a, 0x1b,
-	0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x0b, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x52, 0x52, 0x58, 0xaa, 0x02, 0x17, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0xca, 0x02, 0x17, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x23,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64,
+	0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
+	0x2f, 0x0a, 0x14, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73,
+	0x77, 0x61, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x2f, 0x0a, 0x14, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74,
+	0x6f, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x73, 0x77, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x61, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x65, 0x61, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0x63, 0x0a, 0x0d, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x9c, 0x09, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x65, 0x61, 0x72, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x45, 0x61, 0x72, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x54, 0x74, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x15, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x46, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x4a, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x66,
+	0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x66,
+	0x65, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x66, 0x65, 0x65,
+	0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x0e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x4d, 0x61, 0x78,
+	0x52, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x36, 0x0a,
+	0x09, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x65, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x65,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x55,
+	0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x24, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x21, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x44, 0x0a, 0x1e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1c,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x29, 0xe8, 0xa0,
+	0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x78, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2a, 0x89, 0x01, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x45, 0x45, 0x5f,
+	0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x45, 0x45, 0x5f, 0x52,
+	0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49,
+	0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x45, 0x45,
+	0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x45, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x52, 0x45, 0x41, 0x53, 0x55, 0x52, 0x59, 0x10, 0x03, 0x1a, 0x04, 0x88,
+	0xa3, 0x1e, 0x00, 0x2a, 0xd9, 0x01, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x41, 0x52, 0x54, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x4c, 0x49, 0x51, 0x55,
+	0x49, 0x44, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53,
+	0x5f, 0x54, 0x4f, 0x5f, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x54, 0x4f,
+	0x4b, 0x45, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x53, 0x10, 0x04, 0x12,
+	0x12, 0x0a, 0x0e, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x41, 0x52,
+	0x4e, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x44, 0x45, 0x45, 0x4d, 0x10, 0x06, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42,
+	0xd0, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42,
+	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x27,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x52, 0x52, 0x58, 0xaa, 0x02, 0x17,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x17, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0xe2, 0x02, 0x23, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -592,16 +3620,24 @@ func file_rewardchain_rewardchain_params_proto_rawDescGZIP() []byte {
 	return file_rewardchain_rewardchain_params_proto_rawDescData
 }
 
-var file_rewardchain_rewardchain_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rewardchain_rewardchain_params_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rewardchain_rewardchain_params_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_rewardchain_rewardchain_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: rewardchain.rewardchain.Params
+	(FeeRecipient)(0),     // 0: rewardchain.rewardchain.FeeRecipient
+	(Operation)(0),        // 1: rewardchain.rewardchain.Operation
+	(*PauseMatrix)(nil),   // 2: rewardchain.rewardchain.PauseMatrix
+	(*DenomCurrency)(nil), // 3: rewardchain.rewardchain.DenomCurrency
+	(*Params)(nil),        // 4: rewardchain.rewardchain.Params
 }
 var file_rewardchain_rewardchain_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: rewardchain.rewardchain.Params.fee_recipient:type_name -> rewardchain.rewardchain.FeeRecipient
+	2, // 1: rewardchain.rewardchain.Params.paused:type_name -> rewardchain.rewardchain.PauseMatrix
+	3, // 2: rewardchain.rewardchain.Params.transfer_denoms:type_name -> rewardchain.rewardchain.DenomCurrency
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_rewardchain_rewardchain_params_proto_init() }
@@ -611,6 +3647,30 @@ func file_rewardchain_rewardchain_params_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_rewardchain_rewardchain_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseMatrix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rewardchain_rewardchain_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomCurrency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rewardchain_rewardchain_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -628,13 +3688,14 @@ func file_rewardchain_rewardchain_params_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rewardchain_rewardchain_params_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_rewardchain_rewardchain_params_proto_goTypes,
 		DependencyIndexes: file_rewardchain_rewardchain_params_proto_depIdxs,
+		EnumInfos:         file_rewardchain_rewardchain_params_proto_enumTypes,
 		MessageInfos:      file_rewardchain_rewardchain_params_proto_msgTypes,
 	}.Build()
 	File_rewardchain_rewardchain_params_proto = out.File
@@ -19,9 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/rewardchain.rewardchain.Query/Params"
-	Query_Partner_FullMethodName  = "/rewardchain.rewardchain.Query/Partner"
-	Query_Partners_FullMethodName = "/rewardchain.rewardchain.Query/Partners"
+	Query_Params_FullMethodName                  = "/rewardchain.rewardchain.Query/Params"
+	Query_Partner_FullMethodName                 = "/rewardchain.rewardchain.Query/Partner"
+	Query_Partners_FullMethodName                = "/rewardchain.rewardchain.Query/Partners"
+	Query_PartnerSponsorships_FullMethodName     = "/rewardchain.rewardchain.Query/PartnerSponsorships"
+	Query_MemberBalance_FullMethodName           = "/rewardchain.rewardchain.Query/MemberBalance"
+	Query_ReceiptKey_FullMethodName              = "/rewardchain.rewardchain.Query/ReceiptKey"
+	Query_ClientRef_FullMethodName               = "/rewardchain.rewardchain.Query/ClientRef"
+	Query_Settlement_FullMethodName              = "/rewardchain.rewardchain.Query/Settlement"
+	Query_PartnerSettlements_FullMethodName      = "/rewardchain.rewardchain.Query/PartnerSettlements"
+	Query_SettlementEscrow_FullMethodName        = "/rewardchain.rewardchain.Query/SettlementEscrow"
+	Query_ExchangeRate_FullMethodName            = "/rewardchain.rewardchain.Query/ExchangeRate"
+	Query_ExchangeRates_FullMethodName           = "/rewardchain.rewardchain.Query/ExchangeRates"
+	Query_TransferPolicy_FullMethodName          = "/rewardchain.rewardchain.Query/TransferPolicy"
+	Query_MemberTransfers_FullMethodName         = "/rewardchain.rewardchain.Query/MemberTransfers"
+	Query_VelocityLimits_FullMethodName          = "/rewardchain.rewardchain.Query/VelocityLimits"
+	Query_MemberVelocity_FullMethodName          = "/rewardchain.rewardchain.Query/MemberVelocity"
+	Query_Attestations_FullMethodName            = "/rewardchain.rewardchain.Query/Attestations"
+	Query_KycRequirement_FullMethodName          = "/rewardchain.rewardchain.Query/KycRequirement"
+	Query_BlockedAddress_FullMethodName          = "/rewardchain.rewardchain.Query/BlockedAddress"
+	Query_BlockedAddresses_FullMethodName        = "/rewardchain.rewardchain.Query/BlockedAddresses"
+	Query_LiquidityStatus_FullMethodName         = "/rewardchain.rewardchain.Query/LiquidityStatus"
+	Query_PartnerLiquidityHistory_FullMethodName = "/rewardchain.rewardchain.Query/PartnerLiquidityHistory"
+	Query_PauseStatus_FullMethodName             = "/rewardchain.rewardchain.Query/PauseStatus"
+	Query_PointsEscrow_FullMethodName            = "/rewardchain.rewardchain.Query/PointsEscrow"
+	Query_PointsVouchers_FullMethodName          = "/rewardchain.rewardchain.Query/PointsVouchers"
+	Query_RemoteTreasury_FullMethodName          = "/rewardchain.rewardchain.Query/RemoteTreasury"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +57,61 @@ type QueryClient interface {
 	Partner(ctx context.Context, in *QueryPartnerRequest, opts ...grpc.CallOption) (*QueryPartnerResponse, error)
 	// Partners lists partners.
 	Partners(ctx context.Context, in *QueryPartnersRequest, opts ...grpc.CallOption) (*QueryPartnersResponse, error)
+	// PartnerSponsorships lists a partner's member sponsorships together with
+	// the fee allowance each member has left.
+	PartnerSponsorships(ctx context.Context, in *QueryPartnerSponsorshipsRequest, opts ...grpc.CallOption) (*QueryPartnerSponsorshipsResponse, error)
+	// MemberBalance queries a member's points balance with a partner.
+	MemberBalance(ctx context.Context, in *QueryMemberBalanceRequest, opts ...grpc.CallOption) (*QueryMemberBalanceResponse, error)
+	// ReceiptKey queries the key a partner signs earn receipts with.
+	ReceiptKey(ctx context.Context, in *QueryReceiptKeyRequest, opts ...grpc.CallOption) (*QueryReceiptKeyResponse, error)
+	// ClientRef queries the stored outcome of a message sent with a client_ref.
+	ClientRef(ctx context.Context, in *QueryClientRefRequest, opts ...grpc.CallOption) (*QueryClientRefResponse, error)
+	// Settlement queries a settlement by id.
+	Settlement(ctx context.Context, in *QuerySettlementRequest, opts ...grpc.CallOption) (*QuerySettlementResponse, error)
+	// PartnerSettlements lists the open or closed settlements a partner pays
+	// or receives.
+	PartnerSettlements(ctx context.Context, in *QueryPartnerSettlementsRequest, opts ...grpc.CallOption) (*QueryPartnerSettlementsResponse, error)
+	// SettlementEscrow queries the coins a partner holds in settlement escrow.
+	SettlementEscrow(ctx context.Context, in *QuerySettlementEscrowRequest, opts ...grpc.CallOption) (*QuerySettlementEscrowResponse, error)
+	// ExchangeRate queries the rate between two currencies, derived from the
+	// inverse pair when only that one is fed.
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRates lists the aggregated exchange rates.
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// TransferPolicy queries a partner's member transfer policy.
+	TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error)
+	// MemberTransfers lists the transfers a member sent or received, oldest
+	// first within each partner. partner_id 0 lists transfers with all
+	// partners.
+	MemberTransfers(ctx context.Context, in *QueryMemberTransfersRequest, opts ...grpc.CallOption) (*QueryMemberTransfersResponse, error)
+	// VelocityLimits queries a partner's redeem velocity limits.
+	VelocityLimits(ctx context.Context, in *QueryVelocityLimitsRequest, opts ...grpc.CallOption) (*QueryVelocityLimitsResponse, error)
+	// MemberVelocity queries how much a member redeemed against a partner's
+	// velocity limits, its cool-down and whether it is frozen.
+	MemberVelocity(ctx context.Context, in *QueryMemberVelocityRequest, opts ...grpc.CallOption) (*QueryMemberVelocityResponse, error)
+	// Attestations lists the attestations recorded for a member.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// KycRequirement queries a partner's KYC requirement for payouts.
+	KycRequirement(ctx context.Context, in *QueryKycRequirementRequest, opts ...grpc.CallOption) (*QueryKycRequirementResponse, error)
+	// BlockedAddress queries whether an address is on the blocklist.
+	BlockedAddress(ctx context.Context, in *QueryBlockedAddressRequest, opts ...grpc.CallOption) (*QueryBlockedAddressResponse, error)
+	// BlockedAddresses lists the blocklist.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
+	// LiquidityStatus queries a partner's liquidity thresholds, current level
+	// and whether earning is paused.
+	LiquidityStatus(ctx context.Context, in *QueryLiquidityStatusRequest, opts ...grpc.CallOption) (*QueryLiquidityStatusResponse, error)
+	// PartnerLiquidityHistory queries the liquidity checkpoints of a partner
+	// between two heights, oldest first.
+	PartnerLiquidityHistory(ctx context.Context, in *QueryPartnerLiquidityHistoryRequest, opts ...grpc.CallOption) (*QueryPartnerLiquidityHistoryResponse, error)
+	// PauseStatus queries the pause matrix.
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
+	// PointsEscrow queries the points escrowed for a partner on a channel.
+	PointsEscrow(ctx context.Context, in *QueryPointsEscrowRequest, opts ...grpc.CallOption) (*QueryPointsEscrowResponse, error)
+	// PointsVouchers lists the points vouchers a member holds.
+	PointsVouchers(ctx context.Context, in *QueryPointsVouchersRequest, opts ...grpc.CallOption) (*QueryPointsVouchersResponse, error)
+	// RemoteTreasury queries a partner's remote treasury and its interchain
+	// account.
+	RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +149,213 @@ func (c *queryClient) Partners(ctx context.Context, in *QueryPartnersRequest, op
 	return out, nil
 }
 
+func (c *queryClient) PartnerSponsorships(ctx context.Context, in *QueryPartnerSponsorshipsRequest, opts ...grpc.CallOption) (*QueryPartnerSponsorshipsResponse, error) {
+	out := new(QueryPartnerSponsorshipsResponse)
+	err := c.cc.Invoke(ctx, Query_PartnerSponsorships_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberBalance(ctx context.Context, in *QueryMemberBalanceRequest, opts ...grpc.CallOption) (*QueryMemberBalanceResponse, error) {
+	out := new(QueryMemberBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_MemberBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReceiptKey(ctx context.Context, in *QueryReceiptKeyRequest, opts ...grpc.CallOption) (*QueryReceiptKeyResponse, error) {
+	out := new(QueryReceiptKeyResponse)
+	err := c.cc.Invoke(ctx, Query_ReceiptKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientRef(ctx context.Context, in *QueryClientRefRequest, opts ...grpc.CallOption) (*QueryClientRefResponse, error) {
+	out := new(QueryClientRefResponse)
+	err := c.cc.Invoke(ctx, Query_ClientRef_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Settlement(ctx context.Context, in *QuerySettlementRequest, opts ...grpc.CallOption) (*QuerySettlementResponse, error) {
+	out := new(QuerySettlementResponse)
+	err := c.cc.Invoke(ctx, Query_Settlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PartnerSettlements(ctx context.Context, in *QueryPartnerSettlementsRequest, opts ...grpc.CallOption) (*QueryPartnerSettlementsResponse, error) {
+	out := new(QueryPartnerSettlementsResponse)
+	err := c.cc.Invoke(ctx, Query_PartnerSettlements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementEscrow(ctx context.Context, in *QuerySettlementEscrowRequest, opts ...grpc.CallOption) (*QuerySettlementEscrowResponse, error) {
+	out := new(QuerySettlementEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_SettlementEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, Query_ExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, Query_ExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error) {
+	out := new(QueryTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_TransferPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberTransfers(ctx context.Context, in *QueryMemberTransfersRequest, opts ...grpc.CallOption) (*QueryMemberTransfersResponse, error) {
+	out := new(QueryMemberTransfersResponse)
+	err := c.cc.Invoke(ctx, Query_MemberTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VelocityLimits(ctx context.Context, in *QueryVelocityLimitsRequest, opts ...grpc.CallOption) (*QueryVelocityLimitsResponse, error) {
+	out := new(QueryVelocityLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_VelocityLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberVelocity(ctx context.Context, in *QueryMemberVelocityRequest, opts ...grpc.CallOption) (*QueryMemberVelocityResponse, error) {
+	out := new(QueryMemberVelocityResponse)
+	err := c.cc.Invoke(ctx, Query_MemberVelocity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, Query_Attestations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KycRequirement(ctx context.Context, in *QueryKycRequirementRequest, opts ...grpc.CallOption) (*QueryKycRequirementResponse, error) {
+	out := new(QueryKycRequirementResponse)
+	err := c.cc.Invoke(ctx, Query_KycRequirement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddress(ctx context.Context, in *QueryBlockedAddressRequest, opts ...grpc.CallOption) (*QueryBlockedAddressResponse, error) {
+	out := new(QueryBlockedAddressResponse)
+	err := c.cc.Invoke(ctx, Query_BlockedAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_BlockedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityStatus(ctx context.Context, in *QueryLiquidityStatusRequest, opts ...grpc.CallOption) (*QueryLiquidityStatusResponse, error) {
+	out := new(QueryLiquidityStatusResponse)
+	err := c.cc.Invoke(ctx, Query_LiquidityStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PartnerLiquidityHistory(ctx context.Context, in *QueryPartnerLiquidityHistoryRequest, opts ...grpc.CallOption) (*QueryPartnerLiquidityHistoryResponse, error) {
+	out := new(QueryPartnerLiquidityHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PartnerLiquidityHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, Query_PauseStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PointsEscrow(ctx context.Context, in *QueryPointsEscrowRequest, opts ...grpc.CallOption) (*QueryPointsEscrowResponse, error) {
+	out := new(QueryPointsEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_PointsEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PointsVouchers(ctx context.Context, in *QueryPointsVouchersRequest, opts ...grpc.CallOption) (*QueryPointsVouchersResponse, error) {
+	out := new(QueryPointsVouchersResponse)
+	err := c.cc.Invoke(ctx, Query_PointsVouchers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error) {
+	out := new(QueryRemoteTreasuryResponse)
+	err := c.cc.Invoke(ctx, Query_RemoteTreasury_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +366,61 @@ type QueryServer interface {
 	Partner(context.Context, *QueryPartnerRequest) (*QueryPartnerResponse, error)
 	// Partners lists partners.
 	Partners(context.Context, *QueryPartnersRequest) (*QueryPartnersResponse, error)
+	// PartnerSponsorships lists a partner's member sponsorships together with
+	// the fee allowance each member has left.
+	PartnerSponsorships(context.Context, *QueryPartnerSponsorshipsRequest) (*QueryPartnerSponsorshipsResponse, error)
+	// MemberBalance queries a member's points balance with a partner.
+	MemberBalance(context.Context, *QueryMemberBalanceRequest) (*QueryMemberBalanceResponse, error)
+	// ReceiptKey queries the key a partner signs earn receipts with.
+	ReceiptKey(context.Context, *QueryReceiptKeyRequest) (*QueryReceiptKeyResponse, error)
+	// ClientRef queries the stored outcome of a message sent with a client_ref.
+	ClientRef(context.Context, *QueryClientRefRequest) (*QueryClientRefResponse, error)
+	// Settlement queries a settlement by id.
+	Settlement(context.Context, *QuerySettlementRequest) (*QuerySettlementResponse, error)
+	// PartnerSettlements lists the open or closed settlements a partner pays
+	// or receives.
+	PartnerSettlements(context.Context, *QueryPartnerSettlementsRequest) (*QueryPartnerSettlementsResponse, error)
+	// SettlementEscrow queries the coins a partner holds in settlement escrow.
+	SettlementEscrow(context.Context, *QuerySettlementEscrowRequest) (*QuerySettlementEscrowResponse, error)
+	// ExchangeRate queries the rate between two currencies, derived from the
+	// inverse pair when only that one is fed.
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRates lists the aggregated exchange rates.
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// TransferPolicy queries a partner's member transfer policy.
+	TransferPolicy(context.Context, *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error)
+	// MemberTransfers lists the transfers a member sent or received, oldest
+	// first within each partner. partner_id 0 lists transfers with all
+	// partners.
+	MemberTransfers(context.Context, *QueryMemberTransfersRequest) (*QueryMemberTransfersResponse, error)
+	// VelocityLimits queries a partner's redeem velocity limits.
+	VelocityLimits(context.Context, *QueryVelocityLimitsRequest) (*QueryVelocityLimitsResponse, error)
+	// MemberVelocity queries how much a member redeemed against a partner's
+	// velocity limits, its cool-down and whether it is frozen.
+	MemberVelocity(context.Context, *QueryMemberVelocityRequest) (*QueryMemberVelocityResponse, error)
+	// Attestations lists the attestations recorded for a member.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	// KycRequirement queries a partner's KYC requirement for payouts.
+	KycRequirement(context.Context, *QueryKycRequirementRequest) (*QueryKycRequirementResponse, error)
+	// BlockedAddress queries whether an address is on the blocklist.
+	BlockedAddress(context.Context, *QueryBlockedAddressRequest) (*QueryBlockedAddressResponse, error)
+	// BlockedAddresses lists the blocklist.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
+	// LiquidityStatus queries a partner's liquidity thresholds, current level
+	// and whether earning is paused.
+	LiquidityStatus(context.Context, *QueryLiquidityStatusRequest) (*QueryLiquidityStatusResponse, error)
+	// PartnerLiquidityHistory queries the liquidity checkpoints of a partner
+	// between two heights, oldest first.
+	PartnerLiquidityHistory(context.Context, *QueryPartnerLiquidityHistoryRequest) (*QueryPartnerLiquidityHistoryResponse, error)
+	// PauseStatus queries the pause matrix.
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
+	// PointsEscrow queries the points escrowed for a partner on a channel.
+	PointsEscrow(context.Context, *QueryPointsEscrowRequest) (*QueryPointsEscrowResponse, error)
+	// PointsVouchers lists the points vouchers a member holds.
+	PointsVouchers(context.Context, *QueryPointsVouchersRequest) (*QueryPointsVouchersResponse, error)
+	// RemoteTreasury queries a partner's remote treasury and its interchain
+	// account.
+	RemoteTreasury(context.Context, *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +437,75 @@ func (UnimplementedQueryServer) Partner(context.Context, *QueryPartnerRequest) (
 func (UnimplementedQueryServer) Partners(context.Context, *QueryPartnersRequest) (*QueryPartnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Partners not implemented")
 }
+func (UnimplementedQueryServer) PartnerSponsorships(context.Context, *QueryPartnerSponsorshipsRequest) (*QueryPartnerSponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartnerSponsorships not implemented")
+}
+func (UnimplementedQueryServer) MemberBalance(context.Context, *QueryMemberBalanceRequest) (*QueryMemberBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberBalance not implemented")
+}
+func (UnimplementedQueryServer) ReceiptKey(context.Context, *QueryReceiptKeyRequest) (*QueryReceiptKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiptKey not implemented")
+}
+func (UnimplementedQueryServer) ClientRef(context.Context, *QueryClientRefRequest) (*QueryClientRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientRef not implemented")
+}
+func (UnimplementedQueryServer) Settlement(context.Context, *QuerySettlementRequest) (*QuerySettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Settlement not implemented")
+}
+func (UnimplementedQueryServer) PartnerSettlements(context.Context, *QueryPartnerSettlementsRequest) (*QueryPartnerSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartnerSettlements not implemented")
+}
+func (UnimplementedQueryServer) SettlementEscrow(context.Context, *QuerySettlementEscrowRequest) (*QuerySettlementEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementEscrow not implemented")
+}
+func (UnimplementedQueryServer) ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (UnimplementedQueryServer) ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+}
+func (UnimplementedQueryServer) TransferPolicy(context.Context, *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicy not implemented")
+}
+func (UnimplementedQueryServer) MemberTransfers(context.Context, *QueryMemberTransfersRequest) (*QueryMemberTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberTransfers not implemented")
+}
+func (UnimplementedQueryServer) VelocityLimits(context.Context, *QueryVelocityLimitsRequest) (*QueryVelocityLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VelocityLimits not implemented")
+}
+func (UnimplementedQueryServer) MemberVelocity(context.Context, *QueryMemberVelocityRequest) (*QueryMemberVelocityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberVelocity not implemented")
+}
+func (UnimplementedQueryServer) Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (UnimplementedQueryServer) KycRequirement(context.Context, *QueryKycRequirementRequest) (*QueryKycRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KycRequirement not implemented")
+}
+func (UnimplementedQueryServer) BlockedAddress(context.Context, *QueryBlockedAddressRequest) (*QueryBlockedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddress not implemented")
+}
+func (UnimplementedQueryServer) BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
+func (UnimplementedQueryServer) LiquidityStatus(context.Context, *QueryLiquidityStatusRequest) (*QueryLiquidityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityStatus not implemented")
+}
+func (UnimplementedQueryServer) PartnerLiquidityHistory(context.Context, *QueryPartnerLiquidityHistoryRequest) (*QueryPartnerLiquidityHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartnerLiquidityHistory not implemented")
+}
+func (UnimplementedQueryServer) PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
+func (UnimplementedQueryServer) PointsEscrow(context.Context, *QueryPointsEscrowRequest) (*QueryPointsEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PointsEscrow not implemented")
+}
+func (UnimplementedQueryServer) PointsVouchers(context.Context, *QueryPointsVouchersRequest) (*QueryPointsVouchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PointsVouchers not implemented")
+}
+func (UnimplementedQueryServer) RemoteTreasury(context.Context, *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteTreasury not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +573,420 @@ func _Query_Partners_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PartnerSponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnerSponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartnerSponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PartnerSponsorships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartnerSponsorships(ctx, req.(*QueryPartnerSponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MemberBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberBalance(ctx, req.(*QueryMemberBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReceiptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReceiptKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ReceiptKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReceiptKey(ctx, req.(*QueryReceiptKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClientRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientRef(ctx, req.(*QueryClientRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Settlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Settlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Settlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Settlement(ctx, req.(*QuerySettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PartnerSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnerSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartnerSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PartnerSettlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartnerSettlements(ctx, req.(*QueryPartnerSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SettlementEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementEscrow(ctx, req.(*QuerySettlementEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPolicy(ctx, req.(*QueryTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MemberTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberTransfers(ctx, req.(*QueryMemberTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VelocityLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVelocityLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VelocityLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VelocityLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VelocityLimits(ctx, req.(*QueryVelocityLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MemberVelocity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberVelocity(ctx, req.(*QueryMemberVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Attestations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KycRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKycRequirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KycRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KycRequirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KycRequirement(ctx, req.(*QueryKycRequirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockedAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddress(ctx, req.(*QueryBlockedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiquidityStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityStatus(ctx, req.(*QueryLiquidityStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PartnerLiquidityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnerLiquidityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartnerLiquidityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PartnerLiquidityHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartnerLiquidityHistory(ctx, req.(*QueryPartnerLiquidityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PauseStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PointsEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPointsEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PointsEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PointsEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PointsEscrow(ctx, req.(*QueryPointsEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PointsVouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPointsVouchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PointsVouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PointsVouchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PointsVouchers(ctx, req.(*QueryPointsVouchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemoteTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RemoteTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteTreasury(ctx, req.(*QueryRemoteTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +1006,98 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Partners",
 			Handler:    _Query_Partners_Handler,
 		},
+		{
+			MethodName: "PartnerSponsorships",
+			Handler:    _Query_PartnerSponsorships_Handler,
+		},
+		{
+			MethodName: "MemberBalance",
+			Handler:    _Query_MemberBalance_Handler,
+		},
+		{
+			MethodName: "ReceiptKey",
+			Handler:    _Query_ReceiptKey_Handler,
+		},
+		{
+			MethodName: "ClientRef",
+			Handler:    _Query_ClientRef_Handler,
+		},
+		{
+			MethodName: "Settlement",
+			Handler:    _Query_Settlement_Handler,
+		},
+		{
+			MethodName: "PartnerSettlements",
+			Handler:    _Query_PartnerSettlements_Handler,
+		},
+		{
+			MethodName: "SettlementEscrow",
+			Handler:    _Query_SettlementEscrow_Handler,
+		},
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "TransferPolicy",
+			Handler:    _Query_TransferPolicy_Handler,
+		},
+		{
+			MethodName: "MemberTransfers",
+			Handler:    _Query_MemberTransfers_Handler,
+		},
+		{
+			MethodName: "VelocityLimits",
+			Handler:    _Query_VelocityLimits_Handler,
+		},
+		{
+			MethodName: "MemberVelocity",
+			Handler:    _Query_MemberVelocity_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "KycRequirement",
+			Handler:    _Query_KycRequirement_Handler,
+		},
+		{
+			MethodName: "BlockedAddress",
+			Handler:    _Query_BlockedAddress_Handler,
+		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
+		{
+			MethodName: "LiquidityStatus",
+			Handler:    _Query_LiquidityStatus_Handler,
+		},
+		{
+			MethodName: "PartnerLiquidityHistory",
+			Handler:    _Query_PartnerLiquidityHistory_Handler,
+		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
+		{
+			MethodName: "PointsEscrow",
+			Handler:    _Query_PointsEscrow_Handler,
+		},
+		{
+			MethodName: "PointsVouchers",
+			Handler:    _Query_PointsVouchers_Handler,
+		},
+		{
+			MethodName: "RemoteTreasury",
+			Handler:    _Query_RemoteTreasury_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rewardchain/rewardchain/query.proto",
@@ -0,0 +1,1170 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package rewardchain
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_RemoteTreasury                  protoreflect.MessageDescriptor
+	fd_RemoteTreasury_partner_id       protoreflect.FieldDescriptor
+	fd_RemoteTreasury_connection_id    protoreflect.FieldDescriptor
+	fd_RemoteTreasury_owner            protoreflect.FieldDescriptor
+	fd_RemoteTreasury_escrow_address   protoreflect.FieldDescriptor
+	fd_RemoteTreasury_denom            protoreflect.FieldDescriptor
+	fd_RemoteTreasury_top_up_threshold protoreflect.FieldDescriptor
+	fd_RemoteTreasury_top_up_amount    protoreflect.FieldDescriptor
+	fd_RemoteTreasury_pending_sequence protoreflect.FieldDescriptor
+	fd_RemoteTreasury_pending_amount   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_rewardchain_rewardchain_remote_treasury_proto_init()
+	md_RemoteTreasury = File_rewardchain_rewardchain_remote_treasury_proto.Messages().ByName("RemoteTreasury")
+	fd_RemoteTreasury_partner_id = md_RemoteTreasury.Fields().ByName("partner_id")
+	fd_RemoteTreasury_connection_id = md_RemoteTreasury.Fields().ByName("connection_id")
+	fd_RemoteTreasury_owner = md_RemoteTreasury.Fields().ByName("owner")
+	fd_RemoteTreasury_escrow_address = md_RemoteTreasury.Fields().ByName("escrow_address")
+	fd_RemoteTreasury_denom = md_RemoteTreasury.Fields().ByName("denom")
+	fd_RemoteTreasury_top_up_threshold = md_RemoteTreasury.Fields().ByName("top_up_threshold")
+	fd_RemoteTreasury_top_up_amount = md_RemoteTreasury.Fields().ByName("top_up_amount")
+	fd_RemoteTreasury_pending_sequence = md_RemoteTreasury.Fields().ByName("pending_sequence")
+	fd_RemoteTreasury_pending_amount = md_RemoteTreasury.Fields().ByName("pending_amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_RemoteTreasury)(nil)
+
+type fastReflection_RemoteTreasury RemoteTreasury
+
+func (x *RemoteTreasury) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RemoteTreasury)(x)
+}
+
+func (x *RemoteTreasury) slowProtoReflect() protoreflect.Message {
+	mi := &file_rewardchain_rewardchain_remote_treasury_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RemoteTreasury_messageType fastReflection_RemoteTreasury_messageType
+var _ protoreflect.MessageType = fastReflection_RemoteTreasury_messageType{}
+
+type fastReflection_RemoteTreasury_messageType struct{}
+
+func (x fastReflection_RemoteTreasury_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RemoteTreasury)(nil)
+}
+func (x fastReflection_RemoteTreasury_messageType) New() protoreflect.Message {
+	return new(fastReflection_RemoteTreasury)
+}
+func (x fastReflection_RemoteTreasury_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteTreasury
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RemoteTreasury) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteTreasury
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RemoteTreasury) Type() protoreflect.MessageType {
+	return _fastReflection_RemoteTreasury_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RemoteTreasury) New() protoreflect.Message {
+	return new(fastReflection_RemoteTreasury)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RemoteTreasury) Interface() protoreflect.ProtoMessage {
+	return (*RemoteTreasury)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RemoteTreasury) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PartnerId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PartnerId)
+		if !f(fd_RemoteTreasury_partner_id, value) {
+			return
+		}
+	}
+	if x.ConnectionId != "" {
+		value := protoreflect.ValueOfString(x.ConnectionId)
+		if !f(fd_RemoteTreasury_connection_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_RemoteTreasury_owner, value) {
+			return
+		}
+	}
+	if x.EscrowAddress != "" {
+		value := protoreflect.ValueOfString(x.EscrowAddress)
+		if !f(fd_RemoteTreasury_escrow_address, value) {
+			return
+		}
+	}
+	if x.Denom != nil {
+		value := protoreflect.ValueOfMessage(x.Denom.ProtoReflect())
+		if !f(fd_RemoteTreasury_denom, value) {
+			return
+		}
+	}
+	if x.TopUpThreshold != "" {
+		value := protoreflect.ValueOfString(x.TopUpThreshold)
+		if !f(fd_RemoteTreasury_top_up_threshold, value) {
+			return
+		}
+	}
+	if x.TopUpAmount != "" {
+		value := protoreflect.ValueOfString(x.TopUpAmount)
+		if !f(fd_RemoteTreasury_top_up_amount, value) {
+			return
+		}
+	}
+	if x.PendingSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PendingSequence)
+		if !f(fd_RemoteTreasury_pending_sequence, value) {
+			return
+		}
+	}
+	if x.PendingAmount != "" {
+		value := protoreflect.ValueOfString(x.PendingAmount)
+		if !f(fd_RemoteTreasury_pending_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RemoteTreasury) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.RemoteTreasury.partner_id":
+		return x.PartnerId != uint64(0)
+	case "rewardchain.rewardchain.RemoteTreasury.connection_id":
+		return x.ConnectionId != ""
+	case "rewardchain.rewardchain.RemoteTreasury.owner":
+		return x.Owner != ""
+	case "rewardchain.rewardchain.RemoteTreasury.escrow_address":
+		return x.EscrowAddress != ""
+	case "rewardchain.rewardchain.RemoteTreasury.denom":
+		return x.Denom != nil
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_threshold":
+		return x.TopUpThreshold != ""
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_amount":
+		return x.TopUpAmount != ""
+	case "rewardchain.rewardchain.RemoteTreasury.pending_sequence":
+		return x.PendingSequence != uint64(0)
+	case "rewardchain.rewardchain.RemoteTreasury.pending_amount":
+		return x.PendingAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.RemoteTreasury"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.RemoteTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTreasury) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.RemoteTreasury.partner_id":
+		x.PartnerId = uint64(0)
+	case "rewardchain.rewardchain.RemoteTreasury.connection_id":
+		x.ConnectionId = ""
+	case "rewardchain.rewardchain.RemoteTreasury.owner":
+		x.Owner = ""
+	case "rewardchain.rewardchain.RemoteTreasury.escrow_address":
+		x.EscrowAddress = ""
+	case "rewardchain.rewardchain.RemoteTreasury.denom":
+		x.Denom = nil
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_threshold":
+		x.TopUpThreshold = ""
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_amount":
+		x.TopUpAmount = ""
+	case "rewardchain.rewardchain.RemoteTreasury.pending_sequence":
+		x.PendingSequence = uint64(0)
+	case "rewardchain.rewardchain.RemoteTreasury.pending_amount":
+		x.PendingAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.RemoteTreasury"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.RemoteTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RemoteTreasury) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "rewardchain.rewardchain.RemoteTreasury.partner_id":
+		value := x.PartnerId
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.RemoteTreasury.connection_id":
+		value := x.ConnectionId
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.RemoteTreasury.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.RemoteTreasury.escrow_address":
+		value := x.EscrowAddress
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.RemoteTreasury.denom":
+		value := x.Denom
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_threshold":
+		value := x.TopUpThreshold
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_amount":
+		value := x.TopUpAmount
+		return protoreflect.ValueOfString(value)
+	case "rewardchain.rewardchain.RemoteTreasury.pending_sequence":
+		value := x.PendingSequence
+		return protoreflect.ValueOfUint64(value)
+	case "rewardchain.rewardchain.RemoteTreasury.pending_amount":
+		value := x.PendingAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.RemoteTreasury"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.RemoteTreasury does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTreasury) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.RemoteTreasury.partner_id":
+		x.PartnerId = value.Uint()
+	case "rewardchain.rewardchain.RemoteTreasury.connection_id":
+		x.ConnectionId = value.Interface().(string)
+	case "rewardchain.rewardchain.RemoteTreasury.owner":
+		x.Owner = value.Interface().(string)
+	case "rewardchain.rewardchain.RemoteTreasury.escrow_address":
+		x.EscrowAddress = value.Interface().(string)
+	case "rewardchain.rewardchain.RemoteTreasury.denom":
+		x.Denom = value.Message().Interface().(*DenomCurrency)
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_threshold":
+		x.TopUpThreshold = value.Interface().(string)
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_amount":
+		x.TopUpAmount = value.Interface().(string)
+	case "rewardchain.rewardchain.RemoteTreasury.pending_sequence":
+		x.PendingSequence = value.Uint()
+	case "rewardchain.rewardchain.RemoteTreasury.pending_amount":
+		x.PendingAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.RemoteTreasury"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.RemoteTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTreasury) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.RemoteTreasury.denom":
+		if x.Denom == nil {
+			x.Denom = new(DenomCurrency)
+		}
+		return protoreflect.ValueOfMessage(x.Denom.ProtoReflect())
+	case "rewardchain.rewardchain.RemoteTreasury.partner_id":
+		panic(fmt.Errorf("field partner_id of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	case "rewardchain.rewardchain.RemoteTreasury.connection_id":
+		panic(fmt.Errorf("field connection_id of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	case "rewardchain.rewardchain.RemoteTreasury.owner":
+		panic(fmt.Errorf("field owner of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	case "rewardchain.rewardchain.RemoteTreasury.escrow_address":
+		panic(fmt.Errorf("field escrow_address of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_threshold":
+		panic(fmt.Errorf("field top_up_threshold of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_amount":
+		panic(fmt.Errorf("field top_up_amount of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	case "rewardchain.rewardchain.RemoteTreasury.pending_sequence":
+		panic(fmt.Errorf("field pending_sequence of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	case "rewardchain.rewardchain.RemoteTreasury.pending_amount":
+		panic(fmt.Errorf("field pending_amount of message rewardchain.rewardchain.RemoteTreasury is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.RemoteTreasury"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.RemoteTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RemoteTreasury) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "rewardchain.rewardchain.RemoteTreasury.partner_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.RemoteTreasury.connection_id":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.RemoteTreasury.owner":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.RemoteTreasury.escrow_address":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.RemoteTreasury.denom":
+		m := new(DenomCurrency)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_threshold":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.RemoteTreasury.top_up_amount":
+		return protoreflect.ValueOfString("")
+	case "rewardchain.rewardchain.RemoteTreasury.pending_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "rewardchain.rewardchain.RemoteTreasury.pending_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: rewardchain.rewardchain.RemoteTreasury"))
+		}
+		panic(fmt.Errorf("message rewardchain.rewardchain.RemoteTreasury does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RemoteTreasury) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in rewardchain.rewardchain.RemoteTreasury", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RemoteTreasury) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTreasury) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RemoteTreasury) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RemoteTreasury) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RemoteTreasury)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PartnerId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PartnerId))
+		}
+		l = len(x.ConnectionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EscrowAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Denom != nil {
+			l = options.Size(x.Denom)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TopUpThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TopUpAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingSequence))
+		}
+		l = len(x.PendingAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteTreasury)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PendingAmount) > 0 {
+			i -= len(x.PendingAmount)
+			copy(dAtA[i:], x.PendingAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingAmount)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.PendingSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingSequence))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.TopUpAmount) > 0 {
+			i -= len(x.TopUpAmount)
+			copy(dAtA[i:], x.TopUpAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TopUpAmount)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.TopUpThreshold) > 0 {
+			i -= len(x.TopUpThreshold)
+			copy(dAtA[i:], x.TopUpThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TopUpThreshold)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Denom != nil {
+			encoded, err := options.Marshal(x.Denom)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.EscrowAddress) > 0 {
+			i -= len(x.EscrowAddress)
+			copy(dAtA[i:], x.EscrowAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EscrowAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ConnectionId) > 0 {
+			i -= len(x.ConnectionId)
+			copy(dAtA[i:], x.ConnectionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConnectionId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PartnerId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PartnerId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteTreasury)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTreasury: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+				}
+				x.PartnerId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PartnerId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ConnectionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Denom == nil {
+					x.Denom = &DenomCurrency{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Denom); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopUpThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopUpThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TopUpAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingSequence", wireType)
+				}
+				x.PendingSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: rewardchain/rewardchain/remote_treasury.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RemoteTreasury is a partner treasury kept on another chain through an
+// interchain account rewardchain controls on the partner's behalf. Top-ups
+// send the account's tokens to the escrow address on that chain and credit
+// the partner's liquidity once the transfer is acknowledged.
+type RemoteTreasury struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartnerId    uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// owner is the interchain account owner; the controller port is
+	// icacontroller-<owner>.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// escrow_address is the address on the host chain top-ups pay into.
+	EscrowAddress string `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	// denom is the host chain token sent and the currency it is worth.
+	Denom *DenomCurrency `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// top_up_threshold is the available liquidity, in points, below which a
+	// top-up is sent automatically. Empty or zero disables automatic top-ups.
+	TopUpThreshold string `protobuf:"bytes,6,opt,name=top_up_threshold,json=topUpThreshold,proto3" json:"top_up_threshold,omitempty"`
+	// top_up_amount is the amount of denom an automatic top-up sends.
+	TopUpAmount string `protobuf:"bytes,7,opt,name=top_up_amount,json=topUpAmount,proto3" json:"top_up_amount,omitempty"`
+	// pending_sequence is the packet sequence of the top-up in flight, or zero.
+	PendingSequence uint64 `protobuf:"varint,8,opt,name=pending_sequence,json=pendingSequence,proto3" json:"pending_sequence,omitempty"`
+	PendingAmount   string `protobuf:"bytes,9,opt,name=pending_amount,json=pendingAmount,proto3" json:"pending_amount,omitempty"`
+}
+
+func (x *RemoteTreasury) Reset() {
+	*x = RemoteTreasury{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rewardchain_rewardchain_remote_treasury_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteTreasury) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteTreasury) ProtoMessage() {}
+
+// Deprecated: Use RemoteTreasury.ProtoReflect.Descriptor instead.
+func (*RemoteTreasury) Descriptor() ([]byte, []int) {
+	return file_rewardchain_rewardchain_remote_treasury_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RemoteTreasury) GetPartnerId() uint64 {
+	if x != nil {
+		return x.PartnerId
+	}
+	return 0
+}
+
+func (x *RemoteTreasury) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *RemoteTreasury) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *RemoteTreasury) GetEscrowAddress() string {
+	if x != nil {
+		return x.EscrowAddress
+	}
+	return ""
+}
+
+func (x *RemoteTreasury) GetDenom() *DenomCurrency {
+	if x != nil {
+		return x.Denom
+	}
+	return nil
+}
+
+func (x *RemoteTreasury) GetTopUpThreshold() string {
+	if x != nil {
+		return x.TopUpThreshold
+	}
+	return ""
+}
+
+func (x *RemoteTreasury) GetTopUpAmount() string {
+	if x != nil {
+		return x.TopUpAmount
+	}
+	return ""
+}
+
+func (x *RemoteTreasury) GetPendingSequence() uint64 {
+	if x != nil {
+		return x.PendingSequence
+	}
+	return 0
+}
+
+func (x *RemoteTreasury) GetPendingAmount() string {
+	if x != nil {
+		return x.PendingAmount
+	}
+	return ""
+}
+
+var File_rewardchain_rewardchain_remote_treasury_proto protoreflect.FileDescriptor
+
+var file_rewardchain_rewardchain_remote_treasury_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54,
+	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x74, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x28, 0x0a, 0x10,
+	0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x6f, 0x70, 0x55, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0xd8, 0x01, 0x0a,
+	0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x13, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x27, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xa2, 0x02, 0x03, 0x52,
+	0x52, 0x58, 0xaa, 0x02, 0x17, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xca, 0x02, 0x17, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0xe2, 0x02, 0x23, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rewardchain_rewardchain_remote_treasury_proto_rawDescOnce sync.Once
+	file_rewardchain_rewardchain_remote_treasury_proto_rawDescData = file_rewardchain_rewardchain_remote_treasury_proto_rawDesc
+)
+
+func file_rewardchain_rewardchain_remote_treasury_proto_rawDescGZIP() []byte {
+	file_rewardchain_rewardchain_remote_treasury_proto_rawDescOnce.Do(func() {
+		file_rewardchain_rewardchain_remote_treasury_proto_rawDescData = protoimpl.X.CompressGZIP(file_rewardchain_rewardchain_remote_treasury_proto_rawDescData)
+	})
+	return file_rewardchain_rewardchain_remote_treasury_proto_rawDescData
+}
+
+var file_rewardchain_rewardchain_remote_treasury_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rewardchain_rewardchain_remote_treasury_proto_goTypes = []interface{}{
+	(*RemoteTreasury)(nil), // 0: rewardchain.rewardchain.RemoteTreasury
+	(*DenomCurrency)(nil),  // 1: rewardchain.rewardchain.DenomCurrency
+}
+var file_rewardchain_rewardchain_remote_treasury_proto_depIdxs = []int32{
+	1, // 0: rewardchain.rewardchain.RemoteTreasury.denom:type_name -> rewardchain.rewardchain.DenomCurrency
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rewardchain_rewardchain_remote_treasury_proto_init() }
+func file_rewardchain_rewardchain_remote_treasury_proto_init() {
+	if File_rewardchain_rewardchain_remote_treasury_proto != nil {
+		return
+	}
+	file_rewardchain_rewardchain_params_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rewardchain_rewardchain_remote_treasury_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteTreasury); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rewardchain_rewardchain_remote_treasury_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rewardchain_rewardchain_remote_treasury_proto_goTypes,
+		DependencyIndexes: file_rewardchain_rewardchain_remote_treasury_proto_depIdxs,
+		MessageInfos:      file_rewardchain_rewardchain_remote_treasury_proto_msgTypes,
+	}.Build()
+	File_rewardchain_rewardchain_remote_treasury_proto = out.File
+	file_rewardchain_rewardchain_remote_treasury_proto_rawDesc = nil
+	file_rewardchain_rewardchain_remote_treasury_proto_goTypes = nil
+	file_rewardchain_rewardchain_remote_treasury_proto_depIdxs = nil
+}
@@ -19,10 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName        = "/rewardchain.rewardchain.Msg/UpdateParams"
-	Msg_CreatePartner_FullMethodName       = "/rewardchain.rewardchain.Msg/CreatePartner"
-	Msg_AddPartnerLiquidity_FullMethodName = "/rewardchain.rewardchain.Msg/AddPartnerLiquidity"
-	Msg_Swap_FullMethodName                = "/rewardchain.rewardchain.Msg/Swap"
+	Msg_UpdateParams_FullMethodName             = "/rewardchain.rewardchain.Msg/UpdateParams"
+	Msg_CreatePartner_FullMethodName            = "/rewardchain.rewardchain.Msg/CreatePartner"
+	Msg_AddPartnerLiquidity_FullMethodName      = "/rewardchain.rewardchain.Msg/AddPartnerLiquidity"
+	Msg_Swap_FullMethodName                     = "/rewardchain.rewardchain.Msg/Swap"
+	Msg_DisablePartner_FullMethodName           = "/rewardchain.rewardchain.Msg/DisablePartner"
+	Msg_SponsorMembers_FullMethodName           = "/rewardchain.rewardchain.Msg/SponsorMembers"
+	Msg_SetReceiptKey_FullMethodName            = "/rewardchain.rewardchain.Msg/SetReceiptKey"
+	Msg_ClaimReceipt_FullMethodName             = "/rewardchain.rewardchain.Msg/ClaimReceipt"
+	Msg_BatchEarnPoints_FullMethodName          = "/rewardchain.rewardchain.Msg/BatchEarnPoints"
+	Msg_ReversePointsEarn_FullMethodName        = "/rewardchain.rewardchain.Msg/ReversePointsEarn"
+	Msg_RecordObligation_FullMethodName         = "/rewardchain.rewardchain.Msg/RecordObligation"
+	Msg_FundSettlementEscrow_FullMethodName     = "/rewardchain.rewardchain.Msg/FundSettlementEscrow"
+	Msg_WithdrawSettlementEscrow_FullMethodName = "/rewardchain.rewardchain.Msg/WithdrawSettlementEscrow"
+	Msg_ConfirmSettlement_FullMethodName        = "/rewardchain.rewardchain.Msg/ConfirmSettlement"
+	Msg_RedeemPoints_FullMethodName             = "/rewardchain.rewardchain.Msg/RedeemPoints"
+	Msg_SubmitRate_FullMethodName               = "/rewardchain.rewardchain.Msg/SubmitRate"
+	Msg_SetTransferPolicy_FullMethodName        = "/rewardchain.rewardchain.Msg/SetTransferPolicy"
+	Msg_TransferPoints_FullMethodName           = "/rewardchain.rewardchain.Msg/TransferPoints"
+	Msg_SetVelocityLimits_FullMethodName        = "/rewardchain.rewardchain.Msg/SetVelocityLimits"
+	Msg_FreezeMember_FullMethodName             = "/rewardchain.rewardchain.Msg/FreezeMember"
+	Msg_UnfreezeMember_FullMethodName           = "/rewardchain.rewardchain.Msg/UnfreezeMember"
+	Msg_RecordAttestation_FullMethodName        = "/rewardchain.rewardchain.Msg/RecordAttestation"
+	Msg_RevokeAttestation_FullMethodName        = "/rewardchain.rewardchain.Msg/RevokeAttestation"
+	Msg_SetKycRequirement_FullMethodName        = "/rewardchain.rewardchain.Msg/SetKycRequirement"
+	Msg_BlockAddress_FullMethodName             = "/rewardchain.rewardchain.Msg/BlockAddress"
+	Msg_UnblockAddress_FullMethodName           = "/rewardchain.rewardchain.Msg/UnblockAddress"
+	Msg_SetLiquidityThresholds_FullMethodName   = "/rewardchain.rewardchain.Msg/SetLiquidityThresholds"
+	Msg_SetPaused_FullMethodName                = "/rewardchain.rewardchain.Msg/SetPaused"
+	Msg_SendPoints_FullMethodName               = "/rewardchain.rewardchain.Msg/SendPoints"
+	Msg_RegisterRemoteTreasury_FullMethodName   = "/rewardchain.rewardchain.Msg/RegisterRemoteTreasury"
+	Msg_ExecuteTopUp_FullMethodName             = "/rewardchain.rewardchain.Msg/ExecuteTopUp"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +62,33 @@ type MsgClient interface {
 	CreatePartner(ctx context.Context, in *MsgCreatePartner, opts ...grpc.CallOption) (*MsgCreatePartnerResponse, error)
 	AddPartnerLiquidity(ctx context.Context, in *MsgAddPartnerLiquidity, opts ...grpc.CallOption) (*MsgAddPartnerLiquidityResponse, error)
 	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	DisablePartner(ctx context.Context, in *MsgDisablePartner, opts ...grpc.CallOption) (*MsgDisablePartnerResponse, error)
+	SponsorMembers(ctx context.Context, in *MsgSponsorMembers, opts ...grpc.CallOption) (*MsgSponsorMembersResponse, error)
+	SetReceiptKey(ctx context.Context, in *MsgSetReceiptKey, opts ...grpc.CallOption) (*MsgSetReceiptKeyResponse, error)
+	ClaimReceipt(ctx context.Context, in *MsgClaimReceipt, opts ...grpc.CallOption) (*MsgClaimReceiptResponse, error)
+	BatchEarnPoints(ctx context.Context, in *MsgBatchEarnPoints, opts ...grpc.CallOption) (*MsgBatchEarnPointsResponse, error)
+	ReversePointsEarn(ctx context.Context, in *MsgReversePointsEarn, opts ...grpc.CallOption) (*MsgReversePointsEarnResponse, error)
+	RecordObligation(ctx context.Context, in *MsgRecordObligation, opts ...grpc.CallOption) (*MsgRecordObligationResponse, error)
+	FundSettlementEscrow(ctx context.Context, in *MsgFundSettlementEscrow, opts ...grpc.CallOption) (*MsgFundSettlementEscrowResponse, error)
+	WithdrawSettlementEscrow(ctx context.Context, in *MsgWithdrawSettlementEscrow, opts ...grpc.CallOption) (*MsgWithdrawSettlementEscrowResponse, error)
+	ConfirmSettlement(ctx context.Context, in *MsgConfirmSettlement, opts ...grpc.CallOption) (*MsgConfirmSettlementResponse, error)
+	RedeemPoints(ctx context.Context, in *MsgRedeemPoints, opts ...grpc.CallOption) (*MsgRedeemPointsResponse, error)
+	SubmitRate(ctx context.Context, in *MsgSubmitRate, opts ...grpc.CallOption) (*MsgSubmitRateResponse, error)
+	SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error)
+	TransferPoints(ctx context.Context, in *MsgTransferPoints, opts ...grpc.CallOption) (*MsgTransferPointsResponse, error)
+	SetVelocityLimits(ctx context.Context, in *MsgSetVelocityLimits, opts ...grpc.CallOption) (*MsgSetVelocityLimitsResponse, error)
+	FreezeMember(ctx context.Context, in *MsgFreezeMember, opts ...grpc.CallOption) (*MsgFreezeMemberResponse, error)
+	UnfreezeMember(ctx context.Context, in *MsgUnfreezeMember, opts ...grpc.CallOption) (*MsgUnfreezeMemberResponse, error)
+	RecordAttestation(ctx context.Context, in *MsgRecordAttestation, opts ...grpc.CallOption) (*MsgRecordAttestationResponse, error)
+	RevokeAttestation(ctx context.Context, in *MsgRevokeAttestation, opts ...grpc.CallOption) (*MsgRevokeAttestationResponse, error)
+	SetKycRequirement(ctx context.Context, in *MsgSetKycRequirement, opts ...grpc.CallOption) (*MsgSetKycRequirementResponse, error)
+	BlockAddress(ctx context.Context, in *MsgBlockAddress, opts ...grpc.CallOption) (*MsgBlockAddressResponse, error)
+	UnblockAddress(ctx context.Context, in *MsgUnblockAddress, opts ...grpc.CallOption) (*MsgUnblockAddressResponse, error)
+	SetLiquidityThresholds(ctx context.Context, in *MsgSetLiquidityThresholds, opts ...grpc.CallOption) (*MsgSetLiquidityThresholdsResponse, error)
+	SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error)
+	SendPoints(ctx context.Context, in *MsgSendPoints, opts ...grpc.CallOption) (*MsgSendPointsResponse, error)
+	RegisterRemoteTreasury(ctx context.Context, in *MsgRegisterRemoteTreasury, opts ...grpc.CallOption) (*MsgRegisterRemoteTreasuryResponse, error)
+	ExecuteTopUp(ctx context.Context, in *MsgExecuteTopUp, opts ...grpc.CallOption) (*MsgExecuteTopUpResponse, error)
 }
 
 type msgClient struct {
@@ -81,6 +135,249 @@ func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) DisablePartner(ctx context.Context, in *MsgDisablePartner, opts ...grpc.CallOption) (*MsgDisablePartnerResponse, error) {
+	out := new(MsgDisablePartnerResponse)
+	err := c.cc.Invoke(ctx, Msg_DisablePartner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SponsorMembers(ctx context.Context, in *MsgSponsorMembers, opts ...grpc.CallOption) (*MsgSponsorMembersResponse, error) {
+	out := new(MsgSponsorMembersResponse)
+	err := c.cc.Invoke(ctx, Msg_SponsorMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReceiptKey(ctx context.Context, in *MsgSetReceiptKey, opts ...grpc.CallOption) (*MsgSetReceiptKeyResponse, error) {
+	out := new(MsgSetReceiptKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetReceiptKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimReceipt(ctx context.Context, in *MsgClaimReceipt, opts ...grpc.CallOption) (*MsgClaimReceiptResponse, error) {
+	out := new(MsgClaimReceiptResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchEarnPoints(ctx context.Context, in *MsgBatchEarnPoints, opts ...grpc.CallOption) (*MsgBatchEarnPointsResponse, error) {
+	out := new(MsgBatchEarnPointsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchEarnPoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReversePointsEarn(ctx context.Context, in *MsgReversePointsEarn, opts ...grpc.CallOption) (*MsgReversePointsEarnResponse, error) {
+	out := new(MsgReversePointsEarnResponse)
+	err := c.cc.Invoke(ctx, Msg_ReversePointsEarn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecordObligation(ctx context.Context, in *MsgRecordObligation, opts ...grpc.CallOption) (*MsgRecordObligationResponse, error) {
+	out := new(MsgRecordObligationResponse)
+	err := c.cc.Invoke(ctx, Msg_RecordObligation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundSettlementEscrow(ctx context.Context, in *MsgFundSettlementEscrow, opts ...grpc.CallOption) (*MsgFundSettlementEscrowResponse, error) {
+	out := new(MsgFundSettlementEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_FundSettlementEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSettlementEscrow(ctx context.Context, in *MsgWithdrawSettlementEscrow, opts ...grpc.CallOption) (*MsgWithdrawSettlementEscrowResponse, error) {
+	out := new(MsgWithdrawSettlementEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawSettlementEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmSettlement(ctx context.Context, in *MsgConfirmSettlement, opts ...grpc.CallOption) (*MsgConfirmSettlementResponse, error) {
+	out := new(MsgConfirmSettlementResponse)
+	err := c.cc.Invoke(ctx, Msg_ConfirmSettlement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemPoints(ctx context.Context, in *MsgRedeemPoints, opts ...grpc.CallOption) (*MsgRedeemPointsResponse, error) {
+	out := new(MsgRedeemPointsResponse)
+	err := c.cc.Invoke(ctx, Msg_RedeemPoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitRate(ctx context.Context, in *MsgSubmitRate, opts ...grpc.CallOption) (*MsgSubmitRateResponse, error) {
+	out := new(MsgSubmitRateResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error) {
+	out := new(MsgSetTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTransferPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferPoints(ctx context.Context, in *MsgTransferPoints, opts ...grpc.CallOption) (*MsgTransferPointsResponse, error) {
+	out := new(MsgTransferPointsResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferPoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetVelocityLimits(ctx context.Context, in *MsgSetVelocityLimits, opts ...grpc.CallOption) (*MsgSetVelocityLimitsResponse, error) {
+	out := new(MsgSetVelocityLimitsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetVelocityLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeMember(ctx context.Context, in *MsgFreezeMember, opts ...grpc.CallOption) (*MsgFreezeMemberResponse, error) {
+	out := new(MsgFreezeMemberResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeMember(ctx context.Context, in *MsgUnfreezeMember, opts ...grpc.CallOption) (*MsgUnfreezeMemberResponse, error) {
+	out := new(MsgUnfreezeMemberResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecordAttestation(ctx context.Context, in *MsgRecordAttestation, opts ...grpc.CallOption) (*MsgRecordAttestationResponse, error) {
+	out := new(MsgRecordAttestationResponse)
+	err := c.cc.Invoke(ctx, Msg_RecordAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAttestation(ctx context.Context, in *MsgRevokeAttestation, opts ...grpc.CallOption) (*MsgRevokeAttestationResponse, error) {
+	out := new(MsgRevokeAttestationResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeAttestation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetKycRequirement(ctx context.Context, in *MsgSetKycRequirement, opts ...grpc.CallOption) (*MsgSetKycRequirementResponse, error) {
+	out := new(MsgSetKycRequirementResponse)
+	err := c.cc.Invoke(ctx, Msg_SetKycRequirement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BlockAddress(ctx context.Context, in *MsgBlockAddress, opts ...grpc.CallOption) (*MsgBlockAddressResponse, error) {
+	out := new(MsgBlockAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_BlockAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblockAddress(ctx context.Context, in *MsgUnblockAddress, opts ...grpc.CallOption) (*MsgUnblockAddressResponse, error) {
+	out := new(MsgUnblockAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_UnblockAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLiquidityThresholds(ctx context.Context, in *MsgSetLiquidityThresholds, opts ...grpc.CallOption) (*MsgSetLiquidityThresholdsResponse, error) {
+	out := new(MsgSetLiquidityThresholdsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetLiquidityThresholds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPaused(ctx context.Context, in *MsgSetPaused, opts ...grpc.CallOption) (*MsgSetPausedResponse, error) {
+	out := new(MsgSetPausedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendPoints(ctx context.Context, in *MsgSendPoints, opts ...grpc.CallOption) (*MsgSendPointsResponse, error) {
+	out := new(MsgSendPointsResponse)
+	err := c.cc.Invoke(ctx, Msg_SendPoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterRemoteTreasury(ctx context.Context, in *MsgRegisterRemoteTreasury, opts ...grpc.CallOption) (*MsgRegisterRemoteTreasuryResponse, error) {
+	out := new(MsgRegisterRemoteTreasuryResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterRemoteTreasury_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteTopUp(ctx context.Context, in *MsgExecuteTopUp, opts ...grpc.CallOption) (*MsgExecuteTopUpResponse, error) {
+	out := new(MsgExecuteTopUpResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecuteTopUp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,6 +388,33 @@ type MsgServer interface {
 	CreatePartner(context.Context, *MsgCreatePartner) (*MsgCreatePartnerResponse, error)
 	AddPartnerLiquidity(context.Context, *MsgAddPartnerLiquidity) (*MsgAddPartnerLiquidityResponse, error)
 	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
+	DisablePartner(context.Context, *MsgDisablePartner) (*MsgDisablePartnerResponse, error)
+	SponsorMembers(context.Context, *MsgSponsorMembers) (*MsgSponsorMembersResponse, error)
+	SetReceiptKey(context.Context, *MsgSetReceiptKey) (*MsgSetReceiptKeyResponse, error)
+	ClaimReceipt(context.Context, *MsgClaimReceipt) (*MsgClaimReceiptResponse, error)
+	BatchEarnPoints(context.Context, *MsgBatchEarnPoints) (*MsgBatchEarnPointsResponse, error)
+	ReversePointsEarn(context.Context, *MsgReversePointsEarn) (*MsgReversePointsEarnResponse, error)
+	RecordObligation(context.Context, *MsgRecordObligation) (*MsgRecordObligationResponse, error)
+	FundSettlementEscrow(context.Context, *MsgFundSettlementEscrow) (*MsgFundSettlementEscrowResponse, error)
+	WithdrawSettlementEscrow(context.Context, *MsgWithdrawSettlementEscrow) (*MsgWithdrawSettlementEscrowResponse, error)
+	ConfirmSettlement(context.Context, *MsgConfirmSettlement) (*MsgConfirmSettlementResponse, error)
+	RedeemPoints(context.Context, *MsgRedeemPoints) (*MsgRedeemPointsResponse, error)
+	SubmitRate(context.Context, *MsgSubmitRate) (*MsgSubmitRateResponse, error)
+	SetTransferPolicy(context.Context, *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error)
+	TransferPoints(context.Context, *MsgTransferPoints) (*MsgTransferPointsResponse, error)
+	SetVelocityLimits(context.Context, *MsgSetVelocityLimits) (*MsgSetVelocityLimitsResponse, error)
+	FreezeMember(context.Context, *MsgFreezeMember) (*MsgFreezeMemberResponse, error)
+	UnfreezeMember(context.Context, *MsgUnfreezeMember) (*MsgUnfreezeMemberResponse, error)
+	RecordAttestation(context.Context, *MsgRecordAttestation) (*MsgRecordAttestationResponse, error)
+	RevokeAttestation(context.Context, *MsgRevokeAttestation) (*MsgRevokeAttestationResponse, error)
+	SetKycRequirement(context.Context, *MsgSetKycRequirement) (*MsgSetKycRequirementResponse, error)
+	BlockAddress(context.Context, *MsgBlockAddress) (*MsgBlockAddressResponse, error)
+	UnblockAddress(context.Context, *MsgUnblockAddress) (*MsgUnblockAddressResponse, error)
+	SetLiquidityThresholds(context.Context, *MsgSetLiquidityThresholds) (*MsgSetLiquidityThresholdsResponse, error)
+	SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error)
+	SendPoints(context.Context, *MsgSendPoints) (*MsgSendPointsResponse, error)
+	RegisterRemoteTreasury(context.Context, *MsgRegisterRemoteTreasury) (*MsgRegisterRemoteTreasuryResponse, error)
+	ExecuteTopUp(context.Context, *MsgExecuteTopUp) (*MsgExecuteTopUpResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -110,6 +434,87 @@ func (UnimplementedMsgServer) AddPartnerLiquidity(context.Context, *MsgAddPartne
 func (UnimplementedMsgServer) Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
 }
+func (UnimplementedMsgServer) DisablePartner(context.Context, *MsgDisablePartner) (*MsgDisablePartnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePartner not implemented")
+}
+func (UnimplementedMsgServer) SponsorMembers(context.Context, *MsgSponsorMembers) (*MsgSponsorMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorMembers not implemented")
+}
+func (UnimplementedMsgServer) SetReceiptKey(context.Context, *MsgSetReceiptKey) (*MsgSetReceiptKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiptKey not implemented")
+}
+func (UnimplementedMsgServer) ClaimReceipt(context.Context, *MsgClaimReceipt) (*MsgClaimReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReceipt not implemented")
+}
+func (UnimplementedMsgServer) BatchEarnPoints(context.Context, *MsgBatchEarnPoints) (*MsgBatchEarnPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchEarnPoints not implemented")
+}
+func (UnimplementedMsgServer) ReversePointsEarn(context.Context, *MsgReversePointsEarn) (*MsgReversePointsEarnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReversePointsEarn not implemented")
+}
+func (UnimplementedMsgServer) RecordObligation(context.Context, *MsgRecordObligation) (*MsgRecordObligationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordObligation not implemented")
+}
+func (UnimplementedMsgServer) FundSettlementEscrow(context.Context, *MsgFundSettlementEscrow) (*MsgFundSettlementEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSettlementEscrow not implemented")
+}
+func (UnimplementedMsgServer) WithdrawSettlementEscrow(context.Context, *MsgWithdrawSettlementEscrow) (*MsgWithdrawSettlementEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSettlementEscrow not implemented")
+}
+func (UnimplementedMsgServer) ConfirmSettlement(context.Context, *MsgConfirmSettlement) (*MsgConfirmSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSettlement not implemented")
+}
+func (UnimplementedMsgServer) RedeemPoints(context.Context, *MsgRedeemPoints) (*MsgRedeemPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemPoints not implemented")
+}
+func (UnimplementedMsgServer) SubmitRate(context.Context, *MsgSubmitRate) (*MsgSubmitRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRate not implemented")
+}
+func (UnimplementedMsgServer) SetTransferPolicy(context.Context, *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferPolicy not implemented")
+}
+func (UnimplementedMsgServer) TransferPoints(context.Context, *MsgTransferPoints) (*MsgTransferPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPoints not implemented")
+}
+func (UnimplementedMsgServer) SetVelocityLimits(context.Context, *MsgSetVelocityLimits) (*MsgSetVelocityLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVelocityLimits not implemented")
+}
+func (UnimplementedMsgServer) FreezeMember(context.Context, *MsgFreezeMember) (*MsgFreezeMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeMember not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeMember(context.Context, *MsgUnfreezeMember) (*MsgUnfreezeMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeMember not implemented")
+}
+func (UnimplementedMsgServer) RecordAttestation(context.Context, *MsgRecordAttestation) (*MsgRecordAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAttestation not implemented")
+}
+func (UnimplementedMsgServer) RevokeAttestation(context.Context, *MsgRevokeAttestation) (*MsgRevokeAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAttestation not implemented")
+}
+func (UnimplementedMsgServer) SetKycRequirement(context.Context, *MsgSetKycRequirement) (*MsgSetKycRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKycRequirement not implemented")
+}
+func (UnimplementedMsgServer) BlockAddress(context.Context, *MsgBlockAddress) (*MsgBlockAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAddress not implemented")
+}
+func (UnimplementedMsgServer) UnblockAddress(context.Context, *MsgUnblockAddress) (*MsgUnblockAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAddress not implemented")
+}
+func (UnimplementedMsgServer) SetLiquidityThresholds(context.Context, *MsgSetLiquidityThresholds) (*MsgSetLiquidityThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLiquidityThresholds not implemented")
+}
+func (UnimplementedMsgServer) SetPaused(context.Context, *MsgSetPaused) (*MsgSetPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaused not implemented")
+}
+func (UnimplementedMsgServer) SendPoints(context.Context, *MsgSendPoints) (*MsgSendPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPoints not implemented")
+}
+func (UnimplementedMsgServer) RegisterRemoteTreasury(context.Context, *MsgRegisterRemoteTreasury) (*MsgRegisterRemoteTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterRemoteTreasury not implemented")
+}
+func (UnimplementedMsgServer) ExecuteTopUp(context.Context, *MsgExecuteTopUp) (*MsgExecuteTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteTopUp not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -195,28 +600,622 @@ func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
-// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Msg_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "rewardchain.rewardchain.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreatePartner",
-			Handler:    _Msg_CreatePartner_Handler,
-		},
-		{
-			MethodName: "AddPartnerLiquidity",
-			Handler:    _Msg_AddPartnerLiquidity_Handler,
-		},
-		{
-			MethodName: "Swap",
-			Handler:    _Msg_Swap_Handler,
+func _Msg_DisablePartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisablePartner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisablePartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisablePartner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisablePartner(ctx, req.(*MsgDisablePartner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SponsorMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSponsorMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SponsorMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SponsorMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SponsorMembers(ctx, req.(*MsgSponsorMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReceiptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReceiptKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReceiptKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetReceiptKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReceiptKey(ctx, req.(*MsgSetReceiptKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimReceipt(ctx, req.(*MsgClaimReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchEarnPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchEarnPoints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchEarnPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchEarnPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchEarnPoints(ctx, req.(*MsgBatchEarnPoints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReversePointsEarn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReversePointsEarn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReversePointsEarn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReversePointsEarn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReversePointsEarn(ctx, req.(*MsgReversePointsEarn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecordObligation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecordObligation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecordObligation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecordObligation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecordObligation(ctx, req.(*MsgRecordObligation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSettlementEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSettlementEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSettlementEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundSettlementEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSettlementEscrow(ctx, req.(*MsgFundSettlementEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSettlementEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSettlementEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSettlementEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawSettlementEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSettlementEscrow(ctx, req.(*MsgWithdrawSettlementEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConfirmSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmSettlement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConfirmSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmSettlement(ctx, req.(*MsgConfirmSettlement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemPoints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedeemPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemPoints(ctx, req.(*MsgRedeemPoints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitRate(ctx, req.(*MsgSubmitRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTransferPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferPolicy(ctx, req.(*MsgSetTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPoints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPoints(ctx, req.(*MsgTransferPoints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVelocityLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVelocityLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVelocityLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetVelocityLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVelocityLimits(ctx, req.(*MsgSetVelocityLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeMember(ctx, req.(*MsgFreezeMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeMember(ctx, req.(*MsgUnfreezeMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecordAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecordAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecordAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RecordAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecordAttestation(ctx, req.(*MsgRecordAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAttestation(ctx, req.(*MsgRevokeAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetKycRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetKycRequirement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetKycRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetKycRequirement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetKycRequirement(ctx, req.(*MsgSetKycRequirement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BlockAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BlockAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockAddress(ctx, req.(*MsgBlockAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnblockAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockAddress(ctx, req.(*MsgUnblockAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLiquidityThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLiquidityThresholds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLiquidityThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetLiquidityThresholds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLiquidityThresholds(ctx, req.(*MsgSetLiquidityThresholds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaused(ctx, req.(*MsgSetPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendPoints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SendPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendPoints(ctx, req.(*MsgSendPoints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterRemoteTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterRemoteTreasury)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterRemoteTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterRemoteTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterRemoteTreasury(ctx, req.(*MsgRegisterRemoteTreasury))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecuteTopUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteTopUp(ctx, req.(*MsgExecuteTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rewardchain.rewardchain.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreatePartner",
+			Handler:    _Msg_CreatePartner_Handler,
+		},
+		{
+			MethodName: "AddPartnerLiquidity",
+			Handler:    _Msg_AddPartnerLiquidity_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "DisablePartner",
+			Handler:    _Msg_DisablePartner_Handler,
+		},
+		{
+			MethodName: "SponsorMembers",
+			Handler:    _Msg_SponsorMembers_Handler,
+		},
+		{
+			MethodName: "SetReceiptKey",
+			Handler:    _Msg_SetReceiptKey_Handler,
+		},
+		{
+			MethodName: "ClaimReceipt",
+			Handler:    _Msg_ClaimReceipt_Handler,
+		},
+		{
+			MethodName: "BatchEarnPoints",
+			Handler:    _Msg_BatchEarnPoints_Handler,
+		},
+		{
+			MethodName: "ReversePointsEarn",
+			Handler:    _Msg_ReversePointsEarn_Handler,
+		},
+		{
+			MethodName: "RecordObligation",
+			Handler:    _Msg_RecordObligation_Handler,
+		},
+		{
+			MethodName: "FundSettlementEscrow",
+			Handler:    _Msg_FundSettlementEscrow_Handler,
+		},
+		{
+			MethodName: "WithdrawSettlementEscrow",
+			Handler:    _Msg_WithdrawSettlementEscrow_Handler,
+		},
+		{
+			MethodName: "ConfirmSettlement",
+			Handler:    _Msg_ConfirmSettlement_Handler,
+		},
+		{
+			MethodName: "RedeemPoints",
+			Handler:    _Msg_RedeemPoints_Handler,
+		},
+		{
+			MethodName: "SubmitRate",
+			Handler:    _Msg_SubmitRate_Handler,
+		},
+		{
+			MethodName: "SetTransferPolicy",
+			Handler:    _Msg_SetTransferPolicy_Handler,
+		},
+		{
+			MethodName: "TransferPoints",
+			Handler:    _Msg_TransferPoints_Handler,
+		},
+		{
+			MethodName: "SetVelocityLimits",
+			Handler:    _Msg_SetVelocityLimits_Handler,
+		},
+		{
+			MethodName: "FreezeMember",
+			Handler:    _Msg_FreezeMember_Handler,
+		},
+		{
+			MethodName: "UnfreezeMember",
+			Handler:    _Msg_UnfreezeMember_Handler,
+		},
+		{
+			MethodName: "RecordAttestation",
+			Handler:    _Msg_RecordAttestation_Handler,
+		},
+		{
+			MethodName: "RevokeAttestation",
+			Handler:    _Msg_RevokeAttestation_Handler,
+		},
+		{
+			MethodName: "SetKycRequirement",
+			Handler:    _Msg_SetKycRequirement_Handler,
+		},
+		{
+			MethodName: "BlockAddress",
+			Handler:    _Msg_BlockAddress_Handler,
+		},
+		{
+			MethodName: "UnblockAddress",
+			Handler:    _Msg_UnblockAddress_Handler,
+		},
+		{
+			MethodName: "SetLiquidityThresholds",
+			Handler:    _Msg_SetLiquidityThresholds_Handler,
+		},
+		{
+			MethodName: "SetPaused",
+			Handler:    _Msg_SetPaused_Handler,
+		},
+		{
+			MethodName: "SendPoints",
+			Handler:    _Msg_SendPoints_Handler,
+		},
+		{
+			MethodName: "RegisterRemoteTreasury",
+			Handler:    _Msg_RegisterRemoteTreasury_Handler,
+		},
+		{
+			MethodName: "ExecuteTopUp",
+			Handler:    _Msg_ExecuteTopUp_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
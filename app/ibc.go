@@ -35,6 +35,8 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	rewardchainmodule "rewardchain/x/rewardchain/module"
+	rewardchainmoduletypes "rewardchain/x/rewardchain/types"
 	// this line is used by starport scaffolding # ibc/app/import
 )
 
@@ -74,6 +76,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	scopedIBCTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	// the rewardchain keeper reaches its scoped keeper through GetCapabilityScopedKeeper
+	app.GetCapabilityScopedKeeper(rewardchainmoduletypes.ModuleName)
 
 	// Create IBC keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -143,12 +147,16 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
 	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// rewardchain transfer memos run between the fee middleware and transfer
+	transferIBCModule := ibcfee.NewIBCMiddleware(
+		rewardchainmodule.NewTransferMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.RewardchainKeeper),
+		app.IBCFeeKeeper,
+	)
 
-	// integration point for custom authentication modules
-	var noAuthzModule porttypes.IBCModule
+	// integration point for custom authentication modules: rewardchain settles
+	// the top-ups sent by the interchain accounts of remote treasuries
 	icaControllerIBCModule := ibcfee.NewIBCMiddleware(
-		icacontroller.NewIBCMiddleware(noAuthzModule, app.ICAControllerKeeper),
+		icacontroller.NewIBCMiddleware(rewardchainmodule.NewICAControllerModule(app.RewardchainKeeper), app.ICAControllerKeeper),
 		app.IBCFeeKeeper,
 	)
 
@@ -160,6 +168,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
 
+	rewardchainIBCModule := ibcfee.NewIBCMiddleware(rewardchainmodule.NewIBCModule(app.RewardchainKeeper), app.IBCFeeKeeper)
+	ibcRouter.AddRoute(rewardchainmoduletypes.ModuleName, rewardchainIBCModule)
 	// this line is used by starport scaffolding # ibc/app/module
 
 	app.IBCKeeper.SetRouter(ibcRouter)
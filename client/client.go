@@ -0,0 +1,165 @@
+// Package client is a Go client for rewardchain nodes. It talks to a node's
+// gRPC endpoint, signs with a keyring entry or a raw private key, sizes gas
+// by simulation, keeps the signer's account sequence in step across
+// concurrent broadcasts and decodes tx results into typed module events.
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/x/tx/signing"
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+const (
+	// DefaultBech32Prefix is the account address prefix of rewardchain.
+	DefaultBech32Prefix = "reward"
+	// DefaultGasAdjustment scales simulated gas into the gas limit.
+	DefaultGasAdjustment = 1.5
+	// DefaultPollInterval is how often a broadcast tx is looked up until it
+	// is included in a block.
+	DefaultPollInterval = 500 * time.Millisecond
+)
+
+// ErrNoSigner is returned when a client without a signer broadcasts.
+var ErrNoSigner = errors.New("client has no signer")
+
+// Client queries and transacts with a rewardchain node. Its methods are safe
+// for concurrent use.
+type Client struct {
+	// Query is the module's query service.
+	Query types.QueryClient
+
+	auth authtypes.QueryClient
+	tx   txtypes.ServiceClient
+	cmt  cmtservice.ServiceClient
+
+	txConfig     sdkclient.TxConfig
+	addressCodec address.Bech32Codec
+
+	chainID       string
+	signer        Signer
+	address       string
+	gasAdjustment float64
+	gasPrices     sdk.DecCoins
+	gasLimit      uint64
+	memo          string
+	pollInterval  time.Duration
+
+	seq sequence
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithChainID sets the chain id instead of reading it from the node.
+func WithChainID(chainID string) Option {
+	return func(c *Client) { c.chainID = chainID }
+}
+
+// WithSigner sets the key transactions are signed with. Clients without a
+// signer can only query.
+func WithSigner(s Signer) Option {
+	return func(c *Client) { c.signer = s }
+}
+
+// WithBech32Prefix sets the account address prefix.
+func WithBech32Prefix(prefix string) Option {
+	return func(c *Client) { c.addressCodec = address.Bech32Codec{Bech32Prefix: prefix} }
+}
+
+// WithGasAdjustment sets the factor simulated gas is scaled by.
+func WithGasAdjustment(adjustment float64) Option {
+	return func(c *Client) { c.gasAdjustment = adjustment }
+}
+
+// WithGasPrices sets the prices fees are paid at.
+func WithGasPrices(prices sdk.DecCoins) Option {
+	return func(c *Client) { c.gasPrices = prices }
+}
+
+// WithGasLimit sets a fixed gas limit and skips simulation.
+func WithGasLimit(limit uint64) Option {
+	return func(c *Client) { c.gasLimit = limit }
+}
+
+// WithMemo sets the memo of every transaction.
+func WithMemo(memo string) Option {
+	return func(c *Client) { c.memo = memo }
+}
+
+// WithPollInterval sets how often broadcast txs are looked up.
+func WithPollInterval(d time.Duration) Option {
+	return func(c *Client) { c.pollInterval = d }
+}
+
+// New creates a client over conn. Unless WithChainID is given the chain id
+// is read from the node's latest block.
+func New(ctx context.Context, conn grpc.ClientConnInterface, opts ...Option) (*Client, error) {
+	c := &Client{
+		Query:         types.NewQueryClient(conn),
+		auth:          authtypes.NewQueryClient(conn),
+		tx:            txtypes.NewServiceClient(conn),
+		cmt:           cmtservice.NewServiceClient(conn),
+		addressCodec:  address.Bech32Codec{Bech32Prefix: DefaultBech32Prefix},
+		gasAdjustment: DefaultGasAdjustment,
+		pollInterval:  DefaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          c.addressCodec,
+			ValidatorAddressCodec: address.Bech32Codec{Bech32Prefix: c.addressCodec.Bech32Prefix + "valoper"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	c.txConfig = authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	if c.signer != nil {
+		if c.address, err = c.addressCodec.BytesToString(c.signer.Address()); err != nil {
+			return nil, err
+		}
+	}
+	if c.chainID == "" {
+		res, err := c.cmt.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+		if err != nil {
+			return nil, err
+		}
+		c.chainID = res.SdkBlock.Header.ChainID
+	}
+	return c, nil
+}
+
+// ChainID returns the chain the client signs for.
+func (c *Client) ChainID() string {
+	return c.chainID
+}
+
+// Address returns the signer's address, or "" for query-only clients.
+func (c *Client) Address() string {
+	return c.address
+}
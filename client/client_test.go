@@ -0,0 +1,150 @@
+package client_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"rewardchain/client"
+	"rewardchain/testutil/network"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestClient(t *testing.T) {
+	// a raw key that creates partners alongside the validator
+	key := secp256k1.GenPrivKey()
+	cfg := network.DefaultConfig()
+	require.NoError(t, network.AddAdmins(&cfg, sdk.AccAddress(key.PubKey().Address())))
+	net := network.New(t, cfg)
+	val := net.Validators[0]
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	conn, err := grpc.NewClient(val.AppConfig.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	gasPrices, err := sdk.ParseDecCoins(val.AppConfig.MinGasPrices)
+	require.NoError(t, err)
+	newClient := func(opts ...client.Option) *client.Client {
+		opts = append(opts, client.WithGasPrices(gasPrices), client.WithPollInterval(100*time.Millisecond))
+		c, err := client.New(ctx, conn, opts...)
+		require.NoError(t, err)
+		return c
+	}
+
+	reader := newClient()
+	require.Equal(t, net.Config.ChainID, reader.ChainID())
+	params, err := reader.Query.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().SettlementEpoch(), params.Params.SettlementEpoch())
+	_, _, err = reader.CreatePartner(ctx, &types.MsgCreatePartner{Name: "Acme"})
+	require.ErrorIs(t, err, client.ErrNoSigner)
+
+	record, err := val.ClientCtx.Keyring.KeyByAddress(val.Address)
+	require.NoError(t, err)
+	keyringSigner, err := client.NewKeyringSigner(val.ClientCtx.Keyring, record.Name)
+	require.NoError(t, err)
+	operator := newClient(client.WithSigner(keyringSigner))
+	require.Equal(t, val.Address.String(), operator.Address())
+
+	created, _, err := operator.CreatePartner(ctx, &types.MsgCreatePartner{
+		Name:             "Acme",
+		Country:          "US",
+		Currency:         "USD",
+		EarnCostPerPoint: "0.01",
+		BurnCostPerPoint: "0.01",
+		TotalLiquidity:   "100",
+	})
+	require.NoError(t, err)
+	partnerID, err := strconv.ParseUint(created.Id, 10, 64)
+	require.NoError(t, err)
+	partner, err := operator.Query.Partner(ctx, &types.QueryPartnerRequest{Id: partnerID})
+	require.NoError(t, err)
+	require.Equal(t, "Acme", partner.Partner.Name)
+
+	_, tx, err := operator.AddPartnerLiquidity(ctx, &types.MsgAddPartnerLiquidity{PartnerId: partnerID, Amount: "5", Currency: "USD"})
+	require.NoError(t, err)
+	events, err := tx.DecodeEvents()
+	require.NoError(t, err)
+	require.Equal(t, []*client.AddPartnerLiquidityEvent{{PartnerID: partnerID, Amount: "5", Currency: "USD"}},
+		client.EventsOf[*client.AddPartnerLiquidityEvent](events))
+
+	// the partner and its absent balances are proven against the app hash
+	// of the block after the one they were read at
+	require.NoError(t, net.WaitForNextBlock())
+	proof, err := reader.ProvePartner(ctx, partnerID, 0)
+	require.NoError(t, err)
+	require.NoError(t, net.WaitForNextBlock())
+	appHash, err := reader.AppHash(ctx, proof.Height)
+	require.NoError(t, err)
+	proven, err := types.VerifyPartnerProof(appHash, partnerID, proof)
+	require.NoError(t, err)
+	partner, err = reader.Query.Partner(ctx, &types.QueryPartnerRequest{Id: partnerID})
+	require.NoError(t, err)
+	require.Equal(t, partner.Partner, proven)
+	_, err = types.VerifyPartnerProof(appHash, partnerID+1, proof)
+	require.Error(t, err)
+	balanceProof, err := reader.ProveMemberBalance(ctx, partnerID, val.Address.String(), proof.Height)
+	require.NoError(t, err)
+	balance, err := types.VerifyMemberBalanceProof(appHash, partnerID, val.Address, balanceProof)
+	require.NoError(t, err)
+	require.Equal(t, "0.000000000000000000", balance.Points)
+
+	// failures simulate with the node's message; with a fixed gas limit the
+	// chain's error comes back as the module's sentinel
+	_, _, err = operator.AddPartnerLiquidity(ctx, &types.MsgAddPartnerLiquidity{PartnerId: 99, Amount: "5", Currency: "USD"})
+	require.ErrorContains(t, err, types.ErrPartnerNotFound.Error())
+	_, _, err = newClient(client.WithSigner(keyringSigner), client.WithGasLimit(200_000)).
+		AddPartnerLiquidity(ctx, &types.MsgAddPartnerLiquidity{PartnerId: 99, Amount: "5", Currency: "USD"})
+	require.ErrorIs(t, err, types.ErrPartnerNotFound)
+
+	// raw keys sign too; fund a fresh account first
+	rawSigner, err := client.NewRawKeySigner(key.Bytes())
+	require.NoError(t, err)
+	_, err = operator.BroadcastMsgs(ctx, banktypes.NewMsgSend(
+		val.Address, rawSigner.Address(), sdk.NewCoins(sdk.NewInt64Coin(net.Config.BondDenom, 10_000_000)),
+	))
+	require.NoError(t, err)
+
+	// concurrent broadcasts of one client take consecutive sequences
+	raw := newClient(client.WithSigner(rawSigner))
+	const n = 5
+	ids := make(chan string, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, _, err := raw.CreatePartner(ctx, &types.MsgCreatePartner{Name: "Shop", Country: "US", Currency: "USD"})
+			if !assert.NoError(t, err) {
+				return
+			}
+			ids <- res.Id
+		}()
+	}
+	wg.Wait()
+	close(ids)
+	seen := make(map[string]bool)
+	for id := range ids {
+		seen[id] = true
+	}
+	require.Len(t, seen, n)
+
+	// a sequence used by another client is reloaded and the tx retried
+	_, _, err = newClient(client.WithSigner(client.NewPrivKeySigner(key))).
+		CreatePartner(ctx, &types.MsgCreatePartner{Name: "Other", Country: "US", Currency: "USD"})
+	require.NoError(t, err)
+	_, _, err = raw.CreatePartner(ctx, &types.MsgCreatePartner{Name: "Again", Country: "US", Currency: "USD"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,533 @@
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// Event is a typed rewardchain event. Fields are filled from the attributes
+// named by their attr tags; decimals and coins stay strings as on chain.
+type Event interface {
+	EventType() string
+}
+
+// eventTypes maps every event type the module emits to its typed event.
+var eventTypes = map[string]func() Event{}
+
+func registerEvents(events ...Event) {
+	for _, e := range events {
+		t := reflect.TypeOf(e)
+		eventTypes[e.EventType()] = func() Event {
+			return reflect.New(t).Interface().(Event)
+		}
+	}
+}
+
+func init() {
+	registerEvents(
+		AddPartnerLiquidityEvent{},
+		PartnerSwapEvent{},
+		DisablePartnerEvent{},
+		SponsorMembersEvent{},
+		SetReceiptKeyEvent{},
+		ClaimReceiptEvent{},
+		EarnPointsEvent{},
+		BatchEarnPointsEvent{},
+		ReversePointsEarnEvent{},
+		RecordObligationEvent{},
+		FundSettlementEscrowEvent{},
+		WithdrawSettlementEscrowEvent{},
+		SettlementOpenedEvent{},
+		SettlementConfirmedEvent{},
+		RedeemPointsEvent{},
+		SubmitRateEvent{},
+		ExchangeRateUpdatedEvent{},
+		SetTransferPolicyEvent{},
+		TransferPointsEvent{},
+		SetVelocityLimitsEvent{},
+		FreezeMemberEvent{},
+		UnfreezeMemberEvent{},
+		RecordAttestationEvent{},
+		RevokeAttestationEvent{},
+		SetKycRequirementEvent{},
+		BlockAddressEvent{},
+		UnblockAddressEvent{},
+		SetLiquidityThresholdsEvent{},
+		PartnerLiquidityEvent{},
+		PartnerEarnPausedEvent{},
+		SetPausedEvent{},
+		ClientRefReplayEvent{},
+		SendPointsEvent{},
+		ReceivePointsEvent{},
+		RefundPointsEvent{},
+		TransferMemoEvent{},
+		RemoteTreasuryRegisteredEvent{},
+		RemoteTopUpSentEvent{},
+		RemoteTopUpConfirmedEvent{},
+		RemoteTopUpFailedEvent{},
+	)
+}
+
+// DecodeEvents returns the rewardchain events among events, in order, as
+// pointers to their typed events. Events of other modules are skipped.
+func DecodeEvents(events []abci.Event) ([]Event, error) {
+	out := make([]Event, 0, len(events))
+	for _, ev := range events {
+		e, ok, err := DecodeEvent(ev)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+// DecodeEvent returns the typed event of ev. ok is false for events the
+// rewardchain module does not emit.
+func DecodeEvent(ev abci.Event) (e Event, ok bool, err error) {
+	newEvent, ok := eventTypes[ev.Type]
+	if !ok {
+		return nil, false, nil
+	}
+	e = newEvent()
+	if err := decodeAttributes(ev, e); err != nil {
+		return nil, true, err
+	}
+	return e, true, nil
+}
+
+// EventsOf returns the events of type T, which must be a pointer to a typed
+// event such as *EarnPointsEvent.
+func EventsOf[T Event](events []Event) []T {
+	var out []T
+	for _, e := range events {
+		if t, ok := e.(T); ok {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func decodeAttributes(ev abci.Event, out Event) error {
+	v := reflect.ValueOf(out).Elem()
+	fields := make(map[string]reflect.Value, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		if tag := v.Type().Field(i).Tag.Get("attr"); tag != "" {
+			fields[tag] = v.Field(i)
+		}
+	}
+
+	for _, a := range ev.Attributes {
+		f, ok := fields[a.Key]
+		if !ok {
+			continue
+		}
+		if err := setField(f, a.Value); err != nil {
+			return fmt.Errorf("event %s attribute %s: %w", ev.Type, a.Key, err)
+		}
+	}
+	return nil
+}
+
+func setField(f reflect.Value, s string) error {
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetUint(n)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Slice:
+		if s != "" {
+			f.Set(reflect.ValueOf(strings.Split(s, ",")))
+		}
+	default:
+		return fmt.Errorf("unsupported field kind %s", f.Kind())
+	}
+	return nil
+}
+
+type AddPartnerLiquidityEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Amount    string `attr:"amount"`
+	Currency  string `attr:"currency"`
+	ExtWallet string `attr:"ext_wallet"`
+}
+
+func (AddPartnerLiquidityEvent) EventType() string { return "add_partner_liquidity" }
+
+type PartnerSwapEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Route     string `attr:"route"`
+	Points    string `attr:"points"`
+	Fee       string `attr:"fee"`
+}
+
+func (PartnerSwapEvent) EventType() string { return "partner_swap" }
+
+type DisablePartnerEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+}
+
+func (DisablePartnerEvent) EventType() string { return "disable_partner" }
+
+type SponsorMembersEvent struct {
+	PartnerID  uint64   `attr:"partner_id"`
+	Granter    string   `attr:"granter"`
+	Members    []string `attr:"members"`
+	SpendLimit string   `attr:"spend_limit"`
+}
+
+func (SponsorMembersEvent) EventType() string { return "sponsor_members" }
+
+type SetReceiptKeyEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	KeyType   string `attr:"key_type"`
+}
+
+func (SetReceiptKeyEvent) EventType() string { return "set_receipt_key" }
+
+type ClaimReceiptEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Member    string `attr:"member"`
+	Points    string `attr:"points"`
+	Nonce     string `attr:"nonce"`
+}
+
+func (ClaimReceiptEvent) EventType() string { return "claim_receipt" }
+
+type EarnPointsEvent struct {
+	PartnerID   uint64 `attr:"partner_id"`
+	Member      string `attr:"member"`
+	Points      string `attr:"points"`
+	ReferenceID string `attr:"reference_id"`
+}
+
+func (EarnPointsEvent) EventType() string { return "earn_points" }
+
+type BatchEarnPointsEvent struct {
+	PartnerID   uint64 `attr:"partner_id"`
+	Entries     int    `attr:"entries"`
+	Credited    int    `attr:"credited"`
+	TotalPoints string `attr:"total_points"`
+}
+
+func (BatchEarnPointsEvent) EventType() string { return "batch_earn_points" }
+
+type ReversePointsEarnEvent struct {
+	PartnerID     uint64 `attr:"partner_id"`
+	Member        string `attr:"member"`
+	ReferenceID   string `attr:"reference_id"`
+	EarnHeight    int64  `attr:"earn_height"`
+	Points        string `attr:"points"`
+	ReversedTotal string `attr:"reversed_total"`
+	Balance       string `attr:"balance"`
+	Debt          string `attr:"debt"`
+	Reason        string `attr:"reason"`
+}
+
+func (ReversePointsEarnEvent) EventType() string { return "reverse_points_earn" }
+
+type RecordObligationEvent struct {
+	ObligationID uint64 `attr:"obligation_id"`
+	PayerID      uint64 `attr:"payer_id"`
+	PayeeID      uint64 `attr:"payee_id"`
+	Amount       string `attr:"amount"`
+	Currency     string `attr:"currency"`
+	Reference    string `attr:"reference"`
+}
+
+func (RecordObligationEvent) EventType() string { return "record_obligation" }
+
+type FundSettlementEscrowEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Amount    string `attr:"amount"`
+}
+
+func (FundSettlementEscrowEvent) EventType() string { return "fund_settlement_escrow" }
+
+type WithdrawSettlementEscrowEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Amount    string `attr:"amount"`
+}
+
+func (WithdrawSettlementEscrowEvent) EventType() string { return "withdraw_settlement_escrow" }
+
+// SettlementEvent carries the attributes shared by settlement events.
+type SettlementEvent struct {
+	SettlementID uint64 `attr:"settlement_id"`
+	PayerID      uint64 `attr:"payer_id"`
+	PayeeID      uint64 `attr:"payee_id"`
+	Amount       string `attr:"amount"`
+	Currency     string `attr:"currency"`
+	Status       string `attr:"status"`
+}
+
+type SettlementOpenedEvent SettlementEvent
+
+func (SettlementOpenedEvent) EventType() string { return "settlement_opened" }
+
+type SettlementConfirmedEvent SettlementEvent
+
+func (SettlementConfirmedEvent) EventType() string { return "settlement_confirmed" }
+
+type RedeemPointsEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Member    string `attr:"member"`
+	Points    string `attr:"points"`
+	Fee       string `attr:"fee"`
+}
+
+func (RedeemPointsEvent) EventType() string { return "redeem_points" }
+
+type SubmitRateEvent struct {
+	Feeder string `attr:"feeder"`
+	Base   string `attr:"base"`
+	Quote  string `attr:"quote"`
+	Rate   string `attr:"rate"`
+}
+
+func (SubmitRateEvent) EventType() string { return "submit_rate" }
+
+type ExchangeRateUpdatedEvent struct {
+	Base        string `attr:"base"`
+	Quote       string `attr:"quote"`
+	Rate        string `attr:"rate"`
+	Submissions uint32 `attr:"submissions"`
+}
+
+func (ExchangeRateUpdatedEvent) EventType() string { return "exchange_rate_updated" }
+
+type SetTransferPolicyEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Mode      string `attr:"mode"`
+	FeeRate   string `attr:"fee_rate"`
+	DailyCap  string `attr:"daily_cap"`
+}
+
+func (SetTransferPolicyEvent) EventType() string { return "set_transfer_policy" }
+
+type TransferPointsEvent struct {
+	TransferID uint64 `attr:"transfer_id"`
+	PartnerID  uint64 `attr:"partner_id"`
+	From       string `attr:"from"`
+	To         string `attr:"to"`
+	Amount     string `attr:"amount"`
+	Fee        string `attr:"fee"`
+}
+
+func (TransferPointsEvent) EventType() string { return "transfer_points" }
+
+type SetVelocityLimitsEvent struct {
+	PartnerID                uint64 `attr:"partner_id"`
+	MaxRedeemPerTx           string `attr:"max_redeem_per_tx"`
+	MaxRedeemPerDay          string `attr:"max_redeem_per_day"`
+	MaxRedeemPerWindow       string `attr:"max_redeem_per_window"`
+	WindowSeconds            uint64 `attr:"window_seconds"`
+	NewMemberCooldownSeconds uint64 `attr:"new_member_cooldown_seconds"`
+}
+
+func (SetVelocityLimitsEvent) EventType() string { return "set_velocity_limits" }
+
+type FreezeMemberEvent struct {
+	Member string `attr:"member"`
+	Reason string `attr:"reason"`
+	Admin  string `attr:"admin"`
+}
+
+func (FreezeMemberEvent) EventType() string { return "freeze_member" }
+
+type UnfreezeMemberEvent struct {
+	Member string `attr:"member"`
+	Admin  string `attr:"admin"`
+}
+
+func (UnfreezeMemberEvent) EventType() string { return "unfreeze_member" }
+
+type RecordAttestationEvent struct {
+	Member       string `attr:"member"`
+	Attestor     string `attr:"attestor"`
+	Level        uint32 `attr:"level"`
+	Jurisdiction string `attr:"jurisdiction"`
+	Expires      int64  `attr:"expires"`
+}
+
+func (RecordAttestationEvent) EventType() string { return "record_attestation" }
+
+type RevokeAttestationEvent struct {
+	Member   string `attr:"member"`
+	Attestor string `attr:"attestor"`
+}
+
+func (RevokeAttestationEvent) EventType() string { return "revoke_attestation" }
+
+type SetKycRequirementEvent struct {
+	PartnerID     uint64   `attr:"partner_id"`
+	Threshold     string   `attr:"threshold"`
+	MinLevel      uint32   `attr:"min_level"`
+	Jurisdictions []string `attr:"jurisdictions"`
+}
+
+func (SetKycRequirementEvent) EventType() string { return "set_kyc_requirement" }
+
+type BlockAddressEvent struct {
+	Address  string `attr:"address"`
+	Reason   string `attr:"reason"`
+	Note     string `attr:"note"`
+	ListedBy string `attr:"listed_by"`
+}
+
+func (BlockAddressEvent) EventType() string { return "block_address" }
+
+type UnblockAddressEvent struct {
+	Address    string `attr:"address"`
+	Reason     string `attr:"reason"`
+	Note       string `attr:"note"`
+	DelistedBy string `attr:"delisted_by"`
+}
+
+func (UnblockAddressEvent) EventType() string { return "unblock_address" }
+
+type SetLiquidityThresholdsEvent struct {
+	PartnerID     uint64 `attr:"partner_id"`
+	Warning       string `attr:"warning"`
+	Critical      string `attr:"critical"`
+	AutoPauseEarn bool   `attr:"auto_pause_earn"`
+}
+
+func (SetLiquidityThresholdsEvent) EventType() string { return "set_liquidity_thresholds" }
+
+type PartnerLiquidityEvent struct {
+	PartnerID          uint64 `attr:"partner_id"`
+	Level              string `attr:"level"`
+	PreviousLevel      string `attr:"previous_level"`
+	AvailableLiquidity string `attr:"available_liquidity"`
+	Warning            string `attr:"warning"`
+	Critical           string `attr:"critical"`
+}
+
+func (PartnerLiquidityEvent) EventType() string { return "partner_liquidity" }
+
+type PartnerEarnPausedEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Paused    bool   `attr:"paused"`
+}
+
+func (PartnerEarnPausedEvent) EventType() string { return "partner_earn_paused" }
+
+type SetPausedEvent struct {
+	Operation string `attr:"operation"`
+	Paused    bool   `attr:"paused"`
+	Signer    string `attr:"signer"`
+}
+
+func (SetPausedEvent) EventType() string { return "set_paused" }
+
+type ClientRefReplayEvent struct {
+	Signer     string `attr:"signer"`
+	ClientRef  string `attr:"client_ref"`
+	MsgTypeURL string `attr:"msg_type_url"`
+}
+
+func (ClientRefReplayEvent) EventType() string { return "client_ref_replay" }
+
+type SendPointsEvent struct {
+	ChannelID string `attr:"channel_id"`
+	Sequence  uint64 `attr:"sequence"`
+	PartnerID uint64 `attr:"partner_id"`
+	Sender    string `attr:"sender"`
+	Receiver  string `attr:"receiver"`
+	Points    string `attr:"points"`
+	Voucher   bool   `attr:"voucher"`
+}
+
+func (SendPointsEvent) EventType() string { return "send_points" }
+
+type ReceivePointsEvent struct {
+	ChannelID string `attr:"channel_id"`
+	PartnerID uint64 `attr:"partner_id"`
+	Sender    string `attr:"sender"`
+	Receiver  string `attr:"receiver"`
+	Points    string `attr:"points"`
+	Voucher   bool   `attr:"voucher"`
+}
+
+func (ReceivePointsEvent) EventType() string { return "receive_points" }
+
+type RefundPointsEvent struct {
+	ChannelID string `attr:"channel_id"`
+	Sequence  uint64 `attr:"sequence"`
+	PartnerID uint64 `attr:"partner_id"`
+	Sender    string `attr:"sender"`
+	Points    string `attr:"points"`
+	Voucher   bool   `attr:"voucher"`
+}
+
+func (RefundPointsEvent) EventType() string { return "refund_points" }
+
+type TransferMemoEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Action    string `attr:"action"`
+	Amount    string `attr:"amount"`
+	Currency  string `attr:"currency"`
+	Points    string `attr:"points"`
+	Recipient string `attr:"recipient"`
+}
+
+func (TransferMemoEvent) EventType() string { return "transfer_memo" }
+
+type RemoteTreasuryRegisteredEvent struct {
+	PartnerID     uint64 `attr:"partner_id"`
+	ConnectionID  string `attr:"connection_id"`
+	PortID        string `attr:"port_id"`
+	EscrowAddress string `attr:"escrow_address"`
+}
+
+func (RemoteTreasuryRegisteredEvent) EventType() string { return "remote_treasury_registered" }
+
+type RemoteTopUpSentEvent struct {
+	PartnerID     uint64 `attr:"partner_id"`
+	Sequence      uint64 `attr:"sequence"`
+	Amount        string `attr:"amount"`
+	EscrowAddress string `attr:"escrow_address"`
+}
+
+func (RemoteTopUpSentEvent) EventType() string { return "remote_top_up_sent" }
+
+type RemoteTopUpConfirmedEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Sequence  uint64 `attr:"sequence"`
+	Amount    string `attr:"amount"`
+	Points    string `attr:"points"`
+}
+
+func (RemoteTopUpConfirmedEvent) EventType() string { return "remote_top_up_confirmed" }
+
+type RemoteTopUpFailedEvent struct {
+	PartnerID uint64 `attr:"partner_id"`
+	Sequence  uint64 `attr:"sequence"`
+	Amount    string `attr:"amount"`
+	Reason    string `attr:"reason"`
+}
+
+func (RemoteTopUpFailedEvent) EventType() string { return "remote_top_up_failed" }
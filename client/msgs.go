@@ -0,0 +1,441 @@
+package client
+
+import (
+	"context"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// UpdateParams broadcasts a MsgUpdateParams and returns its response. An empty Authority
+// defaults to the client's address.
+func (c *Client) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, *TxResult, error) {
+	if msg.Authority == "" {
+		msg.Authority = c.address
+	}
+	res := new(types.MsgUpdateParamsResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// CreatePartner broadcasts a MsgCreatePartner and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) CreatePartner(ctx context.Context, msg *types.MsgCreatePartner) (*types.MsgCreatePartnerResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgCreatePartnerResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// AddPartnerLiquidity broadcasts a MsgAddPartnerLiquidity and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) AddPartnerLiquidity(ctx context.Context, msg *types.MsgAddPartnerLiquidity) (*types.MsgAddPartnerLiquidityResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgAddPartnerLiquidityResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// Swap broadcasts a MsgSwap and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) Swap(ctx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSwapResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// DisablePartner broadcasts a MsgDisablePartner and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) DisablePartner(ctx context.Context, msg *types.MsgDisablePartner) (*types.MsgDisablePartnerResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgDisablePartnerResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SponsorMembers broadcasts a MsgSponsorMembers and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SponsorMembers(ctx context.Context, msg *types.MsgSponsorMembers) (*types.MsgSponsorMembersResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSponsorMembersResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SetReceiptKey broadcasts a MsgSetReceiptKey and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SetReceiptKey(ctx context.Context, msg *types.MsgSetReceiptKey) (*types.MsgSetReceiptKeyResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSetReceiptKeyResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// ClaimReceipt broadcasts a MsgClaimReceipt and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) ClaimReceipt(ctx context.Context, msg *types.MsgClaimReceipt) (*types.MsgClaimReceiptResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgClaimReceiptResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// BatchEarnPoints broadcasts a MsgBatchEarnPoints and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) BatchEarnPoints(ctx context.Context, msg *types.MsgBatchEarnPoints) (*types.MsgBatchEarnPointsResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgBatchEarnPointsResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// ReversePointsEarn broadcasts a MsgReversePointsEarn and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) ReversePointsEarn(ctx context.Context, msg *types.MsgReversePointsEarn) (*types.MsgReversePointsEarnResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgReversePointsEarnResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// RecordObligation broadcasts a MsgRecordObligation and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) RecordObligation(ctx context.Context, msg *types.MsgRecordObligation) (*types.MsgRecordObligationResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgRecordObligationResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// FundSettlementEscrow broadcasts a MsgFundSettlementEscrow and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) FundSettlementEscrow(ctx context.Context, msg *types.MsgFundSettlementEscrow) (*types.MsgFundSettlementEscrowResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgFundSettlementEscrowResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// WithdrawSettlementEscrow broadcasts a MsgWithdrawSettlementEscrow and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) WithdrawSettlementEscrow(ctx context.Context, msg *types.MsgWithdrawSettlementEscrow) (*types.MsgWithdrawSettlementEscrowResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgWithdrawSettlementEscrowResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// ConfirmSettlement broadcasts a MsgConfirmSettlement and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) ConfirmSettlement(ctx context.Context, msg *types.MsgConfirmSettlement) (*types.MsgConfirmSettlementResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgConfirmSettlementResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// RedeemPoints broadcasts a MsgRedeemPoints and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) RedeemPoints(ctx context.Context, msg *types.MsgRedeemPoints) (*types.MsgRedeemPointsResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgRedeemPointsResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SubmitRate broadcasts a MsgSubmitRate and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SubmitRate(ctx context.Context, msg *types.MsgSubmitRate) (*types.MsgSubmitRateResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSubmitRateResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SetTransferPolicy broadcasts a MsgSetTransferPolicy and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SetTransferPolicy(ctx context.Context, msg *types.MsgSetTransferPolicy) (*types.MsgSetTransferPolicyResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSetTransferPolicyResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// TransferPoints broadcasts a MsgTransferPoints and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) TransferPoints(ctx context.Context, msg *types.MsgTransferPoints) (*types.MsgTransferPointsResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgTransferPointsResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SetVelocityLimits broadcasts a MsgSetVelocityLimits and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SetVelocityLimits(ctx context.Context, msg *types.MsgSetVelocityLimits) (*types.MsgSetVelocityLimitsResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSetVelocityLimitsResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// FreezeMember broadcasts a MsgFreezeMember and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) FreezeMember(ctx context.Context, msg *types.MsgFreezeMember) (*types.MsgFreezeMemberResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgFreezeMemberResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// UnfreezeMember broadcasts a MsgUnfreezeMember and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) UnfreezeMember(ctx context.Context, msg *types.MsgUnfreezeMember) (*types.MsgUnfreezeMemberResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgUnfreezeMemberResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// RecordAttestation broadcasts a MsgRecordAttestation and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) RecordAttestation(ctx context.Context, msg *types.MsgRecordAttestation) (*types.MsgRecordAttestationResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgRecordAttestationResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// RevokeAttestation broadcasts a MsgRevokeAttestation and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) RevokeAttestation(ctx context.Context, msg *types.MsgRevokeAttestation) (*types.MsgRevokeAttestationResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgRevokeAttestationResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SetKycRequirement broadcasts a MsgSetKycRequirement and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SetKycRequirement(ctx context.Context, msg *types.MsgSetKycRequirement) (*types.MsgSetKycRequirementResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSetKycRequirementResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// BlockAddress broadcasts a MsgBlockAddress and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) BlockAddress(ctx context.Context, msg *types.MsgBlockAddress) (*types.MsgBlockAddressResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgBlockAddressResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// UnblockAddress broadcasts a MsgUnblockAddress and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) UnblockAddress(ctx context.Context, msg *types.MsgUnblockAddress) (*types.MsgUnblockAddressResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgUnblockAddressResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SetLiquidityThresholds broadcasts a MsgSetLiquidityThresholds and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SetLiquidityThresholds(ctx context.Context, msg *types.MsgSetLiquidityThresholds) (*types.MsgSetLiquidityThresholdsResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSetLiquidityThresholdsResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SetPaused broadcasts a MsgSetPaused and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SetPaused(ctx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSetPausedResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// SendPoints broadcasts a MsgSendPoints and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) SendPoints(ctx context.Context, msg *types.MsgSendPoints) (*types.MsgSendPointsResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgSendPointsResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// RegisterRemoteTreasury broadcasts a MsgRegisterRemoteTreasury and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) RegisterRemoteTreasury(ctx context.Context, msg *types.MsgRegisterRemoteTreasury) (*types.MsgRegisterRemoteTreasuryResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgRegisterRemoteTreasuryResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
+
+// ExecuteTopUp broadcasts a MsgExecuteTopUp and returns its response. An empty Creator
+// defaults to the client's address.
+func (c *Client) ExecuteTopUp(ctx context.Context, msg *types.MsgExecuteTopUp) (*types.MsgExecuteTopUpResponse, *TxResult, error) {
+	if msg.Creator == "" {
+		msg.Creator = c.address
+	}
+	res := new(types.MsgExecuteTopUpResponse)
+	tx, err := c.execMsg(ctx, msg, res)
+	if err != nil {
+		return nil, tx, err
+	}
+	return res, tx, nil
+}
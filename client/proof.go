@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// ProvePartner reads partner id as of height through the store's ABCI query
+// and returns it with its ICS-23 proof; a height of 0 reads the latest state.
+// Check the result with types.VerifyPartnerProof against an app hash you
+// trust.
+func (c *Client) ProvePartner(ctx context.Context, id uint64, height int64) (types.StoreProof, error) {
+	return c.proveKey(ctx, types.PartnerStoreKey(id), height)
+}
+
+// ProveMemberBalance reads a member's balance with a partner as of height and
+// returns it with its ICS-23 proof. Check the result with
+// types.VerifyMemberBalanceProof.
+func (c *Client) ProveMemberBalance(ctx context.Context, partnerID uint64, member string, height int64) (types.StoreProof, error) {
+	addr, err := c.addressCodec.StringToBytes(member)
+	if err != nil {
+		return types.StoreProof{}, err
+	}
+	return c.proveKey(ctx, types.MemberBalanceStoreKey(partnerID, addr), height)
+}
+
+// AppHash returns the app hash committing the state after height, which is
+// the one in the header of block height+1. It is what the node reports; an
+// auditor that does not trust the node takes it from a light client instead.
+func (c *Client) AppHash(ctx context.Context, height int64) ([]byte, error) {
+	res, err := c.cmt.GetBlockByHeight(ctx, &cmtservice.GetBlockByHeightRequest{Height: height + 1})
+	if err != nil {
+		return nil, err
+	}
+	return res.SdkBlock.Header.AppHash, nil
+}
+
+func (c *Client) proveKey(ctx context.Context, key []byte, height int64) (types.StoreProof, error) {
+	res, err := c.cmt.ABCIQuery(ctx, &cmtservice.ABCIQueryRequest{
+		Path:   types.StoreQueryPath,
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return types.StoreProof{}, err
+	}
+	if res.Code != 0 {
+		return types.StoreProof{}, errorsmod.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	p := types.StoreProof{Height: res.Height, Key: key, Proof: &cmtcrypto.ProofOps{}}
+	if len(res.Value) > 0 {
+		p.Value = res.Value
+	}
+	if res.ProofOps != nil {
+		for _, op := range res.ProofOps.Ops {
+			p.Proof.Ops = append(p.Proof.Ops, cmtcrypto.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data})
+		}
+	}
+	return p, nil
+}
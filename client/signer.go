@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Signer signs transactions in SIGN_MODE_DIRECT.
+type Signer interface {
+	Address() sdk.AccAddress
+	PubKey() cryptotypes.PubKey
+	Sign(signBytes []byte) ([]byte, error)
+}
+
+type keyringSigner struct {
+	kr     keyring.Keyring
+	uid    string
+	pubKey cryptotypes.PubKey
+}
+
+// NewKeyringSigner signs with the key named uid in kr.
+func NewKeyringSigner(kr keyring.Keyring, uid string) (Signer, error) {
+	record, err := kr.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return keyringSigner{kr: kr, uid: uid, pubKey: pubKey}, nil
+}
+
+func (s keyringSigner) Address() sdk.AccAddress    { return sdk.AccAddress(s.pubKey.Address()) }
+func (s keyringSigner) PubKey() cryptotypes.PubKey { return s.pubKey }
+
+func (s keyringSigner) Sign(signBytes []byte) ([]byte, error) {
+	sig, _, err := s.kr.Sign(s.uid, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+	return sig, err
+}
+
+type privKeySigner struct {
+	key cryptotypes.PrivKey
+}
+
+// NewPrivKeySigner signs with key.
+func NewPrivKeySigner(key cryptotypes.PrivKey) Signer {
+	return privKeySigner{key: key}
+}
+
+// NewRawKeySigner signs with a raw 32-byte secp256k1 private key.
+func NewRawKeySigner(key []byte) (Signer, error) {
+	if len(key) != secp256k1.PrivKeySize {
+		return nil, fmt.Errorf("secp256k1 private key must be %d bytes, got %d", secp256k1.PrivKeySize, len(key))
+	}
+	return privKeySigner{key: &secp256k1.PrivKey{Key: key}}, nil
+}
+
+func (s privKeySigner) Address() sdk.AccAddress    { return sdk.AccAddress(s.key.PubKey().Address()) }
+func (s privKeySigner) PubKey() cryptotypes.PubKey { return s.key.PubKey() }
+
+func (s privKeySigner) Sign(signBytes []byte) ([]byte, error) {
+	return s.key.Sign(signBytes)
+}
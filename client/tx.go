@@ -0,0 +1,281 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TxResult is a transaction included in a block.
+type TxResult struct {
+	Hash      string
+	Height    int64
+	GasWanted int64
+	GasUsed   int64
+	Events    []abci.Event
+	Response  *sdk.TxResponse
+}
+
+// DecodeEvents returns the rewardchain events the transaction emitted.
+func (r *TxResult) DecodeEvents() ([]Event, error) {
+	return DecodeEvents(r.Events)
+}
+
+// UnpackResponse unmarshals the response of the i-th message into out.
+func (r *TxResult) UnpackResponse(i int, out proto.Message) error {
+	bz, err := hex.DecodeString(r.Response.Data)
+	if err != nil {
+		return err
+	}
+	var data sdk.TxMsgData
+	if err := proto.Unmarshal(bz, &data); err != nil {
+		return err
+	}
+	if i < 0 || i >= len(data.MsgResponses) {
+		return fmt.Errorf("tx %s has %d message responses, want index %d", r.Hash, len(data.MsgResponses), i)
+	}
+	res := data.MsgResponses[i]
+	if want := sdk.MsgTypeURL(out); res.TypeUrl != want {
+		return fmt.Errorf("message response %d is %s, not %s", i, res.TypeUrl, want)
+	}
+	return proto.Unmarshal(res.Value, out)
+}
+
+// sequence tracks the signer's account number and next sequence so that
+// concurrent broadcasts of one client sign with consecutive sequences.
+type sequence struct {
+	mu     sync.Mutex
+	loaded bool
+	number uint64
+	next   uint64
+}
+
+// BroadcastMsgs signs msgs into one transaction, broadcasts it and waits
+// until it is included in a block. A transaction that fails on chain is
+// returned together with its error. Errors the node reports for a broadcast
+// match errors.Is against the module sentinels, e.g. types.ErrPartnerNotFound;
+// simulation errors only carry the node's message.
+func (c *Client) BroadcastMsgs(ctx context.Context, msgs ...sdk.Msg) (*TxResult, error) {
+	hash, err := c.submit(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+	return c.WaitForTx(ctx, hash)
+}
+
+// EstimateGas simulates msgs and returns the gas limit they would be sent
+// with.
+func (c *Client) EstimateGas(ctx context.Context, msgs ...sdk.Msg) (uint64, error) {
+	if c.signer == nil {
+		return 0, ErrNoSigner
+	}
+	c.seq.mu.Lock()
+	defer c.seq.mu.Unlock()
+
+	if !c.seq.loaded {
+		if err := c.loadAccount(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return c.simulate(ctx, msgs)
+}
+
+// WaitForTx polls for a broadcast transaction until it is included in a
+// block or ctx is done.
+func (c *Client) WaitForTx(ctx context.Context, hash string) (*TxResult, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		res, err := c.tx.GetTx(ctx, &txtypes.GetTxRequest{Hash: hash})
+		if err == nil {
+			r := res.TxResponse
+			result := &TxResult{
+				Hash:      r.TxHash,
+				Height:    r.Height,
+				GasWanted: r.GasWanted,
+				GasUsed:   r.GasUsed,
+				Events:    r.Events,
+				Response:  r,
+			}
+			if r.Code != 0 {
+				return result, abciError(r)
+			}
+			return result, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) execMsg(ctx context.Context, msg sdk.Msg, res proto.Message) (*TxResult, error) {
+	tx, err := c.BroadcastMsgs(ctx, msg)
+	if err != nil {
+		return tx, err
+	}
+	return tx, tx.UnpackResponse(0, res)
+}
+
+// submit signs and broadcasts msgs with the next sequence and returns the tx
+// hash once the node accepted it into its mempool. The sequence lock is held
+// until then, so concurrent submits never sign with the same sequence. A
+// stale sequence, e.g. after the key was used elsewhere, is reloaded and the
+// transaction retried once.
+func (c *Client) submit(ctx context.Context, msgs []sdk.Msg) (string, error) {
+	if c.signer == nil {
+		return "", ErrNoSigner
+	}
+	c.seq.mu.Lock()
+	defer c.seq.mu.Unlock()
+
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if !c.seq.loaded || attempt > 0 {
+			if err := c.loadAccount(ctx); err != nil {
+				return "", err
+			}
+		}
+		var hash string
+		if hash, err = c.signAndBroadcast(ctx, msgs); err == nil {
+			c.seq.next++
+			return hash, nil
+		}
+		if !isWrongSequence(err) {
+			break
+		}
+	}
+	return "", err
+}
+
+func (c *Client) signAndBroadcast(ctx context.Context, msgs []sdk.Msg) (string, error) {
+	gas := c.gasLimit
+	if gas == 0 {
+		var err error
+		if gas, err = c.simulate(ctx, msgs); err != nil {
+			return "", err
+		}
+	}
+	txBytes, err := c.buildTx(ctx, msgs, gas, true)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.tx.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		// the node may or may not have taken the tx; reload the sequence
+		c.seq.loaded = false
+		return "", err
+	}
+	if res.TxResponse.Code != 0 {
+		return "", abciError(res.TxResponse)
+	}
+	return res.TxResponse.TxHash, nil
+}
+
+// simulate returns the adjusted gas limit of msgs.
+func (c *Client) simulate(ctx context.Context, msgs []sdk.Msg) (uint64, error) {
+	txBytes, err := c.buildTx(ctx, msgs, 0, false)
+	if err != nil {
+		return 0, err
+	}
+	res, err := c.tx.Simulate(ctx, &txtypes.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, fmt.Errorf("simulate: %w", err)
+	}
+	return uint64(float64(res.GasInfo.GasUsed) * c.gasAdjustment), nil
+}
+
+// buildTx encodes msgs signed with the current sequence. Unsigned
+// transactions carry an empty signature for simulation.
+func (c *Client) buildTx(ctx context.Context, msgs []sdk.Msg, gas uint64, sign bool) ([]byte, error) {
+	txb := c.txConfig.NewTxBuilder()
+	if err := txb.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	txb.SetMemo(c.memo)
+	txb.SetGasLimit(gas)
+	txb.SetFeeAmount(c.fees(gas))
+
+	data := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+	sig := signing.SignatureV2{PubKey: c.signer.PubKey(), Data: data, Sequence: c.seq.next}
+	if err := txb.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	if sign {
+		signBytes, err := authsigning.GetSignBytesAdapter(ctx, c.txConfig.SignModeHandler(), data.SignMode, authsigning.SignerData{
+			Address:       c.address,
+			ChainID:       c.chainID,
+			AccountNumber: c.seq.number,
+			Sequence:      c.seq.next,
+			PubKey:        c.signer.PubKey(),
+		}, txb.GetTx())
+		if err != nil {
+			return nil, err
+		}
+		if data.Signature, err = c.signer.Sign(signBytes); err != nil {
+			return nil, err
+		}
+		if err := txb.SetSignatures(sig); err != nil {
+			return nil, err
+		}
+	}
+	return c.txConfig.TxEncoder()(txb.GetTx())
+}
+
+func (c *Client) fees(gas uint64) sdk.Coins {
+	limit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas))
+	fees := make([]sdk.Coin, 0, len(c.gasPrices))
+	for _, p := range c.gasPrices {
+		fees = append(fees, sdk.NewCoin(p.Denom, p.Amount.Mul(limit).Ceil().RoundInt()))
+	}
+	return sdk.NewCoins(fees...)
+}
+
+func (c *Client) loadAccount(ctx context.Context) error {
+	res, err := c.auth.AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: c.address})
+	if err != nil {
+		return err
+	}
+	c.seq.number, c.seq.next, c.seq.loaded = res.Info.AccountNumber, res.Info.Sequence, true
+	return nil
+}
+
+// abciError turns a failed tx response into the error registered for its
+// codespace and code.
+func abciError(r *sdk.TxResponse) error {
+	return errorsmod.ABCIError(r.Codespace, r.Code, r.RawLog)
+}
+
+// isWrongSequence also matches simulation errors, which only carry the
+// error message.
+func isWrongSequence(err error) bool {
+	return errors.Is(err, sdkerrors.ErrWrongSequence) || strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error())
+}
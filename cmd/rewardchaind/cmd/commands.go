@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/viper"
 
 	"rewardchain/app"
+	rewardchaincli "rewardchain/x/rewardchain/client/cli"
 )
 
 func initRootCmd(
@@ -47,10 +48,16 @@ func initRootCmd(
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(txConfig, basicManager),
+		genesisCommand(
+			txConfig,
+			basicManager,
+			rewardchaincli.AddGenesisAdminCmd(app.DefaultNodeHome),
+			rewardchaincli.AddGenesisPartnerCmd(app.DefaultNodeHome),
+		),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		indexerCommand(),
 	)
 }
 
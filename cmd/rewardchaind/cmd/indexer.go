@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"rewardchain/indexer"
+)
+
+const (
+	flagIndexerDB           = "db"
+	flagIndexerListen       = "listen"
+	flagIndexerPollInterval = "poll-interval"
+	flagIndexerFromHeight   = "from-height"
+)
+
+// indexerCommand follows a node and serves its rewardchain events from an
+// SQLite database.
+func indexerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer",
+		Short: "Index rewardchain events into SQLite and serve them over JSON and GraphQL",
+		Long: `Follow the block results of a node over CometBFT RPC, store the rewardchain
+module's events and the liquidity of the partners they touch in an SQLite
+database, and serve them over HTTP:
+
+  GET  /status
+  GET  /events?type=&partner_id=&sender=&from_height=&to_height=&limit=
+  GET  /partners/{id}/liquidity?from_height=&to_height=
+  POST /graphql
+
+The indexer resumes after the last indexed height. An empty database is filled
+from --from-height, or from the node's earliest block; point it at an archive
+node to replay the chain from genesis.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			nodeURI, _ := cmd.Flags().GetString(flags.FlagNode)
+			dbPath, _ := cmd.Flags().GetString(flagIndexerDB)
+			listen, _ := cmd.Flags().GetString(flagIndexerListen)
+			interval, _ := cmd.Flags().GetDuration(flagIndexerPollInterval)
+			fromHeight, _ := cmd.Flags().GetInt64(flagIndexerFromHeight)
+			if dbPath == "" {
+				dbPath = filepath.Join(clientCtx.HomeDir, "data", "indexer.db")
+			}
+
+			node, err := client.NewClientFromNode(nodeURI)
+			if err != nil {
+				return err
+			}
+			store, err := indexer.OpenStore(dbPath)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+			handler, err := indexer.NewHandler(store)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewLogger(cmd.ErrOrStderr()).With("module", "indexer")
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			srv := &http.Server{Addr: listen, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+			g, ctx := errgroup.WithContext(ctx)
+			g.Go(func() error {
+				logger.Info("serving index", "address", listen, "db", dbPath)
+				if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			})
+			g.Go(func() error {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				return srv.Shutdown(shutdownCtx)
+			})
+			g.Go(func() error {
+				return indexer.New(node, store, logger, fromHeight).Run(ctx, interval)
+			})
+			return g.Wait()
+		},
+	}
+
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to CometBFT RPC interface for this chain")
+	cmd.Flags().String(flagIndexerDB, "", "SQLite database path (default <home>/data/indexer.db)")
+	cmd.Flags().String(flagIndexerListen, "localhost:8090", "Address the JSON and GraphQL API listens on")
+	cmd.Flags().Duration(flagIndexerPollInterval, time.Second, "How often the node is polled for new blocks")
+	cmd.Flags().Int64(flagIndexerFromHeight, 0, "Height an empty database starts at (default: the node's earliest block)")
+	return cmd
+}
@@ -0,0 +1,18 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/cmd/rewardchaind/cmd"
+)
+
+// TestNewRootCmd builds every autocli command, which panics when a message
+// field's flag clashes with a tx flag.
+func TestNewRootCmd(t *testing.T) {
+	root := cmd.NewRootCmd()
+	blockCmd, _, err := root.Find([]string{"tx", "rewardchain", "block-address"})
+	require.NoError(t, err)
+	require.Equal(t, "block-address [address] [reason] [note]", blockCmd.Use)
+}
@@ -44,6 +44,7 @@ type valArgs struct {
 	accountsToFund     []sdk.AccAddress
 	upgradeToTrigger   string
 	homeDir            string
+	rewardchain        rewardchainSeed
 }
 
 func NewInPlaceTestnetCmd(addStartFlags servertypes.ModuleInitFlags) *cobra.Command {
@@ -53,11 +54,13 @@ func NewInPlaceTestnetCmd(addStartFlags servertypes.ModuleInitFlags) *cobra.Comm
 	cmd.Long = `The test command modifies both application and consensus stores within a local mainnet node and starts the node,
 with the aim of facilitating testing procedures. This command replaces existing validator data with updated information,
 thereby removing the old validator set and introducing a new set suitable for local testing purposes. By altering the state extracted from the mainnet node,
-it enables developers to configure their local environments to reflect mainnet conditions more accurately.`
+it enables developers to configure their local environments to reflect mainnet conditions more accurately.
+The --rewardchain-admins, --rewardchain-partners-file and --rewardchain-fixture flags add rewardchain admins, partners and members on top.`
 
 	cmd.Example = fmt.Sprintf(`%sd in-place-testnet testing-1 cosmosvaloper1w7f3xx7e75p4l7qdym5msqem9rd4dyc4mq79dm --home $HOME/.%sd/validator1 --validator-privkey=6dq+/KHNvyiw2TToCgOpUpQKIzrLs69Rb8Az39xvmxPHNoPxY1Cil8FY+4DhT9YwD6s0tFABMlLcpaylzKKBOg== --accounts-to-fund="cosmos1f7twgcq4ypzg7y24wuywy06xmdet8pc4473tnq,cosmos1qvuhm5m644660nd8377d6l7yz9e9hhm9evmx3x"`, "rewardchain", "rewardchain")
 
 	cmd.Flags().String(flagAccountsToFund, "", "Comma-separated list of account addresses that will be funded for testing purposes")
+	addRewardchainSeedFlags(cmd)
 	return cmd
 }
 
@@ -210,6 +213,19 @@ func initAppForTestnet(app *app.App, args valArgs) *app.App {
 		}
 	}
 
+	// REWARDCHAIN
+	//
+
+	// Add admins and partners; partners without a treasury fall back to the
+	// first funded account.
+	var fallbackTreasury string
+	if len(args.accountsToFund) > 0 {
+		fallbackTreasury = args.accountsToFund[0].String()
+	}
+	if err := args.rewardchain.applyKeeper(ctx, app.RewardchainKeeper, fallbackTreasury); err != nil {
+		tmos.Exit(err.Error())
+	}
+
 	return app
 }
 
@@ -251,6 +267,17 @@ func getCommandArgs(appOpts servertypes.AppOptions) (valArgs, error) {
 		}
 	}
 
+	// validate and load the rewardchain state to add
+	rewardchain, err := readRewardchainSeed(
+		cast.ToString(appOpts.Get(flagRewardchainAdmins)),
+		cast.ToString(appOpts.Get(flagRewardchainPartnersFile)),
+		cast.ToString(appOpts.Get(flagRewardchainFixture)),
+	)
+	if err != nil {
+		return args, err
+	}
+	args.rewardchain = rewardchain
+
 	// home dir
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	if homeDir == "" {
@@ -37,6 +37,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	runtime "github.com/cosmos/cosmos-sdk/runtime"
+
+	rewardchaintypes "rewardchain/x/rewardchain/types"
 )
 
 var (
@@ -61,6 +63,7 @@ type initArgs struct {
 	startingIPAddress      string
 	validatorsStakesAmount map[int]sdk.Coin
 	ports                  map[int]string
+	rewardchain            rewardchainSeed
 }
 
 // NewTestnetMultiNodeCmd returns a cmd to initialize all files for tendermint testnet and application
@@ -76,6 +79,9 @@ or a similar setup where each node has a manually configurable IP address.
 
 Note, strict routability for addresses is turned off in the config file.
 
+The --rewardchain-admins, --rewardchain-partners-file and --rewardchain-fixture
+flags add rewardchain admins, partners and members to the genesis file.
+
 Example:
 	rewardchaind multi-node --v 4 --output-dir ./.testnets --validators-stake-amount 1000000,200000,300000,400000 --list-ports 47222,50434,52851,44210
 	`,
@@ -128,6 +134,13 @@ Example:
 				}
 			}
 
+			admins, _ := cmd.Flags().GetString(flagRewardchainAdmins)
+			partnersFile, _ := cmd.Flags().GetString(flagRewardchainPartnersFile)
+			fixture, _ := cmd.Flags().GetString(flagRewardchainFixture)
+			if args.rewardchain, err = readRewardchainSeed(admins, partnersFile, fixture); err != nil {
+				return err
+			}
+
 			return initTestnetFiles(clientCtx, cmd, config, mbm, genBalIterator, args)
 		},
 	}
@@ -138,6 +151,7 @@ Example:
 	cmd.Flags().String(flagValidatorsStakeAmount, "100000000,100000000,100000000,100000000", "Amount of stake for each validator")
 	cmd.Flags().String(flagStartingIPAddress, "localhost", "Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
 	cmd.Flags().String(flags.FlagKeyringBackend, "test", "Select keyring's backend (os|file|test)")
+	addRewardchainSeedFlags(cmd)
 
 	return cmd
 }
@@ -312,7 +326,7 @@ func initTestnetFiles(
 		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), appConfig)
 	}
 
-	if err := initGenFiles(clientCtx, mbm, args.chainID, genAccounts, genBalances, genFiles, args.numValidators); err != nil {
+	if err := initGenFiles(clientCtx, mbm, args.chainID, genAccounts, genBalances, genFiles, args.numValidators, args.rewardchain); err != nil {
 		return err
 	}
 	// copy gentx file
@@ -358,7 +372,7 @@ func writeFile(file, dir string, contents []byte) error {
 func initGenFiles(
 	clientCtx client.Context, mbm module.BasicManager, chainID string,
 	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
-	genFiles []string, numValidators int,
+	genFiles []string, numValidators int, rewardchainSeed rewardchainSeed,
 ) error {
 	appGenState := mbm.DefaultGenesis(clientCtx.Codec)
 
@@ -384,6 +398,15 @@ func initGenFiles(
 	}
 	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
 
+	// add the rewardchain admins and partners; partners without a treasury
+	// fall back to the first validator's account
+	var rewardchainGenState rewardchaintypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[rewardchaintypes.ModuleName], &rewardchainGenState)
+	if err := rewardchainSeed.applyGenesis(&rewardchainGenState, genAccounts[0].GetAddress().String()); err != nil {
+		return err
+	}
+	appGenState[rewardchaintypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&rewardchainGenState)
+
 	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
 	if err != nil {
 		return err
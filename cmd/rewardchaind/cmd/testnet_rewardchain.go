@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	rewardchaincli "rewardchain/x/rewardchain/client/cli"
+	rewardchainkeeper "rewardchain/x/rewardchain/keeper"
+	rewardchaintypes "rewardchain/x/rewardchain/types"
+)
+
+var (
+	flagRewardchainAdmins       = "rewardchain-admins"
+	flagRewardchainPartnersFile = "rewardchain-partners-file"
+	flagRewardchainFixture      = "rewardchain-fixture"
+)
+
+// rewardchainFixture is a generated loyalty dataset: partners, each with
+// liquidity and members holding points that came out of it.
+type rewardchainFixture struct {
+	partners  int
+	members   int
+	liquidity int64
+}
+
+var rewardchainFixtures = map[string]rewardchainFixture{
+	"small": {partners: 3, members: 10, liquidity: 100_000},
+	"demo":  {partners: 8, members: 50, liquidity: 1_000_000},
+	"load":  {partners: 50, members: 500, liquidity: 100_000_000},
+}
+
+var fixtureBrands = []struct{ name, category, country, currency string }{
+	{"Northwind Coffee", "dining", "US", "USD"},
+	{"Contoso Air", "travel", "GB", "GBP"},
+	{"Fabrikam Fuel", "fuel", "DE", "EUR"},
+	{"Tailspin Books", "retail", "FR", "EUR"},
+	{"Wingtip Grocers", "grocery", "NL", "EUR"},
+	{"Adventure Works", "retail", "CA", "CAD"},
+	{"Litware Cinemas", "entertainment", "AU", "AUD"},
+	{"Proseware Hotels", "travel", "JP", "JPY"},
+}
+
+// rewardchainSeed is the rewardchain state the testnet commands add to a
+// network.
+type rewardchainSeed struct {
+	admins   []string
+	partners []seedPartner
+}
+
+// seedPartner is a partner without an id and the points balances of its
+// members.
+type seedPartner struct {
+	partner  rewardchaintypes.Partner
+	balances []rewardchaintypes.MemberBalance
+}
+
+func addRewardchainSeedFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagRewardchainAdmins, "", "Comma-separated list of addresses to add to the rewardchain admins; the first also funds partners without a treasury")
+	cmd.Flags().String(flagRewardchainPartnersFile, "", "CSV or JSON file of partners to create, in the import-partners format")
+	cmd.Flags().String(flagRewardchainFixture, "", fmt.Sprintf("Generated dataset of partners, members and liquidity to create (%s)", strings.Join(rewardchainFixtureNames(), "|")))
+}
+
+func rewardchainFixtureNames() []string {
+	names := make([]string, 0, len(rewardchainFixtures))
+	for name := range rewardchainFixtures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readRewardchainSeed validates the rewardchain testnet flags and loads the
+// partners they name. Partners of the file come before those of the fixture.
+func readRewardchainSeed(admins, partnersFile, fixture string) (rewardchainSeed, error) {
+	var seed rewardchainSeed
+	for _, admin := range strings.Split(admins, ",") {
+		admin = strings.TrimSpace(admin)
+		if admin == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+			return seed, fmt.Errorf("invalid rewardchain admin %q: %w", admin, err)
+		}
+		if slices.Contains(seed.admins, admin) {
+			continue
+		}
+		seed.admins = append(seed.admins, admin)
+	}
+
+	if partnersFile != "" {
+		partners, err := rewardchaincli.ReadGenesisPartners(partnersFile)
+		if err != nil {
+			return seed, err
+		}
+		for _, p := range partners {
+			seed.partners = append(seed.partners, seedPartner{partner: p})
+		}
+	}
+
+	if fixture != "" {
+		f, ok := rewardchainFixtures[fixture]
+		if !ok {
+			return seed, fmt.Errorf("unknown rewardchain fixture %q, want one of %s", fixture, strings.Join(rewardchainFixtureNames(), ", "))
+		}
+		seed.partners = append(seed.partners, f.generate(fixture)...)
+	}
+	return seed, nil
+}
+
+// generate builds the fixture's partners. The data only depends on the
+// fixture, so every network seeded with it holds the same partners, and
+// member i of one partner is member i of every other.
+func (f rewardchainFixture) generate(name string) []seedPartner {
+	members := make([]string, f.members)
+	for i := range members {
+		members[i] = sdk.AccAddress(tmhash.SumTruncated([]byte(fmt.Sprintf("rewardchain-fixture/%s/member/%d", name, i)))).String()
+	}
+
+	partners := make([]seedPartner, f.partners)
+	for i := range partners {
+		brand := fixtureBrands[i%len(fixtureBrands)]
+		partnerName := brand.name
+		if round := i / len(fixtureBrands); round > 0 {
+			partnerName = fmt.Sprintf("%s %d", brand.name, round+1)
+		}
+
+		rng := rand.New(rand.NewSource(int64(i)))
+		issued := math.LegacyZeroDec()
+		balances := make([]rewardchaintypes.MemberBalance, len(members))
+		for j, member := range members {
+			points := math.LegacyNewDec(int64(10 + rng.Intn(991)))
+			issued = issued.Add(points)
+			balances[j] = rewardchaintypes.MemberBalance{Member: member, Points: points.String()}
+		}
+
+		partners[i] = seedPartner{
+			partner: rewardchaintypes.Partner{
+				Name:                 partnerName,
+				Category:             brand.category,
+				Country:              brand.country,
+				Currency:             brand.currency,
+				TotalLiquidity:       fmt.Sprint(f.liquidity),
+				AvailableLiquidity:   math.LegacyNewDec(f.liquidity).Sub(issued).String(),
+				OnHoldLiquidity:      "0",
+				EarnCostPerPoint:     fmt.Sprintf("0.%02d", 1+i%5),
+				RedeemCostPerPoint:   fmt.Sprintf("0.%02d", 2+i%5),
+				NegativeBalanceLimit: math.LegacyZeroDec().String(),
+			},
+			balances: balances,
+		}
+	}
+	return partners
+}
+
+// treasury returns the address funding partners without a treasury: the
+// first admin, or fallback if there is none.
+func (s rewardchainSeed) treasury(fallback string) (string, error) {
+	if len(s.admins) > 0 {
+		return s.admins[0], nil
+	}
+	if fallback == "" {
+		for _, sp := range s.partners {
+			if sp.partner.Treasury == "" {
+				return "", fmt.Errorf("partner %q has no treasury, set one in the partners file or pass --%s", sp.partner.Name, flagRewardchainAdmins)
+			}
+		}
+	}
+	return fallback, nil
+}
+
+// applyGenesis adds the seed to a rewardchain genesis state, numbering the
+// partners after the ones already in it.
+func (s rewardchainSeed) applyGenesis(gs *rewardchaintypes.GenesisState, fallbackTreasury string) error {
+	treasury, err := s.treasury(fallbackTreasury)
+	if err != nil {
+		return err
+	}
+	for _, admin := range s.admins {
+		if err := gs.AddAdmin(admin); err != nil {
+			return err
+		}
+	}
+	for _, sp := range s.partners {
+		p := sp.partner
+		if p.Treasury == "" {
+			p.Treasury = treasury
+		}
+		id, err := gs.AddPartner(p)
+		if err != nil {
+			return fmt.Errorf("invalid partner %q: %w", p.Name, err)
+		}
+		for _, b := range sp.balances {
+			b.PartnerId = id
+			gs.MemberBalances = append(gs.MemberBalances, b)
+		}
+	}
+	return gs.Validate()
+}
+
+// applyKeeper adds the seed to the state of a running chain. Partners get ids
+// from the partner counter and admins already on the allowlist are kept once.
+func (s rewardchainSeed) applyKeeper(ctx sdk.Context, k rewardchainkeeper.Keeper, fallbackTreasury string) error {
+	treasury, err := s.treasury(fallbackTreasury)
+	if err != nil {
+		return err
+	}
+	params := k.GetParams(ctx)
+	for _, admin := range s.admins {
+		if !k.IsAdmin(ctx, admin) {
+			params.AdminAddresses = append(params.AdminAddresses, admin)
+		}
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	for _, sp := range s.partners {
+		p := sp.partner
+		if p.Treasury == "" {
+			p.Treasury = treasury
+		}
+		id, err := k.GetNextPartnerID(ctx)
+		if err != nil {
+			return err
+		}
+		p.Id = id
+		if err := k.SetPartner(ctx, p); err != nil {
+			return err
+		}
+		k.SetPartnerCounter(ctx, id)
+		for _, b := range sp.balances {
+			member, err := sdk.AccAddressFromBech32(b.Member)
+			if err != nil {
+				return err
+			}
+			if err := k.SetMemberBalance(ctx, id, member, math.LegacyMustNewDecFromStr(b.Points)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
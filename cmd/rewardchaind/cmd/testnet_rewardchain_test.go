@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	rewardchaintypes "rewardchain/x/rewardchain/types"
+)
+
+func TestRewardchainSeedGenesis(t *testing.T) {
+	admin, treasury, validator := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	path := filepath.Join(t.TempDir(), "partners.csv")
+	require.NoError(t, os.WriteFile(path, []byte("name,country,currency,total_liquidity,treasury\n"+
+		"Acme,us,usd,500,"+treasury+"\n"+
+		"Globex,GB,GBP,700,\n"), 0o600))
+
+	_, err := readRewardchainSeed("not-an-address", "", "")
+	require.ErrorContains(t, err, "invalid rewardchain admin")
+	_, err = readRewardchainSeed("", "", "huge")
+	require.ErrorContains(t, err, "unknown rewardchain fixture \"huge\", want one of demo, load, small")
+
+	// without an admin the partners without a treasury fall back to the
+	// validator's account
+	seed, err := readRewardchainSeed("", path, "small")
+	require.NoError(t, err)
+	gs := rewardchaintypes.DefaultGenesis()
+	require.NoError(t, seed.applyGenesis(gs, validator))
+	require.Len(t, gs.Partners, 5)
+	require.Equal(t, treasury, gs.Partners[0].Treasury)
+	require.Equal(t, "US", gs.Partners[0].Country)
+	require.Equal(t, validator, gs.Partners[1].Treasury)
+	require.Equal(t, "Northwind Coffee", gs.Partners[2].Name)
+	require.Equal(t, uint64(5), gs.Partners[4].Id)
+	require.Len(t, gs.MemberBalances, 3*10)
+
+	// the fixture's points come out of each partner's liquidity
+	for _, p := range gs.Partners[2:] {
+		issued := math.LegacyZeroDec()
+		for _, b := range gs.MemberBalances {
+			if b.PartnerId == p.Id {
+				issued = issued.Add(math.LegacyMustNewDecFromStr(b.Points))
+			}
+		}
+		require.True(t, issued.IsPositive())
+		require.Equal(t, math.LegacyMustNewDecFromStr(p.TotalLiquidity).Sub(issued).String(), p.AvailableLiquidity)
+	}
+
+	// the same fixture generates the same data
+	again, err := readRewardchainSeed("", "", "small")
+	require.NoError(t, err)
+	require.Equal(t, seed.partners[2:], again.partners)
+
+	// an admin funds partners without a treasury and is listed once
+	seed, err = readRewardchainSeed(admin+","+admin, path, "")
+	require.NoError(t, err)
+	gs = rewardchaintypes.DefaultGenesis()
+	require.NoError(t, seed.applyGenesis(gs, validator))
+	require.Equal(t, []string{admin}, gs.Params.AdminAddresses)
+	require.Equal(t, admin, gs.Partners[1].Treasury)
+
+	// nothing to fall back to
+	seed, err = readRewardchainSeed("", path, "")
+	require.NoError(t, err)
+	require.ErrorContains(t, seed.applyGenesis(rewardchaintypes.DefaultGenesis(), ""), "partner \"Globex\" has no treasury")
+}
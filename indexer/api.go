@@ -0,0 +1,260 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+)
+
+const graphqlSchema = `
+schema {
+	query: Query
+}
+
+type Query {
+	status: Status!
+	events(type: String, partnerId: Int, sender: String, fromHeight: Int, toHeight: Int, limit: Int): [Event!]!
+	partnerLiquidity(partnerId: Int!, fromHeight: Int, toHeight: Int): [LiquiditySnapshot!]!
+}
+
+type Status {
+	lastHeight: Int!
+}
+
+type Attribute {
+	key: String!
+	value: String!
+}
+
+type Event {
+	id: Int!
+	height: Int!
+	time: String!
+	txHash: String!
+	type: String!
+	partnerId: Int!
+	sender: String!
+	attributes: [Attribute!]!
+}
+
+type LiquiditySnapshot {
+	partnerId: Int!
+	height: Int!
+	time: String!
+	totalLiquidity: String!
+	availableLiquidity: String!
+}
+`
+
+// NewHandler serves the store:
+//
+//	GET  /status
+//	GET  /events?type=&partner_id=&sender=&from_height=&to_height=&limit=
+//	GET  /partners/{id}/liquidity?from_height=&to_height=
+//	POST /graphql
+func NewHandler(store *Store) (http.Handler, error) {
+	schema, err := graphql.ParseSchema(graphqlSchema, &resolver{store: store})
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, r *http.Request) {
+		last, err := store.LastHeight(r.Context())
+		writeJSON(w, map[string]int64{"last_height": last}, err)
+	})
+	mux.HandleFunc("GET /events", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		f := EventFilter{Type: q.Get("type"), Sender: q.Get("sender")}
+		var err error
+		if f.PartnerID, err = parseUint(q.Get("partner_id")); err != nil {
+			http.Error(w, "invalid partner_id", http.StatusBadRequest)
+			return
+		}
+		if f.FromHeight, f.ToHeight, err = parseHeightRange(q.Get("from_height"), q.Get("to_height")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if v := q.Get("limit"); v != "" {
+			if f.Limit, err = strconv.Atoi(v); err != nil {
+				http.Error(w, "invalid limit", http.StatusBadRequest)
+				return
+			}
+		}
+		events, err := store.Events(r.Context(), f)
+		writeJSON(w, events, err)
+	})
+	mux.HandleFunc("GET /partners/{id}/liquidity", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid partner id", http.StatusBadRequest)
+			return
+		}
+		q := r.URL.Query()
+		from, to, err := parseHeightRange(q.Get("from_height"), q.Get("to_height"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		snapshots, err := store.PartnerLiquidity(r.Context(), id, from, to)
+		writeJSON(w, snapshots, err)
+	})
+	mux.Handle("POST /graphql", &relay.Handler{Schema: schema})
+	return mux, nil
+}
+
+func writeJSON(w http.ResponseWriter, v any, err error) {
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func parseUint(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func parseHeightRange(from, to string) (int64, int64, error) {
+	var f, t int64
+	var err error
+	if from != "" {
+		if f, err = strconv.ParseInt(from, 10, 64); err != nil {
+			return 0, 0, errors.New("invalid from_height")
+		}
+	}
+	if to != "" {
+		if t, err = strconv.ParseInt(to, 10, 64); err != nil {
+			return 0, 0, errors.New("invalid to_height")
+		}
+	}
+	return f, t, nil
+}
+
+// resolver implements the GraphQL query type. GraphQL Ints are 32 bits, which
+// covers partner ids and heights of any realistic chain.
+type resolver struct {
+	store *Store
+}
+
+type statusResolver struct {
+	lastHeight int64
+}
+
+func (r *statusResolver) LastHeight() int32 { return int32(r.lastHeight) }
+
+func (r *resolver) Status(ctx context.Context) (*statusResolver, error) {
+	last, err := r.store.LastHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &statusResolver{lastHeight: last}, nil
+}
+
+type eventsArgs struct {
+	Type       *string
+	PartnerID  *int32
+	Sender     *string
+	FromHeight *int32
+	ToHeight   *int32
+	Limit      *int32
+}
+
+func (r *resolver) Events(ctx context.Context, args eventsArgs) ([]*eventResolver, error) {
+	f := EventFilter{
+		Type:       deref(args.Type),
+		PartnerID:  uint64(deref(args.PartnerID)),
+		Sender:     deref(args.Sender),
+		FromHeight: int64(deref(args.FromHeight)),
+		ToHeight:   int64(deref(args.ToHeight)),
+		Limit:      int(deref(args.Limit)),
+	}
+	events, err := r.store.Events(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*eventResolver, len(events))
+	for i := range events {
+		out[i] = &eventResolver{events[i]}
+	}
+	return out, nil
+}
+
+type partnerLiquidityArgs struct {
+	PartnerID  int32
+	FromHeight *int32
+	ToHeight   *int32
+}
+
+func (r *resolver) PartnerLiquidity(ctx context.Context, args partnerLiquidityArgs) ([]*snapshotResolver, error) {
+	snapshots, err := r.store.PartnerLiquidity(ctx, uint64(args.PartnerID), int64(deref(args.FromHeight)), int64(deref(args.ToHeight)))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*snapshotResolver, len(snapshots))
+	for i := range snapshots {
+		out[i] = &snapshotResolver{snapshots[i]}
+	}
+	return out, nil
+}
+
+type eventResolver struct {
+	e EventRecord
+}
+
+func (r *eventResolver) ID() int32        { return int32(r.e.ID) }
+func (r *eventResolver) Height() int32    { return int32(r.e.Height) }
+func (r *eventResolver) Time() string     { return formatTime(r.e.Time) }
+func (r *eventResolver) TxHash() string   { return r.e.TxHash }
+func (r *eventResolver) Type() string     { return r.e.Type }
+func (r *eventResolver) PartnerID() int32 { return int32(r.e.PartnerID) }
+func (r *eventResolver) Sender() string   { return r.e.Sender }
+
+type attributeResolver struct {
+	key, value string
+}
+
+func (r *attributeResolver) Key() string   { return r.key }
+func (r *attributeResolver) Value() string { return r.value }
+
+// Attributes are sorted by key so responses are stable.
+func (r *eventResolver) Attributes() []*attributeResolver {
+	keys := make([]string, 0, len(r.e.Attributes))
+	for k := range r.e.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]*attributeResolver, len(keys))
+	for i, k := range keys {
+		out[i] = &attributeResolver{key: k, value: r.e.Attributes[k]}
+	}
+	return out
+}
+
+type snapshotResolver struct {
+	l LiquiditySnapshot
+}
+
+func (r *snapshotResolver) PartnerID() int32           { return int32(r.l.PartnerID) }
+func (r *snapshotResolver) Height() int32              { return int32(r.l.Height) }
+func (r *snapshotResolver) Time() string               { return formatTime(r.l.Time) }
+func (r *snapshotResolver) TotalLiquidity() string     { return r.l.TotalLiquidity }
+func (r *snapshotResolver) AvailableLiquidity() string { return r.l.AvailableLiquidity }
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
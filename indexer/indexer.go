@@ -0,0 +1,248 @@
+// Package indexer follows a rewardchain node over CometBFT RPC and stores the
+// rewardchain module's events, plus the liquidity of every partner they
+// touch, in an SQLite database that it serves over JSON and GraphQL.
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"rewardchain/client"
+	"rewardchain/x/rewardchain/types"
+)
+
+// partnerQueryPath is the gRPC route liquidity snapshots are queried with.
+const partnerQueryPath = "/rewardchain.rewardchain.Query/Partner"
+
+// Node is the part of the CometBFT RPC client the indexer uses.
+type Node interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+	ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error)
+}
+
+// Indexer copies rewardchain events from a node into a Store.
+type Indexer struct {
+	node       Node
+	store      *Store
+	logger     log.Logger
+	fromHeight int64
+}
+
+// New creates an indexer. An empty store is filled starting at fromHeight,
+// or at the node's earliest block when fromHeight is 0; on archive nodes
+// that replays the chain from genesis.
+func New(node Node, store *Store, logger log.Logger, fromHeight int64) *Indexer {
+	return &Indexer{node: node, store: store, logger: logger, fromHeight: fromHeight}
+}
+
+// Run indexes new blocks every interval until ctx is done. Failures are
+// logged and retried at the next poll.
+func (ix *Indexer) Run(ctx context.Context, interval time.Duration) error {
+	for {
+		if _, err := ix.Sync(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			ix.logger.Error("indexing failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Sync indexes every block after the last indexed one up to the node's
+// latest block and returns the last indexed height. CometBFT blocks are
+// final, so the index only ever grows and resumes where it stopped.
+func (ix *Indexer) Sync(ctx context.Context) (int64, error) {
+	last, err := ix.store.LastHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+	status, err := ix.node.Status(ctx)
+	if err != nil {
+		return last, err
+	}
+
+	next := last + 1
+	if last == 0 {
+		next = max(ix.fromHeight, status.SyncInfo.EarliestBlockHeight, 1)
+	}
+	if earliest := status.SyncInfo.EarliestBlockHeight; next < earliest {
+		return last, fmt.Errorf("node only has blocks from height %d but the index needs height %d; use an archive node", earliest, next)
+	}
+
+	for h := next; h <= status.SyncInfo.LatestBlockHeight; h++ {
+		if err := ctx.Err(); err != nil {
+			return last, err
+		}
+		if err := ix.indexHeight(ctx, h); err != nil {
+			return last, fmt.Errorf("height %d: %w", h, err)
+		}
+		last = h
+	}
+	return last, nil
+}
+
+func (ix *Indexer) indexHeight(ctx context.Context, height int64) error {
+	res, err := ix.node.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	// txIndexes holds the transaction of every event, -1 for block events
+	var (
+		b         = Block{Height: height}
+		txIndexes []int
+	)
+	for i, tx := range res.TxsResults {
+		// failed transactions only keep the events of their fees
+		if tx.Code != 0 {
+			continue
+		}
+		senders := messageSenders(tx.Events)
+		for _, ev := range tx.Events {
+			e, ok, err := record(ev)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if idx, found := attribute(ev, "msg_index"); found {
+				e.Sender = senders[idx]
+			}
+			b.Events, txIndexes = append(b.Events, e), append(txIndexes, i)
+		}
+	}
+	for _, ev := range res.FinalizeBlockEvents {
+		e, ok, err := record(ev)
+		if err != nil {
+			return err
+		}
+		if ok {
+			b.Events, txIndexes = append(b.Events, e), append(txIndexes, -1)
+		}
+	}
+
+	if len(b.Events) > 0 {
+		block, err := ix.node.Block(ctx, &height)
+		if err != nil {
+			return err
+		}
+		b.Time = block.Block.Time
+		for i, txIndex := range txIndexes {
+			if txIndex >= 0 {
+				b.Events[i].TxHash = fmt.Sprintf("%X", block.Block.Txs[txIndex].Hash())
+			}
+		}
+		if b.Snapshots, err = ix.snapshots(ctx, height, b.Events); err != nil {
+			return err
+		}
+	}
+	return ix.store.SaveBlock(ctx, b)
+}
+
+// snapshots queries the liquidity of the partners events touched as of the
+// end of the block. Partners the node cannot answer for, e.g. because it
+// pruned the height, get no snapshot.
+func (ix *Indexer) snapshots(ctx context.Context, height int64, events []EventRecord) ([]LiquiditySnapshot, error) {
+	touched := make(map[uint64]struct{})
+	for _, e := range events {
+		for _, key := range []string{"partner_id", "payer_id", "payee_id"} {
+			if id, err := strconv.ParseUint(e.Attributes[key], 10, 64); err == nil && id != 0 {
+				touched[id] = struct{}{}
+			}
+		}
+	}
+	ids := make([]uint64, 0, len(touched))
+	for id := range touched {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	out := make([]LiquiditySnapshot, 0, len(ids))
+	for _, id := range ids {
+		req := types.QueryPartnerRequest{Id: id}
+		bz, err := req.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		res, err := ix.node.ABCIQueryWithOptions(ctx, partnerQueryPath, bz, rpcclient.ABCIQueryOptions{Height: height})
+		if err != nil {
+			return nil, err
+		}
+		if !res.Response.IsOK() {
+			ix.logger.Debug("no liquidity snapshot", "partner_id", id, "height", height, "log", res.Response.Log)
+			continue
+		}
+		var partner types.QueryPartnerResponse
+		if err := partner.Unmarshal(res.Response.Value); err != nil {
+			return nil, err
+		}
+		out = append(out, LiquiditySnapshot{
+			PartnerID:          id,
+			Height:             height,
+			TotalLiquidity:     partner.Partner.TotalLiquidity,
+			AvailableLiquidity: partner.Partner.AvailableLiquidity,
+		})
+	}
+	return out, nil
+}
+
+// record converts a rewardchain event; ok is false for other events.
+func record(ev abci.Event) (EventRecord, bool, error) {
+	if _, ok, err := client.DecodeEvent(ev); !ok || err != nil {
+		return EventRecord{}, ok, err
+	}
+	e := EventRecord{Type: ev.Type, Attributes: make(map[string]string, len(ev.Attributes))}
+	for _, a := range ev.Attributes {
+		if a.Key == "msg_index" {
+			continue
+		}
+		e.Attributes[a.Key] = a.Value
+	}
+	e.PartnerID, _ = strconv.ParseUint(e.Attributes["partner_id"], 10, 64)
+	return e, true, nil
+}
+
+// messageSenders maps the msg_index of a transaction's messages to their
+// signers.
+func messageSenders(events []abci.Event) map[string]string {
+	senders := make(map[string]string)
+	for _, ev := range events {
+		if ev.Type != "message" {
+			continue
+		}
+		idx, ok := attribute(ev, "msg_index")
+		if !ok {
+			continue
+		}
+		if sender, ok := attribute(ev, "sender"); ok {
+			senders[idx] = sender
+		}
+	}
+	return senders
+}
+
+func attribute(ev abci.Event, key string) (string, bool) {
+	for _, a := range ev.Attributes {
+		if a.Key == key {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,165 @@
+package indexer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"rewardchain/client"
+	"rewardchain/indexer"
+	"rewardchain/testutil/network"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestIndexer(t *testing.T) {
+	net := network.New(t)
+	val := net.Validators[0]
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	conn, err := grpc.NewClient(val.AppConfig.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	gasPrices, err := sdk.ParseDecCoins(val.AppConfig.MinGasPrices)
+	require.NoError(t, err)
+	record, err := val.ClientCtx.Keyring.KeyByAddress(val.Address)
+	require.NoError(t, err)
+	signer, err := client.NewKeyringSigner(val.ClientCtx.Keyring, record.Name)
+	require.NoError(t, err)
+	c, err := client.New(ctx, conn, client.WithSigner(signer), client.WithGasPrices(gasPrices), client.WithPollInterval(100*time.Millisecond))
+	require.NoError(t, err)
+
+	created, _, err := c.CreatePartner(ctx, &types.MsgCreatePartner{
+		Name:             "Acme",
+		Country:          "US",
+		Currency:         "USD",
+		EarnCostPerPoint: "0.01",
+		BurnCostPerPoint: "0.01",
+		TotalLiquidity:   "100",
+	})
+	require.NoError(t, err)
+	partnerID, err := strconv.ParseUint(created.Id, 10, 64)
+	require.NoError(t, err)
+	_, first, err := c.AddPartnerLiquidity(ctx, &types.MsgAddPartnerLiquidity{PartnerId: partnerID, Amount: "5", Currency: "USD"})
+	require.NoError(t, err)
+	afterFirst := totalLiquidity(ctx, t, c, partnerID)
+
+	store, err := indexer.OpenStore(filepath.Join(t.TempDir(), "index.db"))
+	require.NoError(t, err)
+	defer store.Close()
+	ix := indexer.New(val.RPCClient, store, log.NewNopLogger(), 0)
+	last, err := ix.Sync(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, last, first.Height)
+
+	events, err := store.Events(ctx, indexer.EventFilter{Type: "add_partner_liquidity", PartnerID: partnerID})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, first.Height, events[0].Height)
+	require.Equal(t, first.Hash, events[0].TxHash)
+	require.Equal(t, val.Address.String(), events[0].Sender)
+	require.Equal(t, "5", events[0].Attributes["amount"])
+	require.NotContains(t, events[0].Attributes, "msg_index")
+
+	snapshots, err := store.PartnerLiquidity(ctx, partnerID, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, first.Height, snapshots[0].Height)
+	require.Equal(t, afterFirst, snapshots[0].TotalLiquidity)
+
+	// a restarted indexer resumes after the last indexed height
+	_, second, err := c.AddPartnerLiquidity(ctx, &types.MsgAddPartnerLiquidity{PartnerId: partnerID, Amount: "7", Currency: "USD"})
+	require.NoError(t, err)
+	afterSecond := totalLiquidity(ctx, t, c, partnerID)
+	require.NotEqual(t, afterFirst, afterSecond)
+	last, err = indexer.New(val.RPCClient, store, log.NewNopLogger(), 0).Sync(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, last, second.Height)
+	events, err = store.Events(ctx, indexer.EventFilter{Sender: val.Address.String()})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "7", events[1].Attributes["amount"])
+	snapshots, err = store.PartnerLiquidity(ctx, partnerID, second.Height, 0)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, afterSecond, snapshots[0].TotalLiquidity)
+
+	handler, err := indexer.NewHandler(store)
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	var status struct {
+		LastHeight int64 `json:"last_height"`
+	}
+	getJSON(t, srv.URL+"/status", &status)
+	require.Equal(t, last, status.LastHeight)
+
+	var byAddress []indexer.EventRecord
+	getJSON(t, srv.URL+"/events?type=add_partner_liquidity&sender="+val.Address.String()+"&limit=1", &byAddress)
+	require.Len(t, byAddress, 1)
+	require.Equal(t, first.Hash, byAddress[0].TxHash)
+
+	var history []indexer.LiquiditySnapshot
+	getJSON(t, srv.URL+"/partners/"+created.Id+"/liquidity", &history)
+	require.Len(t, history, 2)
+	require.Equal(t, []string{afterFirst, afterSecond}, []string{history[0].TotalLiquidity, history[1].TotalLiquidity})
+
+	res, err := http.Get(srv.URL + "/events?partner_id=x")
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	query := `{"query":"{ partnerLiquidity(partnerId: ` + created.Id + `) { height totalLiquidity } events(type: \"add_partner_liquidity\") { sender attributes { key value } } }"}`
+	res, err = http.Post(srv.URL+"/graphql", "application/json", strings.NewReader(query))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	var gql struct {
+		Data struct {
+			PartnerLiquidity []struct {
+				Height         int64
+				TotalLiquidity string
+			}
+			Events []struct {
+				Sender     string
+				Attributes []struct{ Key, Value string }
+			}
+		}
+		Errors []json.RawMessage
+	}
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&gql))
+	require.Empty(t, gql.Errors)
+	require.Len(t, gql.Data.PartnerLiquidity, 2)
+	require.Equal(t, second.Height, gql.Data.PartnerLiquidity[1].Height)
+	require.Len(t, gql.Data.Events, 2)
+	require.Equal(t, val.Address.String(), gql.Data.Events[0].Sender)
+	require.Contains(t, gql.Data.Events[0].Attributes, struct{ Key, Value string }{"amount", "5"})
+}
+
+func getJSON(t *testing.T, url string, out any) {
+	t.Helper()
+	res, err := http.Get(url)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(out))
+}
+
+func totalLiquidity(ctx context.Context, t *testing.T, c *client.Client, partnerID uint64) string {
+	t.Helper()
+	res, err := c.Query.Partner(ctx, &types.QueryPartnerRequest{Id: partnerID})
+	require.NoError(t, err)
+	return res.Partner.TotalLiquidity
+}
@@ -0,0 +1,269 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // registers the "sqlite" driver
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS meta (
+	key   TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS events (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	height     INTEGER NOT NULL,
+	time       TEXT    NOT NULL,
+	tx_hash    TEXT    NOT NULL,
+	type       TEXT    NOT NULL,
+	partner_id INTEGER,
+	sender     TEXT    NOT NULL,
+	attributes TEXT    NOT NULL
+);
+CREATE INDEX IF NOT EXISTS events_by_type    ON events (type, height);
+CREATE INDEX IF NOT EXISTS events_by_partner ON events (partner_id, height);
+CREATE INDEX IF NOT EXISTS events_by_sender  ON events (sender, height);
+CREATE TABLE IF NOT EXISTS partner_liquidity (
+	partner_id          INTEGER NOT NULL,
+	height              INTEGER NOT NULL,
+	time                TEXT    NOT NULL,
+	total_liquidity     TEXT    NOT NULL,
+	available_liquidity TEXT    NOT NULL,
+	PRIMARY KEY (partner_id, height)
+);
+`
+
+const lastHeightKey = "last_height"
+
+// DefaultLimit caps the rows an events query returns.
+const DefaultLimit = 100
+
+// EventRecord is an indexed rewardchain event. TxHash is empty for events
+// emitted outside transactions, e.g. by the end blocker; Sender is the signer
+// of the message that emitted the event.
+type EventRecord struct {
+	ID         int64             `json:"id"`
+	Height     int64             `json:"height"`
+	Time       time.Time         `json:"time"`
+	TxHash     string            `json:"tx_hash,omitempty"`
+	Type       string            `json:"type"`
+	PartnerID  uint64            `json:"partner_id,omitempty"`
+	Sender     string            `json:"sender,omitempty"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// LiquiditySnapshot is a partner's liquidity at the end of a block in which
+// one of its events was emitted.
+type LiquiditySnapshot struct {
+	PartnerID          uint64    `json:"partner_id"`
+	Height             int64     `json:"height"`
+	Time               time.Time `json:"time"`
+	TotalLiquidity     string    `json:"total_liquidity"`
+	AvailableLiquidity string    `json:"available_liquidity"`
+}
+
+// EventFilter selects indexed events. Zero fields match everything.
+type EventFilter struct {
+	Type       string
+	PartnerID  uint64
+	Sender     string
+	FromHeight int64
+	ToHeight   int64
+	Limit      int
+}
+
+// Block is everything indexed for one height.
+type Block struct {
+	Height    int64
+	Time      time.Time
+	Events    []EventRecord
+	Snapshots []LiquiditySnapshot
+}
+
+// Store is the indexer's SQLite database.
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens or creates the database at path.
+func OpenStore(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	// a single connection serialises writers and keeps :memory: databases
+	// shared
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// LastHeight returns the last fully indexed height, or 0.
+func (s *Store) LastHeight(ctx context.Context) (int64, error) {
+	var v string
+	err := s.db.QueryRowContext(ctx, `SELECT value FROM meta WHERE key = ?`, lastHeightKey).Scan(&v)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+// SaveBlock stores a block and advances the last indexed height in one
+// transaction, so an interrupted indexer resumes at the first missing block.
+func (s *Store) SaveBlock(ctx context.Context, b Block) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	for _, e := range b.Events {
+		attrs, err := json.Marshal(e.Attributes)
+		if err != nil {
+			return err
+		}
+		var partnerID any
+		if e.PartnerID != 0 {
+			partnerID = e.PartnerID
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO events (height, time, tx_hash, type, partner_id, sender, attributes) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			b.Height, formatTime(b.Time), e.TxHash, e.Type, partnerID, e.Sender, string(attrs),
+		); err != nil {
+			return err
+		}
+	}
+	for _, l := range b.Snapshots {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT OR REPLACE INTO partner_liquidity (partner_id, height, time, total_liquidity, available_liquidity) VALUES (?, ?, ?, ?, ?)`,
+			l.PartnerID, b.Height, formatTime(b.Time), l.TotalLiquidity, l.AvailableLiquidity,
+		); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO meta (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value`,
+		lastHeightKey, strconv.FormatInt(b.Height, 10),
+	); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Events returns the events matching f in chain order.
+func (s *Store) Events(ctx context.Context, f EventFilter) ([]EventRecord, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if f.Type != "" {
+		where, args = append(where, "type = ?"), append(args, f.Type)
+	}
+	if f.PartnerID != 0 {
+		where, args = append(where, "partner_id = ?"), append(args, f.PartnerID)
+	}
+	if f.Sender != "" {
+		where, args = append(where, "sender = ?"), append(args, f.Sender)
+	}
+	where, args = appendHeightRange(where, args, f.FromHeight, f.ToHeight)
+	limit := f.Limit
+	if limit <= 0 || limit > DefaultLimit {
+		limit = DefaultLimit
+	}
+
+	q := `SELECT id, height, time, tx_hash, type, COALESCE(partner_id, 0), sender, attributes FROM events`
+	if len(where) > 0 {
+		q += " WHERE " + strings.Join(where, " AND ")
+	}
+	q += " ORDER BY id LIMIT ?"
+	rows, err := s.db.QueryContext(ctx, q, append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]EventRecord, 0)
+	for rows.Next() {
+		var (
+			e         EventRecord
+			t, attrs  string
+			partnerID int64
+		)
+		if err := rows.Scan(&e.ID, &e.Height, &t, &e.TxHash, &e.Type, &partnerID, &e.Sender, &attrs); err != nil {
+			return nil, err
+		}
+		e.PartnerID = uint64(partnerID)
+		if e.Time, err = time.Parse(time.RFC3339Nano, t); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(attrs), &e.Attributes); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+// PartnerLiquidity returns a partner's liquidity snapshots between two
+// heights, oldest first.
+func (s *Store) PartnerLiquidity(ctx context.Context, partnerID uint64, fromHeight, toHeight int64) ([]LiquiditySnapshot, error) {
+	where, args := appendHeightRange([]string{"partner_id = ?"}, []any{partnerID}, fromHeight, toHeight)
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT partner_id, height, time, total_liquidity, available_liquidity FROM partner_liquidity WHERE `+
+			strings.Join(where, " AND ")+` ORDER BY height`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]LiquiditySnapshot, 0)
+	for rows.Next() {
+		var (
+			l         LiquiditySnapshot
+			t         string
+			partnerID int64
+		)
+		if err := rows.Scan(&partnerID, &l.Height, &t, &l.TotalLiquidity, &l.AvailableLiquidity); err != nil {
+			return nil, err
+		}
+		l.PartnerID = uint64(partnerID)
+		if l.Time, err = time.Parse(time.RFC3339Nano, t); err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
+
+func appendHeightRange(where []string, args []any, from, to int64) ([]string, []any) {
+	if from > 0 {
+		where, args = append(where, "height >= ?"), append(args, from)
+	}
+	if to > 0 {
+		where, args = append(where, "height <= ?"), append(args, to)
+	}
+	return where, args
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
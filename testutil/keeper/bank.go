@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+var _ types.BankKeeper = (*MockBankKeeper)(nil)
+
+// MockBankKeeper is an in-memory bank for keeper tests. Module accounts are
+// addressed by their derived module address.
+type MockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// Fund mints coins into an account.
+func (b *MockBankKeeper) Fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(amt...)
+}
+
+// Balance returns the coins held by an account.
+func (b *MockBankKeeper) Balance(addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *MockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balance(addr)
+}
+
+func (b *MockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	return b.send(from, authtypes.NewModuleAddress(module), amt)
+}
+
+func (b *MockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, module string, to sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(module), to, amt)
+}
+
+func (b *MockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(from, to, amt)
+}
+
+func (b *MockBankKeeper) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(module)
+	left, hasNeg := b.balances[addr.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds to burn: %s < %s", b.balances[addr.String()], amt)
+	}
+	b.balances[addr.String()] = left
+	return nil
+}
+
+func (b *MockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	left, hasNeg := b.balances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = left
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+var _ types.DistributionKeeper = (*MockDistrKeeper)(nil)
+
+// MockDistrKeeper funds the community pool by moving coins to the
+// distribution module address of a MockBankKeeper.
+type MockDistrKeeper struct {
+	bank *MockBankKeeper
+}
+
+func NewMockDistrKeeper(bank *MockBankKeeper) *MockDistrKeeper {
+	return &MockDistrKeeper{bank: bank}
+}
+
+func (d *MockDistrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return d.bank.send(sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+}
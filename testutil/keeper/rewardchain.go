@@ -22,6 +22,12 @@ import (
 )
 
 func RewardchainKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := RewardchainKeeperWithBank(t)
+	return k, ctx
+}
+
+// RewardchainKeeperWithBank returns a keeper backed by an in-memory bank.
+func RewardchainKeeperWithBank(t testing.TB) (keeper.Keeper, *MockBankKeeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -32,12 +38,21 @@ func RewardchainKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	bank := NewMockBankKeeper()
 
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		nil,
+		bank,
+		NewMockDistrKeeper(bank),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -47,5 +62,5 @@ func RewardchainKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, bank, ctx
 }
@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
 	"github.com/stretchr/testify/require"
 
 	"rewardchain/app"
+	"rewardchain/x/rewardchain/types"
 )
 
 type (
@@ -38,11 +43,15 @@ func New(t *testing.T, configs ...Config) *Network {
 
 // DefaultConfig will initialize config for the network with custom application,
 // genesis and single validator. All other parameters are inherited from cosmos-sdk/testutil/network.DefaultConfig
+// The validators are listed as rewardchain admins.
 func DefaultConfig() network.Config {
 	cfg, err := network.DefaultConfigWithAppConfig(app.AppConfig())
 	if err != nil {
 		panic(err)
 	}
+	if err := addValidatorAdmins(&cfg); err != nil {
+		panic(err)
+	}
 	ports, err := freePorts(3)
 	if err != nil {
 		panic(err)
@@ -59,6 +68,48 @@ func DefaultConfig() network.Config {
 	return cfg
 }
 
+// addValidatorAdmins picks the mnemonics of the validators and lists their
+// operator accounts as admins in the rewardchain genesis.
+func addValidatorAdmins(cfg *network.Config) error {
+	for i := len(cfg.Mnemonics); i < cfg.NumValidators; i++ {
+		entropy, err := bip39.NewEntropy(256)
+		if err != nil {
+			return err
+		}
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		if err != nil {
+			return err
+		}
+		cfg.Mnemonics = append(cfg.Mnemonics, mnemonic)
+	}
+	admins := make([]sdk.AccAddress, len(cfg.Mnemonics))
+	for i, mnemonic := range cfg.Mnemonics {
+		derived, err := hd.Secp256k1.Derive()(mnemonic, keyring.DefaultBIP39Passphrase, sdk.GetConfig().GetFullBIP44Path())
+		if err != nil {
+			return err
+		}
+		admins[i] = sdk.AccAddress(hd.Secp256k1.Generate()(derived).PubKey().Address())
+	}
+	return AddAdmins(cfg, admins...)
+}
+
+// AddAdmins lists addrs as admins in the rewardchain genesis of cfg.
+func AddAdmins(cfg *Config, addrs ...sdk.AccAddress) error {
+	var gs types.GenesisState
+	if err := cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &gs); err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		gs.Params.AdminAddresses = append(gs.Params.AdminAddresses, addr.String())
+	}
+	bz, err := cfg.Codec.MarshalJSON(&gs)
+	if err != nil {
+		return err
+	}
+	cfg.GenesisState[types.ModuleName] = bz
+	return nil
+}
+
 // freePorts return the available ports based on the number of requested ports.
 func freePorts(n int) ([]string, error) {
 	closeFns := make([]func() error, n)
@@ -0,0 +1,87 @@
+// Package ante contains the rewardchain ante decorators the app chains in
+// front of the SDK defaults.
+package ante
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/gogoproto/proto"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// BlocklistKeeper is the part of the rewardchain keeper the blocklist
+// decorator reads.
+type BlocklistKeeper interface {
+	CheckNotBlocked(ctx context.Context, addr sdk.AccAddress) error
+}
+
+// BlocklistDecorator rejects transactions in which a blocked address signs a
+// rewardchain message, directly or through an authz MsgExec, or is the
+// recipient of a points transfer.
+type BlocklistDecorator struct {
+	k   BlocklistKeeper
+	cdc codec.Codec
+}
+
+func NewBlocklistDecorator(k BlocklistKeeper, cdc codec.Codec) BlocklistDecorator {
+	return BlocklistDecorator{k: k, cdc: cdc}
+}
+
+func (d BlocklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		if err := d.checkMsg(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d BlocklistDecorator) checkMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if exec, ok := msg.(*authz.MsgExec); ok {
+		msgs, err := exec.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if err := d.checkMsg(ctx, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !isRewardchainMsg(msg) {
+		return nil
+	}
+
+	signers, _, err := d.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return err
+	}
+	for _, s := range signers {
+		if err := d.k.CheckNotBlocked(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	if t, ok := msg.(*types.MsgTransferPoints); ok {
+		to, err := sdk.AccAddressFromBech32(t.To)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidTransfer, err.Error())
+		}
+		if err := d.k.CheckNotBlocked(ctx, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isRewardchainMsg(msg sdk.Msg) bool {
+	return strings.HasPrefix(proto.MessageName(msg), types.ModuleName+".")
+}
@@ -0,0 +1,81 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	keepertest "rewardchain/testutil/keeper"
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/ante"
+	"rewardchain/x/rewardchain/types"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestBlocklistDecorator(t *testing.T) {
+	k, ctx := keepertest.RewardchainKeeper(t)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	handler := sdk.ChainAnteDecorators(ante.NewBlocklistDecorator(k, cdc))
+
+	bad := sample.AccAddress()
+	good := sample.AccAddress()
+	require.NoError(t, k.SetBlockedAddress(ctx, types.BlockedAddress{
+		Address: bad,
+		Reason:  types.BLOCK_REASON_FRAUD,
+	}))
+
+	exec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(good), []sdk.Msg{
+		types.NewMsgRedeemPoints(bad, 1, "10"),
+	})
+
+	for _, tc := range []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "clean signer",
+			msgs: []sdk.Msg{types.NewMsgRedeemPoints(good, 1, "10")},
+		},
+		{
+			name: "blocked signer",
+			msgs: []sdk.Msg{types.NewMsgRedeemPoints(good, 1, "10"), types.NewMsgRedeemPoints(bad, 1, "10")},
+			err:  types.ErrAddressBlocked,
+		},
+		{
+			name: "blocked transfer recipient",
+			msgs: []sdk.Msg{&types.MsgTransferPoints{Creator: good, PartnerId: 1, To: bad, Amount: "1"}},
+			err:  types.ErrAddressBlocked,
+		},
+		{
+			name: "blocked signer through authz",
+			msgs: []sdk.Msg{&exec},
+			err:  types.ErrAddressBlocked,
+		},
+		{
+			name: "other modules are not checked",
+			msgs: []sdk.Msg{banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(bad), sdk.MustAccAddressFromBech32(good), nil)},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := handler(ctx, mockTx{msgs: tc.msgs}, false)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+const (
+	flagName                 = "name"
+	flagCategory             = "category"
+	flagCountry              = "country"
+	flagCurrency             = "currency"
+	flagEarnCostPerPoint     = "earn-cost-per-point"
+	flagBurnCostPerPoint     = "burn-cost-per-point"
+	flagLiquidity            = "liquidity"
+	flagTreasury             = "treasury"
+	flagNegativeBalanceLimit = "negative-balance-limit"
+)
+
+// AddGenesisAdminCmd returns the genesis subcommand that adds a module admin.
+func AddGenesisAdminCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-rewardchain-admin [address_or_key_name]",
+		Short: "Add a rewardchain admin to genesis.json",
+		Long: `Add an account to the rewardchain admin allowlist (params.admin_addresses) of
+genesis.json. If a key name is given, the address is looked up in the local
+keyring.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := genesisAddress(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			return updateGenesis(cmd, func(gs *types.GenesisState) error {
+				return gs.AddAdmin(addr)
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	return cmd
+}
+
+// AddGenesisPartnerCmd returns the genesis subcommand that adds a partner.
+func AddGenesisPartnerCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-partner",
+		Short: "Add a partner to genesis.json",
+		Long: `Add a partner to the rewardchain genesis state of genesis.json. The partner is
+validated like a create-partner transaction and gets the id after the highest
+partner id in the file, from which the chain continues numbering partners. Its
+available liquidity starts at --liquidity. The treasury may be given as an
+address or a key name in the local keyring.`,
+		Example: fmt.Sprintf(`%sd genesis add-partner --name "Acme Corporation" --category retail --country US --currency USD \
+  --earn-cost-per-point 0.10 --burn-cost-per-point 0.15 --liquidity 1000000 --treasury alice`, "rewardchain"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			treasury, _ := cmd.Flags().GetString(flagTreasury)
+			treasury, err := genesisAddress(cmd, treasury)
+			if err != nil {
+				return err
+			}
+			rec := partnerRecord{Treasury: treasury}
+			for flag, field := range map[string]*string{
+				flagName:                 &rec.Name,
+				flagCategory:             &rec.Category,
+				flagCountry:              &rec.Country,
+				flagCurrency:             &rec.Currency,
+				flagEarnCostPerPoint:     &rec.EarnCostPerPoint,
+				flagBurnCostPerPoint:     &rec.BurnCostPerPoint,
+				flagLiquidity:            &rec.TotalLiquidity,
+				flagNegativeBalanceLimit: &rec.NegativeBalanceLimit,
+			} {
+				*field, _ = cmd.Flags().GetString(flag)
+			}
+			// the treasury stands in for the creator CreatePartner defaults
+			// it to
+			msg, err := rec.msg(treasury)
+			if err != nil {
+				return err
+			}
+
+			return updateGenesis(cmd, func(gs *types.GenesisState) error {
+				id, err := gs.AddPartner(genesisPartner(msg))
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "added partner %d\n", id)
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagName, "", "Partner name")
+	cmd.Flags().String(flagCategory, "", "Partner category")
+	cmd.Flags().String(flagCountry, "", "ISO 3166-1 alpha-2 country code")
+	cmd.Flags().String(flagCurrency, "", "ISO 4217 currency code")
+	cmd.Flags().String(flagEarnCostPerPoint, "", "Cost per earned point")
+	cmd.Flags().String(flagBurnCostPerPoint, "", "Cost per burned point")
+	cmd.Flags().String(flagLiquidity, "0", "Initial total and available liquidity")
+	cmd.Flags().String(flagTreasury, "", "Treasury address or key name funding member sponsorships")
+	cmd.Flags().String(flagNegativeBalanceLimit, "", "How far below zero earn reversals may take a member balance")
+	_ = cmd.MarkFlagRequired(flagName)
+	_ = cmd.MarkFlagRequired(flagTreasury)
+	return cmd
+}
+
+// ReadGenesisPartners reads a partners file in the import-partners format and
+// returns the partners CreatePartner would store for its rows, without ids.
+// Rows without a treasury keep it empty for the caller to fill in.
+func ReadGenesisPartners(path string) ([]types.Partner, error) {
+	format, err := formatFromPath(path, "")
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	rows, err := readPartners(f, format)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		partners = make([]types.Partner, 0, len(rows))
+		errs     []error
+	)
+	for _, row := range rows {
+		// nobody signs these rows; the module address only satisfies
+		// ValidateBasic and is not used as the treasury
+		msg, err := row.record.msg(authtypes.NewModuleAddress(types.ModuleName).String())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", row.pos, err))
+			continue
+		}
+		partners = append(partners, genesisPartner(msg))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", path, err)
+	}
+	return partners, nil
+}
+
+// genesisPartner builds the partner CreatePartner would store for msg.
+func genesisPartner(msg *types.MsgCreatePartner) types.Partner {
+	liquidity := strings.TrimSpace(msg.TotalLiquidity)
+	if liquidity == "" {
+		liquidity = "0"
+	}
+	negLimit := math.LegacyZeroDec()
+	if msg.NegativeBalanceLimit != "" {
+		negLimit = math.LegacyMustNewDecFromStr(msg.NegativeBalanceLimit)
+	}
+	return types.Partner{
+		Name:                 strings.TrimSpace(msg.Name),
+		Category:             strings.TrimSpace(msg.Category),
+		Country:              types.NormalizeCode(msg.Country),
+		Currency:             types.NormalizeCode(msg.Currency),
+		TotalLiquidity:       liquidity,
+		AvailableLiquidity:   liquidity,
+		OnHoldLiquidity:      "0",
+		EarnCostPerPoint:     strings.TrimSpace(msg.EarnCostPerPoint),
+		RedeemCostPerPoint:   strings.TrimSpace(msg.BurnCostPerPoint),
+		Treasury:             msg.Treasury,
+		NegativeBalanceLimit: negLimit.String(),
+	}
+}
+
+// genesisAddress resolves an address or a key name of the local keyring.
+func genesisAddress(cmd *cobra.Command, addressOrKey string) (string, error) {
+	if _, err := sdk.AccAddressFromBech32(addressOrKey); err == nil {
+		return addressOrKey, nil
+	}
+
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	kr := clientCtx.Keyring
+	if backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend); backend != "" && kr == nil {
+		var err error
+		kr, err = keyring.New(sdk.KeyringServiceName(), backend, clientCtx.HomeDir, bufio.NewReader(cmd.InOrStdin()), clientCtx.Codec)
+		if err != nil {
+			return "", err
+		}
+	}
+	if kr == nil {
+		return "", fmt.Errorf("%q is not an address and no keyring is available", addressOrKey)
+	}
+	k, err := kr.Key(addressOrKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to get address from keyring: %w", err)
+	}
+	addr, err := k.GetAddress()
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+// updateGenesis applies update to the rewardchain state of the genesis file
+// and writes the file back if the result is valid.
+func updateGenesis(cmd *cobra.Command, update func(*types.GenesisState) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	config := server.GetServerContextFromCmd(cmd).Config
+	config.SetRoot(clientCtx.HomeDir)
+	genFile := config.GenesisFile()
+
+	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+	genState := types.DefaultGenesis()
+	if bz, ok := appState[types.ModuleName]; ok {
+		if err := clientCtx.Codec.UnmarshalJSON(bz, genState); err != nil {
+			return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+		}
+	}
+
+	if err := update(genState); err != nil {
+		return err
+	}
+	if err := genState.Validate(); err != nil {
+		return fmt.Errorf("invalid %s genesis state: %w", types.ModuleName, err)
+	}
+
+	if appState[types.ModuleName], err = clientCtx.Codec.MarshalJSON(genState); err != nil {
+		return err
+	}
+	if appGenesis.AppState, err = json.Marshal(appState); err != nil {
+		return err
+	}
+	return genutil.ExportGenesisFile(appGenesis, genFile)
+}
@@ -0,0 +1,79 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/client/cli"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestGenesisCommands(t *testing.T) {
+	home := t.TempDir()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	cfg := cmtcfg.DefaultConfig()
+	cfg.SetRoot(home)
+	require.NoError(t, os.MkdirAll(filepath.Dir(cfg.GenesisFile()), 0o755))
+	require.NoError(t, genutil.ExportGenesisFileWithTime(cfg.GenesisFile(), "test", nil, json.RawMessage(`{}`), time.Now()))
+
+	exec := func(cmd *cobra.Command, args ...string) error {
+		clientCtx := client.Context{}.WithCodec(cdc).WithHomeDir(home)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+		ctx = context.WithValue(ctx, server.ServerContextKey, server.NewContext(viper.New(), cfg, log.NewNopLogger()))
+		cmd.SetArgs(append(args, "--home="+home))
+		cmd.SetOut(io.Discard)
+		return cmd.ExecuteContext(ctx)
+	}
+	genesis := func() types.GenesisState {
+		appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+		require.NoError(t, err)
+		var gs types.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(appState[types.ModuleName], &gs))
+		return gs
+	}
+
+	admin, treasury := sample.AccAddress(), sample.AccAddress()
+	require.NoError(t, exec(cli.AddGenesisAdminCmd(home), admin))
+	require.ErrorContains(t, exec(cli.AddGenesisAdminCmd(home), admin), "already an admin")
+	require.Error(t, exec(cli.AddGenesisAdminCmd(home), "missing-key"))
+	require.Equal(t, []string{admin}, genesis().Params.AdminAddresses)
+
+	require.NoError(t, exec(cli.AddGenesisPartnerCmd(home),
+		"--name=Acme", "--country=us", "--currency=usd", "--liquidity=1000", "--earn-cost-per-point=0.10", "--treasury="+treasury))
+	require.NoError(t, exec(cli.AddGenesisPartnerCmd(home), "--name=Globex", "--country=GB", "--currency=GBP", "--treasury="+treasury))
+	require.ErrorContains(t, exec(cli.AddGenesisPartnerCmd(home), "--name=Initech", "--country=DE", "--currency=EUR", "--liquidity=-1", "--treasury="+treasury),
+		"total_liquidity must be a non-negative decimal")
+	require.Error(t, exec(cli.AddGenesisPartnerCmd(home), "--name=Initech", "--country=DE", "--currency=EUR"))
+
+	gs := genesis()
+	require.Len(t, gs.Partners, 2)
+	acme := gs.Partners[0]
+	require.Equal(t, uint64(1), acme.Id)
+	require.Equal(t, "US", acme.Country)
+	require.Equal(t, "USD", acme.Currency)
+	require.Equal(t, "1000", acme.TotalLiquidity)
+	require.Equal(t, "1000", acme.AvailableLiquidity)
+	require.Equal(t, "0", acme.OnHoldLiquidity)
+	require.Equal(t, treasury, acme.Treasury)
+	require.Equal(t, uint64(2), gs.Partners[1].Id)
+	require.Equal(t, "0", gs.Partners[1].TotalLiquidity)
+	require.Equal(t, []string{admin}, gs.Params.AdminAddresses)
+}
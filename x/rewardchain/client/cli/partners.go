@@ -0,0 +1,279 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+// partnerRecord is a partner as import-partners reads it and export-partners
+// writes it. ID, AvailableLiquidity, OnHoldLiquidity and Disabled are only
+// exported; import accepts and ignores them so that an export can be
+// imported into another chain.
+type partnerRecord struct {
+	ID                   string `json:"id,omitempty"`
+	Name                 string `json:"name"`
+	Category             string `json:"category,omitempty"`
+	Country              string `json:"country"`
+	Currency             string `json:"currency"`
+	EarnCostPerPoint     string `json:"earn_cost_per_point,omitempty"`
+	BurnCostPerPoint     string `json:"burn_cost_per_point,omitempty"`
+	TotalLiquidity       string `json:"total_liquidity,omitempty"`
+	AvailableLiquidity   string `json:"available_liquidity,omitempty"`
+	OnHoldLiquidity      string `json:"on_hold_liquidity,omitempty"`
+	Treasury             string `json:"treasury,omitempty"`
+	NegativeBalanceLimit string `json:"negative_balance_limit,omitempty"`
+	Disabled             bool   `json:"disabled,omitempty"`
+	ClientRef            string `json:"client_ref,omitempty"`
+}
+
+// partnerColumns are the CSV columns in export order.
+var partnerColumns = []struct {
+	name  string
+	field func(*partnerRecord) *string
+}{
+	{"id", func(r *partnerRecord) *string { return &r.ID }},
+	{"name", func(r *partnerRecord) *string { return &r.Name }},
+	{"category", func(r *partnerRecord) *string { return &r.Category }},
+	{"country", func(r *partnerRecord) *string { return &r.Country }},
+	{"currency", func(r *partnerRecord) *string { return &r.Currency }},
+	{"earn_cost_per_point", func(r *partnerRecord) *string { return &r.EarnCostPerPoint }},
+	{"burn_cost_per_point", func(r *partnerRecord) *string { return &r.BurnCostPerPoint }},
+	{"total_liquidity", func(r *partnerRecord) *string { return &r.TotalLiquidity }},
+	{"available_liquidity", func(r *partnerRecord) *string { return &r.AvailableLiquidity }},
+	{"on_hold_liquidity", func(r *partnerRecord) *string { return &r.OnHoldLiquidity }},
+	{"treasury", func(r *partnerRecord) *string { return &r.Treasury }},
+	{"negative_balance_limit", func(r *partnerRecord) *string { return &r.NegativeBalanceLimit }},
+}
+
+// disabled is not a string and client_ref is never exported, so neither is
+// in partnerColumns.
+const (
+	disabledColumn  = "disabled"
+	clientRefColumn = "client_ref"
+)
+
+func newPartnerRecord(p types.Partner) partnerRecord {
+	return partnerRecord{
+		ID:                   strconv.FormatUint(p.Id, 10),
+		Name:                 p.Name,
+		Category:             p.Category,
+		Country:              p.Country,
+		Currency:             p.Currency,
+		EarnCostPerPoint:     p.EarnCostPerPoint,
+		BurnCostPerPoint:     p.RedeemCostPerPoint,
+		TotalLiquidity:       p.TotalLiquidity,
+		AvailableLiquidity:   p.AvailableLiquidity,
+		OnHoldLiquidity:      p.OnHoldLiquidity,
+		Treasury:             p.Treasury,
+		NegativeBalanceLimit: p.NegativeBalanceLimit,
+		Disabled:             p.Disabled,
+	}
+}
+
+// importRow is a parsed record and where it came from, e.g. "line 3".
+type importRow struct {
+	pos    string
+	record partnerRecord
+}
+
+// formatFromPath picks the format of a file by its extension.
+func formatFromPath(path, format string) (string, error) {
+	if format == "" {
+		if strings.EqualFold(filepath.Ext(path), ".json") {
+			return formatJSON, nil
+		}
+		return formatCSV, nil
+	}
+	if format != formatCSV && format != formatJSON {
+		return "", fmt.Errorf("unknown format %q, want %s or %s", format, formatCSV, formatJSON)
+	}
+	return format, nil
+}
+
+func readPartners(r io.Reader, format string) ([]importRow, error) {
+	if format == formatJSON {
+		return readPartnersJSON(r)
+	}
+	return readPartnersCSV(r)
+}
+
+// readPartnersCSV reads a CSV file whose first row names the columns.
+func readPartnersCSV(r io.Reader) ([]importRow, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("empty file, want a header row")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	setters := make([]func(*partnerRecord, string) error, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case disabledColumn:
+			setters[i] = func(rec *partnerRecord, v string) error {
+				if v == "" {
+					return nil
+				}
+				var err error
+				rec.Disabled, err = strconv.ParseBool(v)
+				return err
+			}
+		case clientRefColumn:
+			setters[i] = func(rec *partnerRecord, v string) error { rec.ClientRef = v; return nil }
+		default:
+			for _, c := range partnerColumns {
+				if c.name == name {
+					field := c.field
+					setters[i] = func(rec *partnerRecord, v string) error { *field(rec) = v; return nil }
+				}
+			}
+		}
+		if setters[i] == nil {
+			return nil, fmt.Errorf("line 1: unknown column %q", header[i])
+		}
+	}
+
+	var rows []importRow
+	for {
+		fields, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		row := importRow{pos: fmt.Sprintf("line %d", line)}
+		for i, v := range fields {
+			if err := setters[i](&row.record, strings.TrimSpace(v)); err != nil {
+				return nil, fmt.Errorf("%s: column %s: %w", row.pos, header[i], err)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// readPartnersJSON reads an array of records.
+func readPartnersJSON(r io.Reader) ([]importRow, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var records []partnerRecord
+	if err := dec.Decode(&records); err != nil {
+		return nil, err
+	}
+	rows := make([]importRow, len(records))
+	for i, rec := range records {
+		rows[i] = importRow{pos: fmt.Sprintf("entry %d", i+1), record: rec}
+	}
+	return rows, nil
+}
+
+func writePartnersCSV(w io.Writer, records []partnerRecord) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, 0, len(partnerColumns)+1)
+	for _, c := range partnerColumns {
+		header = append(header, c.name)
+	}
+	if err := cw.Write(append(header, disabledColumn)); err != nil {
+		return err
+	}
+	for i := range records {
+		row := make([]string, 0, len(header)+1)
+		for _, c := range partnerColumns {
+			row = append(row, *c.field(&records[i]))
+		}
+		if err := cw.Write(append(row, strconv.FormatBool(records[i].Disabled))); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writePartnersJSON(w io.Writer, records []partnerRecord) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// msg validates a record the way CreatePartner does and returns its message.
+// Records without a client_ref get one derived from their content, so that
+// a retried import replays instead of creating the partner twice.
+func (rec partnerRecord) msg(creator string) (*types.MsgCreatePartner, error) {
+	msg := &types.MsgCreatePartner{
+		Creator:              creator,
+		Name:                 rec.Name,
+		Category:             rec.Category,
+		Country:              rec.Country,
+		Currency:             rec.Currency,
+		EarnCostPerPoint:     rec.EarnCostPerPoint,
+		BurnCostPerPoint:     rec.BurnCostPerPoint,
+		TotalLiquidity:       rec.TotalLiquidity,
+		Treasury:             rec.Treasury,
+		ClientRef:            rec.ClientRef,
+		NegativeBalanceLimit: rec.NegativeBalanceLimit,
+	}
+	if msg.ClientRef == "" {
+		msg.ClientRef = importClientRef(msg)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(msg.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if msg.Treasury != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Treasury); err != nil {
+			return nil, fmt.Errorf("invalid treasury address: %w", err)
+		}
+	}
+	for _, d := range []struct{ name, value string }{
+		{"earn_cost_per_point", msg.EarnCostPerPoint},
+		{"burn_cost_per_point", msg.BurnCostPerPoint},
+		{"total_liquidity", msg.TotalLiquidity},
+		{"negative_balance_limit", msg.NegativeBalanceLimit},
+	} {
+		if d.value == "" {
+			continue
+		}
+		if v, err := math.LegacyNewDecFromStr(d.value); err != nil || v.IsNegative() {
+			return nil, fmt.Errorf("%s must be a non-negative decimal", d.name)
+		}
+	}
+	return msg, nil
+}
+
+// importClientRef hashes the fields a record creates a partner from.
+func importClientRef(msg *types.MsgCreatePartner) string {
+	h := sha256.New()
+	for _, v := range []string{
+		msg.Name, msg.Category, msg.Country, msg.Currency, msg.EarnCostPerPoint,
+		msg.BurnCostPerPoint, msg.TotalLiquidity, msg.Treasury, msg.NegativeBalanceLimit,
+	} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return "import-" + hex.EncodeToString(h.Sum(nil))[:32]
+}
@@ -0,0 +1,108 @@
+package cli_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/network"
+	"rewardchain/x/rewardchain/client/cli"
+)
+
+func TestImportExportPartners(t *testing.T) {
+	net := network.New(t)
+	val := net.Validators[0]
+	dir := t.TempDir()
+
+	txArgs := func(args ...string) []string {
+		return append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			fmt.Sprintf("--%s=%s", flags.FlagGasPrices, val.AppConfig.MinGasPrices),
+			fmt.Sprintf("--%s=1.5", flags.FlagGasAdjustment),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		)
+	}
+	importPartners := func(args ...string) (string, error) {
+		out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdImportPartners(), txArgs(args...))
+		return out.String(), err
+	}
+	exportPartners := func(args ...string) string {
+		out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdExportPartners(), args)
+		require.NoError(t, err)
+		return out.String()
+	}
+	exportCSV := func() [][]string {
+		rows, err := csv.NewReader(strings.NewReader(exportPartners("--page-limit=2"))).ReadAll()
+		require.NoError(t, err)
+		return rows
+	}
+
+	header := "name,category,country,currency,earn_cost_per_point,burn_cost_per_point,total_liquidity\n"
+	rows := []string{
+		"Acme,retail,US,USD,0.10,0.15,1000\n",
+		"Globex,travel,GB,GBP,0.20,0.25,2000\n",
+		"Initech,software,DE,EUR,0.30,0.35,3000\n",
+		"Umbrella,pharma,FR,EUR,0.40,0.45,4000\n",
+	}
+	path := filepath.Join(dir, "partners.csv")
+
+	// invalid rows are all reported and nothing is sent
+	require.NoError(t, os.WriteFile(path, []byte(header+rows[0]+",retail,US,USD,,,\n"+"Bad,retail,US,USD,x,,\n"+rows[0]), 0o600))
+	_, err := importPartners(path)
+	require.ErrorContains(t, err, "line 3: name is required")
+	require.ErrorContains(t, err, "line 4: earn_cost_per_point must be a non-negative decimal")
+	require.ErrorContains(t, err, "line 5: duplicates line 2")
+	require.Len(t, exportCSV(), 1)
+
+	// a budget that fits one message per transaction
+	require.NoError(t, os.WriteFile(path, []byte(header+rows[0]+rows[1]), 0o600))
+	_, err = importPartners(path, "--gas-budget=1000")
+	require.ErrorContains(t, err, "more than the budget of 1000")
+	out, err := importPartners(path, "--gas-budget=80000")
+	require.NoError(t, err)
+	require.Contains(t, out, "2 partners in "+path+", 0 already imported")
+	require.Equal(t, 2, strings.Count(out, "created partners"))
+
+	// an extended file resumes after the imported rows and packs the rest
+	// into one transaction
+	require.NoError(t, os.WriteFile(path, []byte(header+strings.Join(rows, "")), 0o600))
+	out, err = importPartners(path)
+	require.NoError(t, err)
+	require.Contains(t, out, "4 partners in "+path+", 2 already imported")
+	require.Contains(t, out, "created partners 3 to 4 in tx")
+
+	// without the progress file the rows' client refs replay their partners
+	require.NoError(t, os.Remove(path+".progress"))
+	out, err = importPartners(path)
+	require.NoError(t, err)
+	require.Contains(t, out, "created partners 1 to 4 in tx")
+
+	exported := exportCSV()
+	require.Len(t, exported, 5)
+	require.Equal(t, "id", exported[0][0])
+	require.Equal(t, []string{"4", "Umbrella", "pharma", "FR", "EUR", "0.40", "0.45", "4000"}, exported[4][:8])
+
+	var records []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(exportPartners("--format=json")), &records))
+	require.Len(t, records, 4)
+	require.Equal(t, "Globex", records[1]["name"])
+	require.Equal(t, "0.25", records[1]["burn_cost_per_point"])
+
+	// an export imports as is and creates copies of the partners
+	jsonPath := filepath.Join(dir, "export.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(exportPartners("--format=json")), 0o600))
+	out, err = importPartners(jsonPath)
+	require.NoError(t, err)
+	require.Contains(t, out, "created partners 5 to 8 in tx")
+	exported = exportCSV()
+	require.Len(t, exported, 9)
+	require.Equal(t, exported[4][1:], exported[8][1:])
+}
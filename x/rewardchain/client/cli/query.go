@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+const (
+	flagIncludeDisabled = "include-disabled"
+	flagPageLimit       = "page-limit"
+	flagAppHash         = "app-hash"
+
+	// DefaultExportPageLimit is how many partners export-partners requests
+	// per page by default.
+	DefaultExportPageLimit = 100
+)
+
+// GetQueryCmd returns the module's hand-written query commands; autocli adds
+// the generated ones next to them.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(CmdExportPartners(), CmdProvePartner(), CmdProveMemberBalance())
+	return cmd
+}
+
+func CmdExportPartners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-partners",
+		Short: "Writes every partner as CSV or JSON",
+		Long: `Walk the pages of the partners query and write every partner to stdout as CSV
+with a header row, or as a JSON array with --format json. Disabled partners are
+only included with --include-disabled. The output can be imported into another
+chain with import-partners.`,
+		Example: fmt.Sprintf(`%[1]sd query %[2]s export-partners --format csv > partners.csv`, "rewardchain", types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			format, _ := cmd.Flags().GetString(flagFormat)
+			includeDisabled, _ := cmd.Flags().GetBool(flagIncludeDisabled)
+			pageLimit, _ := cmd.Flags().GetUint64(flagPageLimit)
+			if format != formatCSV && format != formatJSON {
+				return fmt.Errorf("unknown format %q, want %s or %s", format, formatCSV, formatJSON)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			records := make([]partnerRecord, 0)
+			var next []byte
+			for {
+				res, err := queryClient.Partners(cmd.Context(), &types.QueryPartnersRequest{
+					Pagination:      &query.PageRequest{Key: next, Limit: pageLimit},
+					IncludeDisabled: includeDisabled,
+				})
+				if err != nil {
+					return err
+				}
+				for _, p := range res.Partners {
+					records = append(records, newPartnerRecord(p))
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				next = res.Pagination.NextKey
+			}
+
+			if format == formatJSON {
+				return writePartnersJSON(cmd.OutOrStdout(), records)
+			}
+			return writePartnersCSV(cmd.OutOrStdout(), records)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagFormat, formatCSV, "Output format, csv or json")
+	cmd.Flags().Bool(flagIncludeDisabled, false, "Also export disabled partners")
+	cmd.Flags().Uint64(flagPageLimit, DefaultExportPageLimit, "Partners requested per page")
+	return cmd
+}
+
+func CmdProvePartner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove-partner [id]",
+		Short: "Shows a partner with the Merkle proof of it against the app hash",
+		Long: `Read a partner as of --height (default: the block before the latest) through
+the store's ABCI query with prove=true and verify its ICS-23 proof against the
+app hash of the following block. The app hash is taken from --app-hash if it
+is given, e.g. from a light client or a node you trust, and from the queried
+node otherwise. The output carries the proof for verifying it again elsewhere.`,
+		Example: fmt.Sprintf(`%[1]sd query %[2]s prove-partner 1 --height 1200`, "rewardchain", types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			proof, appHash, err := queryStoreProof(cmd, types.PartnerStoreKey(id))
+			if err != nil {
+				return err
+			}
+			partner, err := types.VerifyPartnerProof(appHash, id, proof)
+			if err != nil {
+				return err
+			}
+			return printStoreProof(cmd, &partner, proof, appHash)
+		},
+	}
+
+	addProofFlags(cmd)
+	return cmd
+}
+
+func CmdProveMemberBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove-member-balance [partner-id] [member]",
+		Short: "Shows a member balance with the Merkle proof of it against the app hash",
+		Long: `Like prove-partner, for a member's points balance with a partner. A member
+without a balance is proven absent and shown with zero points.`,
+		Example: fmt.Sprintf(`%[1]sd query %[2]s prove-member-balance 1 reward1... --height 1200`, "rewardchain", types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			partnerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			member, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			proof, appHash, err := queryStoreProof(cmd, types.MemberBalanceStoreKey(partnerID, member))
+			if err != nil {
+				return err
+			}
+			balance, err := types.VerifyMemberBalanceProof(appHash, partnerID, member, proof)
+			if err != nil {
+				return err
+			}
+			return printStoreProof(cmd, &balance, proof, appHash)
+		},
+	}
+
+	addProofFlags(cmd)
+	return cmd
+}
+
+func addProofFlags(cmd *cobra.Command) {
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagAppHash, "", "Hex app hash of the block after --height to verify against instead of the node's")
+}
+
+// queryStoreProof reads key with its proof and returns the app hash to check
+// the proof against.
+func queryStoreProof(cmd *cobra.Command, key []byte) (types.StoreProof, []byte, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return types.StoreProof{}, nil, err
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return types.StoreProof{}, nil, err
+	}
+
+	// the app hash of a height is only known once the next block exists
+	height := clientCtx.Height
+	if height == 0 {
+		status, err := node.Status(cmd.Context())
+		if err != nil {
+			return types.StoreProof{}, nil, err
+		}
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+	// baseapp only proves from height 2 on
+	if height < 2 {
+		return types.StoreProof{}, nil, errors.New("proofs are available from height 2 on")
+	}
+
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   types.StoreQueryPath,
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return types.StoreProof{}, nil, err
+	}
+	proof := types.StoreProof{Height: res.Height, Key: key, Proof: res.ProofOps}
+	if len(res.Value) > 0 {
+		proof.Value = res.Value
+	}
+
+	if s, _ := cmd.Flags().GetString(flagAppHash); s != "" {
+		appHash, err := hex.DecodeString(s)
+		if err != nil {
+			return types.StoreProof{}, nil, fmt.Errorf("invalid --%s: %w", flagAppHash, err)
+		}
+		return proof, appHash, nil
+	}
+	next := res.Height + 1
+	block, err := node.Block(cmd.Context(), &next)
+	if err != nil {
+		return types.StoreProof{}, nil, err
+	}
+	return proof, block.Block.AppHash, nil
+}
+
+func printStoreProof(cmd *cobra.Command, value proto.Message, proof types.StoreProof, appHash []byte) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	valueJSON, err := clientCtx.Codec.MarshalJSON(value)
+	if err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(struct {
+		AppHash string           `json:"app_hash"`
+		Value   json.RawMessage  `json:"value"`
+		Proof   types.StoreProof `json:"proof"`
+	}{hex.EncodeToString(appHash), valueJSON, proof}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
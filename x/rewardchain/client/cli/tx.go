@@ -0,0 +1,320 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+const (
+	flagFormat       = "format"
+	flagGasBudget    = "gas-budget"
+	flagProgressFile = "progress-file"
+
+	// DefaultImportGasBudget is the gas an import-partners transaction may
+	// use by default.
+	DefaultImportGasBudget = 2_000_000
+
+	// txWaitTimeout is how long import-partners waits for a transaction to
+	// be included in a block.
+	txWaitTimeout = time.Minute
+)
+
+// GetTxCmd returns the module's hand-written transaction commands; autocli
+// adds the generated ones next to them.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(CmdImportPartners())
+	return cmd
+}
+
+// importProgress is one line of an import's progress file.
+type importProgress struct {
+	ClientRef string `json:"client_ref"`
+	PartnerID string `json:"partner_id"`
+	TxHash    string `json:"tx_hash"`
+}
+
+func CmdImportPartners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-partners [file]",
+		Short: "Create partners from a CSV or JSON file in multi-message transactions",
+		Long: `Create a partner for every row of a CSV file, or every entry of a JSON array
+when the file ends in .json or --format json is given. The CSV header names the
+columns: name, country and currency are required; category,
+earn_cost_per_point, burn_cost_per_point, total_liquidity, treasury,
+negative_balance_limit and client_ref are optional. The id,
+available_liquidity, on_hold_liquidity and disabled columns written by
+export-partners are ignored.
+
+All rows are validated before anything is sent. Rows are then packed into
+transactions whose simulated gas stays within --gas-budget, and every
+transaction is waited for before the next is built. Imported rows are appended
+to --progress-file (default <file>.progress) and skipped when the command is
+run again, so an interrupted import can simply be restarted. Each row is sent
+with its client_ref, derived from its content when the column is empty, so a
+row whose transaction was included just before an interruption replays its
+partner id instead of creating the partner twice.`,
+		Example: fmt.Sprintf(`%[1]sd tx %[2]s import-partners partners.csv --from alice --gas-prices 0.025stake --gas-adjustment 1.5 --yes
+
+name,category,country,currency,earn_cost_per_point,burn_cost_per_point,total_liquidity
+Acme Corporation,retail,US,USD,0.10,0.15,1000000`, "rewardchain", types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GenerateOnly || clientCtx.Offline || clientCtx.Simulate {
+				return errors.New("import-partners signs and broadcasts transactions and cannot run with --generate-only, --offline or --dry-run")
+			}
+			clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastSync)
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			gasBudget, _ := cmd.Flags().GetUint64(flagGasBudget)
+			progressPath, _ := cmd.Flags().GetString(flagProgressFile)
+			if progressPath == "" {
+				progressPath = args[0] + ".progress"
+			}
+			if format, err = formatFromPath(args[0], format); err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			rows, err := readPartners(f, format)
+			f.Close()
+			if err != nil {
+				return err
+			}
+
+			// validate every row before sending anything
+			var (
+				creator = clientCtx.GetFromAddress().String()
+				msgs    = make([]*types.MsgCreatePartner, 0, len(rows))
+				refs    = make(map[string]string, len(rows))
+				errs    []error
+			)
+			for _, row := range rows {
+				msg, err := row.record.msg(creator)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", row.pos, err))
+					continue
+				}
+				if pos, ok := refs[msg.ClientRef]; ok {
+					errs = append(errs, fmt.Errorf("%s: duplicates %s", row.pos, pos))
+					continue
+				}
+				refs[msg.ClientRef] = row.pos
+				msgs = append(msgs, msg)
+			}
+			if err := errors.Join(errs...); err != nil {
+				return fmt.Errorf("invalid %s: %w", args[0], err)
+			}
+
+			done, err := readImportProgress(progressPath)
+			if err != nil {
+				return err
+			}
+			pending := make([]*types.MsgCreatePartner, 0, len(msgs))
+			for _, msg := range msgs {
+				if _, ok := done[msg.ClientRef]; !ok {
+					pending = append(pending, msg)
+				}
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "%d partners in %s, %d already imported\n", len(msgs), args[0], len(msgs)-len(pending))
+			if len(pending) == 0 {
+				return nil
+			}
+			if !clientCtx.SkipConfirm {
+				ok, err := input.GetConfirmation(fmt.Sprintf("create %d partners from %s", len(pending), creator), bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+				if err != nil || !ok {
+					return err
+				}
+			}
+
+			progress, err := os.OpenFile(progressPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+			if err != nil {
+				return err
+			}
+			defer progress.Close()
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if txf, err = txf.Prepare(clientCtx); err != nil {
+				return err
+			}
+			for len(pending) > 0 {
+				batch, gas, err := nextImportBatch(clientCtx, txf, pending, gasBudget)
+				if err != nil {
+					return err
+				}
+				res, err := sendImportBatch(clientCtx, txf.WithGas(gas), batch)
+				if err != nil {
+					return err
+				}
+				enc := json.NewEncoder(progress)
+				for _, p := range res {
+					if err := enc.Encode(p); err != nil {
+						return err
+					}
+				}
+				if err := progress.Sync(); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "created partners %s to %s in tx %s\n", res[0].PartnerID, res[len(res)-1].PartnerID, res[0].TxHash)
+
+				pending = pending[len(batch):]
+				txf = txf.WithSequence(txf.Sequence() + 1)
+			}
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagFormat, "", "File format, csv or json (default: json for .json files, csv otherwise)")
+	cmd.Flags().Uint64(flagGasBudget, DefaultImportGasBudget, "Maximum gas of a single import transaction")
+	cmd.Flags().String(flagProgressFile, "", "File recording imported rows (default <file>.progress)")
+	return cmd
+}
+
+// nextImportBatch returns the longest prefix of msgs whose simulated gas
+// fits the budget, and that gas.
+func nextImportBatch(clientCtx client.Context, txf tx.Factory, msgs []*types.MsgCreatePartner, budget uint64) ([]sdk.Msg, uint64, error) {
+	var (
+		batch []sdk.Msg
+		gas   uint64
+	)
+	for _, msg := range msgs {
+		candidate := append(batch, msg)
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, candidate...)
+		if err != nil {
+			return nil, 0, fmt.Errorf("simulate partner %q: %w", msg.Name, err)
+		}
+		if adjusted > budget {
+			if len(batch) == 0 {
+				return nil, 0, fmt.Errorf("partner %q alone needs %d gas, more than the budget of %d", msg.Name, adjusted, budget)
+			}
+			break
+		}
+		batch, gas = candidate, adjusted
+	}
+	return batch, gas, nil
+}
+
+// sendImportBatch signs and broadcasts one transaction and waits for it to be
+// included.
+func sendImportBatch(clientCtx client.Context, txf tx.Factory, msgs []sdk.Msg) ([]importProgress, error) {
+	txb, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(clientCtx.CmdContext, txf, clientCtx.FromName, txb, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("tx %s rejected: %s", res.TxHash, res.RawLog)
+	}
+
+	hash := res.TxHash
+	deadline := time.Now().Add(txWaitTimeout)
+	for {
+		if res, err = authtx.QueryTx(clientCtx, hash); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("tx %s not included after %s; run the import again once it is: %w", hash, txWaitTimeout, err)
+		}
+		time.Sleep(time.Second)
+	}
+	if res.Code != 0 {
+		return nil, fmt.Errorf("tx %s failed: %s", res.TxHash, res.RawLog)
+	}
+
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return nil, err
+	}
+	var msgData sdk.TxMsgData
+	if err := proto.Unmarshal(data, &msgData); err != nil {
+		return nil, err
+	}
+	if len(msgData.MsgResponses) != len(msgs) {
+		return nil, fmt.Errorf("tx %s has %d message responses, want %d", res.TxHash, len(msgData.MsgResponses), len(msgs))
+	}
+	out := make([]importProgress, len(msgs))
+	for i, msgRes := range msgData.MsgResponses {
+		var created types.MsgCreatePartnerResponse
+		if err := proto.Unmarshal(msgRes.Value, &created); err != nil {
+			return nil, err
+		}
+		out[i] = importProgress{
+			ClientRef: msgs[i].(*types.MsgCreatePartner).ClientRef,
+			PartnerID: created.Id,
+			TxHash:    res.TxHash,
+		}
+	}
+	return out, nil
+}
+
+// readImportProgress returns the client refs a progress file records.
+func readImportProgress(path string) (map[string]importProgress, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]importProgress{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	done := make(map[string]importProgress)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var p importProgress
+		if err := json.Unmarshal(scanner.Bytes(), &p); err != nil {
+			// a line cut short by an interruption is re-sent; its
+			// client_ref replays the partner
+			continue
+		}
+		done[p.ClientRef] = p
+	}
+	return done, scanner.Err()
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker prunes expired module state, nets pending obligations into
+// settlements at every settlement epoch and aggregates oracle submissions at
+// the end of every oracle window. Partner liquidity is checkpointed every
+// checkpoint interval. Remote treasuries whose partner ran low on
+// liquidity are topped up last.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	k.PruneClientRefs(ctx, height)
+	k.PruneVelocityCounters(ctx, sdkCtx.BlockTime().Unix())
+	k.PruneKycPayouts(ctx, sdkCtx.BlockTime().Unix())
+	k.PruneClaimedReceipts(ctx, sdkCtx.BlockTime().Unix())
+
+	params := k.GetParams(ctx)
+	if height > 0 && height%params.SettlementEpoch() == 0 {
+		settlements, err := k.NetObligations(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range settlements {
+			sdkCtx.EventManager().EmitEvent(settlementEvent("settlement_opened", s))
+		}
+	}
+
+	if height > 0 && height%params.OracleWindow() == 0 {
+		rates, err := k.AggregateRates(ctx)
+		if err != nil {
+			return err
+		}
+		for _, r := range rates {
+			sdkCtx.EventManager().EmitEvent(exchangeRateEvent(r))
+		}
+	}
+
+	if interval := params.LiquidityCheckpointInterval(); interval > 0 && height > 0 && height%interval == 0 {
+		if err := k.RecordLiquidityCheckpoints(ctx); err != nil {
+			return err
+		}
+	}
+
+	k.AutoTopUp(sdkCtx)
+	return nil
+}
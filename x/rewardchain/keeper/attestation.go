@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getAttestationStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.AttestationKeyPrefix)
+}
+
+func (k Keeper) getKycRequirementStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.KycRequirementKeyPrefix)
+}
+
+func (k Keeper) getKycPayoutStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.KycPayoutKeyPrefix)
+}
+
+func (k Keeper) getKycPayoutExpiryStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.KycPayoutExpiryKeyPrefix)
+}
+
+func (k Keeper) SetAttestation(ctx context.Context, a types.Attestation) error {
+	member, err := sdk.AccAddressFromBech32(a.Member)
+	if err != nil {
+		return err
+	}
+	attestor, err := sdk.AccAddressFromBech32(a.Attestor)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&a)
+	if err != nil {
+		return err
+	}
+	k.getAttestationStore(ctx).Set(types.AttestationKey(member, attestor), bz)
+	return nil
+}
+
+// RemoveAttestation deletes an attestor's attestation of a member and
+// reports whether there was one.
+func (k Keeper) RemoveAttestation(ctx context.Context, member, attestor sdk.AccAddress) bool {
+	as := k.getAttestationStore(ctx)
+	key := types.AttestationKey(member, attestor)
+	if !as.Has(key) {
+		return false
+	}
+	as.Delete(key)
+	return true
+}
+
+// GetMemberAttestations returns every attestation recorded for a member,
+// including expired ones.
+func (k Keeper) GetMemberAttestations(ctx context.Context, member sdk.AccAddress) []types.Attestation {
+	ms := prefix.NewStore(k.getAttestationStore(ctx), types.AttestationMemberPrefix(member))
+	iter := ms.Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.Attestation, 0)
+	for ; iter.Valid(); iter.Next() {
+		var a types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &a)
+		out = append(out, a)
+	}
+	return out
+}
+
+func (k Keeper) GetAllAttestations(ctx context.Context) []types.Attestation {
+	iter := k.getAttestationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.Attestation, 0)
+	for ; iter.Valid(); iter.Next() {
+		var a types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &a)
+		out = append(out, a)
+	}
+	return out
+}
+
+// AttestationLevel returns the highest level among a member's unexpired
+// attestations from attestors still listed in Params, limited to the
+// jurisdictions req accepts.
+func (k Keeper) AttestationLevel(ctx context.Context, member sdk.AccAddress, req types.KycRequirement) uint32 {
+	params := k.GetParams(ctx)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	var level uint32
+	for _, a := range k.GetMemberAttestations(ctx, member) {
+		if a.Expires <= now || !params.IsAttestor(a.Attestor) || !req.Accepts(a.Jurisdiction) {
+			continue
+		}
+		if a.Level > level {
+			level = a.Level
+		}
+	}
+	return level
+}
+
+// GetKycRequirement returns a partner's KYC requirement. Partners without
+// one require no attestation.
+func (k Keeper) GetKycRequirement(ctx context.Context, partnerID uint64) types.KycRequirement {
+	bz := k.getKycRequirementStore(ctx).Get(types.PartnerKey(partnerID))
+	if bz == nil {
+		return types.KycRequirement{PartnerId: partnerID}
+	}
+	var r types.KycRequirement
+	k.cdc.MustUnmarshal(bz, &r)
+	return r
+}
+
+func (k Keeper) SetKycRequirement(ctx context.Context, r types.KycRequirement) error {
+	bz, err := k.cdc.Marshal(&r)
+	if err != nil {
+		return err
+	}
+	k.getKycRequirementStore(ctx).Set(types.PartnerKey(r.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetAllKycRequirements(ctx context.Context) []types.KycRequirement {
+	iter := k.getKycRequirementStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.KycRequirement, 0)
+	for ; iter.Valid(); iter.Next() {
+		var r types.KycRequirement
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		out = append(out, r)
+	}
+	return out
+}
+
+// SetKycPayout stores a payout counter and queues it for pruning.
+func (k Keeper) SetKycPayout(ctx context.Context, c types.KycPayout) error {
+	member, err := sdk.AccAddressFromBech32(c.Member)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&c)
+	if err != nil {
+		return err
+	}
+	k.getKycPayoutStore(ctx).Set(types.KycPayoutKey(c.PartnerId, member, c.Bucket), bz)
+	k.getKycPayoutExpiryStore(ctx).Set(types.KycPayoutExpiryKey(c.Expires, c.PartnerId, member, c.Bucket), []byte{})
+	return nil
+}
+
+func (k Keeper) GetAllKycPayouts(ctx context.Context) []types.KycPayout {
+	iter := k.getKycPayoutStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.KycPayout, 0)
+	for ; iter.Valid(); iter.Next() {
+		var c types.KycPayout
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		out = append(out, c)
+	}
+	return out
+}
+
+// PaidOutSince sums the points a partner paid out to a member in the buckets
+// starting at or after from.
+func (k Keeper) PaidOutSince(ctx context.Context, partnerID uint64, member sdk.AccAddress, from int64) math.LegacyDec {
+	if from < 0 {
+		from = 0
+	}
+	cs := prefix.NewStore(k.getKycPayoutStore(ctx), types.KycPayoutPrefix(partnerID, member))
+	iter := cs.Iterator(sdk.Uint64ToBigEndian(uint64(from)), nil)
+	defer iter.Close()
+
+	total := math.LegacyZeroDec()
+	for ; iter.Valid(); iter.Next() {
+		var c types.KycPayout
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		if paid, err := math.LegacyNewDecFromStr(c.Paid); err == nil {
+			total = total.Add(paid)
+		}
+	}
+	return total
+}
+
+// RecordPayout adds points to the member's payout counter for the current
+// bucket.
+func (k Keeper) RecordPayout(ctx context.Context, partnerID uint64, member sdk.AccAddress, points math.LegacyDec) error {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	bucket := types.VelocityBucket(now)
+
+	c := types.KycPayout{
+		PartnerId: partnerID,
+		Member:    member.String(),
+		Bucket:    bucket,
+		Paid:      points.String(),
+		Expires:   bucket + types.VelocityBucketSeconds + types.KycWindowSeconds,
+	}
+	if bz := k.getKycPayoutStore(ctx).Get(types.KycPayoutKey(partnerID, member, bucket)); bz != nil {
+		var prev types.KycPayout
+		k.cdc.MustUnmarshal(bz, &prev)
+		if paid, err := math.LegacyNewDecFromStr(prev.Paid); err == nil {
+			c.Paid = paid.Add(points).String()
+		}
+		// keep a single pruning entry per counter
+		k.getKycPayoutExpiryStore(ctx).Delete(types.KycPayoutExpiryKey(prev.Expires, partnerID, member, bucket))
+	}
+	return k.SetKycPayout(ctx, c)
+}
+
+// PruneKycPayouts removes payout counters that expire at or before now.
+func (k Keeper) PruneKycPayouts(ctx context.Context, now int64) {
+	es := k.getKycPayoutExpiryStore(ctx)
+	cs := k.getKycPayoutStore(ctx)
+
+	iter := es.Iterator(nil, sdk.Uint64ToBigEndian(uint64(now)+1))
+	defer iter.Close()
+
+	var done [][]byte
+	for ; iter.Valid(); iter.Next() {
+		done = append(done, append([]byte(nil), iter.Key()...))
+	}
+	for _, key := range done {
+		es.Delete(key)
+		cs.Delete(key[8:])
+	}
+}
+
+// CheckKyc returns ErrKycRequired when paying out points to member would take
+// the member's payouts over the last KycWindowSeconds above the partner's
+// threshold and the member lacks the required level. Earlier payouts are
+// read from the payout counters.
+func (k Keeper) CheckKyc(ctx context.Context, partnerID uint64, member sdk.AccAddress, points math.LegacyDec) error {
+	req := k.GetKycRequirement(ctx, partnerID)
+	if req.MinLevel == 0 {
+		return nil
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	paid := k.PaidOutSince(ctx, partnerID, member, types.VelocityBucket(now-types.KycWindowSeconds+types.VelocityBucketSeconds))
+	if paid.Add(points).LTE(req.PayoutThreshold()) {
+		return nil
+	}
+	if level := k.AttestationLevel(ctx, member, req); level < req.MinLevel {
+		return errorsmod.Wrapf(types.ErrKycRequired, "paid out %s, payouts above %s in %ds need level %d, member has %d", paid, req.PayoutThreshold(), types.KycWindowSeconds, req.MinLevel, level)
+	}
+	return nil
+}
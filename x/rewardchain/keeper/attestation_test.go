@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestKycAttestations(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	now := time.Unix(1_000_000, 0)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(now)
+
+	attestor := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.Attestors = []string{attestor}
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.SetParams(ctx, params))
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "500", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+
+	_, err = ms.SetKycRequirement(ctx, types.NewMsgSetKycRequirement(member, 1, "100", 2, []string{"IN"}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetKycRequirement(ctx, types.NewMsgSetKycRequirement(treasury, 1, "100", 2, []string{"IN"}))
+	require.NoError(t, err)
+
+	// payouts up to the threshold need no attestation
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "100"))
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "150"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+	// the threshold caps the payouts of a day, not of each message
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "1"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+	_, err = ms.RedeemPoints(ctx.WithBlockTime(now.Add(12*time.Hour)), types.NewMsgRedeemPoints(member, 1, "1"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+
+	sum := sha256.Sum256([]byte("kyc-file-42"))
+	evidence := hex.EncodeToString(sum[:])
+	expires := now.Add(24 * time.Hour).Unix()
+	_, err = ms.RecordAttestation(ctx, types.NewMsgRecordAttestation(member, member, 2, expires, "IN", evidence))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.RecordAttestation(ctx, types.NewMsgRecordAttestation(attestor, member, 2, expires, "IN", "John Doe"))
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+	_, err = ms.RecordAttestation(ctx, types.NewMsgRecordAttestation(attestor, member, 2, now.Unix(), "IN", evidence))
+	require.ErrorIs(t, err, types.ErrInvalidAttestation)
+
+	// too low a level, then the wrong jurisdiction
+	_, err = ms.RecordAttestation(ctx, types.NewMsgRecordAttestation(attestor, member, 1, expires, "IN", evidence))
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "150"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+	_, err = ms.RecordAttestation(ctx, types.NewMsgRecordAttestation(attestor, member, 2, expires, "US", evidence))
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "150"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+
+	_, err = ms.RecordAttestation(ctx, types.NewMsgRecordAttestation(attestor, member, 2, expires, "IN", evidence))
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "150"))
+	require.NoError(t, err)
+
+	res, err := k.Attestations(ctx, &types.QueryAttestationsRequest{Member: member})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 1)
+	require.Equal(t, uint32(2), res.Level)
+
+	// the swap withdraw path is gated for its signer too
+	_, err = ms.Swap(ctx, types.NewMsgSwap(treasury, 1, "points_to_token", "101"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+	_, err = ms.Swap(ctx, types.NewMsgSwap(treasury, 1, "points_to_token", "100"))
+	require.NoError(t, err)
+	_, err = ms.Swap(ctx, types.NewMsgSwap(treasury, 1, "points_to_token", "1"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+
+	// expired attestations and removed attestors no longer count
+	_, err = ms.RedeemPoints(ctx.WithBlockTime(now.Add(25*time.Hour)), types.NewMsgRedeemPoints(member, 1, "101"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+	// a day later the earlier payouts have left the window
+	_, err = ms.RedeemPoints(ctx.WithBlockTime(now.Add(25*time.Hour)), types.NewMsgRedeemPoints(member, 1, "100"))
+	require.NoError(t, err)
+	params.Attestors = nil
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "101"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+
+	_, err = ms.RevokeAttestation(ctx, types.NewMsgRevokeAttestation(attestor, member))
+	require.NoError(t, err)
+	_, err = ms.RevokeAttestation(ctx, types.NewMsgRevokeAttestation(attestor, member))
+	require.ErrorIs(t, err, types.ErrAttestationNotFound)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getBlockedAddressStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.BlockedAddressKeyPrefix)
+}
+
+// canManageBlocklist reports whether addr may list and delist addresses:
+// a module admin or the governance authority.
+func (k Keeper) canManageBlocklist(ctx context.Context, addr string) bool {
+	return addr == k.authority || k.IsAdmin(ctx, addr)
+}
+
+// GetBlockedAddress returns the blocklist entry of an address, if any.
+func (k Keeper) GetBlockedAddress(ctx context.Context, addr sdk.AccAddress) (types.BlockedAddress, bool) {
+	bz := k.getBlockedAddressStore(ctx).Get(addr)
+	if bz == nil {
+		return types.BlockedAddress{}, false
+	}
+	var b types.BlockedAddress
+	k.cdc.MustUnmarshal(bz, &b)
+	return b, true
+}
+
+// IsBlocked reports whether an address is on the blocklist.
+func (k Keeper) IsBlocked(ctx context.Context, addr sdk.AccAddress) bool {
+	return k.getBlockedAddressStore(ctx).Has(addr)
+}
+
+// CheckNotBlocked returns ErrAddressBlocked when the address is on the
+// blocklist.
+func (k Keeper) CheckNotBlocked(ctx context.Context, addr sdk.AccAddress) error {
+	if b, blocked := k.GetBlockedAddress(ctx, addr); blocked {
+		return errorsmod.Wrapf(types.ErrAddressBlocked, "%s (%s)", b.Address, b.Reason)
+	}
+	return nil
+}
+
+func (k Keeper) SetBlockedAddress(ctx context.Context, b types.BlockedAddress) error {
+	addr, err := sdk.AccAddressFromBech32(b.Address)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&b)
+	if err != nil {
+		return err
+	}
+	k.getBlockedAddressStore(ctx).Set(addr, bz)
+	return nil
+}
+
+func (k Keeper) RemoveBlockedAddress(ctx context.Context, addr sdk.AccAddress) {
+	k.getBlockedAddressStore(ctx).Delete(addr)
+}
+
+func (k Keeper) GetAllBlockedAddresses(ctx context.Context) []types.BlockedAddress {
+	iter := k.getBlockedAddressStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.BlockedAddress, 0)
+	for ; iter.Valid(); iter.Next() {
+		var b types.BlockedAddress
+		k.cdc.MustUnmarshal(iter.Value(), &b)
+		out = append(out, b)
+	}
+	return out
+}
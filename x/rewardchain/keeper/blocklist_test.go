@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestBlocklist(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.AdminAddresses = []string{admin}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	bad := sample.AccAddress()
+	_, err := ms.BlockAddress(ctx, types.NewMsgBlockAddress(sample.AccAddress(), bad, types.BLOCK_REASON_SANCTIONS, ""))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.BlockAddress(ctx, types.NewMsgBlockAddress(admin, bad, types.BLOCK_REASON_RESOLVED, ""))
+	require.ErrorIs(t, err, types.ErrInvalidBlock)
+	_, err = ms.BlockAddress(ctx, types.NewMsgBlockAddress(admin, bad, types.BLOCK_REASON_SANCTIONS, "OFAC SDN"))
+	require.NoError(t, err)
+	require.True(t, k.IsBlocked(ctx, sdk.MustAccAddressFromBech32(bad)))
+
+	res, err := k.BlockedAddress(ctx, &types.QueryBlockedAddressRequest{Address: bad})
+	require.NoError(t, err)
+	require.True(t, res.Blocked)
+	require.Equal(t, types.BLOCK_REASON_SANCTIONS, res.Entry.Reason)
+	require.Equal(t, admin, res.Entry.ListedBy)
+
+	// points cannot be sent to a blocked member
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	_, err = ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	_, err = ms.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{Creator: treasury, PartnerId: 1, Mode: types.TRANSFER_MODE_OPEN})
+	require.NoError(t, err)
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "100", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+	_, err = ms.TransferPoints(ctx, &types.MsgTransferPoints{Creator: member, PartnerId: 1, To: bad, Amount: "10"})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+
+	// governance can delist with a reason code
+	_, err = ms.UnblockAddress(ctx, types.NewMsgUnblockAddress(k.GetAuthority(), bad, types.BLOCK_REASON_UNSPECIFIED, ""))
+	require.ErrorIs(t, err, types.ErrInvalidBlock)
+	_, err = ms.UnblockAddress(ctx, types.NewMsgUnblockAddress(k.GetAuthority(), bad, types.BLOCK_REASON_RESOLVED, "delisted"))
+	require.NoError(t, err)
+	_, err = ms.UnblockAddress(ctx, types.NewMsgUnblockAddress(admin, bad, types.BLOCK_REASON_RESOLVED, ""))
+	require.ErrorIs(t, err, types.ErrAddressNotBlocked)
+	_, err = ms.TransferPoints(ctx, &types.MsgTransferPoints{Creator: member, PartnerId: 1, To: bad, Amount: "10"})
+	require.NoError(t, err)
+
+	all, err := k.BlockedAddresses(ctx, &types.QueryBlockedAddressesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, all.Entries)
+}
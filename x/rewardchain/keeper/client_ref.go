@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getClientRefStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.ClientRefKeyPrefix)
+}
+
+func (k Keeper) getClientRefExpiryStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.ClientRefExpiryKeyPrefix)
+}
+
+// SetClientRefRecord stores a client_ref record and queues it for pruning.
+func (k Keeper) SetClientRefRecord(ctx context.Context, r types.ClientRefRecord) error {
+	signer, err := sdk.AccAddressFromBech32(r.Signer)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidClientRef, "invalid signer address")
+	}
+	bz, err := k.cdc.Marshal(&r)
+	if err != nil {
+		return err
+	}
+	k.getClientRefStore(ctx).Set(types.ClientRefKey(signer, r.ClientRef), bz)
+	k.getClientRefExpiryStore(ctx).Set(types.ClientRefExpiryKey(r.ExpiresHeight, signer, r.ClientRef), []byte{})
+	return nil
+}
+
+func (k Keeper) GetClientRefRecord(ctx context.Context, signer sdk.AccAddress, ref string) (types.ClientRefRecord, bool) {
+	bz := k.getClientRefStore(ctx).Get(types.ClientRefKey(signer, ref))
+	if bz == nil {
+		return types.ClientRefRecord{}, false
+	}
+	var r types.ClientRefRecord
+	k.cdc.MustUnmarshal(bz, &r)
+	return r, true
+}
+
+func (k Keeper) GetAllClientRefRecords(ctx context.Context) []types.ClientRefRecord {
+	iter := k.getClientRefStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.ClientRefRecord, 0)
+	for ; iter.Valid(); iter.Next() {
+		var r types.ClientRefRecord
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		out = append(out, r)
+	}
+	return out
+}
+
+// ReplayClientRef looks up the outcome of an earlier message the signer sent
+// with the same client_ref. When one exists it is decoded into resp and true
+// is returned. Reusing a client_ref for a different message is an error.
+// An empty client_ref never matches.
+func (k Keeper) ReplayClientRef(ctx context.Context, signer, ref string, msg sdk.Msg, resp proto.Message) (bool, error) {
+	if ref == "" {
+		return false, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return false, errorsmod.Wrap(types.ErrInvalidClientRef, "invalid signer address")
+	}
+	r, found := k.GetClientRefRecord(ctx, addr, ref)
+	if !found {
+		return false, nil
+	}
+
+	hash, err := k.msgHash(msg)
+	if err != nil {
+		return false, err
+	}
+	if r.MsgTypeUrl != sdk.MsgTypeURL(msg) || !bytes.Equal(r.MsgHash, hash) {
+		return false, errorsmod.Wrapf(types.ErrClientRefConflict, "client_ref %q was used at height %d", ref, r.Height)
+	}
+	if r.Response == nil || r.Response.TypeUrl != "/"+proto.MessageName(resp) {
+		return false, errorsmod.Wrapf(types.ErrClientRefConflict, "stored response has type %s", r.Response.GetTypeUrl())
+	}
+	if err := k.cdc.Unmarshal(r.Response.Value, resp); err != nil {
+		return false, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"client_ref_replay",
+			sdk.NewAttribute("signer", signer),
+			sdk.NewAttribute("client_ref", ref),
+			sdk.NewAttribute("msg_type_url", r.MsgTypeUrl),
+		),
+	)
+	return true, nil
+}
+
+// SaveClientRef records the outcome of a message sent with a client_ref so a
+// retry returns it unchanged. An empty client_ref is a no-op.
+func (k Keeper) SaveClientRef(ctx context.Context, signer, ref string, msg sdk.Msg, resp proto.Message) error {
+	if ref == "" {
+		return nil
+	}
+	hash, err := k.msgHash(msg)
+	if err != nil {
+		return err
+	}
+	anyResp, err := codectypes.NewAnyWithValue(resp)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.SetClientRefRecord(ctx, types.ClientRefRecord{
+		Signer:        signer,
+		ClientRef:     ref,
+		MsgTypeUrl:    sdk.MsgTypeURL(msg),
+		MsgHash:       hash,
+		Response:      anyResp,
+		Height:        sdkCtx.BlockHeight(),
+		ExpiresHeight: sdkCtx.BlockHeight() + k.GetParams(ctx).ClientRefTTL(),
+	})
+}
+
+// PruneClientRefs removes client_ref records that expire at or before height.
+func (k Keeper) PruneClientRefs(ctx context.Context, height int64) {
+	es := k.getClientRefExpiryStore(ctx)
+	rs := k.getClientRefStore(ctx)
+
+	iter := es.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iter.Close()
+
+	var done [][]byte
+	for ; iter.Valid(); iter.Next() {
+		done = append(done, append([]byte(nil), iter.Key()...))
+	}
+	for _, key := range done {
+		es.Delete(key)
+		rs.Delete(key[8:])
+	}
+}
+
+func (k Keeper) msgHash(msg sdk.Msg) ([]byte, error) {
+	bz, err := k.cdc.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(bz)
+	return sum[:], nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestClientRefIdempotency(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	params := k.GetParams(sdkCtx)
+	params.ClientRefTtlBlocks = 5
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	creator := addAdmin(t, k, sdkCtx)
+	create := &types.MsgCreatePartner{
+		Creator:          creator,
+		Name:             "Acme",
+		Country:          "IN",
+		Currency:         "INR",
+		BurnCostPerPoint: "2",
+		TotalLiquidity:   "100",
+		ClientRef:        "create-1",
+	}
+	res, err := ms.CreatePartner(sdkCtx, create)
+	require.NoError(t, err)
+	replayed, err := ms.CreatePartner(sdkCtx, create)
+	require.NoError(t, err)
+	require.Equal(t, res.Id, replayed.Id)
+	_, found := k.GetPartner(sdkCtx, 2)
+	require.False(t, found)
+
+	add := &types.MsgAddPartnerLiquidity{
+		Creator:   creator,
+		PartnerId: 1,
+		Amount:    "50",
+		Currency:  "INR",
+		ClientRef: "add-1",
+	}
+	for i := 0; i < 3; i++ {
+		_, err = ms.AddPartnerLiquidity(sdkCtx, add)
+		require.NoError(t, err)
+	}
+	p, _ := k.GetPartner(sdkCtx, 1)
+	require.Equal(t, "125.000000000000000000", p.TotalLiquidity)
+
+	// the same ref on a different message is rejected
+	changed := *add
+	changed.Amount = "60"
+	_, err = ms.AddPartnerLiquidity(sdkCtx, &changed)
+	require.ErrorIs(t, err, types.ErrClientRefConflict)
+
+	// refs are scoped to the signer
+	other := *add
+	other.Creator = sample.AccAddress()
+	_, err = ms.AddPartnerLiquidity(sdkCtx, &other)
+	require.NoError(t, err)
+
+	qres, err := k.ClientRef(sdkCtx, &types.QueryClientRefRequest{Signer: creator, ClientRef: "add-1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MsgTypeURL(add), qres.Record.MsgTypeUrl)
+	require.Equal(t, int64(15), qres.Record.ExpiresHeight)
+
+	require.NoError(t, k.EndBlocker(sdkCtx.WithBlockHeight(14)))
+	_, err = k.ClientRef(sdkCtx, &types.QueryClientRefRequest{Signer: creator, ClientRef: "add-1"})
+	require.NoError(t, err)
+
+	require.NoError(t, k.EndBlocker(sdkCtx.WithBlockHeight(15)))
+	_, err = k.ClientRef(sdkCtx, &types.QueryClientRefRequest{Signer: creator, ClientRef: "add-1"})
+	require.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getEarnRecordStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.EarnRecordKeyPrefix)
+}
+
+func (k Keeper) SetEarnRecord(ctx context.Context, r types.EarnRecord) error {
+	bz, err := k.cdc.Marshal(&r)
+	if err != nil {
+		return err
+	}
+	k.getEarnRecordStore(ctx).Set(types.EarnRecordKey(r.PartnerId, r.ReferenceId), bz)
+	return nil
+}
+
+func (k Keeper) GetEarnRecord(ctx context.Context, partnerID uint64, referenceID string) (types.EarnRecord, bool) {
+	bz := k.getEarnRecordStore(ctx).Get(types.EarnRecordKey(partnerID, referenceID))
+	if bz == nil {
+		return types.EarnRecord{}, false
+	}
+	var r types.EarnRecord
+	k.cdc.MustUnmarshal(bz, &r)
+	return r, true
+}
+
+// HasEarnRecord reports whether the partner already used a reference id.
+func (k Keeper) HasEarnRecord(ctx context.Context, partnerID uint64, referenceID string) bool {
+	return k.getEarnRecordStore(ctx).Has(types.EarnRecordKey(partnerID, referenceID))
+}
+
+func (k Keeper) GetAllEarnRecords(ctx context.Context) []types.EarnRecord {
+	iter := k.getEarnRecordStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.EarnRecord, 0)
+	for ; iter.Valid(); iter.Next() {
+		var r types.EarnRecord
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		out = append(out, r)
+	}
+	return out
+}
+
+// ReverseEarn claws back points of an earn record. The member balance may go
+// below zero down to the partner's negative_balance_limit; any shortfall past
+// that is added to the member's debt. Only the points taken from the balance
+// are returned to the partner's available liquidity; the debt goes back as
+// later credits repay it. It returns the member's new balance and debt.
+func (k Keeper) ReverseEarn(ctx context.Context, p *types.Partner, r *types.EarnRecord, points math.LegacyDec) (math.LegacyDec, math.LegacyDec, error) {
+	earned, err := math.LegacyNewDecFromStr(r.Points)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidReversal, "invalid points on earn record")
+	}
+	reversed, err := parseLiquidity(r.Reversed)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidReversal, "invalid reversed on earn record")
+	}
+	if !points.IsPositive() {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidReversal, "points must be > 0")
+	}
+	if left := earned.Sub(reversed); points.GT(left) {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidReversal, "only %s points left to reverse", left)
+	}
+	limit, err := parseLiquidity(p.NegativeBalanceLimit)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "invalid negative_balance_limit on partner")
+	}
+	member, err := sdk.AccAddressFromBech32(r.Member)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidReversal, "invalid member on earn record")
+	}
+
+	balance := k.GetMemberBalance(ctx, p.Id, member)
+	newBalance := balance.Sub(points)
+	// Never raise a balance that is already below a since lowered limit.
+	floor := math.LegacyMinDec(balance, limit.Neg())
+	debt := k.GetMemberDebt(ctx, p.Id, member)
+	if newBalance.LT(floor) {
+		debt = debt.Add(floor.Sub(newBalance))
+		newBalance = floor
+	}
+	if err := k.SetMemberBalance(ctx, p.Id, member, newBalance); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+	if err := k.SetMemberDebt(ctx, p.Id, member, debt); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	avail, err := parseLiquidity(p.AvailableLiquidity)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "invalid available_liquidity on partner")
+	}
+	p.AvailableLiquidity = avail.Add(balance.Sub(newBalance)).String()
+	if err := k.SetPartner(ctx, *p); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+	if err := k.updateLiquidityLevel(ctx, *p); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	r.Reversed = reversed.Add(points).String()
+	if err := k.SetEarnRecord(ctx, *r); err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+	return newBalance, debt, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// ComputeFee returns the fee for moving points of a partner at rate. The
+// points are valued at the partner's redeem_cost_per_point in the fee denom
+// and the fee is truncated to whole coins.
+func (k Keeper) ComputeFee(ctx context.Context, p types.Partner, points, rate math.LegacyDec) sdk.Coin {
+	denom := k.GetParams(ctx).FeeCoinDenom()
+	price, err := parseLiquidity(p.RedeemCostPerPoint)
+	if err != nil || !rate.IsPositive() || !price.IsPositive() {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+	return sdk.NewCoin(denom, points.Mul(price).Mul(rate).TruncateInt())
+}
+
+// CollectFee charges payer the fee and routes it to the recipient selected
+// in Params: the community pool, a burn, or the fee treasury.
+func (k Keeper) CollectFee(ctx context.Context, payer sdk.AccAddress, fee sdk.Coin) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+	coins := sdk.NewCoins(fee)
+	params := k.GetParams(ctx)
+
+	var err error
+	switch params.FeeRecipient {
+	case types.FEE_RECIPIENT_BURN:
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, coins); err == nil {
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+		}
+	case types.FEE_RECIPIENT_TREASURY:
+		treasury, addrErr := sdk.AccAddressFromBech32(params.FeeTreasury)
+		if addrErr != nil {
+			return errorsmod.Wrap(types.ErrInvalidFee, "invalid fee_treasury in params")
+		}
+		err = k.bankKeeper.SendCoins(ctx, payer, treasury, coins)
+	default:
+		err = k.distrKeeper.FundCommunityPool(ctx, coins, payer)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(err, "collect fee %s", fee)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rewardchain/testutil/keeper"
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/keeper"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestSwapAndRedeemFees(t *testing.T) {
+	k, bank, ctx := keepertest.RewardchainKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	feeTreasury := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.SwapFeeRate = "0.01"
+	params.RedeemFeeRate = "0.02"
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.SetParams(ctx, params))
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:          admin,
+		Treasury:         treasury,
+		Name:             "Acme",
+		Country:          "IN",
+		Currency:         "INR",
+		BurnCostPerPoint: "10",
+		TotalLiquidity:   "1000",
+	})
+	require.NoError(t, err)
+
+	// 100 points at 10stake each, 1% fee, paid to the community pool
+	treasuryAddr := sdk.MustAccAddressFromBech32(treasury)
+	_, err = ms.Swap(ctx, types.NewMsgSwap(treasury, 1, "points_to_token", "100"))
+	require.Error(t, err)
+	bank.Fund(treasuryAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	swap, err := ms.Swap(ctx, types.NewMsgSwap(treasury, 1, "points_to_token", "100"))
+	require.NoError(t, err)
+	require.Equal(t, "10stake", swap.Fee.String())
+	require.Equal(t, "10stake", bank.Balance(authtypes.NewModuleAddress(distrtypes.ModuleName)).String())
+
+	// token_to_points does nothing yet and is free
+	swap, err = ms.Swap(ctx, types.NewMsgSwap(treasury, 1, "token_to_points", "100"))
+	require.NoError(t, err)
+	require.True(t, swap.Fee.IsZero())
+	require.Equal(t, "10stake", bank.Balance(authtypes.NewModuleAddress(distrtypes.ModuleName)).String())
+
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "50", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+	memberAddr := sdk.MustAccAddressFromBech32(member)
+	bank.Fund(memberAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	// redeem fees are burned
+	params.FeeRecipient = types.FEE_RECIPIENT_BURN
+	require.NoError(t, k.SetParams(ctx, params))
+	redeem, err := ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "20"))
+	require.NoError(t, err)
+	require.Equal(t, "4stake", redeem.Fee.String())
+	require.Equal(t, "30.000000000000000000", redeem.Balance)
+	require.Equal(t, "96stake", bank.Balance(memberAddr).String())
+	require.True(t, bank.Balance(authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+	p, _ := k.GetPartner(ctx, 1)
+	require.Equal(t, "880.000000000000000000", p.TotalLiquidity)
+
+	// or sent to the fee treasury
+	params.FeeRecipient = types.FEE_RECIPIENT_TREASURY
+	params.FeeTreasury = feeTreasury
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "10"))
+	require.NoError(t, err)
+	require.Equal(t, "2stake", bank.Balance(sdk.MustAccAddressFromBech32(feeTreasury)).String())
+
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "21"))
+	require.ErrorIs(t, err, types.ErrInsufficientPoints)
+}
+
+func TestFeeParamsValidate(t *testing.T) {
+	params := types.DefaultParams()
+	params.SwapFeeRate = "0.21"
+	require.Error(t, params.Validate())
+	params.SwapFeeRate = "-0.01"
+	require.Error(t, params.Validate())
+	params.SwapFeeRate = "0.2"
+	require.NoError(t, params.Validate())
+
+	params.FeeRecipient = types.FEE_RECIPIENT_TREASURY
+	require.Error(t, params.Validate())
+	params.FeeTreasury = sample.AccAddress()
+	require.NoError(t, params.Validate())
+}
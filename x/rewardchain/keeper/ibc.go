@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// IBCEnabled reports whether the app wired the IBC keepers.
+func (k Keeper) IBCEnabled() bool {
+	return k.ibcKeeperFn != nil && k.capabilityScopedFn != nil && k.ibcKeeperFn() != nil
+}
+
+// GetPort returns the port the rewardpoints application is bound to.
+func (k Keeper) GetPort(ctx context.Context) string {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort records the port the rewardpoints application is bound to.
+func (k Keeper) SetPort(ctx context.Context, portID string) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// ShouldBound reports whether the module still has to bind the port. It is
+// false when IBC is not wired or the module already owns the port
+// capability, for instance from the capability genesis.
+func (k Keeper) ShouldBound(ctx sdk.Context, portID string) bool {
+	if !k.IBCEnabled() {
+		return false
+	}
+	_, ok := k.capabilityScopedFn(types.ModuleName).GetCapability(ctx, host.PortPath(portID))
+	return !ok
+}
+
+// BindPort binds the rewardpoints application to a port and claims the
+// port capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	portCap := k.ibcKeeperFn().PortKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, portCap, host.PortPath(portID))
+}
+
+// AuthenticateCapability wraps the scoped keeper's AuthenticateCapability.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.capabilityScopedFn(types.ModuleName).AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability claims a capability core IBC passes to the module.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.capabilityScopedFn(types.ModuleName).ClaimCapability(ctx, capability, name)
+}
+
+func (k Keeper) getPointsEscrowStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.PointsEscrowKeyPrefix)
+}
+
+func (k Keeper) getPointsVoucherStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.PointsVoucherKeyPrefix)
+}
@@ -7,6 +7,8 @@ import (
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	"rewardchain/x/rewardchain/types"
 )
@@ -20,6 +22,18 @@ type (
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
+
+		accountKeeper     types.AccountKeeper
+		bankKeeper        types.BankKeeper
+		distrKeeper       types.DistributionKeeper
+		feegrantKeeper    types.FeegrantKeeper
+		feegrantMsgServer types.FeegrantMsgServer
+
+		// The IBC keepers are created after the module, so they are read
+		// through getters. They are nil when IBC is not wired.
+		ibcKeeperFn           func() *ibckeeper.Keeper
+		capabilityScopedFn    func(string) capabilitykeeper.ScopedKeeper
+		icaControllerKeeperFn func() types.ICAControllerKeeper
 	}
 )
 
@@ -28,7 +42,14 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	logger log.Logger,
 	authority string,
-
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	feegrantKeeper types.FeegrantKeeper,
+	feegrantMsgServer types.FeegrantMsgServer,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	capabilityScopedFn func(string) capabilitykeeper.ScopedKeeper,
+	icaControllerKeeperFn func() types.ICAControllerKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -39,6 +60,16 @@ func NewKeeper(
 		storeService: storeService,
 		authority:    authority,
 		logger:       logger,
+
+		accountKeeper:     accountKeeper,
+		bankKeeper:        bankKeeper,
+		distrKeeper:       distrKeeper,
+		feegrantKeeper:    feegrantKeeper,
+		feegrantMsgServer: feegrantMsgServer,
+
+		ibcKeeperFn:           ibcKeeperFn,
+		capabilityScopedFn:    capabilityScopedFn,
+		icaControllerKeeperFn: icaControllerKeeperFn,
 	}
 }
 
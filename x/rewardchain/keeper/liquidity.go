@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getLiquidityThresholdsStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.LiquidityThresholdsKeyPrefix)
+}
+
+func (k Keeper) getLiquidityStatusStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.LiquidityStatusKeyPrefix)
+}
+
+// GetLiquidityThresholds returns a partner's liquidity thresholds. Partners
+// without thresholds are never warned.
+func (k Keeper) GetLiquidityThresholds(ctx context.Context, partnerID uint64) types.LiquidityThresholds {
+	bz := k.getLiquidityThresholdsStore(ctx).Get(types.PartnerKey(partnerID))
+	if bz == nil {
+		return types.LiquidityThresholds{PartnerId: partnerID}
+	}
+	var t types.LiquidityThresholds
+	k.cdc.MustUnmarshal(bz, &t)
+	return t
+}
+
+func (k Keeper) SetLiquidityThresholds(ctx context.Context, t types.LiquidityThresholds) error {
+	bz, err := k.cdc.Marshal(&t)
+	if err != nil {
+		return err
+	}
+	k.getLiquidityThresholdsStore(ctx).Set(types.PartnerKey(t.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetAllLiquidityThresholds(ctx context.Context) []types.LiquidityThresholds {
+	iter := k.getLiquidityThresholdsStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.LiquidityThresholds, 0)
+	for ; iter.Valid(); iter.Next() {
+		var t types.LiquidityThresholds
+		k.cdc.MustUnmarshal(iter.Value(), &t)
+		out = append(out, t)
+	}
+	return out
+}
+
+// GetLiquidityStatus returns the last liquidity level recorded for a
+// partner, which is normal until a threshold is first crossed.
+func (k Keeper) GetLiquidityStatus(ctx context.Context, partnerID uint64) types.LiquidityStatus {
+	bz := k.getLiquidityStatusStore(ctx).Get(types.PartnerKey(partnerID))
+	if bz == nil {
+		return types.LiquidityStatus{PartnerId: partnerID}
+	}
+	var st types.LiquidityStatus
+	k.cdc.MustUnmarshal(bz, &st)
+	return st
+}
+
+func (k Keeper) SetLiquidityStatus(ctx context.Context, st types.LiquidityStatus) error {
+	bz, err := k.cdc.Marshal(&st)
+	if err != nil {
+		return err
+	}
+	k.getLiquidityStatusStore(ctx).Set(types.PartnerKey(st.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetAllLiquidityStatuses(ctx context.Context) []types.LiquidityStatus {
+	iter := k.getLiquidityStatusStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.LiquidityStatus, 0)
+	for ; iter.Valid(); iter.Next() {
+		var st types.LiquidityStatus
+		k.cdc.MustUnmarshal(iter.Value(), &st)
+		out = append(out, st)
+	}
+	return out
+}
+
+// IsEarnPaused reports whether earning with the partner is paused for low
+// liquidity.
+func (k Keeper) IsEarnPaused(ctx context.Context, partnerID uint64) bool {
+	return k.GetLiquidityStatus(ctx, partnerID).EarnPaused
+}
+
+// CheckEarnAllowed returns ErrEarnPaused while earning with the partner is
+// paused for low liquidity.
+func (k Keeper) CheckEarnAllowed(ctx context.Context, partnerID uint64) error {
+	if k.IsEarnPaused(ctx, partnerID) {
+		return errorsmod.Wrapf(types.ErrEarnPaused, "partner %d", partnerID)
+	}
+	return nil
+}
+
+// updateLiquidityLevel compares the partner's available liquidity with its
+// thresholds and, when the level changed since it was last recorded, stores
+// the new level and emits a partner_liquidity event. Entering the critical
+// level pauses earning when the partner asked for it; leaving it resumes
+// earning.
+func (k Keeper) updateLiquidityLevel(ctx context.Context, p types.Partner) error {
+	avail, err := parseLiquidity(p.AvailableLiquidity)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPartner, "invalid available_liquidity on partner")
+	}
+	t := k.GetLiquidityThresholds(ctx, p.Id)
+	st := k.GetLiquidityStatus(ctx, p.Id)
+
+	level := t.Level(avail)
+	paused := t.AutoPauseEarn && level == types.LIQUIDITY_LEVEL_CRITICAL
+	if level == st.Level && paused == st.EarnPaused {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	prev := st
+	st.Level = level
+	st.EarnPaused = paused
+	st.Height = sdkCtx.BlockHeight()
+	if err := k.SetLiquidityStatus(ctx, st); err != nil {
+		return err
+	}
+
+	if level != prev.Level {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"partner_liquidity",
+				sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+				sdk.NewAttribute("level", level.String()),
+				sdk.NewAttribute("previous_level", prev.Level.String()),
+				sdk.NewAttribute("available_liquidity", avail.String()),
+				sdk.NewAttribute("warning", t.WarningLevel().String()),
+				sdk.NewAttribute("critical", t.CriticalLevel().String()),
+			),
+		)
+	}
+	if paused != prev.EarnPaused {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"partner_earn_paused",
+				sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+				sdk.NewAttribute("paused", strconv.FormatBool(paused)),
+			),
+		)
+	}
+	return nil
+}
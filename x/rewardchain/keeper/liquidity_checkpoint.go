@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getLiquidityCheckpointStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.LiquidityCheckpointKeyPrefix)
+}
+
+// RecordLiquidityCheckpoints checkpoints the liquidity of every partner as of
+// the current block.
+func (k Keeper) RecordLiquidityCheckpoints(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	partners, err := k.GetAllPartners(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range partners {
+		err := k.SetLiquidityCheckpoint(ctx, types.LiquidityCheckpoint{
+			PartnerId:          p.Id,
+			Height:             sdkCtx.BlockHeight(),
+			Time:               sdkCtx.BlockTime().Unix(),
+			TotalLiquidity:     p.TotalLiquidity,
+			AvailableLiquidity: p.AvailableLiquidity,
+			OnHoldLiquidity:    p.OnHoldLiquidity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetLiquidityCheckpoint writes a checkpoint into its slot of the partner's
+// ring, replacing the oldest checkpoint once the ring is full. A slot already
+// holding a later checkpoint keeps it. Slots beyond the current retention,
+// left over from a larger one, are dropped. With checkpoints off the slot is
+// derived from the default interval so that genesis checkpoints are still
+// kept.
+func (k Keeper) SetLiquidityCheckpoint(ctx context.Context, c types.LiquidityCheckpoint) error {
+	params := k.GetParams(ctx)
+	interval := params.LiquidityCheckpointInterval()
+	if interval == 0 {
+		interval = int64(types.DefaultLiquidityCheckpointIntervalBlocks)
+	}
+	slots := params.LiquidityCheckpointSlots()
+
+	store := k.getLiquidityCheckpointStore(ctx)
+	key := types.LiquidityCheckpointKey(c.PartnerId, types.LiquidityCheckpointSlot(c.Height, interval, slots))
+	if bz := store.Get(key); bz != nil {
+		var prev types.LiquidityCheckpoint
+		k.cdc.MustUnmarshal(bz, &prev)
+		if prev.Height > c.Height {
+			return nil
+		}
+	}
+	bz, err := k.cdc.Marshal(&c)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+
+	iter := store.Iterator(types.LiquidityCheckpointKey(c.PartnerId, slots), storetypes.PrefixEndBytes(types.PartnerKey(c.PartnerId)))
+	var stale [][]byte
+	for ; iter.Valid(); iter.Next() {
+		stale = append(stale, append([]byte(nil), iter.Key()...))
+	}
+	iter.Close()
+	for _, key := range stale {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetPartnerLiquidityHistory returns a partner's checkpoints with heights in
+// [from, to], oldest first. A zero to has no upper bound.
+func (k Keeper) GetPartnerLiquidityHistory(ctx context.Context, partnerID uint64, from, to int64) []types.LiquidityCheckpoint {
+	iter := storetypes.KVStorePrefixIterator(k.getLiquidityCheckpointStore(ctx), types.PartnerKey(partnerID))
+	defer iter.Close()
+
+	out := make([]types.LiquidityCheckpoint, 0)
+	for ; iter.Valid(); iter.Next() {
+		var c types.LiquidityCheckpoint
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		if c.Height < from || (to > 0 && c.Height > to) {
+			continue
+		}
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Height < out[j].Height })
+	return out
+}
+
+// GetAllLiquidityCheckpoints returns the checkpoints of all partners, by
+// partner and then height.
+func (k Keeper) GetAllLiquidityCheckpoints(ctx context.Context) []types.LiquidityCheckpoint {
+	iter := k.getLiquidityCheckpointStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.LiquidityCheckpoint, 0)
+	for ; iter.Valid(); iter.Next() {
+		var c types.LiquidityCheckpoint
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		out = append(out, c)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].PartnerId != out[j].PartnerId {
+			return out[i].PartnerId < out[j].PartnerId
+		}
+		return out[i].Height < out[j].Height
+	})
+	return out
+}
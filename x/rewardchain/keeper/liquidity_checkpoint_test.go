@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestLiquidityCheckpoints(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:          admin,
+		Treasury:         treasury,
+		Name:             "Acme",
+		Country:          "IN",
+		Currency:         "INR",
+		TotalLiquidity:   "1000",
+		BurnCostPerPoint: "1",
+	})
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.LiquidityCheckpointIntervalBlocks = 10
+	params.LiquidityCheckpointRetention = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	start := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	endBlock := func(height int64) {
+		require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height)*time.Minute))))
+	}
+	history := func(from, to int64) []int64 {
+		res, err := k.PartnerLiquidityHistory(ctx, &types.QueryPartnerLiquidityHistoryRequest{PartnerId: 1, From: from, To: to})
+		require.NoError(t, err)
+		heights := make([]int64, len(res.Checkpoints))
+		for i, c := range res.Checkpoints {
+			heights[i] = c.Height
+		}
+		return heights
+	}
+
+	// only interval heights are checkpointed
+	endBlock(9)
+	endBlock(10)
+	require.Equal(t, []int64{10}, history(0, 0))
+
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: sample.AccAddress(), Points: "250", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+	endBlock(20)
+	res, err := k.PartnerLiquidityHistory(ctx, &types.QueryPartnerLiquidityHistoryRequest{PartnerId: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Checkpoints, 2)
+	first, second := res.Checkpoints[0], res.Checkpoints[1]
+	require.Equal(t, start.Add(10*time.Minute).Unix(), first.Time)
+	p, _ := k.GetPartner(ctx, 1)
+	require.Equal(t, p.AvailableLiquidity, second.AvailableLiquidity)
+	require.NotEqual(t, first.AvailableLiquidity, second.AvailableLiquidity)
+
+	// the ring keeps the last three checkpoints
+	endBlock(30)
+	endBlock(40)
+	endBlock(50)
+	require.Equal(t, []int64{30, 40, 50}, history(0, 0))
+	require.Equal(t, []int64{40}, history(35, 45))
+	require.Equal(t, []int64{40, 50}, history(40, 0))
+
+	// shrinking the retention drops the slots beyond it
+	params.LiquidityCheckpointRetention = 2
+	require.NoError(t, k.SetParams(ctx, params))
+	endBlock(60)
+	require.Equal(t, []int64{40, 60}, history(0, 0))
+	endBlock(70)
+	require.Equal(t, []int64{60, 70}, history(0, 0))
+
+	// checkpoints off
+	params.LiquidityCheckpointIntervalBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	endBlock(80)
+	require.Equal(t, []int64{60, 70}, history(0, 0))
+	// imported checkpoints still go into the slots of the default interval
+	require.NoError(t, k.SetLiquidityCheckpoint(ctx, types.LiquidityCheckpoint{PartnerId: 1, Height: 90}))
+	require.Equal(t, []int64{70, 90}, history(0, 0))
+
+	_, err = k.PartnerLiquidityHistory(ctx, &types.QueryPartnerLiquidityHistoryRequest{PartnerId: 1, From: 70, To: 60})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.PartnerLiquidityHistory(ctx, &types.QueryPartnerLiquidityHistoryRequest{PartnerId: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestLiquidityThresholds(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:          admin,
+		Treasury:         treasury,
+		Name:             "Acme",
+		Country:          "IN",
+		Currency:         "INR",
+		TotalLiquidity:   "1000",
+		BurnCostPerPoint: "1",
+	})
+	require.NoError(t, err)
+
+	earn := func(ref, points string) error {
+		_, err := ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+			{Member: member, Points: points, ReferenceId: ref},
+		}, false))
+		return err
+	}
+	level := func() types.LiquidityStatus {
+		res, err := k.LiquidityStatus(ctx, &types.QueryLiquidityStatusRequest{PartnerId: 1})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	_, err = ms.SetLiquidityThresholds(ctx, types.NewMsgSetLiquidityThresholds(member, 1, "500", "200", true))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetLiquidityThresholds(ctx, types.NewMsgSetLiquidityThresholds(treasury, 1, "200", "500", true))
+	require.ErrorIs(t, err, types.ErrInvalidLiquidityThresholds)
+	_, err = ms.SetLiquidityThresholds(ctx, types.NewMsgSetLiquidityThresholds(treasury, 1, "500", "", true))
+	require.ErrorIs(t, err, types.ErrInvalidLiquidityThresholds)
+	_, err = ms.SetLiquidityThresholds(ctx, types.NewMsgSetLiquidityThresholds(treasury, 1, "500", "200", true))
+	require.NoError(t, err)
+	require.Equal(t, types.LIQUIDITY_LEVEL_NORMAL, level().Level)
+
+	require.NoError(t, earn("order-1", "600"))
+	require.Equal(t, types.LIQUIDITY_LEVEL_WARNING, level().Level)
+	require.False(t, level().EarnPaused)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, earn("order-2", "250"))
+	st := level()
+	require.Equal(t, types.LIQUIDITY_LEVEL_CRITICAL, st.Level)
+	require.True(t, st.EarnPaused)
+	var sawCritical bool
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == "partner_liquidity" {
+			sawCritical = true
+		}
+	}
+	require.True(t, sawCritical)
+
+	// storing a partner does not re-evaluate its level
+	p, found := k.GetPartner(ctx, 1)
+	require.True(t, found)
+	raised := p
+	raised.AvailableLiquidity = "1000"
+	require.NoError(t, k.SetPartner(ctx, raised))
+	require.Equal(t, types.LIQUIDITY_LEVEL_CRITICAL, level().Level)
+	require.NoError(t, k.SetPartner(ctx, p))
+
+	// earning is paused, redeeming still works
+	require.ErrorIs(t, earn("order-3", "1"), types.ErrEarnPaused)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "50"))
+	require.NoError(t, err)
+
+	pres, err := k.Partner(ctx, &types.QueryPartnerRequest{Id: 1})
+	require.NoError(t, err)
+	require.True(t, pres.EarnPaused)
+
+	// topping up lifts the pause
+	_, err = ms.AddPartnerLiquidity(ctx, types.NewMsgAddPartnerLiquidity(treasury, 1, "1000", "INR", ""))
+	require.NoError(t, err)
+	require.Equal(t, types.LIQUIDITY_LEVEL_NORMAL, level().Level)
+	require.NoError(t, earn("order-3", "1"))
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getMemberBalanceStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.MemberBalanceKeyPrefix)
+}
+
+// GetMemberBalance returns a member's points balance with a partner, or zero.
+func (k Keeper) GetMemberBalance(ctx context.Context, partnerID uint64, member sdk.AccAddress) math.LegacyDec {
+	bz := k.getMemberBalanceStore(ctx).Get(types.MemberBalanceKey(partnerID, member))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+	var b types.MemberBalance
+	k.cdc.MustUnmarshal(bz, &b)
+	points, err := math.LegacyNewDecFromStr(b.Points)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return points
+}
+
+// SetMemberBalance stores a member's points balance. A zero balance removes
+// the record.
+func (k Keeper) SetMemberBalance(ctx context.Context, partnerID uint64, member sdk.AccAddress, points math.LegacyDec) error {
+	ms := k.getMemberBalanceStore(ctx)
+	key := types.MemberBalanceKey(partnerID, member)
+	if points.IsZero() {
+		ms.Delete(key)
+		return nil
+	}
+	b := types.MemberBalance{
+		PartnerId: partnerID,
+		Member:    member.String(),
+		Points:    points.String(),
+	}
+	bz, err := k.cdc.Marshal(&b)
+	if err != nil {
+		return err
+	}
+	ms.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) GetAllMemberBalances(ctx context.Context) []types.MemberBalance {
+	iter := k.getMemberBalanceStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.MemberBalance, 0)
+	for ; iter.Valid(); iter.Next() {
+		var b types.MemberBalance
+		k.cdc.MustUnmarshal(iter.Value(), &b)
+		out = append(out, b)
+	}
+	return out
+}
+
+func (k Keeper) getMemberDebtStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.MemberDebtKeyPrefix)
+}
+
+// GetMemberDebt returns the points a member owes a partner, or zero.
+func (k Keeper) GetMemberDebt(ctx context.Context, partnerID uint64, member sdk.AccAddress) math.LegacyDec {
+	bz := k.getMemberDebtStore(ctx).Get(types.MemberBalanceKey(partnerID, member))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+	var d types.MemberDebt
+	k.cdc.MustUnmarshal(bz, &d)
+	points, err := math.LegacyNewDecFromStr(d.Points)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return points
+}
+
+// SetMemberDebt stores a member's debt to a partner. Zero removes the record.
+func (k Keeper) SetMemberDebt(ctx context.Context, partnerID uint64, member sdk.AccAddress, points math.LegacyDec) error {
+	ds := k.getMemberDebtStore(ctx)
+	key := types.MemberBalanceKey(partnerID, member)
+	if !points.IsPositive() {
+		ds.Delete(key)
+		return nil
+	}
+	d := types.MemberDebt{
+		PartnerId: partnerID,
+		Member:    member.String(),
+		Points:    points.String(),
+	}
+	bz, err := k.cdc.Marshal(&d)
+	if err != nil {
+		return err
+	}
+	ds.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) GetAllMemberDebts(ctx context.Context) []types.MemberDebt {
+	iter := k.getMemberDebtStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.MemberDebt, 0)
+	for ; iter.Valid(); iter.Next() {
+		var d types.MemberDebt
+		k.cdc.MustUnmarshal(iter.Value(), &d)
+		out = append(out, d)
+	}
+	return out
+}
+
+// CreditPoints issues points to a member out of the partner's available
+// liquidity and returns the member's new balance. The partner is updated in
+// place so callers can keep working with it.
+func (k Keeper) CreditPoints(ctx context.Context, p *types.Partner, member sdk.AccAddress, points math.LegacyDec) (math.LegacyDec, error) {
+	if !points.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "points must be > 0")
+	}
+	if err := k.DeductAvailableLiquidity(ctx, p, points); err != nil {
+		return math.LegacyDec{}, err
+	}
+	return k.AddMemberPoints(ctx, p, member, points)
+}
+
+// AddMemberPoints adds points to a member's balance and returns the new
+// balance. Outstanding member debt is repaid first; the repaid points go back
+// to the partner's available liquidity, which a reversal only credited with
+// the points it could take from the balance. The partner is updated in place.
+// The first credit starts the member's new member cool-down.
+func (k Keeper) AddMemberPoints(ctx context.Context, p *types.Partner, member sdk.AccAddress, points math.LegacyDec) (math.LegacyDec, error) {
+	partnerID := p.Id
+	if _, found := k.GetMemberSince(ctx, partnerID, member); !found {
+		if err := k.SetMemberSince(ctx, types.MemberSince{
+			PartnerId: partnerID,
+			Member:    member.String(),
+			Since:     sdk.UnwrapSDKContext(ctx).BlockTime().Unix(),
+		}); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+	if debt := k.GetMemberDebt(ctx, partnerID, member); debt.IsPositive() {
+		repaid := math.LegacyMinDec(debt, points)
+		if err := k.SetMemberDebt(ctx, partnerID, member, debt.Sub(repaid)); err != nil {
+			return math.LegacyDec{}, err
+		}
+		avail, err := parseLiquidity(p.AvailableLiquidity)
+		if err != nil {
+			return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "invalid available_liquidity on partner")
+		}
+		p.AvailableLiquidity = avail.Add(repaid).String()
+		if err := k.SetPartner(ctx, *p); err != nil {
+			return math.LegacyDec{}, err
+		}
+		if err := k.updateLiquidityLevel(ctx, *p); err != nil {
+			return math.LegacyDec{}, err
+		}
+		points = points.Sub(repaid)
+	}
+	balance := k.GetMemberBalance(ctx, partnerID, member).Add(points)
+	if err := k.SetMemberBalance(ctx, partnerID, member, balance); err != nil {
+		return math.LegacyDec{}, err
+	}
+	return balance, nil
+}
+
+// DeductAvailableLiquidity removes points from the partner's available
+// liquidity, stores the partner and re-evaluates its liquidity level.
+func (k Keeper) DeductAvailableLiquidity(ctx context.Context, p *types.Partner, points math.LegacyDec) error {
+	avail, err := parseLiquidity(p.AvailableLiquidity)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPartner, "invalid available_liquidity on partner")
+	}
+	if points.GT(avail) {
+		return errorsmod.Wrapf(types.ErrInsufficientLiquidity, "available %s, requested %s", avail, points)
+	}
+	p.AvailableLiquidity = avail.Sub(points).String()
+	if err := k.SetPartner(ctx, *p); err != nil {
+		return err
+	}
+	return k.updateLiquidityLevel(ctx, *p)
+}
+
+// parseLiquidity parses a partner liquidity amount, treating empty as zero.
+func parseLiquidity(s string) (math.LegacyDec, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	return math.LegacyNewDecFromStr(s)
+}
@@ -14,7 +14,9 @@ import (
 
 // AddPartnerLiquidity handles MsgAddPartnerLiquidity messages.
 // It increases a partner's total and available liquidity based on the
-// provided amount and the current RedeemCostPerPoint.
+// provided amount and the current RedeemCostPerPoint. The amount must be in
+// the partner's currency; partners created before the currency was stored
+// adopt the currency of their first top-up.
 func (k msgServer) AddPartnerLiquidity(goCtx context.Context, msg *types.MsgAddPartnerLiquidity) (*types.MsgAddPartnerLiquidityResponse, error) {
 	if msg == nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "empty request")
@@ -26,9 +28,24 @@ func (k msgServer) AddPartnerLiquidity(goCtx context.Context, msg *types.MsgAddP
 	if msg.PartnerId == 0 {
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "partner_id must be > 0")
 	}
+	currency := types.NormalizeCode(msg.Currency)
+	if err := types.ValidateCurrencyCode(currency); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, err.Error())
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.CheckNotPaused(ctx, types.OPERATION_ADD_LIQUIDITY); err != nil {
+		return nil, err
+	}
+
+	var replay types.MsgAddPartnerLiquidityResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
 	// NOTE: admin check is currently disabled in CreatePartner for testing.
 	// Uncomment the following lines if you want to require admin privileges
 	// for adding liquidity as well.
@@ -44,56 +61,22 @@ func (k msgServer) AddPartnerLiquidity(goCtx context.Context, msg *types.MsgAddP
 	if p.Disabled {
 		return nil, types.ErrPartnerDisabled
 	}
+	if p.Currency == "" {
+		p.Currency = currency
+	} else if p.Currency != currency {
+		return nil, errorsmod.Wrapf(types.ErrCurrencyMismatch, "partner %d is denominated in %s, got %s", p.Id, p.Currency, currency)
+	}
 
 	amountStr := strings.TrimSpace(msg.Amount)
 	if amountStr == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "amount is required")
 	}
-	redeemStr := strings.TrimSpace(p.RedeemCostPerPoint)
-	if redeemStr == "" {
-		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "redeem_cost_per_point is required on partner")
-	}
-
 	amountDec, err := math.LegacyNewDecFromStr(amountStr)
 	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid amount")
 	}
-	redeemDec, err := math.LegacyNewDecFromStr(redeemStr)
-	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid redeem_cost_per_point on partner")
-	}
-	if redeemDec.IsZero() {
-		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "redeem_cost_per_point must be > 0")
-	}
 
-	// points = amount / redeem_cost_per_point
-	points := amountDec.Quo(redeemDec)
-
-	totalStr := strings.TrimSpace(p.TotalLiquidity)
-	if totalStr == "" {
-		totalStr = "0"
-	}
-	totalDec, err := math.LegacyNewDecFromStr(totalStr)
-	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid total_liquidity on partner")
-	}
-
-	availStr := strings.TrimSpace(p.AvailableLiquidity)
-	if availStr == "" {
-		availStr = "0"
-	}
-	availDec, err := math.LegacyNewDecFromStr(availStr)
-	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid available_liquidity on partner")
-	}
-
-	newTotal := totalDec.Add(points)
-	newAvail := availDec.Add(points)
-
-	p.TotalLiquidity = newTotal.String()
-	p.AvailableLiquidity = newAvail.String()
-
-	if err := k.SetPartner(ctx, p); err != nil {
+	if _, err := k.AddLiquidity(ctx, &p, amountDec); err != nil {
 		return nil, err
 	}
 
@@ -103,11 +86,15 @@ func (k msgServer) AddPartnerLiquidity(goCtx context.Context, msg *types.MsgAddP
 			"add_partner_liquidity",
 			sdk.NewAttribute("partner_id", strconv.FormatUint(msg.PartnerId, 10)),
 			sdk.NewAttribute("amount", msg.Amount),
-			sdk.NewAttribute("currency", msg.Currency),
+			sdk.NewAttribute("currency", currency),
 			sdk.NewAttribute("ext_wallet", msg.ExtWallet),
 		),
 	)
 
-	return &types.MsgAddPartnerLiquidityResponse{}, nil
+	res := &types.MsgAddPartnerLiquidityResponse{}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
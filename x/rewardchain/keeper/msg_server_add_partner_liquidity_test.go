@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestAddPartnerLiquidityCurrency(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:          admin,
+		Treasury:         treasury,
+		Name:             "Acme",
+		Country:          "Mumbai",
+		Currency:         "INR",
+		BurnCostPerPoint: "2",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidPartner)
+	_, err = ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:          admin,
+		Treasury:         treasury,
+		Name:             "Acme",
+		Country:          "in",
+		Currency:         "inr",
+		BurnCostPerPoint: "2",
+	})
+	require.NoError(t, err)
+
+	p, found := k.GetPartner(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, "IN", p.Country)
+	require.Equal(t, "INR", p.Currency)
+
+	_, err = ms.AddPartnerLiquidity(ctx, types.NewMsgAddPartnerLiquidity(treasury, 1, "100", "USD", ""))
+	require.ErrorIs(t, err, types.ErrCurrencyMismatch)
+	_, err = ms.AddPartnerLiquidity(ctx, types.NewMsgAddPartnerLiquidity(treasury, 1, "100", "XYZ", ""))
+	require.ErrorIs(t, err, types.ErrInvalidPartner)
+	_, err = ms.AddPartnerLiquidity(ctx, types.NewMsgAddPartnerLiquidity(treasury, 1, "100", "INR", ""))
+	require.NoError(t, err)
+
+	// partners stored before the currency was recorded adopt the first one
+	p.Id = 2
+	p.Currency = ""
+	require.NoError(t, k.SetPartner(ctx, p))
+	_, err = ms.AddPartnerLiquidity(ctx, types.NewMsgAddPartnerLiquidity(treasury, 2, "10", "usd", ""))
+	require.NoError(t, err)
+	p, _ = k.GetPartner(ctx, 2)
+	require.Equal(t, "USD", p.Currency)
+	_, err = ms.AddPartnerLiquidity(ctx, types.NewMsgAddPartnerLiquidity(treasury, 2, "10", "INR", ""))
+	require.ErrorIs(t, err, types.ErrCurrencyMismatch)
+}
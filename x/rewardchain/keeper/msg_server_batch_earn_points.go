@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BatchEarnPoints handles MsgBatchEarnPoints messages.
+// Every entry carries a partner reference id that may only be used once. The
+// points of all accepted entries are deducted from the partner's available
+// liquidity in a single step before members are credited. In partial mode a
+// bad entry is reported in its result and the rest of the batch proceeds;
+// otherwise the first bad entry fails the message.
+func (k msgServer) BatchEarnPoints(goCtx context.Context, msg *types.MsgBatchEarnPoints) (*types.MsgBatchEarnPointsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEarn, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEarn, "invalid creator address")
+	}
+	if len(msg.Entries) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidEarn, "entries are required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.CheckNotPaused(ctx, types.OPERATION_EARN); err != nil {
+		return nil, err
+	}
+
+	var replay types.MsgBatchEarnPointsResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
+	if limit := k.GetParams(ctx).BatchEarnLimit(); len(msg.Entries) > limit {
+		return nil, errorsmod.Wrapf(types.ErrBatchTooLarge, "%d entries, max %d", len(msg.Entries), limit)
+	}
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return nil, types.ErrPartnerDisabled
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can issue points")
+	}
+	if err := k.CheckEarnAllowed(ctx, p.Id); err != nil {
+		return nil, err
+	}
+
+	type accepted struct {
+		index  int
+		member sdk.AccAddress
+		points math.LegacyDec
+	}
+
+	results := make([]types.EarnEntryResult, len(msg.Entries))
+	credits := make([]accepted, 0, len(msg.Entries))
+	seen := make(map[string]struct{}, len(msg.Entries))
+	total := math.LegacyZeroDec()
+
+	for i, e := range msg.Entries {
+		results[i].ReferenceId = e.ReferenceId
+
+		member, points, err := e.Validate()
+		if err == nil {
+			_, dup := seen[e.ReferenceId]
+			if dup || k.HasEarnRecord(ctx, p.Id, e.ReferenceId) {
+				err = errorsmod.Wrap(types.ErrDuplicateReferenceID, e.ReferenceId)
+			}
+		} else {
+			err = errorsmod.Wrap(types.ErrInvalidEarn, err.Error())
+		}
+		if err != nil {
+			if !msg.Partial {
+				return nil, errorsmod.Wrapf(err, "entry %d", i)
+			}
+			results[i].Error = err.Error()
+			continue
+		}
+
+		seen[e.ReferenceId] = struct{}{}
+		credits = append(credits, accepted{index: i, member: member, points: points})
+		total = total.Add(points)
+	}
+
+	if total.IsPositive() {
+		if err := k.DeductAvailableLiquidity(ctx, &p, total); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, c := range credits {
+		e := msg.Entries[c.index]
+		balance, err := k.AddMemberPoints(ctx, &p, c.member, c.points)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.SetEarnRecord(ctx, types.EarnRecord{
+			PartnerId:   p.Id,
+			ReferenceId: e.ReferenceId,
+			Member:      c.member.String(),
+			Points:      c.points.String(),
+			Height:      ctx.BlockHeight(),
+		}); err != nil {
+			return nil, err
+		}
+
+		results[c.index].Success = true
+		results[c.index].Balance = balance.String()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"earn_points",
+				sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+				sdk.NewAttribute("member", c.member.String()),
+				sdk.NewAttribute("points", c.points.String()),
+				sdk.NewAttribute("reference_id", e.ReferenceId),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"batch_earn_points",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("entries", strconv.Itoa(len(msg.Entries))),
+			sdk.NewAttribute("credited", strconv.Itoa(len(credits))),
+			sdk.NewAttribute("total_points", total.String()),
+		),
+	)
+
+	res := &types.MsgBatchEarnPointsResponse{
+		Results:     results,
+		TotalPoints: total.String(),
+	}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestMsgBatchEarnPoints(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	admin := addAdmin(t, k, sdkCtx)
+	treasury := sample.AccAddress()
+	alice := sample.AccAddress()
+	bob := sample.AccAddress()
+	_, err := ms.CreatePartner(sdkCtx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "500",
+	})
+	require.NoError(t, err)
+
+	entries := []types.EarnEntry{
+		{Member: alice, Points: "100", ReferenceId: "order-1"},
+		{Member: "not-an-address", Points: "10", ReferenceId: "order-2"},
+		{Member: bob, Points: "50", ReferenceId: "order-3"},
+		{Member: bob, Points: "5", ReferenceId: "order-3"},
+	}
+
+	// only the treasury may issue points
+	_, err = ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(alice, 1, entries, true))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// atomic mode fails on the first bad entry and changes nothing
+	_, err = ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, entries, false))
+	require.ErrorIs(t, err, types.ErrInvalidEarn)
+	require.True(t, k.GetMemberBalance(sdkCtx, 1, sdk.MustAccAddressFromBech32(alice)).IsZero())
+
+	res, err := ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, entries, true))
+	require.NoError(t, err)
+	require.Len(t, res.Results, 4)
+	require.True(t, res.Results[0].Success)
+	require.Equal(t, "100.000000000000000000", res.Results[0].Balance)
+	require.False(t, res.Results[1].Success)
+	require.NotEmpty(t, res.Results[1].Error)
+	require.True(t, res.Results[2].Success)
+	require.False(t, res.Results[3].Success)
+	require.Contains(t, res.Results[3].Error, types.ErrDuplicateReferenceID.Error())
+	require.Equal(t, "150.000000000000000000", res.TotalPoints)
+
+	p, _ := k.GetPartner(sdkCtx, 1)
+	require.Equal(t, "350.000000000000000000", p.AvailableLiquidity)
+
+	rec, found := k.GetEarnRecord(sdkCtx, 1, "order-3")
+	require.True(t, found)
+	require.Equal(t, bob, rec.Member)
+
+	// reference ids stay used across batches
+	_, err = ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: alice, Points: "1", ReferenceId: "order-1"},
+	}, false))
+	require.ErrorIs(t, err, types.ErrDuplicateReferenceID)
+
+	// the whole batch is deducted at once
+	_, err = ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: alice, Points: "200", ReferenceId: "order-4"},
+		{Member: bob, Points: "200", ReferenceId: "order-5"},
+	}, true))
+	require.ErrorIs(t, err, types.ErrInsufficientLiquidity)
+
+	params := k.GetParams(sdkCtx)
+	params.MaxBatchEarnEntries = 1
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	_, err = ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: alice, Points: "1", ReferenceId: "order-6"},
+		{Member: bob, Points: "1", ReferenceId: "order-7"},
+	}, true))
+	require.ErrorIs(t, err, types.ErrBatchTooLarge)
+}
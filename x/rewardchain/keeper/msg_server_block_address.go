@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockAddress handles MsgBlockAddress messages.
+// A module admin or governance can list an address, after which the ante
+// handler rejects any rewardchain message it signs and transfers to it fail.
+// Listing an address that is already listed replaces its entry.
+func (k msgServer) BlockAddress(goCtx context.Context, msg *types.MsgBlockAddress) (*types.MsgBlockAddressResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBlock, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.canManageBlocklist(ctx, msg.Creator) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only an admin or governance can block addresses")
+	}
+
+	b := msg.Entry(ctx.BlockHeight())
+	if err := k.SetBlockedAddress(ctx, b); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"block_address",
+			sdk.NewAttribute("address", b.Address),
+			sdk.NewAttribute("reason", b.Reason.String()),
+			sdk.NewAttribute("note", b.Note),
+			sdk.NewAttribute("listed_by", b.ListedBy),
+		),
+	)
+
+	return &types.MsgBlockAddressResponse{}, nil
+}
+
+// UnblockAddress handles MsgUnblockAddress messages.
+func (k msgServer) UnblockAddress(goCtx context.Context, msg *types.MsgUnblockAddress) (*types.MsgUnblockAddressResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidBlock, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.canManageBlocklist(ctx, msg.Creator) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only an admin or governance can unblock addresses")
+	}
+
+	addr := sdk.MustAccAddressFromBech32(msg.Address)
+	if !k.IsBlocked(ctx, addr) {
+		return nil, errorsmod.Wrap(types.ErrAddressNotBlocked, msg.Address)
+	}
+	k.RemoveBlockedAddress(ctx, addr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"unblock_address",
+			sdk.NewAttribute("address", msg.Address),
+			sdk.NewAttribute("reason", msg.Reason.String()),
+			sdk.NewAttribute("note", msg.Note),
+			sdk.NewAttribute("delisted_by", msg.Creator),
+		),
+	)
+
+	return &types.MsgUnblockAddressResponse{}, nil
+}
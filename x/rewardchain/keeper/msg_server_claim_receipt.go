@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimReceipt handles MsgClaimReceipt messages.
+// It verifies the partner's signature on the receipt, records the nonce so the
+// receipt cannot be claimed twice, and credits the points to the member out of
+// the partner's available liquidity. The claim is recorded as an earn under
+// types.ReceiptReferenceID so the partner can reverse it.
+func (k msgServer) ClaimReceipt(goCtx context.Context, msg *types.MsgClaimReceipt) (*types.MsgClaimReceiptResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidReceipt, "empty request")
+	}
+	member, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidReceipt, "invalid creator address")
+	}
+	if msg.Receipt.Member != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "receipt was issued to another member")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.CheckNotPaused(ctx, types.OPERATION_EARN); err != nil {
+		return nil, err
+	}
+
+	var replay types.MsgClaimReceiptResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
+	p, found := k.GetPartner(ctx, msg.Receipt.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return nil, types.ErrPartnerDisabled
+	}
+	if err := k.CheckEarnAllowed(ctx, p.Id); err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifyReceipt(ctx, msg.Receipt, msg.Signature); err != nil {
+		return nil, err
+	}
+	referenceID := types.ReceiptReferenceID(msg.Receipt.Nonce)
+	if k.HasEarnRecord(ctx, p.Id, referenceID) {
+		return nil, errorsmod.Wrap(types.ErrDuplicateReferenceID, referenceID)
+	}
+	if err := k.SetClaimedReceipt(ctx, types.ClaimedReceipt{
+		PartnerId: msg.Receipt.PartnerId,
+		Nonce:     msg.Receipt.Nonce,
+		Expiry:    msg.Receipt.Expiry,
+	}); err != nil {
+		return nil, err
+	}
+
+	points, err := math.LegacyNewDecFromStr(strings.TrimSpace(msg.Receipt.Points))
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidReceipt, "invalid points")
+	}
+	balance, err := k.CreditPoints(ctx, &p, member, points)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SetEarnRecord(ctx, types.EarnRecord{
+		PartnerId:   p.Id,
+		ReferenceId: referenceID,
+		Member:      msg.Creator,
+		Points:      points.String(),
+		Height:      ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"claim_receipt",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("member", msg.Creator),
+			sdk.NewAttribute("points", points.String()),
+			sdk.NewAttribute("nonce", msg.Receipt.Nonce),
+			sdk.NewAttribute("reference_id", referenceID),
+		),
+	)
+
+	res := &types.MsgClaimReceiptResponse{Balance: balance.String()}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
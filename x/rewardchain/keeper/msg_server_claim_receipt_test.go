@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/receipt"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestMsgClaimReceipt(t *testing.T) {
+	for _, key := range []cryptotypes.PrivKey{ed25519.GenPrivKey(), secp256k1.GenPrivKey()} {
+		k, ms, ctx := setupMsgServer(t)
+		sdkCtx := sdk.UnwrapSDKContext(ctx).WithChainID("rewardchain").WithBlockTime(time.Unix(1_000, 0))
+
+		admin := addAdmin(t, k, sdkCtx)
+		treasury := sample.AccAddress()
+		member := sample.AccAddress()
+		_, err := ms.CreatePartner(sdkCtx, &types.MsgCreatePartner{
+			Creator:        admin,
+			Treasury:       treasury,
+			Name:           "Acme",
+			Country:        "IN",
+			Currency:       "INR",
+			TotalLiquidity: "500",
+		})
+		require.NoError(t, err)
+
+		signer, err := receipt.NewSigner("rewardchain", 1, key)
+		require.NoError(t, err)
+
+		r, sig, err := signer.Sign(member, "120", "n-1", time.Unix(2_000, 0))
+		require.NoError(t, err)
+
+		_, err = ms.ClaimReceipt(sdkCtx, receipt.ClaimMsg(r, sig))
+		require.ErrorIs(t, err, types.ErrReceiptKeyNotFound)
+
+		_, err = ms.SetReceiptKey(sdkCtx, signer.SetReceiptKeyMsg(sample.AccAddress()))
+		require.ErrorIs(t, err, types.ErrUnauthorized)
+		_, err = ms.SetReceiptKey(sdkCtx, signer.SetReceiptKeyMsg(treasury))
+		require.NoError(t, err)
+
+		res, err := ms.ClaimReceipt(sdkCtx, receipt.ClaimMsg(r, sig))
+		require.NoError(t, err)
+		require.Equal(t, "120.000000000000000000", res.Balance)
+
+		p, _ := k.GetPartner(sdkCtx, 1)
+		require.Equal(t, "380.000000000000000000", p.AvailableLiquidity)
+
+		// replayed nonce
+		_, err = ms.ClaimReceipt(sdkCtx, receipt.ClaimMsg(r, sig))
+		require.ErrorIs(t, err, types.ErrReceiptAlreadyClaimed)
+
+		// tampered amount
+		tampered := r
+		tampered.Nonce = "n-2"
+		_, sig2, err := signer.Sign(member, "1", tampered.Nonce, time.Unix(2_000, 0))
+		require.NoError(t, err)
+		tampered.Points = "400"
+		_, err = ms.ClaimReceipt(sdkCtx, receipt.ClaimMsg(tampered, sig2))
+		require.ErrorIs(t, err, types.ErrInvalidReceiptSig)
+
+		// expired
+		r3, sig3, err := signer.Sign(member, "1", "n-3", time.Unix(999, 0))
+		require.NoError(t, err)
+		_, err = ms.ClaimReceipt(sdkCtx, receipt.ClaimMsg(r3, sig3))
+		require.ErrorIs(t, err, types.ErrReceiptExpired)
+
+		// more points than the partner has left
+		r4, sig4, err := signer.Sign(member, "381", "n-4", time.Unix(2_000, 0))
+		require.NoError(t, err)
+		_, err = ms.ClaimReceipt(sdkCtx, receipt.ClaimMsg(r4, sig4))
+		require.ErrorIs(t, err, types.ErrInsufficientLiquidity)
+
+		// signed for another chain
+		other, err := receipt.NewSigner("other-chain", 1, key)
+		require.NoError(t, err)
+		r5, sig5, err := other.Sign(member, "1", "n-5", time.Unix(2_000, 0))
+		require.NoError(t, err)
+		_, err = ms.ClaimReceipt(sdkCtx, receipt.ClaimMsg(r5, sig5))
+		require.ErrorIs(t, err, types.ErrInvalidReceiptSig)
+
+		// the claim can be reversed by its reference id
+		rec, found := k.GetEarnRecord(sdkCtx, 1, types.ReceiptReferenceID("n-1"))
+		require.True(t, found)
+		require.Equal(t, member, rec.Member)
+		_, err = ms.ReversePointsEarn(sdkCtx, types.NewMsgReversePointsEarn(treasury, 1, types.ReceiptReferenceID("n-1"), "20", "return"))
+		require.NoError(t, err)
+		require.Equal(t, "100.000000000000000000", k.GetMemberBalance(sdkCtx, 1, sdk.MustAccAddressFromBech32(member)).String())
+
+		// claimed nonces are kept until the receipt expires
+		require.NoError(t, k.EndBlocker(sdkCtx.WithBlockTime(time.Unix(2_000, 0))))
+		require.True(t, k.IsReceiptClaimed(sdkCtx, 1, "n-1"))
+		require.NoError(t, k.EndBlocker(sdkCtx.WithBlockTime(time.Unix(2_001, 0))))
+		require.False(t, k.IsReceiptClaimed(sdkCtx, 1, "n-1"))
+		require.Empty(t, k.GetAllClaimedReceipts(sdkCtx))
+		_, err = ms.ClaimReceipt(sdkCtx.WithBlockTime(time.Unix(2_001, 0)), receipt.ClaimMsg(r, sig))
+		require.ErrorIs(t, err, types.ErrReceiptExpired)
+	}
+}
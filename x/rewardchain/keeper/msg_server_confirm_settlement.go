@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConfirmSettlement handles MsgConfirmSettlement messages.
+// The payer closes an open settlement either by declaring it paid off-chain
+// or by paying the payee treasury from its settlement escrow. An escrow
+// payment must be worth at least the settlement amount.
+func (k msgServer) ConfirmSettlement(goCtx context.Context, msg *types.MsgConfirmSettlement) (*types.MsgConfirmSettlementResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "invalid creator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	s, found := k.GetSettlement(ctx, msg.SettlementId)
+	if !found {
+		return nil, types.ErrSettlementNotFound
+	}
+	if !s.IsOpen() {
+		return nil, types.ErrSettlementClosed
+	}
+	payer, found := k.GetPartner(ctx, s.PayerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != payer.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the payer treasury can confirm a settlement")
+	}
+
+	switch msg.Method {
+	case types.SettlementMethodOffchain:
+		if msg.OffchainRef == "" {
+			return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "offchainRef is required for offchain settlements")
+		}
+		s.Status = types.SETTLEMENT_STATUS_SETTLED_OFFCHAIN
+		s.OffchainRef = msg.OffchainRef
+	case types.SettlementMethodEscrow:
+		if !msg.Payment.IsValid() || msg.Payment.IsZero() {
+			return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "payment must be positive for escrow settlements")
+		}
+		amount, err := math.LegacyNewDecFromStr(s.Amount)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "invalid amount on settlement")
+		}
+		value, err := k.ValueSettlementPayment(ctx, msg.Payment, s.Currency)
+		if err != nil {
+			return nil, err
+		}
+		if value.LT(amount) {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientPayment, "payment worth %s %s, settlement is %s %s", value, s.Currency, amount, s.Currency)
+		}
+		if err := k.PaySettlementFromEscrow(ctx, s, msg.Payment); err != nil {
+			return nil, err
+		}
+		s.Status = types.SETTLEMENT_STATUS_SETTLED_ESCROW
+		s.Payment = msg.Payment
+	default:
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "unknown method")
+	}
+	s.SettledHeight = ctx.BlockHeight()
+
+	if err := k.SetSettlement(ctx, s); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(settlementEvent("settlement_confirmed", s))
+
+	return &types.MsgConfirmSettlementResponse{}, nil
+}
@@ -22,16 +22,42 @@ func (k msgServer) CreatePartner(goCtx context.Context, msg *types.MsgCreatePart
 	if strings.TrimSpace(msg.Name) == "" {
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "name is required")
 	}
-	if strings.TrimSpace(msg.Country) == "" {
-		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "country is required")
+	country := types.NormalizeCode(msg.Country)
+	if err := types.ValidateCountryCode(country); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, err.Error())
+	}
+	currency := types.NormalizeCode(msg.Currency)
+	if err := types.ValidateCurrencyCode(currency); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, err.Error())
+	}
+	treasury := strings.TrimSpace(msg.Treasury)
+	if treasury == "" {
+		treasury = msg.Creator
+	}
+	if _, err := sdk.AccAddressFromBech32(treasury); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid treasury address")
+	}
+	negLimit, err := parseLiquidity(msg.NegativeBalanceLimit)
+	if err != nil || negLimit.IsNegative() {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "negative_balance_limit must be a non-negative decimal")
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	// TODO: Re-enable admin check after adding addresses to genesis
-	// Temporarily disabled for testing
-	// if err := requireAdmin(k.Keeper, ctx, msg.Creator); err != nil {
-	// 	return nil, err
-	// }
+
+	if err := k.CheckNotPaused(ctx, types.OPERATION_CREATE_PARTNER); err != nil {
+		return nil, err
+	}
+
+	var replay types.MsgCreatePartnerResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
+	if err := requireAdmin(k.Keeper, ctx, msg.Creator); err != nil {
+		return nil, err
+	}
 
 	id, err := k.GetNextPartnerID(ctx)
 	if err != nil {
@@ -50,7 +76,7 @@ func (k msgServer) CreatePartner(goCtx context.Context, msg *types.MsgCreatePart
 		Name:               strings.TrimSpace(msg.Name),
 		Category:           strings.TrimSpace(msg.Category),
 		Location:           "", // Not in message, set to empty
-		Country:            strings.TrimSpace(msg.Country),
+		Country:            country,
 		Disabled:           false,
 		TotalLiquidity:     strings.TrimSpace(msg.TotalLiquidity),
 		AvailableLiquidity: availableLiquidity,
@@ -59,6 +85,10 @@ func (k msgServer) CreatePartner(goCtx context.Context, msg *types.MsgCreatePart
 		RedeemCostPerPoint: strings.TrimSpace(msg.BurnCostPerPoint), // Map burnCostPerPoint to redeem_cost_per_point
 		StartsFrom:         "",                                      // Not in message, set to empty
 		EndsBefore:         "",                                      // Not in message, set to empty
+		Treasury:           treasury,
+
+		NegativeBalanceLimit: negLimit.String(),
+		Currency:             currency,
 	}
 
 	if err := k.SetPartner(ctx, p); err != nil {
@@ -66,5 +96,9 @@ func (k msgServer) CreatePartner(goCtx context.Context, msg *types.MsgCreatePart
 	}
 	k.SetPartnerCounter(ctx, id)
 
-	return &types.MsgCreatePartnerResponse{Id: strconv.FormatUint(id, 10)}, nil
+	res := &types.MsgCreatePartnerResponse{Id: strconv.FormatUint(id, 10)}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
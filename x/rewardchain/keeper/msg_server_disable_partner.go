@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DisablePartner handles MsgDisablePartner messages.
+// Disabling a partner also revokes every member sponsorship it funds.
+func (k msgServer) DisablePartner(goCtx context.Context, msg *types.MsgDisablePartner) (*types.MsgDisablePartnerResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid creator address")
+	}
+	if msg.PartnerId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "partner_id must be > 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := requireAdmin(k.Keeper, ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return nil, types.ErrPartnerDisabled
+	}
+
+	if err := k.Keeper.DisablePartner(ctx, msg.PartnerId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"disable_partner",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(msg.PartnerId, 10)),
+		),
+	)
+
+	return &types.MsgDisablePartnerResponse{}, nil
+}
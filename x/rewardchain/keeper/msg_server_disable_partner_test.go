@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestMsgDisablePartner(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	admin := sample.AccAddress()
+	require.NoError(t, k.SetParams(ctx, types.NewParams([]string{admin})))
+
+	// only admins create partners
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:  sample.AccAddress(),
+		Name:     "Acme",
+		Country:  "IN",
+		Currency: "INR",
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:  admin,
+		Name:     "Acme",
+		Country:  "IN",
+		Currency: "INR",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1", res.Id)
+
+	p, found := k.GetPartner(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, admin, p.Treasury)
+
+	_, err = ms.DisablePartner(ctx, &types.MsgDisablePartner{Creator: sample.AccAddress(), PartnerId: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.DisablePartner(ctx, &types.MsgDisablePartner{Creator: admin, PartnerId: 2})
+	require.ErrorIs(t, err, types.ErrPartnerNotFound)
+
+	_, err = ms.DisablePartner(ctx, &types.MsgDisablePartner{Creator: admin, PartnerId: 1})
+	require.NoError(t, err)
+
+	p, _ = k.GetPartner(ctx, 1)
+	require.True(t, p.Disabled)
+
+	_, err = ms.DisablePartner(ctx, &types.MsgDisablePartner{Creator: admin, PartnerId: 1})
+	require.ErrorIs(t, err, types.ErrPartnerDisabled)
+}
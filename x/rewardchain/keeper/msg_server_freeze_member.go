@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FreezeMember handles MsgFreezeMember messages.
+// An admin can block a compromised member from redeeming and sending points
+// with every partner until the member is unfrozen.
+func (k msgServer) FreezeMember(goCtx context.Context, msg *types.MsgFreezeMember) (*types.MsgFreezeMemberResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := requireAdmin(k.Keeper, ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetFrozenMember(ctx, types.FrozenMember{
+		Member:   msg.Member,
+		Reason:   msg.Reason,
+		FrozenBy: msg.Creator,
+		Height:   ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"freeze_member",
+			sdk.NewAttribute("member", msg.Member),
+			sdk.NewAttribute("reason", msg.Reason),
+			sdk.NewAttribute("admin", msg.Creator),
+		),
+	)
+
+	return &types.MsgFreezeMemberResponse{}, nil
+}
+
+// UnfreezeMember handles MsgUnfreezeMember messages.
+func (k msgServer) UnfreezeMember(goCtx context.Context, msg *types.MsgUnfreezeMember) (*types.MsgUnfreezeMemberResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := requireAdmin(k.Keeper, ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	member := sdk.MustAccAddressFromBech32(msg.Member)
+	if _, frozen := k.GetFrozenMember(ctx, member); !frozen {
+		return nil, errorsmod.Wrapf(types.ErrMemberFrozen, "%s is not frozen", msg.Member)
+	}
+	k.RemoveFrozenMember(ctx, member)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"unfreeze_member",
+			sdk.NewAttribute("member", msg.Member),
+			sdk.NewAttribute("admin", msg.Creator),
+		),
+	)
+
+	return &types.MsgUnfreezeMemberResponse{}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundSettlementEscrow handles MsgFundSettlementEscrow messages.
+func (k msgServer) FundSettlementEscrow(goCtx context.Context, msg *types.MsgFundSettlementEscrow) (*types.MsgFundSettlementEscrowResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "invalid creator address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "amount must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can fund escrow")
+	}
+
+	if err := k.Keeper.FundSettlementEscrow(ctx, p, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"fund_settlement_escrow",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("amount", msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgFundSettlementEscrowResponse{}, nil
+}
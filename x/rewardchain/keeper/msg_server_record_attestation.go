@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecordAttestation handles MsgRecordAttestation messages.
+// Only attestors listed in Params may attest; a new attestation replaces the
+// attestor's previous one for the member.
+func (k msgServer) RecordAttestation(goCtx context.Context, msg *types.MsgRecordAttestation) (*types.MsgRecordAttestationResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsAttestor(msg.Creator) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not an attestor", msg.Creator)
+	}
+	if msg.Expires <= ctx.BlockTime().Unix() {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "attestation already expired")
+	}
+
+	a := msg.Attestation(ctx.BlockHeight())
+	if err := k.SetAttestation(ctx, a); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"record_attestation",
+			sdk.NewAttribute("member", a.Member),
+			sdk.NewAttribute("attestor", a.Attestor),
+			sdk.NewAttribute("level", strconv.FormatUint(uint64(a.Level), 10)),
+			sdk.NewAttribute("jurisdiction", a.Jurisdiction),
+			sdk.NewAttribute("expires", strconv.FormatInt(a.Expires, 10)),
+		),
+	)
+
+	return &types.MsgRecordAttestationResponse{}, nil
+}
+
+// RevokeAttestation handles MsgRevokeAttestation messages.
+// Attestors may only revoke their own attestations.
+func (k msgServer) RevokeAttestation(goCtx context.Context, msg *types.MsgRevokeAttestation) (*types.MsgRevokeAttestationResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	member := sdk.MustAccAddressFromBech32(msg.Member)
+	attestor := sdk.MustAccAddressFromBech32(msg.Creator)
+	if !k.RemoveAttestation(ctx, member, attestor) {
+		return nil, errorsmod.Wrapf(types.ErrAttestationNotFound, "%s has no attestation from %s", msg.Member, msg.Creator)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"revoke_attestation",
+			sdk.NewAttribute("member", msg.Member),
+			sdk.NewAttribute("attestor", msg.Creator),
+		),
+	)
+
+	return &types.MsgRevokeAttestationResponse{}, nil
+}
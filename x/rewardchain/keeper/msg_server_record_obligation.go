@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecordObligation handles MsgRecordObligation messages.
+// The payer partner acknowledges an amount it owes another partner; it is
+// netted with obligations in the other direction at the next epoch.
+func (k msgServer) RecordObligation(goCtx context.Context, msg *types.MsgRecordObligation) (*types.MsgRecordObligationResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidObligation, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidObligation, "invalid creator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payer, found := k.GetPartner(ctx, msg.PayerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != payer.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the payer treasury can record obligations")
+	}
+
+	id, err := k.Keeper.RecordObligation(ctx, types.Obligation{
+		PayerId:   msg.PayerId,
+		PayeeId:   msg.PayeeId,
+		Amount:    msg.Amount,
+		Currency:  msg.Currency,
+		Reference: msg.Reference,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"record_obligation",
+			sdk.NewAttribute("obligation_id", strconv.FormatUint(id, 10)),
+			sdk.NewAttribute("payer_id", strconv.FormatUint(msg.PayerId, 10)),
+			sdk.NewAttribute("payee_id", strconv.FormatUint(msg.PayeeId, 10)),
+			sdk.NewAttribute("amount", msg.Amount),
+			sdk.NewAttribute("currency", msg.Currency),
+			sdk.NewAttribute("reference", msg.Reference),
+		),
+	)
+
+	return &types.MsgRecordObligationResponse{Id: id}, nil
+}
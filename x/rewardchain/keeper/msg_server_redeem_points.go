@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RedeemPoints handles MsgRedeemPoints messages.
+// It spends points from the member's balance, consumes the same amount of the
+// partner's total liquidity and charges the member the redeem fee from Params.
+// Frozen members are rejected and the partner's velocity limits and KYC
+// requirement apply.
+func (k msgServer) RedeemPoints(goCtx context.Context, msg *types.MsgRedeemPoints) (*types.MsgRedeemPointsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRedeem, "empty request")
+	}
+	member, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRedeem, "invalid creator address")
+	}
+	points, err := math.LegacyNewDecFromStr(strings.TrimSpace(msg.Points))
+	if err != nil || !points.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInvalidRedeem, "points must be a positive decimal")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.CheckNotPaused(ctx, types.OPERATION_REDEEM); err != nil {
+		return nil, err
+	}
+
+	var replay types.MsgRedeemPointsResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return nil, types.ErrPartnerDisabled
+	}
+
+	balance := k.GetMemberBalance(ctx, p.Id, member)
+	if points.GT(balance) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientPoints, "balance %s, requested %s", balance, points)
+	}
+	if err := k.CheckRedeemVelocity(ctx, p.Id, member, points); err != nil {
+		return nil, err
+	}
+	if err := k.CheckKyc(ctx, p.Id, member, points); err != nil {
+		return nil, err
+	}
+	total, err := parseLiquidity(p.TotalLiquidity)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid total_liquidity on partner")
+	}
+	if points.GT(total) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientLiquidity, "total %s, requested %s", total, points)
+	}
+
+	fee := k.ComputeFee(ctx, p, points, k.GetParams(ctx).RedeemFee())
+	if err := k.CollectFee(ctx, member, fee); err != nil {
+		return nil, err
+	}
+
+	balance = balance.Sub(points)
+	if err := k.SetMemberBalance(ctx, p.Id, member, balance); err != nil {
+		return nil, err
+	}
+	p.TotalLiquidity = total.Sub(points).String()
+	if err := k.SetPartner(ctx, p); err != nil {
+		return nil, err
+	}
+	if err := k.RecordRedeem(ctx, p.Id, member, points); err != nil {
+		return nil, err
+	}
+	if err := k.RecordPayout(ctx, p.Id, member, points); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"redeem_points",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("member", msg.Creator),
+			sdk.NewAttribute("points", points.String()),
+			sdk.NewAttribute("fee", fee.String()),
+		),
+	)
+
+	res := &types.MsgRedeemPointsResponse{
+		Balance: balance.String(),
+		Fee:     fee,
+	}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
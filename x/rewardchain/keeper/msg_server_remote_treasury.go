@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterRemoteTreasury handles MsgRegisterRemoteTreasury messages.
+// Admins register an interchain account for a partner's treasury on another
+// chain. Sending the message again updates the top-up settings.
+func (k msgServer) RegisterRemoteTreasury(goCtx context.Context, msg *types.MsgRegisterRemoteTreasury) (*types.MsgRegisterRemoteTreasuryResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRemoteTreasury, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := requireAdmin(k.Keeper, ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if _, found := k.GetPartner(ctx, msg.PartnerId); !found {
+		return nil, types.ErrPartnerNotFound
+	}
+
+	rt := msg.RemoteTreasury()
+	portID, err := k.Keeper.RegisterRemoteTreasury(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_treasury_registered",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(rt.PartnerId, 10)),
+			sdk.NewAttribute("connection_id", rt.ConnectionId),
+			sdk.NewAttribute("port_id", portID),
+			sdk.NewAttribute("escrow_address", rt.EscrowAddress),
+		),
+	)
+
+	return &types.MsgRegisterRemoteTreasuryResponse{PortId: portID}, nil
+}
+
+// ExecuteTopUp handles MsgExecuteTopUp messages.
+// The partner's treasury or an admin sends a top-up from the remote treasury
+// to its escrow address.
+func (k msgServer) ExecuteTopUp(goCtx context.Context, msg *types.MsgExecuteTopUp) (*types.MsgExecuteTopUpResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRemoteTreasury, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury && !k.IsAdmin(ctx, msg.Creator) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury or an admin can top up")
+	}
+	rt, found := k.GetRemoteTreasury(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrRemoteTreasuryNotFound
+	}
+
+	amount, _ := types.ParseTopUpAmount(msg.Amount)
+	seq, err := k.SendTopUp(ctx, rt, amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgExecuteTopUpResponse{Sequence: seq}, nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReversePointsEarn handles MsgReversePointsEarn messages.
+// It claws back all or part of the points issued under an earn reference id
+// and returns them to the partner's liquidity.
+func (k msgServer) ReversePointsEarn(goCtx context.Context, msg *types.MsgReversePointsEarn) (*types.MsgReversePointsEarnResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidReversal, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidReversal, "invalid creator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var replay types.MsgReversePointsEarnResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can reverse points")
+	}
+
+	r, found := k.GetEarnRecord(ctx, p.Id, msg.ReferenceId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrEarnNotFound, msg.ReferenceId)
+	}
+
+	var points math.LegacyDec
+	if s := strings.TrimSpace(msg.Points); s != "" {
+		var err error
+		if points, err = math.LegacyNewDecFromStr(s); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidReversal, "invalid points")
+		}
+	} else {
+		earned, err := math.LegacyNewDecFromStr(r.Points)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidReversal, "invalid points on earn record")
+		}
+		reversed, err := parseLiquidity(r.Reversed)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidReversal, "invalid reversed on earn record")
+		}
+		points = earned.Sub(reversed)
+	}
+
+	balance, debt, err := k.ReverseEarn(ctx, &p, &r, points)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"reverse_points_earn",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("member", r.Member),
+			sdk.NewAttribute("reference_id", r.ReferenceId),
+			sdk.NewAttribute("earn_height", strconv.FormatInt(r.Height, 10)),
+			sdk.NewAttribute("points", points.String()),
+			sdk.NewAttribute("reversed_total", r.Reversed),
+			sdk.NewAttribute("balance", balance.String()),
+			sdk.NewAttribute("debt", debt.String()),
+			sdk.NewAttribute("reason", msg.Reason),
+		),
+	)
+
+	res := &types.MsgReversePointsEarnResponse{
+		Balance:       balance.String(),
+		Debt:          debt.String(),
+		ReversedTotal: r.Reversed,
+	}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
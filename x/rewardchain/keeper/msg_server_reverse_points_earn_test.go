@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestMsgReversePointsEarn(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	admin := addAdmin(t, k, sdkCtx)
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	memberAddr := sdk.MustAccAddressFromBech32(member)
+	_, err := ms.CreatePartner(sdkCtx, &types.MsgCreatePartner{
+		Creator:              admin,
+		Treasury:             treasury,
+		Name:                 "Acme",
+		Country:              "IN",
+		Currency:             "INR",
+		TotalLiquidity:       "500",
+		NegativeBalanceLimit: "20",
+	})
+	require.NoError(t, err)
+
+	_, err = ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "100", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+
+	_, err = ms.ReversePointsEarn(sdkCtx, types.NewMsgReversePointsEarn(member, 1, "order-1", "10", "return"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ReversePointsEarn(sdkCtx, types.NewMsgReversePointsEarn(treasury, 1, "order-2", "10", "return"))
+	require.ErrorIs(t, err, types.ErrEarnNotFound)
+
+	// partial reversal
+	res, err := ms.ReversePointsEarn(sdkCtx, types.NewMsgReversePointsEarn(treasury, 1, "order-1", "40", "return"))
+	require.NoError(t, err)
+	require.Equal(t, "60.000000000000000000", res.Balance)
+	require.Equal(t, "40.000000000000000000", res.ReversedTotal)
+	p, _ := k.GetPartner(sdkCtx, 1)
+	require.Equal(t, "440.000000000000000000", p.AvailableLiquidity)
+
+	// the member spent most of the points; the balance goes to -20 and the
+	// rest becomes debt
+	require.NoError(t, k.SetMemberBalance(sdkCtx, 1, memberAddr, math.LegacyNewDec(10)))
+	res, err = ms.ReversePointsEarn(sdkCtx, types.NewMsgReversePointsEarn(treasury, 1, "order-1", "", "return"))
+	require.NoError(t, err)
+	require.Equal(t, "-20.000000000000000000", res.Balance)
+	require.Equal(t, "30.000000000000000000", res.Debt)
+	require.Equal(t, "100.000000000000000000", res.ReversedTotal)
+	// only the 30 points taken from the balance go back to liquidity
+	p, _ = k.GetPartner(sdkCtx, 1)
+	require.Equal(t, "470.000000000000000000", p.AvailableLiquidity)
+
+	// nothing left to reverse
+	_, err = ms.ReversePointsEarn(sdkCtx, types.NewMsgReversePointsEarn(treasury, 1, "order-1", "1", "return"))
+	require.ErrorIs(t, err, types.ErrInvalidReversal)
+
+	// later earns repay the debt first
+	earn, err := ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "50", ReferenceId: "order-3"},
+	}, false))
+	require.NoError(t, err)
+	require.Equal(t, "0.000000000000000000", earn.Results[0].Balance)
+	require.True(t, k.GetMemberDebt(sdkCtx, 1, memberAddr).IsZero())
+	// the earn takes 50 points and the repaid 30 come back
+	p, _ = k.GetPartner(sdkCtx, 1)
+	require.Equal(t, "450.000000000000000000", p.AvailableLiquidity)
+}
+
+func TestReverseThenEarnLiquidity(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	admin := addAdmin(t, k, sdkCtx)
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	memberAddr := sdk.MustAccAddressFromBech32(member)
+	_, err := ms.CreatePartner(sdkCtx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	earn := func(ref, points string) {
+		_, err := ms.BatchEarnPoints(sdkCtx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+			{Member: member, Points: points, ReferenceId: ref},
+		}, false))
+		require.NoError(t, err)
+	}
+	// available liquidity and the member's points always add up to the
+	// partner's liquidity less what the member spent
+	held := func() math.LegacyDec {
+		p, _ := k.GetPartner(sdkCtx, 1)
+		return math.LegacyMustNewDecFromStr(p.AvailableLiquidity).Add(k.GetMemberBalance(sdkCtx, 1, memberAddr))
+	}
+
+	earn("order-1", "100")
+	// the member spends 80 points off chain
+	require.NoError(t, k.SetMemberBalance(sdkCtx, 1, memberAddr, math.LegacyNewDec(20)))
+	require.Equal(t, math.LegacyNewDec(920), held())
+
+	_, err = ms.ReversePointsEarn(sdkCtx, types.NewMsgReversePointsEarn(treasury, 1, "order-1", "", "return"))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(80), k.GetMemberDebt(sdkCtx, 1, memberAddr))
+	p, _ := k.GetPartner(sdkCtx, 1)
+	require.Equal(t, "920.000000000000000000", p.AvailableLiquidity)
+	require.Equal(t, math.LegacyNewDec(920), held())
+
+	// repaying the debt returns it to liquidity once
+	earn("order-2", "50")
+	earn("order-3", "50")
+	require.True(t, k.GetMemberDebt(sdkCtx, 1, memberAddr).IsZero())
+	require.Equal(t, math.LegacyNewDec(20), k.GetMemberBalance(sdkCtx, 1, memberAddr))
+	p, _ = k.GetPartner(sdkCtx, 1)
+	require.Equal(t, "900.000000000000000000", p.AvailableLiquidity)
+	require.Equal(t, math.LegacyNewDec(920), held())
+}
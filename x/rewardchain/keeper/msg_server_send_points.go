@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendPoints handles MsgSendPoints messages.
+// The points leave the sender's balance into escrow, or the sender's voucher
+// is burned, until the packet is acknowledged; an error acknowledgement or a
+// timeout refunds them.
+func (k msgServer) SendPoints(goCtx context.Context, msg *types.MsgSendPoints) (*types.MsgSendPointsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.Keeper.SendPoints(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"send_points",
+			sdk.NewAttribute("channel_id", msg.SourceChannel),
+			sdk.NewAttribute("sequence", strconv.FormatUint(seq, 10)),
+			sdk.NewAttribute("partner_id", strconv.FormatUint(msg.PartnerId, 10)),
+			sdk.NewAttribute("sender", msg.Creator),
+			sdk.NewAttribute("receiver", msg.Receiver),
+			sdk.NewAttribute("points", msg.Points),
+			sdk.NewAttribute("voucher", strconv.FormatBool(msg.Voucher)),
+		),
+	)
+
+	return &types.MsgSendPointsResponse{Sequence: seq}, nil
+}
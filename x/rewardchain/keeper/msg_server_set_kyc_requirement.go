@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetKycRequirement handles MsgSetKycRequirement messages.
+// Only the partner treasury may change the attestation level its payouts
+// require.
+func (k msgServer) SetKycRequirement(goCtx context.Context, msg *types.MsgSetKycRequirement) (*types.MsgSetKycRequirementResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAttestation, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can set the KYC requirement")
+	}
+
+	r := msg.Requirement()
+	if err := k.Keeper.SetKycRequirement(ctx, r); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"set_kyc_requirement",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("threshold", r.PayoutThreshold().String()),
+			sdk.NewAttribute("min_level", strconv.FormatUint(uint64(r.MinLevel), 10)),
+			sdk.NewAttribute("jurisdictions", strings.Join(r.Jurisdictions, ",")),
+		),
+	)
+
+	return &types.MsgSetKycRequirementResponse{}, nil
+}
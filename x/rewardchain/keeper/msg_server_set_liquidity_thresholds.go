@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetLiquidityThresholds handles MsgSetLiquidityThresholds messages.
+// Only the partner treasury may set them. The partner's liquidity level is
+// re-evaluated right away, so lowering a threshold can end a pause at once.
+func (k msgServer) SetLiquidityThresholds(goCtx context.Context, msg *types.MsgSetLiquidityThresholds) (*types.MsgSetLiquidityThresholdsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidLiquidityThresholds, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can set liquidity thresholds")
+	}
+
+	t := msg.Thresholds()
+	if err := k.Keeper.SetLiquidityThresholds(ctx, t); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"set_liquidity_thresholds",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("warning", t.WarningLevel().String()),
+			sdk.NewAttribute("critical", t.CriticalLevel().String()),
+			sdk.NewAttribute("auto_pause_earn", strconv.FormatBool(t.AutoPauseEarn)),
+		),
+	)
+
+	if err := k.updateLiquidityLevel(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetLiquidityThresholdsResponse{}, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPaused handles MsgSetPaused messages.
+// Emergency admins may pause an operation; resuming one takes governance, so
+// a compromised admin key cannot undo a pause.
+func (k msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPause, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	gov := msg.Creator == k.GetAuthority()
+	if !gov {
+		if !params.IsEmergencyAdmin(msg.Creator) {
+			return nil, errorsmod.Wrap(types.ErrUnauthorized, "only emergency admins or governance can pause operations")
+		}
+		if !msg.Paused {
+			return nil, errorsmod.Wrap(types.ErrUnauthorized, "only governance can resume a paused operation")
+		}
+	}
+
+	if err := params.Paused.SetPaused(msg.Operation, msg.Paused); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPause, err.Error())
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"set_paused",
+			sdk.NewAttribute("operation", msg.Operation.String()),
+			sdk.NewAttribute("paused", strconv.FormatBool(msg.Paused)),
+			sdk.NewAttribute("signer", msg.Creator),
+		),
+	)
+
+	return &types.MsgSetPausedResponse{}, nil
+}
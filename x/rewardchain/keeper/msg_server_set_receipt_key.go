@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetReceiptKey handles MsgSetReceiptKey messages.
+// The partner treasury registers the key its point of sale signs receipts with.
+func (k msgServer) SetReceiptKey(goCtx context.Context, msg *types.MsgSetReceiptKey) (*types.MsgSetReceiptKeyResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidReceipt, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidReceipt, "invalid creator address")
+	}
+	if msg.PartnerId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "partner_id must be > 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return nil, types.ErrPartnerDisabled
+	}
+	if p.Treasury != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can set the receipt key")
+	}
+
+	if err := k.Keeper.SetReceiptKey(ctx, types.ReceiptKey{
+		PartnerId: msg.PartnerId,
+		KeyType:   msg.KeyType,
+		PubKey:    msg.PubKey,
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"set_receipt_key",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(msg.PartnerId, 10)),
+			sdk.NewAttribute("key_type", msg.KeyType),
+		),
+	)
+
+	return &types.MsgSetReceiptKeyResponse{}, nil
+}
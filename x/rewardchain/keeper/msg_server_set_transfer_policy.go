@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTransferPolicy handles MsgSetTransferPolicy messages.
+// Only the partner treasury may change how its members transfer points.
+func (k msgServer) SetTransferPolicy(goCtx context.Context, msg *types.MsgSetTransferPolicy) (*types.MsgSetTransferPolicyResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTransfer, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can set the transfer policy")
+	}
+
+	policy := msg.Policy()
+	if err := k.Keeper.SetTransferPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"set_transfer_policy",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("mode", policy.Mode.String()),
+			sdk.NewAttribute("fee_rate", policy.Fee().String()),
+			sdk.NewAttribute("daily_cap", policy.Cap().String()),
+		),
+	)
+
+	return &types.MsgSetTransferPolicyResponse{}, nil
+}
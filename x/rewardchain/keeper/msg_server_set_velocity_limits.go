@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetVelocityLimits handles MsgSetVelocityLimits messages.
+// Only the partner treasury may change the redeem limits of its members.
+func (k msgServer) SetVelocityLimits(goCtx context.Context, msg *types.MsgSetVelocityLimits) (*types.MsgSetVelocityLimitsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidVelocityLimits, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can set velocity limits")
+	}
+
+	l := msg.Limits()
+	if err := k.Keeper.SetVelocityLimits(ctx, l); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"set_velocity_limits",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("max_redeem_per_tx", l.PerTx().String()),
+			sdk.NewAttribute("max_redeem_per_day", l.PerDay().String()),
+			sdk.NewAttribute("max_redeem_per_window", l.PerWindow().String()),
+			sdk.NewAttribute("window_seconds", strconv.FormatUint(l.WindowSeconds, 10)),
+			sdk.NewAttribute("new_member_cooldown_seconds", strconv.FormatUint(l.NewMemberCooldownSeconds, 10)),
+		),
+	)
+
+	return &types.MsgSetVelocityLimitsResponse{}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SponsorMembers handles MsgSponsorMembers messages.
+// The partner treasury grants each member a fee allowance of spendLimit that
+// can only be spent on rewardchain redeem/swap messages.
+func (k msgServer) SponsorMembers(goCtx context.Context, msg *types.MsgSponsorMembers) (*types.MsgSponsorMembersResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSponsorship, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSponsorship, "invalid creator address")
+	}
+	if msg.PartnerId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "partner_id must be > 0")
+	}
+	if !msg.SpendLimit.IsValid() || msg.SpendLimit.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidSponsorship, "spend_limit must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return nil, types.ErrPartnerDisabled
+	}
+	if p.Treasury != msg.Creator {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can sponsor members")
+	}
+
+	for _, m := range msg.Members {
+		member, err := sdk.AccAddressFromBech32(m)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSponsorship, "invalid member address %q", m)
+		}
+		if err := k.SponsorMember(ctx, p, member, msg.SpendLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"sponsor_members",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(msg.PartnerId, 10)),
+			sdk.NewAttribute("granter", msg.Creator),
+			sdk.NewAttribute("members", strings.Join(msg.Members, ",")),
+			sdk.NewAttribute("spend_limit", msg.SpendLimit.String()),
+		),
+	)
+
+	return &types.MsgSponsorMembersResponse{}, nil
+}
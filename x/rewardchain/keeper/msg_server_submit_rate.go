@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SubmitRate handles MsgSubmitRate messages.
+// Only addresses listed in Params.OracleFeeders may submit; each feeder keeps
+// one submission per pair until the window is aggregated in EndBlock.
+func (k msgServer) SubmitRate(goCtx context.Context, msg *types.MsgSubmitRate) (*types.MsgSubmitRateResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRate, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).IsOracleFeeder(msg.Creator) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not an oracle feeder", msg.Creator)
+	}
+
+	rate, _ := types.ParseRate(msg.Rate)
+	if err := k.SetRateSubmission(ctx, types.RateSubmission{
+		Base:   msg.Base,
+		Quote:  msg.Quote,
+		Rate:   rate.String(),
+		Feeder: msg.Creator,
+		Height: ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("submit_rate",
+		sdk.NewAttribute("feeder", msg.Creator),
+		sdk.NewAttribute("base", msg.Base),
+		sdk.NewAttribute("quote", msg.Quote),
+		sdk.NewAttribute("rate", rate.String()),
+	))
+
+	return &types.MsgSubmitRateResponse{}, nil
+}
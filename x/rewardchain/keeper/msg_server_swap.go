@@ -13,14 +13,18 @@ import (
 )
 
 // Swap handles MsgSwap messages.
-// For route == "points_to_token", it removes that many points from the partner's liquidity.
-// For route == "token_to_points", it currently does nothing (to be defined later).
+// For route == "points_to_token", it removes that many points from the partner's liquidity,
+// subject to the partner's KYC requirement for the creator.
+// It charges the creator the swap fee from Params.
+// For route == "token_to_points", it currently does nothing (to be defined later)
+// and charges no fee.
 func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
 	if msg == nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "empty request")
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid creator address")
 	}
 	if msg.PartnerId == 0 {
@@ -29,6 +33,19 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if op := types.SwapOperation(strings.TrimSpace(strings.ToLower(msg.Route))); op != types.OPERATION_UNSPECIFIED {
+		if err := k.CheckNotPaused(ctx, op); err != nil {
+			return nil, err
+		}
+	}
+
+	var replay types.MsgSwapResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
 	p, found := k.GetPartner(ctx, msg.PartnerId)
 	if !found {
 		return nil, types.ErrPartnerNotFound
@@ -51,8 +68,19 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "points must be >= 0")
 	}
 
+	fee := sdk.NewCoin(k.GetParams(ctx).FeeCoinDenom(), math.ZeroInt())
+
 	switch route {
 	case "points_to_token":
+		if err := k.CheckKyc(ctx, p.Id, creator, pointsDec); err != nil {
+			return nil, err
+		}
+
+		fee = k.ComputeFee(ctx, p, pointsDec, k.GetParams(ctx).SwapFee())
+		if err := k.CollectFee(ctx, creator, fee); err != nil {
+			return nil, err
+		}
+
 		// Remove points from partner's liquidity.
 		totalStr := strings.TrimSpace(p.TotalLiquidity)
 		if totalStr == "" {
@@ -85,6 +113,12 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		if err := k.SetPartner(ctx, p); err != nil {
 			return nil, err
 		}
+		if err := k.updateLiquidityLevel(ctx, p); err != nil {
+			return nil, err
+		}
+		if err := k.RecordPayout(ctx, p.Id, creator, pointsDec); err != nil {
+			return nil, err
+		}
 
 		// Emit event for the swap.
 		ctx.EventManager().EmitEvent(
@@ -93,6 +127,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 				sdk.NewAttribute("partner_id", strconv.FormatUint(msg.PartnerId, 10)),
 				sdk.NewAttribute("route", msg.Route),
 				sdk.NewAttribute("points", msg.Points),
+				sdk.NewAttribute("fee", fee.String()),
 			),
 		)
 
@@ -105,6 +140,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 				sdk.NewAttribute("partner_id", strconv.FormatUint(msg.PartnerId, 10)),
 				sdk.NewAttribute("route", msg.Route),
 				sdk.NewAttribute("points", msg.Points),
+				sdk.NewAttribute("fee", fee.String()),
 			),
 		)
 
@@ -112,6 +148,10 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid route (must be points_to_token or token_to_points)")
 	}
 
-	return &types.MsgSwapResponse{}, nil
+	res := &types.MsgSwapResponse{Fee: fee}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "rewardchain/testutil/keeper"
+	"rewardchain/testutil/sample"
 	"rewardchain/x/rewardchain/keeper"
 	"rewardchain/x/rewardchain/types"
 )
@@ -16,6 +17,16 @@ func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, context.Conte
 	return k, keeper.NewMsgServerImpl(k), ctx
 }
 
+// addAdmin lists a new address as an admin, as CreatePartner requires, and
+// returns it.
+func addAdmin(t testing.TB, k keeper.Keeper, ctx context.Context) string {
+	admin := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.AdminAddresses = append(params.AdminAddresses, admin)
+	require.NoError(t, k.SetParams(ctx, params))
+	return admin
+}
+
 func TestMsgServer(t *testing.T) {
 	k, ms, ctx := setupMsgServer(t)
 	require.NotNil(t, ms)
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferPoints handles MsgTransferPoints messages.
+// It moves points between two members of a partner as the partner's transfer
+// policy allows. In open mode the sender also pays a fee in points, which
+// goes back to the partner's available liquidity, and is bound by a daily
+// cap on the points sent. The points sent and the fee count towards the
+// partner's velocity limits and KYC requirement like a redemption.
+func (k msgServer) TransferPoints(goCtx context.Context, msg *types.MsgTransferPoints) (*types.MsgTransferPointsResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTransfer, "empty request")
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	from := sdk.MustAccAddressFromBech32(msg.Creator)
+	to := sdk.MustAccAddressFromBech32(msg.To)
+	amount := math.LegacyMustNewDecFromStr(strings.TrimSpace(msg.Amount))
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var replay types.MsgTransferPointsResponse
+	if found, err := k.ReplayClientRef(ctx, msg.Creator, msg.ClientRef, msg, &replay); err != nil {
+		return nil, err
+	} else if found {
+		return &replay, nil
+	}
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return nil, types.ErrPartnerDisabled
+	}
+
+	if f, frozen := k.GetFrozenMember(ctx, from); frozen {
+		return nil, errorsmod.Wrap(types.ErrMemberFrozen, f.Reason)
+	}
+	if err := k.CheckNotBlocked(ctx, to); err != nil {
+		return nil, err
+	}
+
+	policy := k.GetTransferPolicy(ctx, p.Id)
+	fee := math.LegacyZeroDec()
+	day := ctx.BlockTime().Unix() / types.SecondsPerDay
+	sent := k.GetTransferUsage(ctx, p.Id, from, day)
+	switch policy.Mode {
+	case types.TRANSFER_MODE_ALLOWLIST:
+		if !policy.Allows(msg.Creator) {
+			return nil, errorsmod.Wrapf(types.ErrTransferNotAllowed, "sender %s", msg.Creator)
+		}
+		if !policy.Allows(msg.To) {
+			return nil, errorsmod.Wrapf(types.ErrTransferNotAllowed, "recipient %s", msg.To)
+		}
+	case types.TRANSFER_MODE_OPEN:
+		fee = amount.Mul(policy.Fee())
+		if c := policy.Cap(); c.IsPositive() && sent.Add(amount).GT(c) {
+			return nil, errorsmod.Wrapf(types.ErrTransferCapExceeded, "sent %s of %s today, requested %s", sent, c, amount)
+		}
+	default:
+		return nil, types.ErrTransferDisabled
+	}
+
+	balance := k.GetMemberBalance(ctx, p.Id, from)
+	if amount.Add(fee).GT(balance) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientPoints, "balance %s, requested %s plus fee %s", balance, amount, fee)
+	}
+	if err := k.CheckRedeemVelocity(ctx, p.Id, from, amount.Add(fee)); err != nil {
+		return nil, err
+	}
+	if err := k.CheckKyc(ctx, p.Id, from, amount.Add(fee)); err != nil {
+		return nil, err
+	}
+
+	balance = balance.Sub(amount).Sub(fee)
+	if err := k.SetMemberBalance(ctx, p.Id, from, balance); err != nil {
+		return nil, err
+	}
+	if err := k.RecordRedeem(ctx, p.Id, from, amount.Add(fee)); err != nil {
+		return nil, err
+	}
+	if err := k.RecordPayout(ctx, p.Id, from, amount.Add(fee)); err != nil {
+		return nil, err
+	}
+	if _, err := k.AddMemberPoints(ctx, &p, to, amount); err != nil {
+		return nil, err
+	}
+	if fee.IsPositive() {
+		avail, err := parseLiquidity(p.AvailableLiquidity)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidPartner, "invalid available_liquidity on partner")
+		}
+		p.AvailableLiquidity = avail.Add(fee).String()
+		if err := k.SetPartner(ctx, p); err != nil {
+			return nil, err
+		}
+		if err := k.updateLiquidityLevel(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.SetTransferUsage(ctx, types.TransferUsage{
+		PartnerId: p.Id,
+		Member:    msg.Creator,
+		Day:       day,
+		Sent:      sent.Add(amount).String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	t := types.PointsTransfer{
+		Id:        k.nextSequence(ctx, types.TransferCountKey),
+		PartnerId: p.Id,
+		From:      msg.Creator,
+		To:        msg.To,
+		Amount:    amount.String(),
+		Fee:       fee.String(),
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime().Unix(),
+	}
+	if err := k.SetPointsTransfer(ctx, t); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"transfer_points",
+			sdk.NewAttribute("transfer_id", strconv.FormatUint(t.Id, 10)),
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("from", t.From),
+			sdk.NewAttribute("to", t.To),
+			sdk.NewAttribute("amount", t.Amount),
+			sdk.NewAttribute("fee", t.Fee),
+		),
+	)
+
+	res := &types.MsgTransferPointsResponse{
+		Balance: balance.String(),
+		Fee:     fee.String(),
+		Id:      t.Id,
+	}
+	if err := k.SaveClientRef(ctx, msg.Creator, msg.ClientRef, msg, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"rewardchain/x/rewardchain/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawSettlementEscrow handles MsgWithdrawSettlementEscrow messages.
+// The partner treasury takes coins it no longer needs for settlements out of
+// escrow.
+func (k msgServer) WithdrawSettlementEscrow(goCtx context.Context, msg *types.MsgWithdrawSettlementEscrow) (*types.MsgWithdrawSettlementEscrowResponse, error) {
+	if msg == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "invalid creator address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidSettlementOp, "amount must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, msg.PartnerId)
+	if !found {
+		return nil, types.ErrPartnerNotFound
+	}
+	if msg.Creator != p.Treasury {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "only the partner treasury can withdraw escrow")
+	}
+
+	if err := k.Keeper.WithdrawSettlementEscrow(ctx, p, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"withdraw_settlement_escrow",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("amount", msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawSettlementEscrowResponse{}, nil
+}
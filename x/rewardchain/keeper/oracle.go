@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getRateSubmissionStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.RateSubmissionKeyPrefix)
+}
+
+func (k Keeper) getExchangeRateStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.ExchangeRateKeyPrefix)
+}
+
+// SetRateSubmission stores a feeder's submission, replacing any earlier one
+// for the same pair in the current window.
+func (k Keeper) SetRateSubmission(ctx context.Context, s types.RateSubmission) error {
+	feeder, err := sdk.AccAddressFromBech32(s.Feeder)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&s)
+	if err != nil {
+		return err
+	}
+	k.getRateSubmissionStore(ctx).Set(types.RateSubmissionKey(s.Base, s.Quote, feeder), bz)
+	return nil
+}
+
+func (k Keeper) GetAllRateSubmissions(ctx context.Context) []types.RateSubmission {
+	iter := k.getRateSubmissionStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.RateSubmission, 0)
+	for ; iter.Valid(); iter.Next() {
+		var s types.RateSubmission
+		k.cdc.MustUnmarshal(iter.Value(), &s)
+		out = append(out, s)
+	}
+	return out
+}
+
+func (k Keeper) SetExchangeRate(ctx context.Context, r types.ExchangeRate) error {
+	bz, err := k.cdc.Marshal(&r)
+	if err != nil {
+		return err
+	}
+	k.getExchangeRateStore(ctx).Set(types.ExchangeRateKey(r.Base, r.Quote), bz)
+	return nil
+}
+
+func (k Keeper) GetExchangeRateRecord(ctx context.Context, base, quote string) (types.ExchangeRate, bool) {
+	bz := k.getExchangeRateStore(ctx).Get(types.ExchangeRateKey(base, quote))
+	if bz == nil {
+		return types.ExchangeRate{}, false
+	}
+	var r types.ExchangeRate
+	k.cdc.MustUnmarshal(bz, &r)
+	return r, true
+}
+
+func (k Keeper) GetAllExchangeRates(ctx context.Context) []types.ExchangeRate {
+	iter := k.getExchangeRateStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.ExchangeRate, 0)
+	for ; iter.Valid(); iter.Next() {
+		var r types.ExchangeRate
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		out = append(out, r)
+	}
+	return out
+}
+
+// AggregateRates turns the submissions of the window ending at the current
+// height into one median rate per pair. Submissions older than the window
+// and pairs below oracle_min_submissions are dropped without touching the
+// previous rate. All submissions are cleared afterwards.
+func (k Keeper) AggregateRates(ctx context.Context) ([]types.ExchangeRate, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	p := k.GetParams(ctx)
+
+	type pair struct{ base, quote string }
+	grouped := make(map[pair][]math.LegacyDec)
+	var pairs []pair
+
+	store := k.getRateSubmissionStore(ctx)
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+		var s types.RateSubmission
+		k.cdc.MustUnmarshal(iter.Value(), &s)
+		if height-s.Height >= p.OracleWindow() || !p.IsOracleFeeder(s.Feeder) {
+			continue
+		}
+		rate, err := types.ParseRate(s.Rate)
+		if err != nil {
+			continue
+		}
+		key := pair{s.Base, s.Quote}
+		if _, ok := grouped[key]; !ok {
+			pairs = append(pairs, key)
+		}
+		grouped[key] = append(grouped[key], rate)
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	out := make([]types.ExchangeRate, 0, len(pairs))
+	for _, key := range pairs {
+		rates := grouped[key]
+		if len(rates) < p.OracleMinFeeders() {
+			continue
+		}
+		r := types.ExchangeRate{
+			Base:        key.base,
+			Quote:       key.quote,
+			Rate:        median(rates).String(),
+			Height:      height,
+			Submissions: uint32(len(rates)),
+		}
+		if err := k.SetExchangeRate(ctx, r); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// median returns the middle value of rates, averaging the two middle values
+// for an even count.
+func median(rates []math.LegacyDec) math.LegacyDec {
+	sort.Slice(rates, func(i, j int) bool { return rates[i].LT(rates[j]) })
+	mid := len(rates) / 2
+	if len(rates)%2 == 1 {
+		return rates[mid]
+	}
+	return rates[mid-1].Add(rates[mid]).QuoInt64(2)
+}
+
+// lookupRate finds the aggregated rate for base/quote, falling back to the
+// inverse pair. inverse reports whether the returned record is quote/base.
+// Rates older than oracle_max_rate_age_blocks are returned with ErrStaleRate.
+func (k Keeper) lookupRate(ctx context.Context, base, quote string) (r types.ExchangeRate, inverse bool, err error) {
+	r, found := k.GetExchangeRateRecord(ctx, base, quote)
+	if !found {
+		if r, found = k.GetExchangeRateRecord(ctx, quote, base); !found {
+			return r, false, errorsmod.Wrapf(types.ErrRateNotFound, "%s/%s", base, quote)
+		}
+		inverse = true
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height-r.Height > k.GetParams(ctx).OracleMaxRateAge() {
+		return r, inverse, errorsmod.Wrapf(types.ErrStaleRate, "%s/%s aggregated at height %d", base, quote, r.Height)
+	}
+	return r, inverse, nil
+}
+
+// GetExchangeRate returns how much quote currency one unit of base buys and
+// the height the rate was aggregated at. When only the inverse pair is fed
+// its reciprocal is used. Stale rates are returned together with
+// ErrStaleRate.
+func (k Keeper) GetExchangeRate(ctx context.Context, base, quote string) (math.LegacyDec, int64, error) {
+	if base == quote {
+		return math.LegacyOneDec(), sdk.UnwrapSDKContext(ctx).BlockHeight(), nil
+	}
+	r, inverse, err := k.lookupRate(ctx, base, quote)
+	if err != nil && !types.ErrStaleRate.Is(err) {
+		return math.LegacyDec{}, 0, err
+	}
+	rate := math.LegacyMustNewDecFromStr(r.Rate)
+	if inverse {
+		rate = math.LegacyOneDec().Quo(rate)
+	}
+	return rate, r.Height, err
+}
+
+// ConvertAmount converts amount from one currency to another with the
+// current oracle rate. Inverse pairs divide by the fed rate rather than
+// multiplying by its rounded reciprocal.
+func (k Keeper) ConvertAmount(ctx context.Context, amount math.LegacyDec, from, to string) (math.LegacyDec, error) {
+	if from == to {
+		return amount, nil
+	}
+	r, inverse, err := k.lookupRate(ctx, from, to)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	rate := math.LegacyMustNewDecFromStr(r.Rate)
+	if inverse {
+		return amount.Quo(rate), nil
+	}
+	return amount.Mul(rate), nil
+}
+
+// ValueTokens values an amount of a token in currency, using the token's
+// currency mapping and the oracle rate from its currency.
+func (k Keeper) ValueTokens(ctx context.Context, dc types.DenomCurrency, amount math.Int, currency string) (math.LegacyDec, error) {
+	value := math.LegacyNewDecFromInt(amount).Quo(math.LegacyNewDec(10).Power(uint64(dc.Exponent)))
+	return k.ConvertAmount(ctx, value, dc.Currency, currency)
+}
+
+func exchangeRateEvent(r types.ExchangeRate) sdk.Event {
+	return sdk.NewEvent("exchange_rate_updated",
+		sdk.NewAttribute("base", r.Base),
+		sdk.NewAttribute("quote", r.Quote),
+		sdk.NewAttribute("rate", r.Rate),
+		sdk.NewAttribute("submissions", strconv.FormatUint(uint64(r.Submissions), 10)),
+	)
+}
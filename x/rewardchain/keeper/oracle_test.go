@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rewardchain/testutil/keeper"
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/keeper"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestOracleAggregation(t *testing.T) {
+	k, ctx := keepertest.RewardchainKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	feeders := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	params := k.GetParams(ctx)
+	params.OracleFeeders = feeders
+	params.OracleWindowBlocks = 10
+	params.OracleMinSubmissions = 2
+	params.OracleMaxRateAgeBlocks = 20
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(5)
+	_, err := ms.SubmitRate(ctx, types.NewMsgSubmitRate(sample.AccAddress(), "USD", "INR", "83"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SubmitRate(ctx, types.NewMsgSubmitRate(feeders[0], "USD", "usd", "1"))
+	require.ErrorIs(t, err, types.ErrInvalidRate)
+
+	for i, rate := range []string{"82", "90", "83"} {
+		_, err = ms.SubmitRate(ctx, types.NewMsgSubmitRate(feeders[i], "USD", "INR", rate))
+		require.NoError(t, err)
+	}
+	// a single feeder is below oracle_min_submissions
+	_, err = ms.SubmitRate(ctx, types.NewMsgSubmitRate(feeders[0], "EUR", "USD", "1.1"))
+	require.NoError(t, err)
+
+	_, _, err = k.GetExchangeRate(ctx, "USD", "INR")
+	require.ErrorIs(t, err, types.ErrRateNotFound)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Empty(t, k.GetAllRateSubmissions(ctx))
+
+	rate, height, err := k.GetExchangeRate(ctx, "USD", "INR")
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+	require.Equal(t, math.LegacyNewDec(83), rate)
+	_, _, err = k.GetExchangeRate(ctx, "EUR", "USD")
+	require.ErrorIs(t, err, types.ErrRateNotFound)
+
+	// inverse pair and identity
+	inr, err := k.ConvertAmount(ctx, math.LegacyNewDec(830), "INR", "USD")
+	require.NoError(t, err)
+	require.Equal(t, "10.000000000000000000", inr.String())
+	same, err := k.ConvertAmount(ctx, math.LegacyNewDec(5), "USD", "USD")
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(5), same)
+
+	res, err := k.ExchangeRate(ctx, &types.QueryExchangeRateRequest{Base: "USD", Quote: "INR"})
+	require.NoError(t, err)
+	require.False(t, res.Stale)
+
+	// stale once older than oracle_max_rate_age_blocks
+	ctx = ctx.WithBlockHeight(31)
+	_, err = k.ConvertAmount(ctx, math.LegacyNewDec(1), "USD", "INR")
+	require.ErrorIs(t, err, types.ErrStaleRate)
+	res, err = k.ExchangeRate(ctx, &types.QueryExchangeRateRequest{Base: "USD", Quote: "INR"})
+	require.NoError(t, err)
+	require.True(t, res.Stale)
+	require.Equal(t, "83.000000000000000000", res.Rate)
+
+	all, err := k.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Rates, 1)
+}
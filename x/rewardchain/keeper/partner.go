@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -80,7 +83,14 @@ func (k Keeper) PaginatePartners(
 	ps := k.getPartnerStore(ctx)
 	partners := make([]types.Partner, 0)
 
-	// Collect all partners first, then filter if needed
+	// Collect all partners first, then filter if needed. A page key is the
+	// store key of the first partner to return, so the page starts after
+	// the listed partners stored before it.
+	var pageKey []byte
+	if pageReq != nil {
+		pageKey = pageReq.Key
+	}
+	keyed := uint64(0)
 	iter := ps.Iterator(nil, nil)
 	defer iter.Close()
 
@@ -89,6 +99,9 @@ func (k Keeper) PaginatePartners(
 		k.cdc.MustUnmarshal(iter.Value(), &p)
 		if includeDisabled || !p.Disabled {
 			partners = append(partners, p)
+			if len(pageKey) > 0 && bytes.Compare(iter.Key(), pageKey) < 0 {
+				keyed++
+			}
 		}
 	}
 
@@ -96,7 +109,9 @@ func (k Keeper) PaginatePartners(
 	start := uint64(0)
 	limit := uint64(100) // default limit
 	if pageReq != nil {
-		if pageReq.Offset > 0 {
+		if len(pageKey) > 0 {
+			start = keyed
+		} else if pageReq.Offset > 0 {
 			start = pageReq.Offset
 		}
 		if pageReq.Limit > 0 {
@@ -128,3 +143,58 @@ func (k Keeper) PaginatePartners(
 		Total:   total,
 	}, nil
 }
+
+// DisablePartner marks a partner as disabled and revokes the fee allowances
+// its treasury granted to members.
+func (k Keeper) DisablePartner(ctx context.Context, id uint64) error {
+	p, found := k.GetPartner(ctx, id)
+	if !found {
+		return types.ErrPartnerNotFound
+	}
+	p.Disabled = true
+	if err := k.SetPartner(ctx, p); err != nil {
+		return err
+	}
+	return k.RevokePartnerSponsorships(ctx, id)
+}
+
+// AddLiquidity converts amount, in the partner's currency, into points at
+// the partner's redeem_cost_per_point and adds them to its total and
+// available liquidity. The partner is updated in place and stored, and its
+// liquidity level re-evaluated. It returns the points added.
+func (k Keeper) AddLiquidity(ctx context.Context, p *types.Partner, amount math.LegacyDec) (math.LegacyDec, error) {
+	redeemStr := strings.TrimSpace(p.RedeemCostPerPoint)
+	if redeemStr == "" {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "redeem_cost_per_point is required on partner")
+	}
+	redeemDec, err := math.LegacyNewDecFromStr(redeemStr)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "invalid redeem_cost_per_point on partner")
+	}
+	if redeemDec.IsZero() {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "redeem_cost_per_point must be > 0")
+	}
+
+	// points = amount / redeem_cost_per_point
+	points := amount.Quo(redeemDec)
+
+	totalDec, err := parseLiquidity(p.TotalLiquidity)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "invalid total_liquidity on partner")
+	}
+	availDec, err := parseLiquidity(p.AvailableLiquidity)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidPartner, "invalid available_liquidity on partner")
+	}
+
+	p.TotalLiquidity = totalDec.Add(points).String()
+	p.AvailableLiquidity = availDec.Add(points).String()
+
+	if err := k.SetPartner(ctx, *p); err != nil {
+		return math.LegacyDec{}, err
+	}
+	if err := k.updateLiquidityLevel(ctx, *p); err != nil {
+		return math.LegacyDec{}, err
+	}
+	return points, nil
+}
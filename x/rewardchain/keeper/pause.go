@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// CheckNotPaused returns ErrOperationPaused when the pause matrix in Params
+// has the operation paused.
+func (k Keeper) CheckNotPaused(ctx context.Context, op types.Operation) error {
+	if k.GetParams(ctx).Paused.IsPaused(op) {
+		return errorsmod.Wrap(types.ErrOperationPaused, op.String())
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestPauseMatrix(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin := sample.AccAddress()
+	emergency := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.AdminAddresses = []string{admin, emergency}
+	params.EmergencyAdmins = []string{emergency}
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.SetParams(ctx, params))
+
+	params.EmergencyAdmins = []string{sample.AccAddress()}
+	require.Error(t, params.Validate())
+
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "100", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+
+	_, err = ms.SetPaused(ctx, types.NewMsgSetPaused(admin, types.OPERATION_SWAP_POINTS_TO_TOKEN, true))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetPaused(ctx, types.NewMsgSetPaused(emergency, types.OPERATION_UNSPECIFIED, true))
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+	_, err = ms.SetPaused(ctx, types.NewMsgSetPaused(emergency, types.OPERATION_SWAP_POINTS_TO_TOKEN, true))
+	require.NoError(t, err)
+	_, err = ms.SetPaused(ctx, types.NewMsgSetPaused(emergency, types.OPERATION_REDEEM, true))
+	require.NoError(t, err)
+
+	res, err := k.PauseStatus(ctx, &types.QueryPauseStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Operation{types.OPERATION_SWAP_POINTS_TO_TOKEN, types.OPERATION_REDEEM}, res.PausedOperations)
+
+	// only the paused route is blocked
+	_, err = ms.Swap(ctx, &types.MsgSwap{Creator: member, PartnerId: 1, Route: "points_to_token", Points: "10"})
+	require.ErrorIs(t, err, types.ErrOperationPaused)
+	_, err = ms.Swap(ctx, &types.MsgSwap{Creator: member, PartnerId: 1, Route: "token_to_points", Points: "10"})
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "10"))
+	require.ErrorIs(t, err, types.ErrOperationPaused)
+
+	// the emergency admin cannot resume; governance can
+	_, err = ms.SetPaused(ctx, types.NewMsgSetPaused(emergency, types.OPERATION_REDEEM, false))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetPaused(ctx, types.NewMsgSetPaused(k.GetAuthority(), types.OPERATION_REDEEM, false))
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "10"))
+	require.NoError(t, err)
+	require.True(t, k.GetParams(ctx).Paused.IsPaused(types.OPERATION_SWAP_POINTS_TO_TOKEN))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) Attestations(goCtx context.Context, req *types.QueryAttestationsRequest) (*types.QueryAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	member, err := sdk.AccAddressFromBech32(req.Member)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid member address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryAttestationsResponse{
+		Attestations: k.GetMemberAttestations(ctx, member),
+		Level:        k.AttestationLevel(ctx, member, types.KycRequirement{}),
+	}, nil
+}
+
+func (k Keeper) KycRequirement(goCtx context.Context, req *types.QueryKycRequirementRequest) (*types.QueryKycRequirementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPartner(ctx, req.PartnerId); !found {
+		return nil, status.Error(codes.NotFound, types.ErrPartnerNotFound.Error())
+	}
+
+	return &types.QueryKycRequirementResponse{Requirement: k.GetKycRequirement(ctx, req.PartnerId)}, nil
+}
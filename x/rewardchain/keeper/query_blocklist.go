@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) BlockedAddress(goCtx context.Context, req *types.QueryBlockedAddressRequest) (*types.QueryBlockedAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	b, blocked := k.GetBlockedAddress(ctx, addr)
+	return &types.QueryBlockedAddressResponse{Blocked: blocked, Entry: b}, nil
+}
+
+func (k Keeper) BlockedAddresses(goCtx context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entries := make([]types.BlockedAddress, 0)
+	pageRes, err := query.Paginate(k.getBlockedAddressStore(ctx), req.Pagination, func(_, value []byte) error {
+		var b types.BlockedAddress
+		if err := k.cdc.Unmarshal(value, &b); err != nil {
+			return err
+		}
+		entries = append(entries, b)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockedAddressesResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) ClientRef(goCtx context.Context, req *types.QueryClientRefRequest) (*types.QueryClientRefResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid signer address")
+	}
+	if req.ClientRef == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_ref is required")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	r, found := k.GetClientRefRecord(ctx, signer, req.ClientRef)
+	if !found {
+		return nil, status.Error(codes.NotFound, "client_ref not found")
+	}
+
+	return &types.QueryClientRefResponse{Record: r}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) ExchangeRate(goCtx context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateCurrencyCode(req.Base); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := types.ValidateCurrencyCode(req.Quote); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rate, height, err := k.GetExchangeRate(ctx, req.Base, req.Quote)
+	stale := types.ErrStaleRate.Is(err)
+	if err != nil && !stale {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryExchangeRateResponse{
+		Rate:   rate.String(),
+		Height: height,
+		Stale:  stale,
+	}, nil
+}
+
+func (k Keeper) ExchangeRates(goCtx context.Context, req *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rates := make([]types.ExchangeRate, 0)
+	pageRes, err := query.Paginate(k.getExchangeRateStore(ctx), req.Pagination, func(_, value []byte) error {
+		var r types.ExchangeRate
+		if err := k.cdc.Unmarshal(value, &r); err != nil {
+			return err
+		}
+		rates = append(rates, r)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExchangeRatesResponse{
+		Rates:      rates,
+		Pagination: pageRes,
+	}, nil
+}
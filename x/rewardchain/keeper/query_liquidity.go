@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) LiquidityStatus(goCtx context.Context, req *types.QueryLiquidityStatusRequest) (*types.QueryLiquidityStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, found := k.GetPartner(ctx, req.PartnerId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrPartnerNotFound.Error())
+	}
+
+	return &types.QueryLiquidityStatusResponse{
+		Thresholds:         k.GetLiquidityThresholds(ctx, p.Id),
+		Status:             k.GetLiquidityStatus(ctx, p.Id),
+		AvailableLiquidity: p.AvailableLiquidity,
+	}, nil
+}
+
+func (k Keeper) PartnerLiquidityHistory(goCtx context.Context, req *types.QueryPartnerLiquidityHistoryRequest) (*types.QueryPartnerLiquidityHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	if req.From < 0 || req.To < 0 {
+		return nil, status.Error(codes.InvalidArgument, "from and to must not be negative")
+	}
+	if req.To > 0 && req.From > req.To {
+		return nil, status.Error(codes.InvalidArgument, "from must not be after to")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPartner(ctx, req.PartnerId); !found {
+		return nil, status.Error(codes.NotFound, types.ErrPartnerNotFound.Error())
+	}
+
+	return &types.QueryPartnerLiquidityHistoryResponse{
+		Checkpoints: k.GetPartnerLiquidityHistory(ctx, req.PartnerId, req.From, req.To),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) MemberBalance(goCtx context.Context, req *types.QueryMemberBalanceRequest) (*types.QueryMemberBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	member, err := sdk.AccAddressFromBech32(req.Member)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid member address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryMemberBalanceResponse{
+		Balance: types.MemberBalance{
+			PartnerId: req.PartnerId,
+			Member:    req.Member,
+			Points:    k.GetMemberBalance(ctx, req.PartnerId, member).String(),
+		},
+		Debt: k.GetMemberDebt(ctx, req.PartnerId, member).String(),
+	}, nil
+}
@@ -25,7 +25,7 @@ func (k Keeper) Partner(goCtx context.Context, req *types.QueryPartnerRequest) (
 		return nil, status.Error(codes.NotFound, errorsmod.Wrap(types.ErrPartnerNotFound, "partner not found").Error())
 	}
 
-	return &types.QueryPartnerResponse{Partner: p}, nil
+	return &types.QueryPartnerResponse{Partner: p, EarnPaused: k.IsEarnPaused(ctx, p.Id)}, nil
 }
 
 func (k Keeper) Partners(goCtx context.Context, req *types.QueryPartnersRequest) (*types.QueryPartnersResponse, error) {
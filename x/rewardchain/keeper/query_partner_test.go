@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestPartnersPageKey(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	admin := sample.AccAddress()
+	require.NoError(t, k.SetParams(ctx, types.NewParams([]string{admin})))
+	for i := 0; i < 5; i++ {
+		_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{Creator: admin, Name: "Acme", Country: "IN", Currency: "INR"})
+		require.NoError(t, err)
+	}
+	_, err := ms.DisablePartner(ctx, &types.MsgDisablePartner{Creator: admin, PartnerId: 2})
+	require.NoError(t, err)
+
+	// walking next keys visits every listed partner once
+	var (
+		ids   []uint64
+		key   []byte
+		pages int
+	)
+	for {
+		res, err := k.Partners(ctx, &types.QueryPartnersRequest{Pagination: &query.PageRequest{Key: key, Limit: 2}})
+		require.NoError(t, err)
+		for _, p := range res.Partners {
+			ids = append(ids, p.Id)
+		}
+		pages++
+		if key = res.Pagination.NextKey; len(key) == 0 {
+			break
+		}
+		require.Less(t, pages, 5)
+	}
+	require.Equal(t, []uint64{1, 3, 4, 5}, ids)
+	require.Equal(t, 2, pages)
+
+	res, err := k.Partners(ctx, &types.QueryPartnersRequest{
+		Pagination:      &query.PageRequest{Key: types.PartnerKey(2), Limit: 2},
+		IncludeDisabled: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Partners, 2)
+	require.Equal(t, uint64(2), res.Partners[0].Id)
+	require.Equal(t, types.PartnerKey(4), res.Pagination.NextKey)
+}
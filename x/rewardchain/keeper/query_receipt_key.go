@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) ReceiptKey(goCtx context.Context, req *types.QueryReceiptKeyRequest) (*types.QueryReceiptKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rk, found := k.GetReceiptKey(ctx, req.PartnerId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrReceiptKeyNotFound.Error())
+	}
+
+	return &types.QueryReceiptKeyResponse{ReceiptKey: rk}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) RemoteTreasury(goCtx context.Context, req *types.QueryRemoteTreasuryRequest) (*types.QueryRemoteTreasuryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rt, found := k.GetRemoteTreasury(ctx, req.PartnerId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrRemoteTreasuryNotFound.Error())
+	}
+	address, channelID, _ := k.RemoteTreasuryAccount(ctx, rt)
+	return &types.QueryRemoteTreasuryResponse{
+		Treasury:       rt,
+		AccountAddress: address,
+		ChannelId:      channelID,
+	}, nil
+}
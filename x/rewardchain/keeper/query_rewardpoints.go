@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) PointsEscrow(goCtx context.Context, req *types.QueryPointsEscrowRequest) (*types.QueryPointsEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPointsEscrowResponse{
+		Points: k.GetPointsEscrow(ctx, req.ChannelId, req.PartnerId).String(),
+	}, nil
+}
+
+func (k Keeper) PointsVouchers(goCtx context.Context, req *types.QueryPointsVouchersRequest) (*types.QueryPointsVouchersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	holder, err := sdk.AccAddressFromBech32(req.Holder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid holder address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPointsVouchersResponse{Vouchers: k.GetHolderPointsVouchers(ctx, holder)}, nil
+}
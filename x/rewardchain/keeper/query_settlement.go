@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) Settlement(goCtx context.Context, req *types.QuerySettlementRequest) (*types.QuerySettlementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	s, found := k.GetSettlement(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrSettlementNotFound.Error())
+	}
+
+	return &types.QuerySettlementResponse{Settlement: s}, nil
+}
+
+func (k Keeper) PartnerSettlements(goCtx context.Context, req *types.QueryPartnerSettlementsRequest) (*types.QueryPartnerSettlementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	idx := prefix.NewStore(k.getSettlementIndexStore(ctx), types.SettlementIndexPrefix(req.PartnerId, !req.Closed))
+	settlements := make([]types.Settlement, 0)
+	pageRes, err := query.Paginate(idx, req.Pagination, func(key, _ []byte) error {
+		s, found := k.GetSettlement(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			settlements = append(settlements, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPartnerSettlementsResponse{
+		Settlements: settlements,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) SettlementEscrow(goCtx context.Context, req *types.QuerySettlementEscrowRequest) (*types.QuerySettlementEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySettlementEscrowResponse{Balance: k.GetSettlementEscrow(ctx, req.PartnerId)}, nil
+}
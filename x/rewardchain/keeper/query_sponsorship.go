@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) PartnerSponsorships(goCtx context.Context, req *types.QueryPartnerSponsorshipsRequest) (*types.QueryPartnerSponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPartner(ctx, req.PartnerId); !found {
+		return nil, status.Error(codes.NotFound, types.ErrPartnerNotFound.Error())
+	}
+
+	ps := prefix.NewStore(k.getSponsorshipStore(ctx), types.SponsorshipPartnerPrefix(req.PartnerId))
+	statuses := make([]types.SponsorshipStatus, 0)
+	total := sdk.NewCoins()
+	pageRes, err := query.Paginate(ps, req.Pagination, func(_, value []byte) error {
+		var s types.Sponsorship
+		if err := k.cdc.Unmarshal(value, &s); err != nil {
+			return err
+		}
+		remaining, err := k.RemainingSponsorship(ctx, s)
+		if err != nil {
+			return err
+		}
+		total = total.Add(remaining...)
+		statuses = append(statuses, types.SponsorshipStatus{Sponsorship: s, Remaining: remaining})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPartnerSponsorshipsResponse{
+		Sponsorships:   statuses,
+		TotalRemaining: total,
+		Pagination:     pageRes,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) TransferPolicy(goCtx context.Context, req *types.QueryTransferPolicyRequest) (*types.QueryTransferPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPartner(ctx, req.PartnerId); !found {
+		return nil, status.Error(codes.NotFound, types.ErrPartnerNotFound.Error())
+	}
+
+	return &types.QueryTransferPolicyResponse{Policy: k.GetTransferPolicy(ctx, req.PartnerId)}, nil
+}
+
+func (k Keeper) MemberTransfers(goCtx context.Context, req *types.QueryMemberTransfersRequest) (*types.QueryMemberTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	member, err := sdk.AccAddressFromBech32(req.Member)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid member address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	idx := prefix.NewStore(k.getTransferIndexStore(ctx), types.TransferIndexPrefix(member, req.PartnerId))
+	transfers := make([]types.PointsTransfer, 0)
+	pageRes, err := query.Paginate(idx, req.Pagination, func(key, _ []byte) error {
+		t, found := k.GetPointsTransfer(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if found {
+			transfers = append(transfers, t)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMemberTransfersResponse{
+		Transfers:  transfers,
+		Pagination: pageRes,
+	}, nil
+}
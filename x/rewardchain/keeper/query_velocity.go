@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) VelocityLimits(goCtx context.Context, req *types.QueryVelocityLimitsRequest) (*types.QueryVelocityLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.PartnerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "partner_id must be > 0")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPartner(ctx, req.PartnerId); !found {
+		return nil, status.Error(codes.NotFound, types.ErrPartnerNotFound.Error())
+	}
+
+	return &types.QueryVelocityLimitsResponse{Limits: k.GetVelocityLimits(ctx, req.PartnerId)}, nil
+}
+
+func (k Keeper) MemberVelocity(goCtx context.Context, req *types.QueryMemberVelocityRequest) (*types.QueryMemberVelocityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	member, err := sdk.AccAddressFromBech32(req.Member)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid member address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	l := k.GetVelocityLimits(ctx, req.PartnerId)
+	now := ctx.BlockTime().Unix()
+	res := &types.QueryMemberVelocityResponse{
+		RedeemedToday:    k.RedeemedSince(ctx, req.PartnerId, member, now-now%types.SecondsPerDay).String(),
+		RedeemedInWindow: k.RedeemedSince(ctx, req.PartnerId, member, windowStart(now, l)).String(),
+	}
+	if since, found := k.GetMemberSince(ctx, req.PartnerId, member); found {
+		res.MemberSince = since
+		res.CooldownEnds = since + int64(l.NewMemberCooldownSeconds)
+	}
+	if f, frozen := k.GetFrozenMember(ctx, member); frozen {
+		res.Frozen = true
+		res.FreezeReason = f.Reason
+	}
+	return res, nil
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getReceiptKeyStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.ReceiptKeyKeyPrefix)
+}
+
+func (k Keeper) getClaimedReceiptStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.ClaimedReceiptKeyPrefix)
+}
+
+func (k Keeper) SetReceiptKey(ctx context.Context, rk types.ReceiptKey) error {
+	if _, err := types.ReceiptPubKey(rk.KeyType, rk.PubKey); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceipt, err.Error())
+	}
+	bz, err := k.cdc.Marshal(&rk)
+	if err != nil {
+		return err
+	}
+	k.getReceiptKeyStore(ctx).Set(types.PartnerKey(rk.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetReceiptKey(ctx context.Context, partnerID uint64) (types.ReceiptKey, bool) {
+	bz := k.getReceiptKeyStore(ctx).Get(types.PartnerKey(partnerID))
+	if bz == nil {
+		return types.ReceiptKey{}, false
+	}
+	var rk types.ReceiptKey
+	k.cdc.MustUnmarshal(bz, &rk)
+	return rk, true
+}
+
+func (k Keeper) GetAllReceiptKeys(ctx context.Context) []types.ReceiptKey {
+	iter := k.getReceiptKeyStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.ReceiptKey, 0)
+	for ; iter.Valid(); iter.Next() {
+		var rk types.ReceiptKey
+		k.cdc.MustUnmarshal(iter.Value(), &rk)
+		out = append(out, rk)
+	}
+	return out
+}
+
+func (k Keeper) getClaimedReceiptExpiryStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.ClaimedReceiptExpiryKeyPrefix)
+}
+
+// SetClaimedReceipt records a claimed receipt nonce and queues it for pruning
+// once the receipt expires.
+func (k Keeper) SetClaimedReceipt(ctx context.Context, c types.ClaimedReceipt) error {
+	bz, err := k.cdc.Marshal(&c)
+	if err != nil {
+		return err
+	}
+	k.getClaimedReceiptStore(ctx).Set(types.ClaimedReceiptKey(c.PartnerId, c.Nonce), bz)
+	k.getClaimedReceiptExpiryStore(ctx).Set(types.ClaimedReceiptExpiryKey(c.Expiry, c.PartnerId, c.Nonce), []byte{})
+	return nil
+}
+
+// PruneClaimedReceipts removes the claimed receipts that expired before now,
+// a unix time in seconds. Expired receipts are rejected before the replay
+// store is consulted, so their nonces no longer need to be kept.
+func (k Keeper) PruneClaimedReceipts(ctx context.Context, now int64) {
+	if now <= 0 {
+		return
+	}
+	es := k.getClaimedReceiptExpiryStore(ctx)
+	cs := k.getClaimedReceiptStore(ctx)
+
+	iter := es.Iterator(nil, sdk.Uint64ToBigEndian(uint64(now)))
+	defer iter.Close()
+
+	var done [][]byte
+	for ; iter.Valid(); iter.Next() {
+		done = append(done, append([]byte(nil), iter.Key()...))
+	}
+	for _, key := range done {
+		es.Delete(key)
+		cs.Delete(key[8:])
+	}
+}
+
+func (k Keeper) IsReceiptClaimed(ctx context.Context, partnerID uint64, nonce string) bool {
+	return k.getClaimedReceiptStore(ctx).Has(types.ClaimedReceiptKey(partnerID, nonce))
+}
+
+func (k Keeper) GetAllClaimedReceipts(ctx context.Context) []types.ClaimedReceipt {
+	iter := k.getClaimedReceiptStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.ClaimedReceipt, 0)
+	for ; iter.Valid(); iter.Next() {
+		var c types.ClaimedReceipt
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		out = append(out, c)
+	}
+	return out
+}
+
+// VerifyReceipt checks a receipt's signature against the partner's receipt
+// key, its expiry against the block time and its nonce against the replay
+// store.
+func (k Keeper) VerifyReceipt(ctx context.Context, r types.Receipt, sig []byte) error {
+	if err := r.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceipt, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockTime().Unix() > r.Expiry {
+		return types.ErrReceiptExpired
+	}
+	if k.IsReceiptClaimed(ctx, r.PartnerId, r.Nonce) {
+		return types.ErrReceiptAlreadyClaimed
+	}
+
+	rk, found := k.GetReceiptKey(ctx, r.PartnerId)
+	if !found {
+		return types.ErrReceiptKeyNotFound
+	}
+	pubKey, err := types.ReceiptPubKey(rk.KeyType, rk.PubKey)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidReceipt, err.Error())
+	}
+	signBytes, err := types.ReceiptSignBytes(sdkCtx.ChainID(), r)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, sig) {
+		return types.ErrInvalidReceiptSig
+	}
+	return nil
+}
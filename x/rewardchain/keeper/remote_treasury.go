@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getRemoteTreasuryStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.RemoteTreasuryKeyPrefix)
+}
+
+func (k Keeper) SetRemoteTreasury(ctx context.Context, rt types.RemoteTreasury) error {
+	bz, err := k.cdc.Marshal(&rt)
+	if err != nil {
+		return err
+	}
+	k.getRemoteTreasuryStore(ctx).Set(types.RemoteTreasuryKey(rt.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetRemoteTreasury(ctx context.Context, partnerID uint64) (types.RemoteTreasury, bool) {
+	bz := k.getRemoteTreasuryStore(ctx).Get(types.RemoteTreasuryKey(partnerID))
+	if bz == nil {
+		return types.RemoteTreasury{}, false
+	}
+	var rt types.RemoteTreasury
+	k.cdc.MustUnmarshal(bz, &rt)
+	return rt, true
+}
+
+func (k Keeper) GetAllRemoteTreasuries(ctx context.Context) []types.RemoteTreasury {
+	iter := k.getRemoteTreasuryStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.RemoteTreasury, 0)
+	for ; iter.Valid(); iter.Next() {
+		var rt types.RemoteTreasury
+		k.cdc.MustUnmarshal(iter.Value(), &rt)
+		out = append(out, rt)
+	}
+	return out
+}
+
+// ICAEnabled reports whether the app wired the interchain accounts
+// controller.
+func (k Keeper) ICAEnabled() bool {
+	return k.icaControllerKeeperFn != nil
+}
+
+// RemoteTreasuryAccount returns the interchain account address and the open
+// channel of a remote treasury. ok is false until the channel is open.
+func (k Keeper) RemoteTreasuryAccount(ctx sdk.Context, rt types.RemoteTreasury) (address, channelID string, ok bool) {
+	if !k.ICAEnabled() {
+		return "", "", false
+	}
+	portID, err := icatypes.NewControllerPortID(rt.Owner)
+	if err != nil {
+		return "", "", false
+	}
+	ica := k.icaControllerKeeperFn()
+	if channelID, ok = ica.GetOpenActiveChannel(ctx, rt.ConnectionId, portID); !ok {
+		return "", "", false
+	}
+	address, ok = ica.GetInterchainAccountAddress(ctx, rt.ConnectionId, portID)
+	return address, channelID, ok
+}
+
+// RegisterRemoteTreasury stores a partner's remote treasury and registers its
+// interchain account unless the account channel is already open. Settings
+// cannot change while a top-up is in flight.
+func (k Keeper) RegisterRemoteTreasury(ctx sdk.Context, rt types.RemoteTreasury) (string, error) {
+	if !k.ICAEnabled() {
+		return "", errorsmod.Wrap(types.ErrInvalidRemoteTreasury, "interchain accounts are not enabled")
+	}
+	if prev, found := k.GetRemoteTreasury(ctx, rt.PartnerId); found && prev.PendingSequence != 0 {
+		return "", errorsmod.Wrapf(types.ErrTopUpPending, "sequence %d", prev.PendingSequence)
+	}
+	portID, err := icatypes.NewControllerPortID(rt.Owner)
+	if err != nil {
+		return "", errorsmod.Wrap(types.ErrInvalidRemoteTreasury, err.Error())
+	}
+
+	ica := k.icaControllerKeeperFn()
+	if _, open := ica.GetOpenActiveChannel(ctx, rt.ConnectionId, portID); !open {
+		if err := ica.RegisterInterchainAccountWithOrdering(ctx, rt.ConnectionId, rt.Owner, "", channeltypes.UNORDERED); err != nil {
+			return "", err
+		}
+	}
+	rt.PendingSequence, rt.PendingAmount = 0, ""
+	return portID, k.SetRemoteTreasury(ctx, rt)
+}
+
+// SendTopUp has the interchain account of a remote treasury send amount of
+// its denom to the escrow address. Liquidity is only credited once the host
+// chain acknowledges the transfer; only one top-up is in flight at a time.
+func (k Keeper) SendTopUp(ctx sdk.Context, rt types.RemoteTreasury, amount math.Int) (uint64, error) {
+	if err := k.CheckNotPaused(ctx, types.OPERATION_ADD_LIQUIDITY); err != nil {
+		return 0, err
+	}
+	if rt.PendingSequence != 0 {
+		return 0, errorsmod.Wrapf(types.ErrTopUpPending, "sequence %d", rt.PendingSequence)
+	}
+	address, _, ok := k.RemoteTreasuryAccount(ctx, rt)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrRemoteTreasuryNotReady, "partner %d", rt.PartnerId)
+	}
+
+	send, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{
+		FromAddress: address,
+		ToAddress:   rt.EscrowAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin(rt.Denom.Denom, amount)),
+	})
+	if err != nil {
+		return 0, err
+	}
+	bz, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: []*codectypes.Any{send}})
+	if err != nil {
+		return 0, err
+	}
+	portID, err := icatypes.NewControllerPortID(rt.Owner)
+	if err != nil {
+		return 0, err
+	}
+	timeout := uint64(ctx.BlockTime().Add(types.DefaultTopUpTimeout).UnixNano())
+	seq, err := k.icaControllerKeeperFn().SendTx(ctx, nil, rt.ConnectionId, portID, icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: bz,
+	}, timeout)
+	if err != nil {
+		return 0, err
+	}
+
+	rt.PendingSequence = seq
+	rt.PendingAmount = amount.String()
+	if err := k.SetRemoteTreasury(ctx, rt); err != nil {
+		return 0, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_top_up_sent",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(rt.PartnerId, 10)),
+			sdk.NewAttribute("sequence", strconv.FormatUint(seq, 10)),
+			sdk.NewAttribute("amount", sdk.NewCoin(rt.Denom.Denom, amount).String()),
+			sdk.NewAttribute("escrow_address", rt.EscrowAddress),
+		),
+	)
+	return seq, nil
+}
+
+// AutoTopUp sends the configured top-up for every remote treasury whose
+// partner's available liquidity dropped below its threshold. Treasuries
+// that cannot top up right now are skipped and retried next block.
+func (k Keeper) AutoTopUp(ctx sdk.Context) {
+	if !k.ICAEnabled() || k.GetParams(ctx).Paused.IsPaused(types.OPERATION_ADD_LIQUIDITY) {
+		return
+	}
+	for _, rt := range k.GetAllRemoteTreasuries(ctx) {
+		if !rt.AutoTopUp() || rt.PendingSequence != 0 {
+			continue
+		}
+		p, found := k.GetPartner(ctx, rt.PartnerId)
+		if !found || p.Disabled {
+			continue
+		}
+		avail, err := parseLiquidity(p.AvailableLiquidity)
+		if err != nil || !avail.LT(math.LegacyMustNewDecFromStr(rt.TopUpThreshold)) {
+			continue
+		}
+		amount, err := types.ParseTopUpAmount(rt.TopUpAmount)
+		if err != nil {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.SendTopUp(cacheCtx, rt, amount); err != nil {
+			k.Logger().Debug("automatic top-up skipped", "partner_id", rt.PartnerId, "error", err)
+			continue
+		}
+		write()
+	}
+}
+
+// OnTopUpAcknowledgement settles the top-up a packet carried. A successful
+// acknowledgement credits the partner's liquidity with the value of the
+// tokens; an error acknowledgement only clears the top-up. When the tokens
+// cannot be valued yet the error is returned, so the acknowledgement can be
+// relayed again later.
+func (k Keeper) OnTopUpAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	rt, amount, ok := k.settleTopUp(ctx, packet)
+	if !ok {
+		return nil
+	}
+	if !ack.Success() {
+		k.emitTopUpFailed(ctx, rt, packet, amount, ack.GetError())
+		return nil
+	}
+
+	p, found := k.GetPartner(ctx, rt.PartnerId)
+	if !found {
+		return types.ErrPartnerNotFound
+	}
+	if p.Currency == "" {
+		p.Currency = rt.Denom.Currency
+	}
+	value, err := k.ValueTokens(ctx, rt.Denom, amount, p.Currency)
+	if err != nil {
+		return err
+	}
+	points, err := k.AddLiquidity(ctx, &p, value)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_top_up_confirmed",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(rt.PartnerId, 10)),
+			sdk.NewAttribute("sequence", strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute("amount", sdk.NewCoin(rt.Denom.Denom, amount).String()),
+			sdk.NewAttribute("points", points.String()),
+		),
+	)
+	return nil
+}
+
+// OnTopUpTimeout clears a top-up the host chain never received.
+func (k Keeper) OnTopUpTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	if rt, amount, ok := k.settleTopUp(ctx, packet); ok {
+		k.emitTopUpFailed(ctx, rt, packet, amount, "timeout")
+	}
+	return nil
+}
+
+// settleTopUp clears the pending top-up a packet carried. ok is false for
+// packets that are not the pending top-up of a remote treasury.
+func (k Keeper) settleTopUp(ctx sdk.Context, packet channeltypes.Packet) (types.RemoteTreasury, math.Int, bool) {
+	partnerID, ok := types.ParseRemoteTreasuryPort(packet.SourcePort)
+	if !ok {
+		return types.RemoteTreasury{}, math.Int{}, false
+	}
+	rt, found := k.GetRemoteTreasury(ctx, partnerID)
+	if !found || rt.PendingSequence != packet.Sequence {
+		return types.RemoteTreasury{}, math.Int{}, false
+	}
+	amount, err := types.ParseTopUpAmount(rt.PendingAmount)
+	if err != nil {
+		return types.RemoteTreasury{}, math.Int{}, false
+	}
+	rt.PendingSequence, rt.PendingAmount = 0, ""
+	if err := k.SetRemoteTreasury(ctx, rt); err != nil {
+		return types.RemoteTreasury{}, math.Int{}, false
+	}
+	return rt, amount, true
+}
+
+func (k Keeper) emitTopUpFailed(ctx sdk.Context, rt types.RemoteTreasury, packet channeltypes.Packet, amount math.Int, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"remote_top_up_failed",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(rt.PartnerId, 10)),
+			sdk.NewAttribute("sequence", strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute("amount", sdk.NewCoin(rt.Denom.Denom, amount).String()),
+			sdk.NewAttribute("reason", reason),
+		),
+	)
+}
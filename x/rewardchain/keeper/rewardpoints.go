@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// GetPointsEscrow returns the points escrowed for a partner on a channel.
+func (k Keeper) GetPointsEscrow(ctx context.Context, channelID string, partnerID uint64) math.LegacyDec {
+	bz := k.getPointsEscrowStore(ctx).Get(types.PointsEscrowKey(channelID, partnerID))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+	var e types.PointsEscrow
+	k.cdc.MustUnmarshal(bz, &e)
+	points, err := math.LegacyNewDecFromStr(e.Points)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return points
+}
+
+func (k Keeper) SetPointsEscrow(ctx context.Context, e types.PointsEscrow) error {
+	bz, err := k.cdc.Marshal(&e)
+	if err != nil {
+		return err
+	}
+	k.getPointsEscrowStore(ctx).Set(types.PointsEscrowKey(e.ChannelId, e.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetAllPointsEscrows(ctx context.Context) []types.PointsEscrow {
+	iter := k.getPointsEscrowStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.PointsEscrow, 0)
+	for ; iter.Valid(); iter.Next() {
+		var e types.PointsEscrow
+		k.cdc.MustUnmarshal(iter.Value(), &e)
+		out = append(out, e)
+	}
+	return out
+}
+
+// GetPointsVoucher returns a holder's voucher for points of a remote partner
+// received on a channel.
+func (k Keeper) GetPointsVoucher(ctx context.Context, holder sdk.AccAddress, portID, channelID string, partnerID uint64) (types.PointsVoucher, bool) {
+	bz := k.getPointsVoucherStore(ctx).Get(types.PointsVoucherKey(holder, portID, channelID, partnerID))
+	if bz == nil {
+		return types.PointsVoucher{}, false
+	}
+	var v types.PointsVoucher
+	k.cdc.MustUnmarshal(bz, &v)
+	return v, true
+}
+
+func (k Keeper) SetPointsVoucher(ctx context.Context, v types.PointsVoucher) error {
+	holder, err := sdk.AccAddressFromBech32(v.Holder)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&v)
+	if err != nil {
+		return err
+	}
+	k.getPointsVoucherStore(ctx).Set(types.PointsVoucherKey(holder, v.PortId, v.ChannelId, v.PartnerId), bz)
+	return nil
+}
+
+// GetHolderPointsVouchers returns every voucher a member holds.
+func (k Keeper) GetHolderPointsVouchers(ctx context.Context, holder sdk.AccAddress) []types.PointsVoucher {
+	hs := prefix.NewStore(k.getPointsVoucherStore(ctx), types.PointsVoucherHolderPrefix(holder))
+	iter := hs.Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.PointsVoucher, 0)
+	for ; iter.Valid(); iter.Next() {
+		var v types.PointsVoucher
+		k.cdc.MustUnmarshal(iter.Value(), &v)
+		out = append(out, v)
+	}
+	return out
+}
+
+func (k Keeper) GetAllPointsVouchers(ctx context.Context) []types.PointsVoucher {
+	iter := k.getPointsVoucherStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.PointsVoucher, 0)
+	for ; iter.Valid(); iter.Next() {
+		var v types.PointsVoucher
+		k.cdc.MustUnmarshal(iter.Value(), &v)
+		out = append(out, v)
+	}
+	return out
+}
+
+// SendPoints sends points to a receiver on the other end of a rewardpoints
+// channel and returns the packet sequence. A member's points with a local
+// partner are escrowed, subject to the same pause, velocity and KYC gates as
+// a redemption. Points held as a voucher go back to the chain of their
+// partner and the voucher is burned.
+func (k Keeper) SendPoints(ctx sdk.Context, msg *types.MsgSendPoints) (uint64, error) {
+	if !k.IBCEnabled() {
+		return 0, errorsmod.Wrap(types.ErrInvalidChannel, "IBC is not enabled")
+	}
+	if err := k.CheckNotPaused(ctx, types.OPERATION_REDEEM); err != nil {
+		return 0, err
+	}
+	sender := sdk.MustAccAddressFromBech32(msg.Creator)
+	points := math.LegacyMustNewDecFromStr(msg.Points)
+
+	portID := k.GetPort(ctx)
+	chanCap, ok := k.capabilityScopedFn(types.ModuleName).GetCapability(ctx, host.ChannelCapabilityPath(portID, msg.SourceChannel))
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrInvalidChannel, "module does not own channel %s on port %s", msg.SourceChannel, portID)
+	}
+
+	data := types.RewardPointsPacketData{
+		PartnerId: msg.PartnerId,
+		Sender:    msg.Creator,
+		Receiver:  msg.Receiver,
+		Points:    points.String(),
+		Voucher:   msg.Voucher,
+	}
+	var err error
+	if msg.Voucher {
+		err = k.burnPointsVoucher(ctx, sender, portID, msg.SourceChannel, msg.PartnerId, points)
+	} else {
+		data.SourceRate, err = k.escrowPoints(ctx, sender, msg.SourceChannel, msg.PartnerId, points)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	timeout := msg.TimeoutTimestamp
+	if timeout == 0 {
+		timeout = uint64(ctx.BlockTime().Add(types.DefaultRelativePacketTimeout).UnixNano())
+	}
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, chanCap, portID, msg.SourceChannel, clienttypes.ZeroHeight(), timeout, data.GetBytes())
+}
+
+// escrowPoints moves points from a member's balance with a partner into the
+// partner's escrow on a channel and returns the partner's redeem rate.
+func (k Keeper) escrowPoints(ctx sdk.Context, sender sdk.AccAddress, channelID string, partnerID uint64, points math.LegacyDec) (string, error) {
+	p, found := k.GetPartner(ctx, partnerID)
+	if !found {
+		return "", types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return "", types.ErrPartnerDisabled
+	}
+
+	balance := k.GetMemberBalance(ctx, p.Id, sender)
+	if points.GT(balance) {
+		return "", errorsmod.Wrapf(types.ErrInsufficientPoints, "balance %s, requested %s", balance, points)
+	}
+	if err := k.CheckRedeemVelocity(ctx, p.Id, sender, points); err != nil {
+		return "", err
+	}
+	if err := k.CheckKyc(ctx, p.Id, sender, points); err != nil {
+		return "", err
+	}
+	if err := k.SetMemberBalance(ctx, p.Id, sender, balance.Sub(points)); err != nil {
+		return "", err
+	}
+	escrow := k.GetPointsEscrow(ctx, channelID, p.Id).Add(points)
+	if err := k.SetPointsEscrow(ctx, types.PointsEscrow{
+		ChannelId: channelID,
+		PartnerId: p.Id,
+		Points:    escrow.String(),
+	}); err != nil {
+		return "", err
+	}
+	if err := k.RecordRedeem(ctx, p.Id, sender, points); err != nil {
+		return "", err
+	}
+	if err := k.RecordPayout(ctx, p.Id, sender, points); err != nil {
+		return "", err
+	}
+	return p.RedeemCostPerPoint, nil
+}
+
+// releasePointsEscrow moves points of a partner's escrow on a channel back
+// to a member's balance.
+func (k Keeper) releasePointsEscrow(ctx sdk.Context, channelID string, partnerID uint64, member sdk.AccAddress, points math.LegacyDec) error {
+	escrow := k.GetPointsEscrow(ctx, channelID, partnerID)
+	if points.GT(escrow) {
+		return errorsmod.Wrapf(types.ErrInsufficientPointsEscrow, "escrow %s, requested %s", escrow, points)
+	}
+	if err := k.SetPointsEscrow(ctx, types.PointsEscrow{
+		ChannelId: channelID,
+		PartnerId: partnerID,
+		Points:    escrow.Sub(points).String(),
+	}); err != nil {
+		return err
+	}
+	balance := k.GetMemberBalance(ctx, partnerID, member).Add(points)
+	return k.SetMemberBalance(ctx, partnerID, member, balance)
+}
+
+// burnPointsVoucher removes points from a holder's voucher.
+func (k Keeper) burnPointsVoucher(ctx sdk.Context, holder sdk.AccAddress, portID, channelID string, partnerID uint64, points math.LegacyDec) error {
+	if f, frozen := k.GetFrozenMember(ctx, holder); frozen {
+		return errorsmod.Wrap(types.ErrMemberFrozen, f.Reason)
+	}
+	v, found := k.GetPointsVoucher(ctx, holder, portID, channelID, partnerID)
+	held := math.LegacyZeroDec()
+	if found {
+		var err error
+		if held, err = math.LegacyNewDecFromStr(v.Points); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidPacket, "invalid points on voucher")
+		}
+	}
+	if points.GT(held) {
+		return errorsmod.Wrapf(types.ErrInsufficientPoints, "voucher %s, requested %s", held, points)
+	}
+	v.Points = held.Sub(points).String()
+	return k.SetPointsVoucher(ctx, v)
+}
+
+// creditPointsVoucher adds points to a holder's voucher, creating it if
+// needed. A non-empty sourceRate replaces the rate of the voucher.
+func (k Keeper) creditPointsVoucher(ctx sdk.Context, holder sdk.AccAddress, portID, channelID string, partnerID uint64, points math.LegacyDec, sourceRate string) error {
+	v, found := k.GetPointsVoucher(ctx, holder, portID, channelID, partnerID)
+	if !found {
+		v = types.PointsVoucher{
+			PortId:    portID,
+			ChannelId: channelID,
+			PartnerId: partnerID,
+			Holder:    holder.String(),
+			Points:    "0",
+		}
+	}
+	held, err := math.LegacyNewDecFromStr(v.Points)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPacket, "invalid points on voucher")
+	}
+	v.Points = held.Add(points).String()
+	if sourceRate != "" {
+		v.SourceRate = sourceRate
+	}
+	return k.SetPointsVoucher(ctx, v)
+}
+
+// OnRecvPacket credits the receiver with vouchers for the points sent. The
+// vouchers are kept per channel and sending partner. Points returning as
+// vouchers are released from the partner's escrow on the channel to the
+// receiver's balance instead.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.RewardPointsPacketData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPacket, err.Error())
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "invalid receiver address: %s", err)
+	}
+	if err := k.CheckNotBlocked(ctx, receiver); err != nil {
+		return err
+	}
+	points := math.LegacyMustNewDecFromStr(data.Points)
+
+	if data.Voucher {
+		err = k.releasePointsEscrow(ctx, packet.DestinationChannel, data.PartnerId, receiver, points)
+	} else {
+		err = k.creditPointsVoucher(ctx, receiver, packet.DestinationPort, packet.DestinationChannel, data.PartnerId, points, data.SourceRate)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"receive_points",
+			sdk.NewAttribute("channel_id", packet.DestinationChannel),
+			sdk.NewAttribute("partner_id", strconv.FormatUint(data.PartnerId, 10)),
+			sdk.NewAttribute("sender", data.Sender),
+			sdk.NewAttribute("receiver", data.Receiver),
+			sdk.NewAttribute("points", points.String()),
+			sdk.NewAttribute("voucher", strconv.FormatBool(data.Voucher)),
+		),
+	)
+	return nil
+}
+
+// OnAcknowledgementPacket refunds the sender when the receiving chain
+// rejected the packet.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.RewardPointsPacketData, ack channeltypes.Acknowledgement) error {
+	if ack.Success() {
+		return nil
+	}
+	return k.refundPoints(ctx, packet, data)
+}
+
+// OnTimeoutPacket refunds the sender of a packet that was never received.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.RewardPointsPacketData) error {
+	return k.refundPoints(ctx, packet, data)
+}
+
+// refundPoints releases the escrow of a failed packet back to the sender's
+// balance with the partner, or re-issues the voucher it burned.
+func (k Keeper) refundPoints(ctx sdk.Context, packet channeltypes.Packet, data types.RewardPointsPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "invalid sender address: %s", err)
+	}
+	points, err := math.LegacyNewDecFromStr(data.Points)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPacket, "invalid points")
+	}
+
+	if data.Voucher {
+		err = k.creditPointsVoucher(ctx, sender, packet.SourcePort, packet.SourceChannel, data.PartnerId, points, "")
+	} else {
+		err = k.releasePointsEscrow(ctx, packet.SourceChannel, data.PartnerId, sender, points)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"refund_points",
+			sdk.NewAttribute("channel_id", packet.SourceChannel),
+			sdk.NewAttribute("sequence", strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute("partner_id", strconv.FormatUint(data.PartnerId, 10)),
+			sdk.NewAttribute("sender", data.Sender),
+			sdk.NewAttribute("points", points.String()),
+			sdk.NewAttribute("voucher", strconv.FormatBool(data.Voucher)),
+		),
+	)
+	return nil
+}
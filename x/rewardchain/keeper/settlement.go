@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getObligationStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.ObligationKeyPrefix)
+}
+
+func (k Keeper) getSettlementStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.SettlementKeyPrefix)
+}
+
+func (k Keeper) getSettlementIndexStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.SettlementIndexKeyPrefix)
+}
+
+func (k Keeper) getSettlementEscrowStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.SettlementEscrowKeyPrefix)
+}
+
+// nextSequence increments and returns the counter stored under key.
+func (k Keeper) nextSequence(ctx context.Context, key []byte) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	var next uint64 = 1
+	if bz := store.Get(key); len(bz) == 8 {
+		next = binary.BigEndian.Uint64(bz) + 1
+	}
+	store.Set(key, types.PartnerKey(next))
+	return next
+}
+
+// bumpSequence raises the counter stored under key to at least id.
+func (k Keeper) bumpSequence(ctx context.Context, key []byte, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if bz := store.Get(key); len(bz) == 8 && binary.BigEndian.Uint64(bz) >= id {
+		return
+	}
+	store.Set(key, types.PartnerKey(id))
+}
+
+func (k Keeper) SetObligation(ctx context.Context, o types.Obligation) error {
+	bz, err := k.cdc.Marshal(&o)
+	if err != nil {
+		return err
+	}
+	k.getObligationStore(ctx).Set(types.PartnerKey(o.Id), bz)
+	k.bumpSequence(ctx, types.ObligationCountKey, o.Id)
+	return nil
+}
+
+func (k Keeper) GetAllObligations(ctx context.Context) []types.Obligation {
+	iter := k.getObligationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.Obligation, 0)
+	for ; iter.Valid(); iter.Next() {
+		var o types.Obligation
+		k.cdc.MustUnmarshal(iter.Value(), &o)
+		out = append(out, o)
+	}
+	return out
+}
+
+// RecordObligation stores an amount the payer partner owes the payee partner
+// until the next settlement epoch nets it. It returns the obligation id.
+func (k Keeper) RecordObligation(ctx context.Context, o types.Obligation) (uint64, error) {
+	if err := o.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidObligation, err.Error())
+	}
+	for _, id := range []uint64{o.PayerId, o.PayeeId} {
+		if _, found := k.GetPartner(ctx, id); !found {
+			return 0, errorsmod.Wrapf(types.ErrPartnerNotFound, "partner %d", id)
+		}
+	}
+	amount, _ := math.LegacyNewDecFromStr(strings.TrimSpace(o.Amount))
+
+	o.Id = k.nextSequence(ctx, types.ObligationCountKey)
+	o.Amount = amount.String()
+	o.Currency = strings.ToUpper(strings.TrimSpace(o.Currency))
+	o.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := k.SetObligation(ctx, o); err != nil {
+		return 0, err
+	}
+	return o.Id, nil
+}
+
+// SetSettlement stores a settlement and keeps the per-partner open/closed
+// index of both parties in step with its status.
+func (k Keeper) SetSettlement(ctx context.Context, s types.Settlement) error {
+	if prev, found := k.GetSettlement(ctx, s.Id); found {
+		idx := k.getSettlementIndexStore(ctx)
+		idx.Delete(types.SettlementIndexKey(prev.PayerId, prev.IsOpen(), prev.Id))
+		idx.Delete(types.SettlementIndexKey(prev.PayeeId, prev.IsOpen(), prev.Id))
+	}
+	bz, err := k.cdc.Marshal(&s)
+	if err != nil {
+		return err
+	}
+	k.getSettlementStore(ctx).Set(types.PartnerKey(s.Id), bz)
+
+	idx := k.getSettlementIndexStore(ctx)
+	idx.Set(types.SettlementIndexKey(s.PayerId, s.IsOpen(), s.Id), []byte{})
+	idx.Set(types.SettlementIndexKey(s.PayeeId, s.IsOpen(), s.Id), []byte{})
+	k.bumpSequence(ctx, types.SettlementCountKey, s.Id)
+	return nil
+}
+
+func (k Keeper) GetSettlement(ctx context.Context, id uint64) (types.Settlement, bool) {
+	bz := k.getSettlementStore(ctx).Get(types.PartnerKey(id))
+	if bz == nil {
+		return types.Settlement{}, false
+	}
+	var s types.Settlement
+	k.cdc.MustUnmarshal(bz, &s)
+	return s, true
+}
+
+func (k Keeper) GetAllSettlements(ctx context.Context) []types.Settlement {
+	iter := k.getSettlementStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.Settlement, 0)
+	for ; iter.Valid(); iter.Next() {
+		var s types.Settlement
+		k.cdc.MustUnmarshal(iter.Value(), &s)
+		out = append(out, s)
+	}
+	return out
+}
+
+// NetObligations folds all pending obligations into one open settlement per
+// partner pair and currency, with the net amount owed in the direction it is
+// owed. Pairs that net to zero produce no settlement. Obligations are removed
+// once netted.
+func (k Keeper) NetObligations(ctx context.Context) ([]types.Settlement, error) {
+	type pairKey struct {
+		lo, hi   uint64
+		currency string
+	}
+	type pairNet struct {
+		net   math.LegacyDec // positive: lo owes hi
+		count uint64
+	}
+
+	obs := k.getObligationStore(ctx)
+	nets := make(map[pairKey]*pairNet)
+	var done [][]byte
+
+	iter := obs.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var o types.Obligation
+		k.cdc.MustUnmarshal(iter.Value(), &o)
+		done = append(done, append([]byte(nil), iter.Key()...))
+
+		amount, err := math.LegacyNewDecFromStr(o.Amount)
+		if err != nil {
+			iter.Close()
+			return nil, errorsmod.Wrapf(types.ErrInvalidObligation, "obligation %d: invalid amount", o.Id)
+		}
+		key := pairKey{lo: o.PayerId, hi: o.PayeeId, currency: o.Currency}
+		if key.lo > key.hi {
+			key.lo, key.hi = key.hi, key.lo
+			amount = amount.Neg()
+		}
+		n, ok := nets[key]
+		if !ok {
+			n = &pairNet{net: math.LegacyZeroDec()}
+			nets[key] = n
+		}
+		n.net = n.net.Add(amount)
+		n.count++
+	}
+	iter.Close()
+
+	for _, key := range done {
+		obs.Delete(key)
+	}
+
+	keys := make([]pairKey, 0, len(nets))
+	for key := range nets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].lo != keys[j].lo {
+			return keys[i].lo < keys[j].lo
+		}
+		if keys[i].hi != keys[j].hi {
+			return keys[i].hi < keys[j].hi
+		}
+		return keys[i].currency < keys[j].currency
+	})
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	out := make([]types.Settlement, 0, len(keys))
+	for _, key := range keys {
+		n := nets[key]
+		if n.net.IsZero() {
+			continue
+		}
+		s := types.Settlement{
+			PayerId:     key.lo,
+			PayeeId:     key.hi,
+			Amount:      n.net.Abs().String(),
+			Currency:    key.currency,
+			EpochHeight: height,
+			Obligations: n.count,
+			Status:      types.SETTLEMENT_STATUS_OPEN,
+		}
+		if n.net.IsNegative() {
+			s.PayerId, s.PayeeId = key.hi, key.lo
+		}
+		s.Id = k.nextSequence(ctx, types.SettlementCountKey)
+		if err := k.SetSettlement(ctx, s); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+// GetSettlementEscrow returns the coins a partner holds in settlement escrow.
+func (k Keeper) GetSettlementEscrow(ctx context.Context, partnerID uint64) sdk.Coins {
+	bz := k.getSettlementEscrowStore(ctx).Get(types.PartnerKey(partnerID))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var e types.SettlementEscrow
+	k.cdc.MustUnmarshal(bz, &e)
+	return e.Balance
+}
+
+// SetSettlementEscrow stores a partner's escrow balance. An empty balance
+// removes the record.
+func (k Keeper) SetSettlementEscrow(ctx context.Context, partnerID uint64, balance sdk.Coins) error {
+	es := k.getSettlementEscrowStore(ctx)
+	if balance.IsZero() {
+		es.Delete(types.PartnerKey(partnerID))
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&types.SettlementEscrow{PartnerId: partnerID, Balance: balance})
+	if err != nil {
+		return err
+	}
+	es.Set(types.PartnerKey(partnerID), bz)
+	return nil
+}
+
+func (k Keeper) GetAllSettlementEscrows(ctx context.Context) []types.SettlementEscrow {
+	iter := k.getSettlementEscrowStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.SettlementEscrow, 0)
+	for ; iter.Valid(); iter.Next() {
+		var e types.SettlementEscrow
+		k.cdc.MustUnmarshal(iter.Value(), &e)
+		out = append(out, e)
+	}
+	return out
+}
+
+// FundSettlementEscrow moves coins from the partner treasury into the module
+// account and credits them to the partner's escrow.
+func (k Keeper) FundSettlementEscrow(ctx context.Context, p types.Partner, amount sdk.Coins) error {
+	treasury, err := sdk.AccAddressFromBech32(p.Treasury)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPartner, "partner has no valid treasury")
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, treasury, types.ModuleName, amount); err != nil {
+		return err
+	}
+	return k.SetSettlementEscrow(ctx, p.Id, k.GetSettlementEscrow(ctx, p.Id).Add(amount...))
+}
+
+// WithdrawSettlementEscrow moves coins from the partner's escrow back to its
+// treasury.
+func (k Keeper) WithdrawSettlementEscrow(ctx context.Context, p types.Partner, amount sdk.Coins) error {
+	treasury, err := sdk.AccAddressFromBech32(p.Treasury)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPartner, "partner has no valid treasury")
+	}
+	escrow := k.GetSettlementEscrow(ctx, p.Id)
+	left, hasNeg := escrow.SafeSub(amount...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrInsufficientEscrow, "escrow %s, withdrawal %s", escrow, amount)
+	}
+	if err := k.SetSettlementEscrow(ctx, p.Id, left); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasury, amount)
+}
+
+// ValueSettlementPayment values escrow coins in a settlement currency. Every
+// denom needs a currency mapping in params.transfer_denoms; amounts in
+// another currency are converted with the oracle rate.
+func (k Keeper) ValueSettlementPayment(ctx context.Context, payment sdk.Coins, currency string) (math.LegacyDec, error) {
+	params := k.GetParams(ctx)
+	total := math.LegacyZeroDec()
+	for _, coin := range payment {
+		dc, ok := params.TransferDenom(coin.Denom)
+		if !ok {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidSettlementOp, "denom %s has no currency mapping", coin.Denom)
+		}
+		value, err := k.ValueTokens(ctx, dc, coin.Amount, currency)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		total = total.Add(value)
+	}
+	return total, nil
+}
+
+// PaySettlementFromEscrow pays the payee treasury out of the payer's escrow.
+func (k Keeper) PaySettlementFromEscrow(ctx context.Context, s types.Settlement, payment sdk.Coins) error {
+	payee, found := k.GetPartner(ctx, s.PayeeId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPartnerNotFound, "partner %d", s.PayeeId)
+	}
+	to, err := sdk.AccAddressFromBech32(payee.Treasury)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPartner, "payee has no valid treasury")
+	}
+	left, hasNeg := k.GetSettlementEscrow(ctx, s.PayerId).SafeSub(payment...)
+	if hasNeg {
+		return errorsmod.Wrapf(types.ErrInsufficientEscrow, "escrow %s, payment %s", k.GetSettlementEscrow(ctx, s.PayerId), payment)
+	}
+	if err := k.SetSettlementEscrow(ctx, s.PayerId, left); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, payment)
+}
+
+// settlementEvent builds the event emitted when a settlement changes state.
+func settlementEvent(eventType string, s types.Settlement) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute("settlement_id", strconv.FormatUint(s.Id, 10)),
+		sdk.NewAttribute("payer_id", strconv.FormatUint(s.PayerId, 10)),
+		sdk.NewAttribute("payee_id", strconv.FormatUint(s.PayeeId, 10)),
+		sdk.NewAttribute("amount", s.Amount),
+		sdk.NewAttribute("currency", s.Currency),
+		sdk.NewAttribute("status", s.Status.String()),
+	)
+}
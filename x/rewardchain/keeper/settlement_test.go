@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "rewardchain/testutil/keeper"
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/keeper"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestSettlementNetting(t *testing.T) {
+	k, bank, ctx := keepertest.RewardchainKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	params := k.GetParams(ctx)
+	params.SettlementEpochBlocks = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	admin := addAdmin(t, k, ctx)
+	treasuries := make([]string, 3)
+	for i := range treasuries {
+		treasuries[i] = sample.AccAddress()
+		_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+			Creator:        admin,
+			Treasury:       treasuries[i],
+			Name:           "Partner",
+			Country:        "IN",
+			Currency:       "INR",
+			TotalLiquidity: "1000",
+		})
+		require.NoError(t, err)
+	}
+
+	record := func(signer string, payer, payee uint64, amount, currency string) error {
+		_, err := ms.RecordObligation(ctx, types.NewMsgRecordObligation(signer, payer, payee, amount, currency, "coalition"))
+		return err
+	}
+	require.ErrorIs(t, record(treasuries[1], 1, 2, "10", "USD"), types.ErrUnauthorized)
+	require.NoError(t, record(treasuries[0], 1, 2, "100", "USD"))
+	require.NoError(t, record(treasuries[1], 2, 1, "30", "usd"))
+	require.NoError(t, record(treasuries[0], 1, 2, "5", "EUR"))
+	require.NoError(t, record(treasuries[2], 3, 1, "40", "USD"))
+	require.NoError(t, record(treasuries[0], 1, 3, "40", "USD"))
+
+	// nothing happens between epochs
+	require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(9)))
+	require.Len(t, k.GetAllSettlements(ctx), 0)
+
+	require.NoError(t, k.EndBlocker(ctx.WithBlockHeight(10)))
+	require.Empty(t, k.GetAllObligations(ctx))
+	settlements := k.GetAllSettlements(ctx)
+	require.Len(t, settlements, 2)
+
+	// 1 -> 2 nets to 70 USD; 1 <-> 3 cancels out
+	usd, eur := settlements[0], settlements[1]
+	if usd.Currency != "USD" {
+		usd, eur = eur, usd
+	}
+	require.Equal(t, uint64(1), usd.PayerId)
+	require.Equal(t, uint64(2), usd.PayeeId)
+	require.Equal(t, "70.000000000000000000", usd.Amount)
+	require.Equal(t, uint64(2), usd.Obligations)
+	require.Equal(t, "5.000000000000000000", eur.Amount)
+
+	open, err := k.PartnerSettlements(ctx, &types.QueryPartnerSettlementsRequest{PartnerId: 2})
+	require.NoError(t, err)
+	require.Len(t, open.Settlements, 2)
+
+	// only the payer treasury may confirm
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[1], usd.Id, types.SettlementMethodOffchain, "wire-1", nil))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], usd.Id, types.SettlementMethodOffchain, "wire-1", nil))
+	require.NoError(t, err)
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], usd.Id, types.SettlementMethodOffchain, "wire-1", nil))
+	require.ErrorIs(t, err, types.ErrSettlementClosed)
+
+	// pay the EUR settlement from escrow; tokens need a currency mapping
+	payment := sdk.NewCoins(sdk.NewInt64Coin("ueur", 5_000_000))
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], eur.Id, types.SettlementMethodEscrow, "", payment))
+	require.ErrorIs(t, err, types.ErrInvalidSettlementOp)
+	params.TransferDenoms = []types.DenomCurrency{{Denom: "ueur", Currency: "EUR", Exponent: 6}, {Denom: "uusdc", Currency: "USD", Exponent: 6}}
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], eur.Id, types.SettlementMethodEscrow, "", payment))
+	require.ErrorIs(t, err, types.ErrInsufficientEscrow)
+
+	// the payment must cover the settlement amount
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], eur.Id, types.SettlementMethodEscrow, "", sdk.NewCoins(sdk.NewInt64Coin("ueur", 4_999_999))))
+	require.ErrorIs(t, err, types.ErrInsufficientPayment)
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], eur.Id, types.SettlementMethodEscrow, "", sdk.NewCoins(sdk.NewInt64Coin("uusdc", 5_400_000))))
+	require.ErrorIs(t, err, types.ErrRateNotFound)
+	require.NoError(t, k.SetExchangeRate(ctx, types.ExchangeRate{Base: "EUR", Quote: "USD", Rate: "1.1", Height: ctx.BlockHeight()}))
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], eur.Id, types.SettlementMethodEscrow, "", sdk.NewCoins(sdk.NewInt64Coin("uusdc", 5_400_000))))
+	require.ErrorIs(t, err, types.ErrInsufficientPayment)
+
+	payer := sdk.MustAccAddressFromBech32(treasuries[0])
+	bank.Fund(payer, payment)
+	_, err = ms.FundSettlementEscrow(ctx, types.NewMsgFundSettlementEscrow(treasuries[0], 1, payment))
+	require.NoError(t, err)
+	require.Equal(t, payment, k.GetSettlementEscrow(ctx, 1))
+
+	_, err = ms.ConfirmSettlement(ctx, types.NewMsgConfirmSettlement(treasuries[0], eur.Id, types.SettlementMethodEscrow, "", payment))
+	require.NoError(t, err)
+	require.True(t, k.GetSettlementEscrow(ctx, 1).IsZero())
+	require.Equal(t, payment, bank.Balance(sdk.MustAccAddressFromBech32(treasuries[1])))
+
+	// unused escrow goes back to the treasury
+	extra := sdk.NewCoins(sdk.NewInt64Coin("ueur", 1_000_000))
+	bank.Fund(payer, extra)
+	_, err = ms.FundSettlementEscrow(ctx, types.NewMsgFundSettlementEscrow(treasuries[0], 1, extra))
+	require.NoError(t, err)
+	_, err = ms.WithdrawSettlementEscrow(ctx, types.NewMsgWithdrawSettlementEscrow(treasuries[1], 1, extra))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.WithdrawSettlementEscrow(ctx, types.NewMsgWithdrawSettlementEscrow(treasuries[0], 1, extra.Add(extra...)))
+	require.ErrorIs(t, err, types.ErrInsufficientEscrow)
+	_, err = ms.WithdrawSettlementEscrow(ctx, types.NewMsgWithdrawSettlementEscrow(treasuries[0], 1, extra))
+	require.NoError(t, err)
+	require.True(t, k.GetSettlementEscrow(ctx, 1).IsZero())
+	require.Equal(t, extra, bank.Balance(payer))
+
+	open, err = k.PartnerSettlements(ctx, &types.QueryPartnerSettlementsRequest{PartnerId: 2})
+	require.NoError(t, err)
+	require.Empty(t, open.Settlements)
+	closed, err := k.PartnerSettlements(ctx, &types.QueryPartnerSettlementsRequest{PartnerId: 1, Closed: true})
+	require.NoError(t, err)
+	require.Len(t, closed.Settlements, 2)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getSponsorshipStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.SponsorshipKeyPrefix)
+}
+
+func (k Keeper) SetSponsorship(ctx context.Context, s types.Sponsorship) error {
+	member, err := sdk.AccAddressFromBech32(s.Member)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSponsorship, "invalid member address")
+	}
+	bz, err := k.cdc.Marshal(&s)
+	if err != nil {
+		return err
+	}
+	k.getSponsorshipStore(ctx).Set(types.SponsorshipKey(s.PartnerId, member), bz)
+	return nil
+}
+
+func (k Keeper) GetSponsorship(ctx context.Context, partnerID uint64, member sdk.AccAddress) (types.Sponsorship, bool) {
+	bz := k.getSponsorshipStore(ctx).Get(types.SponsorshipKey(partnerID, member))
+	if bz == nil {
+		return types.Sponsorship{}, false
+	}
+	var s types.Sponsorship
+	k.cdc.MustUnmarshal(bz, &s)
+	return s, true
+}
+
+func (k Keeper) RemoveSponsorship(ctx context.Context, partnerID uint64, member sdk.AccAddress) {
+	k.getSponsorshipStore(ctx).Delete(types.SponsorshipKey(partnerID, member))
+}
+
+// GetPartnerSponsorships returns all sponsorships recorded for a partner.
+func (k Keeper) GetPartnerSponsorships(ctx context.Context, partnerID uint64) []types.Sponsorship {
+	ps := prefix.NewStore(k.getSponsorshipStore(ctx), types.SponsorshipPartnerPrefix(partnerID))
+	iter := ps.Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.Sponsorship, 0)
+	for ; iter.Valid(); iter.Next() {
+		var s types.Sponsorship
+		k.cdc.MustUnmarshal(iter.Value(), &s)
+		out = append(out, s)
+	}
+	return out
+}
+
+// SponsorMember grants member a fee allowance from the partner treasury that
+// only covers the messages in types.SponsoredMsgTypeURLs. Sponsoring a member
+// again replaces the previous allowance.
+func (k Keeper) SponsorMember(ctx context.Context, p types.Partner, member sdk.AccAddress, spendLimit sdk.Coins) error {
+	granter, err := sdk.AccAddressFromBech32(p.Treasury)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSponsorship, "partner has no valid treasury")
+	}
+	if granter.Equals(member) {
+		return errorsmod.Wrap(types.ErrInvalidSponsorship, "treasury cannot sponsor itself")
+	}
+
+	if err := k.revokeAllowance(ctx, granter, member); err != nil {
+		return err
+	}
+
+	// The feegrant keeper creates missing grantee accounts through the bank
+	// keeper, which is not wired in app config, so create the account here.
+	if k.accountKeeper.GetAccount(ctx, member) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, member))
+	}
+
+	allowance, err := feegrant.NewAllowedMsgAllowance(
+		&feegrant.BasicAllowance{SpendLimit: spendLimit},
+		types.SponsoredMsgTypeURLs,
+	)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSponsorship, err.Error())
+	}
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter, member)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidSponsorship, err.Error())
+	}
+	if _, err := k.feegrantMsgServer.GrantAllowance(ctx, msg); err != nil {
+		return err
+	}
+
+	return k.SetSponsorship(ctx, types.Sponsorship{
+		PartnerId:  p.Id,
+		Member:     member.String(),
+		Granter:    granter.String(),
+		SpendLimit: spendLimit,
+	})
+}
+
+// RemainingSponsorship returns the unspent fee allowance of a sponsorship.
+// It returns an empty set when the allowance was used up or revoked.
+func (k Keeper) RemainingSponsorship(ctx context.Context, s types.Sponsorship) (sdk.Coins, error) {
+	granter, err := sdk.AccAddressFromBech32(s.Granter)
+	if err != nil {
+		return nil, err
+	}
+	member, err := sdk.AccAddressFromBech32(s.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := k.feegrantKeeper.GetAllowance(ctx, granter, member)
+	if err != nil || grant == nil {
+		return sdk.NewCoins(), nil
+	}
+	allowed, ok := grant.(*feegrant.AllowedMsgAllowance)
+	if !ok {
+		return sdk.NewCoins(), nil
+	}
+	inner, err := allowed.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	basic, ok := inner.(*feegrant.BasicAllowance)
+	if !ok {
+		return sdk.NewCoins(), nil
+	}
+	return basic.SpendLimit, nil
+}
+
+// RevokePartnerSponsorships revokes every fee allowance granted on behalf of
+// a partner and removes the sponsorship records.
+func (k Keeper) RevokePartnerSponsorships(ctx context.Context, partnerID uint64) error {
+	for _, s := range k.GetPartnerSponsorships(ctx, partnerID) {
+		granter, err := sdk.AccAddressFromBech32(s.Granter)
+		if err != nil {
+			return err
+		}
+		member, err := sdk.AccAddressFromBech32(s.Member)
+		if err != nil {
+			return err
+		}
+		if err := k.revokeAllowance(ctx, granter, member); err != nil {
+			return err
+		}
+		k.RemoveSponsorship(ctx, partnerID, member)
+	}
+	return nil
+}
+
+// revokeAllowance removes the granter's allowance for grantee, if any is left.
+func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	if grant, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee); err != nil || grant == nil {
+		return nil
+	}
+	_, err := k.feegrantMsgServer.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+	})
+	return err
+}
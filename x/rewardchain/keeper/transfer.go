@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getTransferPolicyStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.TransferPolicyKeyPrefix)
+}
+
+func (k Keeper) getTransferStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.TransferKeyPrefix)
+}
+
+func (k Keeper) getTransferIndexStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.TransferIndexKeyPrefix)
+}
+
+func (k Keeper) getTransferUsageStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.TransferUsageKeyPrefix)
+}
+
+// GetTransferPolicy returns a partner's transfer policy. Partners without a
+// policy have transfers disabled.
+func (k Keeper) GetTransferPolicy(ctx context.Context, partnerID uint64) types.TransferPolicy {
+	bz := k.getTransferPolicyStore(ctx).Get(types.PartnerKey(partnerID))
+	if bz == nil {
+		return types.DefaultTransferPolicy(partnerID)
+	}
+	var p types.TransferPolicy
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+func (k Keeper) SetTransferPolicy(ctx context.Context, p types.TransferPolicy) error {
+	bz, err := k.cdc.Marshal(&p)
+	if err != nil {
+		return err
+	}
+	k.getTransferPolicyStore(ctx).Set(types.PartnerKey(p.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetAllTransferPolicies(ctx context.Context) []types.TransferPolicy {
+	iter := k.getTransferPolicyStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.TransferPolicy, 0)
+	for ; iter.Valid(); iter.Next() {
+		var p types.TransferPolicy
+		k.cdc.MustUnmarshal(iter.Value(), &p)
+		out = append(out, p)
+	}
+	return out
+}
+
+// SetPointsTransfer stores a transfer and indexes it under both members.
+func (k Keeper) SetPointsTransfer(ctx context.Context, t types.PointsTransfer) error {
+	from, err := sdk.AccAddressFromBech32(t.From)
+	if err != nil {
+		return err
+	}
+	to, err := sdk.AccAddressFromBech32(t.To)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&t)
+	if err != nil {
+		return err
+	}
+	k.getTransferStore(ctx).Set(types.PartnerKey(t.Id), bz)
+	idx := k.getTransferIndexStore(ctx)
+	idx.Set(types.TransferIndexKey(from, t.PartnerId, t.Id), []byte{})
+	idx.Set(types.TransferIndexKey(to, t.PartnerId, t.Id), []byte{})
+	k.bumpSequence(ctx, types.TransferCountKey, t.Id)
+	return nil
+}
+
+func (k Keeper) GetPointsTransfer(ctx context.Context, id uint64) (types.PointsTransfer, bool) {
+	bz := k.getTransferStore(ctx).Get(types.PartnerKey(id))
+	if bz == nil {
+		return types.PointsTransfer{}, false
+	}
+	var t types.PointsTransfer
+	k.cdc.MustUnmarshal(bz, &t)
+	return t, true
+}
+
+func (k Keeper) GetAllPointsTransfers(ctx context.Context) []types.PointsTransfer {
+	iter := k.getTransferStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.PointsTransfer, 0)
+	for ; iter.Valid(); iter.Next() {
+		var t types.PointsTransfer
+		k.cdc.MustUnmarshal(iter.Value(), &t)
+		out = append(out, t)
+	}
+	return out
+}
+
+// GetTransferUsage returns the points a member sent to others of a partner
+// on the given day.
+func (k Keeper) GetTransferUsage(ctx context.Context, partnerID uint64, member sdk.AccAddress, day int64) math.LegacyDec {
+	bz := k.getTransferUsageStore(ctx).Get(types.MemberBalanceKey(partnerID, member))
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+	var u types.TransferUsage
+	k.cdc.MustUnmarshal(bz, &u)
+	if u.Day != day {
+		return math.LegacyZeroDec()
+	}
+	sent, err := math.LegacyNewDecFromStr(u.Sent)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return sent
+}
+
+// SetTransferUsage stores a member's sent points for a day, replacing the
+// usage of any earlier day.
+func (k Keeper) SetTransferUsage(ctx context.Context, u types.TransferUsage) error {
+	member, err := sdk.AccAddressFromBech32(u.Member)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&u)
+	if err != nil {
+		return err
+	}
+	k.getTransferUsageStore(ctx).Set(types.MemberBalanceKey(u.PartnerId, member), bz)
+	return nil
+}
+
+func (k Keeper) GetAllTransferUsages(ctx context.Context) []types.TransferUsage {
+	iter := k.getTransferUsageStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.TransferUsage, 0)
+	for ; iter.Valid(); iter.Next() {
+		var u types.TransferUsage
+		k.cdc.MustUnmarshal(iter.Value(), &u)
+		out = append(out, u)
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// ExecuteTransferMemo runs the action of a rewardchain transfer memo with
+// the tokens an ICS-20 transfer credited to receiver. The tokens move into
+// the module account and are valued in the partner's currency; they fund
+// the partner's liquidity and, for buy_points, the points they buy are
+// issued to the memo recipient. It returns the points added.
+func (k Keeper) ExecuteTransferMemo(ctx sdk.Context, memo types.TransferMemo, receiver sdk.AccAddress, coin sdk.Coin) (math.LegacyDec, error) {
+	if err := memo.Validate(); err != nil {
+		return math.LegacyDec{}, err
+	}
+	dc, ok := k.GetParams(ctx).TransferDenom(coin.Denom)
+	if !ok {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrDenomNotAccepted, coin.Denom)
+	}
+
+	op := types.OPERATION_ADD_LIQUIDITY
+	if memo.Action == types.MemoActionBuyPoints {
+		op = types.OPERATION_SWAP_TOKEN_TO_POINTS
+	}
+	if err := k.CheckNotPaused(ctx, op); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	p, found := k.GetPartner(ctx, memo.PartnerID)
+	if !found {
+		return math.LegacyDec{}, types.ErrPartnerNotFound
+	}
+	if p.Disabled {
+		return math.LegacyDec{}, types.ErrPartnerDisabled
+	}
+	// checked before the top-up, which could otherwise lift the pause
+	if memo.Action == types.MemoActionBuyPoints {
+		if err := k.CheckEarnAllowed(ctx, p.Id); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+	if p.Currency == "" {
+		p.Currency = dc.Currency
+	}
+
+	amount, err := k.ValueTokens(ctx, dc, coin.Amount, p.Currency)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !amount.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidTransferMemo, "%s is worth nothing in %s", coin, p.Currency)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, receiver, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return math.LegacyDec{}, err
+	}
+	points, err := k.AddLiquidity(ctx, &p, amount)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if memo.Action == types.MemoActionBuyPoints {
+		recipient := sdk.MustAccAddressFromBech32(memo.Recipient)
+		if err := k.CheckNotBlocked(ctx, recipient); err != nil {
+			return math.LegacyDec{}, err
+		}
+		if _, err := k.CreditPoints(ctx, &p, recipient, points); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"transfer_memo",
+			sdk.NewAttribute("partner_id", strconv.FormatUint(p.Id, 10)),
+			sdk.NewAttribute("action", memo.Action),
+			sdk.NewAttribute("amount", coin.String()),
+			sdk.NewAttribute("currency", p.Currency),
+			sdk.NewAttribute("points", points.String()),
+			sdk.NewAttribute("recipient", memo.Recipient),
+		),
+	)
+	return points, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestTransferPoints(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(time.Unix(10*types.SecondsPerDay, 0))
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	alice, bob, carol := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: alice, Points: "100", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+
+	// transfers are disabled until the treasury sets a policy
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, bob, "10"))
+	require.ErrorIs(t, err, types.ErrTransferDisabled)
+
+	allowlist := types.NewMsgSetTransferPolicy(treasury, 1, types.TRANSFER_MODE_ALLOWLIST, []string{alice, bob}, "", "")
+	allowlist.Creator = alice
+	_, err = ms.SetTransferPolicy(ctx, allowlist)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	allowlist.Creator = treasury
+	_, err = ms.SetTransferPolicy(ctx, allowlist)
+	require.NoError(t, err)
+
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, carol, "10"))
+	require.ErrorIs(t, err, types.ErrTransferNotAllowed)
+	res, err := ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, bob, "10"))
+	require.NoError(t, err)
+	require.Equal(t, "90.000000000000000000", res.Balance)
+	require.Equal(t, "0.000000000000000000", res.Fee)
+	require.Equal(t, "10.000000000000000000", k.GetMemberBalance(ctx, 1, sdk.MustAccAddressFromBech32(bob)).String())
+
+	// open mode charges 10% on top and caps sends at 50 points a day
+	_, err = ms.SetTransferPolicy(ctx, types.NewMsgSetTransferPolicy(treasury, 1, types.TRANSFER_MODE_OPEN, nil, "0.1", "50"))
+	require.NoError(t, err)
+	res, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, carol, "30"))
+	require.NoError(t, err)
+	require.Equal(t, "57.000000000000000000", res.Balance)
+	require.Equal(t, "3.000000000000000000", res.Fee)
+	p, _ := k.GetPartner(ctx, 1)
+	require.Equal(t, "903.000000000000000000", p.AvailableLiquidity)
+
+	// the allowlist transfer earlier today counts towards the cap
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, carol, "11"))
+	require.ErrorIs(t, err, types.ErrTransferCapExceeded)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, carol, "10"))
+	require.NoError(t, err)
+
+	// the cap resets the next day, but the balance must cover the fee
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, carol, "46"))
+	require.ErrorIs(t, err, types.ErrInsufficientPoints)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(alice, 1, carol, "40"))
+	require.NoError(t, err)
+
+	history, err := k.MemberTransfers(ctx, &types.QueryMemberTransfersRequest{Member: carol})
+	require.NoError(t, err)
+	require.Len(t, history.Transfers, 3)
+	require.Equal(t, alice, history.Transfers[0].From)
+	history, err = k.MemberTransfers(ctx, &types.QueryMemberTransfersRequest{Member: alice, PartnerId: 1})
+	require.NoError(t, err)
+	require.Len(t, history.Transfers, 4)
+	history, err = k.MemberTransfers(ctx, &types.QueryMemberTransfersRequest{Member: alice, PartnerId: 2})
+	require.NoError(t, err)
+	require.Empty(t, history.Transfers)
+
+	policy, err := k.TransferPolicy(ctx, &types.QueryTransferPolicyRequest{PartnerId: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.TRANSFER_MODE_OPEN, policy.Policy.Mode)
+}
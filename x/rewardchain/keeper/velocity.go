@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+func (k Keeper) getVelocityLimitsStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.VelocityLimitsKeyPrefix)
+}
+
+func (k Keeper) getVelocityCounterStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.VelocityCounterKeyPrefix)
+}
+
+func (k Keeper) getVelocityExpiryStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.VelocityExpiryKeyPrefix)
+}
+
+func (k Keeper) getMemberSinceStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.MemberSinceKeyPrefix)
+}
+
+func (k Keeper) getFrozenMemberStore(ctx context.Context) prefix.Store {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(store, types.FrozenMemberKeyPrefix)
+}
+
+// GetVelocityLimits returns a partner's velocity limits. Partners without
+// limits enforce none.
+func (k Keeper) GetVelocityLimits(ctx context.Context, partnerID uint64) types.VelocityLimits {
+	bz := k.getVelocityLimitsStore(ctx).Get(types.PartnerKey(partnerID))
+	if bz == nil {
+		return types.VelocityLimits{PartnerId: partnerID}
+	}
+	var l types.VelocityLimits
+	k.cdc.MustUnmarshal(bz, &l)
+	return l
+}
+
+func (k Keeper) SetVelocityLimits(ctx context.Context, l types.VelocityLimits) error {
+	bz, err := k.cdc.Marshal(&l)
+	if err != nil {
+		return err
+	}
+	k.getVelocityLimitsStore(ctx).Set(types.PartnerKey(l.PartnerId), bz)
+	return nil
+}
+
+func (k Keeper) GetAllVelocityLimits(ctx context.Context) []types.VelocityLimits {
+	iter := k.getVelocityLimitsStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.VelocityLimits, 0)
+	for ; iter.Valid(); iter.Next() {
+		var l types.VelocityLimits
+		k.cdc.MustUnmarshal(iter.Value(), &l)
+		out = append(out, l)
+	}
+	return out
+}
+
+// SetVelocityCounter stores a redeem counter and queues it for pruning.
+func (k Keeper) SetVelocityCounter(ctx context.Context, c types.VelocityCounter) error {
+	member, err := sdk.AccAddressFromBech32(c.Member)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&c)
+	if err != nil {
+		return err
+	}
+	k.getVelocityCounterStore(ctx).Set(types.VelocityCounterKey(c.PartnerId, member, c.Bucket), bz)
+	k.getVelocityExpiryStore(ctx).Set(types.VelocityExpiryKey(c.Expires, c.PartnerId, member, c.Bucket), []byte{})
+	return nil
+}
+
+func (k Keeper) GetAllVelocityCounters(ctx context.Context) []types.VelocityCounter {
+	iter := k.getVelocityCounterStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.VelocityCounter, 0)
+	for ; iter.Valid(); iter.Next() {
+		var c types.VelocityCounter
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		out = append(out, c)
+	}
+	return out
+}
+
+// RedeemedSince sums the points a member redeemed with a partner in the
+// buckets starting at or after from.
+func (k Keeper) RedeemedSince(ctx context.Context, partnerID uint64, member sdk.AccAddress, from int64) math.LegacyDec {
+	if from < 0 {
+		from = 0
+	}
+	cs := prefix.NewStore(k.getVelocityCounterStore(ctx), types.VelocityCounterPrefix(partnerID, member))
+	iter := cs.Iterator(sdk.Uint64ToBigEndian(uint64(from)), nil)
+	defer iter.Close()
+
+	total := math.LegacyZeroDec()
+	for ; iter.Valid(); iter.Next() {
+		var c types.VelocityCounter
+		k.cdc.MustUnmarshal(iter.Value(), &c)
+		if redeemed, err := math.LegacyNewDecFromStr(c.Redeemed); err == nil {
+			total = total.Add(redeemed)
+		}
+	}
+	return total
+}
+
+// windowStart returns the first bucket of the rolling window ending now.
+func windowStart(now int64, l types.VelocityLimits) int64 {
+	return types.VelocityBucket(now - int64(l.WindowSeconds) + types.VelocityBucketSeconds)
+}
+
+// CheckRedeemVelocity returns ErrMemberFrozen or ErrVelocityLimit when the
+// member may not redeem points with the partner now.
+func (k Keeper) CheckRedeemVelocity(ctx context.Context, partnerID uint64, member sdk.AccAddress, points math.LegacyDec) error {
+	if f, frozen := k.GetFrozenMember(ctx, member); frozen {
+		return errorsmod.Wrap(types.ErrMemberFrozen, f.Reason)
+	}
+
+	l := k.GetVelocityLimits(ctx, partnerID)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	if l.NewMemberCooldownSeconds > 0 {
+		if since, found := k.GetMemberSince(ctx, partnerID, member); found {
+			if ends := since + int64(l.NewMemberCooldownSeconds); now < ends {
+				return errorsmod.Wrapf(types.ErrVelocityLimit, "new member cool-down ends at %d", ends)
+			}
+		}
+	}
+	if limit := l.PerTx(); limit.IsPositive() && points.GT(limit) {
+		return errorsmod.Wrapf(types.ErrVelocityLimit, "%s exceeds the per transaction limit of %s", points, limit)
+	}
+	if limit := l.PerDay(); limit.IsPositive() {
+		day := now - now%types.SecondsPerDay
+		if used := k.RedeemedSince(ctx, partnerID, member, day); used.Add(points).GT(limit) {
+			return errorsmod.Wrapf(types.ErrVelocityLimit, "redeemed %s of the daily limit of %s", used, limit)
+		}
+	}
+	if limit := l.PerWindow(); limit.IsPositive() {
+		if used := k.RedeemedSince(ctx, partnerID, member, windowStart(now, l)); used.Add(points).GT(limit) {
+			return errorsmod.Wrapf(types.ErrVelocityLimit, "redeemed %s of %s in the last %ds", used, limit, l.WindowSeconds)
+		}
+	}
+	return nil
+}
+
+// RecordRedeem adds points to the member's counter for the current bucket.
+func (k Keeper) RecordRedeem(ctx context.Context, partnerID uint64, member sdk.AccAddress, points math.LegacyDec) error {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	bucket := types.VelocityBucket(now)
+
+	c := types.VelocityCounter{
+		PartnerId: partnerID,
+		Member:    member.String(),
+		Bucket:    bucket,
+		Redeemed:  points.String(),
+		Expires:   bucket + types.VelocityBucketSeconds + k.GetVelocityLimits(ctx, partnerID).Retention(),
+	}
+	if bz := k.getVelocityCounterStore(ctx).Get(types.VelocityCounterKey(partnerID, member, bucket)); bz != nil {
+		var prev types.VelocityCounter
+		k.cdc.MustUnmarshal(bz, &prev)
+		if redeemed, err := math.LegacyNewDecFromStr(prev.Redeemed); err == nil {
+			c.Redeemed = redeemed.Add(points).String()
+		}
+		// keep a single pruning entry per counter
+		k.getVelocityExpiryStore(ctx).Delete(types.VelocityExpiryKey(prev.Expires, partnerID, member, bucket))
+	}
+	return k.SetVelocityCounter(ctx, c)
+}
+
+// PruneVelocityCounters removes redeem counters that expire at or before now.
+func (k Keeper) PruneVelocityCounters(ctx context.Context, now int64) {
+	es := k.getVelocityExpiryStore(ctx)
+	cs := k.getVelocityCounterStore(ctx)
+
+	iter := es.Iterator(nil, sdk.Uint64ToBigEndian(uint64(now)+1))
+	defer iter.Close()
+
+	var done [][]byte
+	for ; iter.Valid(); iter.Next() {
+		done = append(done, append([]byte(nil), iter.Key()...))
+	}
+	for _, key := range done {
+		es.Delete(key)
+		cs.Delete(key[8:])
+	}
+}
+
+// GetMemberSince returns when a member first received points with a partner.
+func (k Keeper) GetMemberSince(ctx context.Context, partnerID uint64, member sdk.AccAddress) (int64, bool) {
+	bz := k.getMemberSinceStore(ctx).Get(types.MemberBalanceKey(partnerID, member))
+	if bz == nil {
+		return 0, false
+	}
+	var m types.MemberSince
+	k.cdc.MustUnmarshal(bz, &m)
+	return m.Since, true
+}
+
+func (k Keeper) SetMemberSince(ctx context.Context, m types.MemberSince) error {
+	member, err := sdk.AccAddressFromBech32(m.Member)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&m)
+	if err != nil {
+		return err
+	}
+	k.getMemberSinceStore(ctx).Set(types.MemberBalanceKey(m.PartnerId, member), bz)
+	return nil
+}
+
+func (k Keeper) GetAllMemberSince(ctx context.Context) []types.MemberSince {
+	iter := k.getMemberSinceStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.MemberSince, 0)
+	for ; iter.Valid(); iter.Next() {
+		var m types.MemberSince
+		k.cdc.MustUnmarshal(iter.Value(), &m)
+		out = append(out, m)
+	}
+	return out
+}
+
+// GetFrozenMember returns the freeze record of a member, if any.
+func (k Keeper) GetFrozenMember(ctx context.Context, member sdk.AccAddress) (types.FrozenMember, bool) {
+	bz := k.getFrozenMemberStore(ctx).Get(member)
+	if bz == nil {
+		return types.FrozenMember{}, false
+	}
+	var f types.FrozenMember
+	k.cdc.MustUnmarshal(bz, &f)
+	return f, true
+}
+
+func (k Keeper) SetFrozenMember(ctx context.Context, f types.FrozenMember) error {
+	member, err := sdk.AccAddressFromBech32(f.Member)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&f)
+	if err != nil {
+		return err
+	}
+	k.getFrozenMemberStore(ctx).Set(member, bz)
+	return nil
+}
+
+func (k Keeper) RemoveFrozenMember(ctx context.Context, member sdk.AccAddress) {
+	k.getFrozenMemberStore(ctx).Delete(member)
+}
+
+func (k Keeper) GetAllFrozenMembers(ctx context.Context) []types.FrozenMember {
+	iter := k.getFrozenMemberStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	out := make([]types.FrozenMember, 0)
+	for ; iter.Valid(); iter.Next() {
+		var f types.FrozenMember
+		k.cdc.MustUnmarshal(iter.Value(), &f)
+		out = append(out, f)
+	}
+	return out
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestRedeemVelocityLimits(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	start := time.Unix(10*types.SecondsPerDay+1_000, 0)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(start)
+
+	admin := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.AdminAddresses = []string{admin}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	memberAddr := sdk.MustAccAddressFromBech32(member)
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "500", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+
+	limits := types.NewMsgSetVelocityLimits(treasury, 1, "50", "80", "60", 3_600, 600)
+	_, err = ms.SetVelocityLimits(ctx, types.NewMsgSetVelocityLimits(member, 1, "50", "", "", 0, 0))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.SetVelocityLimits(ctx, types.NewMsgSetVelocityLimits(treasury, 1, "", "", "60", 0, 0))
+	require.ErrorIs(t, err, types.ErrInvalidVelocityLimits)
+	_, err = ms.SetVelocityLimits(ctx, limits)
+	require.NoError(t, err)
+
+	// new member cool-down
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Minute))
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "10"))
+	require.ErrorIs(t, err, types.ErrVelocityLimit)
+
+	ctx = ctx.WithBlockTime(start.Add(11 * time.Minute))
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "51"))
+	require.ErrorIs(t, err, types.ErrVelocityLimit)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "40"))
+	require.NoError(t, err)
+
+	// rolling window of an hour
+	ctx = ctx.WithBlockTime(start.Add(40 * time.Minute))
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "30"))
+	require.ErrorIs(t, err, types.ErrVelocityLimit)
+	ctx = ctx.WithBlockTime(start.Add(75 * time.Minute))
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "30"))
+	require.NoError(t, err)
+
+	// the day still counts both redemptions
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "20"))
+	require.ErrorIs(t, err, types.ErrVelocityLimit)
+	v, err := k.MemberVelocity(ctx, &types.QueryMemberVelocityRequest{PartnerId: 1, Member: member})
+	require.NoError(t, err)
+	require.Equal(t, "70.000000000000000000", v.RedeemedToday)
+	require.Equal(t, "0.000000000000000000", v.RedeemedInWindow)
+	require.Equal(t, start.Unix()+600, v.CooldownEnds)
+
+	// admins can freeze a member out of redeeming and transferring
+	_, err = ms.FreezeMember(ctx, types.NewMsgFreezeMember(treasury, member, "stolen phone"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = ms.FreezeMember(ctx, types.NewMsgFreezeMember(admin, member, "stolen phone"))
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "1"))
+	require.ErrorIs(t, err, types.ErrMemberFrozen)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(member, 1, sample.AccAddress(), "1"))
+	require.ErrorIs(t, err, types.ErrMemberFrozen)
+	v, err = k.MemberVelocity(ctx, &types.QueryMemberVelocityRequest{PartnerId: 1, Member: member})
+	require.NoError(t, err)
+	require.True(t, v.Frozen)
+	_, err = ms.UnfreezeMember(ctx, types.NewMsgUnfreezeMember(admin, member))
+	require.NoError(t, err)
+	_, err = ms.UnfreezeMember(ctx, types.NewMsgUnfreezeMember(admin, member))
+	require.ErrorIs(t, err, types.ErrMemberFrozen)
+
+	// counters are pruned once neither the day nor the window needs them
+	ctx = ctx.WithBlockTime(start.Add(time.Hour * 24))
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Len(t, k.GetAllVelocityCounters(ctx), 2)
+	ctx = ctx.WithBlockTime(start.Add(time.Hour * 26))
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Empty(t, k.GetAllVelocityCounters(ctx))
+	require.Equal(t, "430.000000000000000000", k.GetMemberBalance(ctx, 1, memberAddr).String())
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "50"))
+	require.NoError(t, err)
+}
+
+func TestTransferVelocityLimits(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(time.Unix(10*types.SecondsPerDay+1_000, 0))
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	member, other := sample.AccAddress(), sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: member, Points: "500", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+	_, err = ms.SetTransferPolicy(ctx, types.NewMsgSetTransferPolicy(treasury, 1, types.TRANSFER_MODE_OPEN, nil, "0.1", ""))
+	require.NoError(t, err)
+	_, err = ms.SetVelocityLimits(ctx, types.NewMsgSetVelocityLimits(treasury, 1, "50", "80", "", 0, 0))
+	require.NoError(t, err)
+
+	// a transfer counts with its fee against the per transaction limit
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(member, 1, other, "46"))
+	require.ErrorIs(t, err, types.ErrVelocityLimit)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(member, 1, other, "40"))
+	require.NoError(t, err)
+
+	// transfers and redemptions share the daily limit
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(member, 1, "37"))
+	require.ErrorIs(t, err, types.ErrVelocityLimit)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(member, 1, other, "33"))
+	require.ErrorIs(t, err, types.ErrVelocityLimit)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(member, 1, other, "30"))
+	require.NoError(t, err)
+
+	// a KYC requirement applies to transfers too
+	_, err = ms.SetKycRequirement(ctx, types.NewMsgSetKycRequirement(treasury, 1, "10", 1, nil))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(48 * time.Hour))
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(member, 1, other, "10"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+	_, err = ms.TransferPoints(ctx, types.NewMsgTransferPoints(member, 1, other, "9"))
+	require.NoError(t, err)
+}
+
+func TestSwapKeepsRedeemAllowance(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(time.Unix(10*types.SecondsPerDay+1_000, 0))
+
+	admin := addAdmin(t, k, ctx)
+	treasury := sample.AccAddress()
+	_, err := ms.CreatePartner(ctx, &types.MsgCreatePartner{
+		Creator:        admin,
+		Treasury:       treasury,
+		Name:           "Acme",
+		Country:        "IN",
+		Currency:       "INR",
+		TotalLiquidity: "1000",
+	})
+	require.NoError(t, err)
+	_, err = ms.BatchEarnPoints(ctx, types.NewMsgBatchEarnPoints(treasury, 1, []types.EarnEntry{
+		{Member: treasury, Points: "100", ReferenceId: "order-1"},
+	}, false))
+	require.NoError(t, err)
+	_, err = ms.SetVelocityLimits(ctx, types.NewMsgSetVelocityLimits(treasury, 1, "", "50", "", 0, 0))
+	require.NoError(t, err)
+	_, err = ms.SetKycRequirement(ctx, types.NewMsgSetKycRequirement(treasury, 1, "100", 1, nil))
+	require.NoError(t, err)
+
+	// a swap leaves the redeem allowance alone but counts towards KYC
+	_, err = ms.Swap(ctx, types.NewMsgSwap(treasury, 1, "points_to_token", "60"))
+	require.NoError(t, err)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(treasury, 1, "50"))
+	require.ErrorIs(t, err, types.ErrKycRequired)
+	_, err = ms.RedeemPoints(ctx, types.NewMsgRedeemPoints(treasury, 1, "40"))
+	require.NoError(t, err)
+
+	res, err := k.MemberVelocity(ctx, &types.QueryMemberVelocityRequest{PartnerId: 1, Member: treasury})
+	require.NoError(t, err)
+	require.Equal(t, "40.000000000000000000", res.RedeemedToday)
+
+	// payout counters are pruned once they leave the KYC window
+	require.Len(t, k.GetAllKycPayouts(ctx), 1)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(25 * time.Hour))
+	require.NoError(t, k.EndBlocker(ctx))
+	require.Empty(t, k.GetAllKycPayouts(ctx))
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: modulev1.Query_ServiceDesc.ServiceName,
+			Service:              modulev1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -30,11 +31,194 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "partners",
 					Short:     "Lists partners",
 				},
+				{
+					RpcMethod: "PartnerSponsorships",
+					Use:       "partner-sponsorships [partner-id]",
+					Short:     "Shows a partner's member sponsorships and their remaining allowance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod: "MemberBalance",
+					Use:       "member-balance [partner-id] [member]",
+					Short:     "Shows a member's points balance with a partner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+						{ProtoField: "member"},
+					},
+				},
+				{
+					RpcMethod: "ReceiptKey",
+					Use:       "receipt-key [partner-id]",
+					Short:     "Shows the key a partner signs earn receipts with",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod: "ClientRef",
+					Use:       "client-ref [signer] [client-ref]",
+					Short:     "Shows the outcome of a message sent with a client ref",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "signer"},
+						{ProtoField: "client_ref"},
+					},
+				},
+				{
+					RpcMethod: "Settlement",
+					Use:       "settlement [id]",
+					Short:     "Shows a settlement",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "PartnerSettlements",
+					Use:       "partner-settlements [partner-id]",
+					Short:     "Lists a partner's open settlements, or closed ones with --closed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod: "SettlementEscrow",
+					Use:       "settlement-escrow [partner-id]",
+					Short:     "Shows the coins a partner holds in settlement escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod:      "ExchangeRate",
+					Use:            "exchange-rate [base] [quote]",
+					Short:          "Shows the oracle rate between two currencies",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "base"},
+						{ProtoField: "quote"},
+					},
+				},
+				{
+					RpcMethod: "ExchangeRates",
+					Use:       "exchange-rates",
+					Short:     "Lists the aggregated oracle exchange rates",
+				},
+				{
+					RpcMethod:      "TransferPolicy",
+					Use:            "transfer-policy [partner-id]",
+					Short:          "Shows a partner's member transfer policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod:      "MemberTransfers",
+					Use:            "member-transfers [member]",
+					Short:          "Lists the points transfers a member sent or received (filter with --partner-id)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "member"},
+					},
+				},
+				{
+					RpcMethod:      "VelocityLimits",
+					Use:            "velocity-limits [partner-id]",
+					Short:          "Shows a partner's redeem velocity limits",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod:      "MemberVelocity",
+					Use:            "member-velocity [partner-id] [member]",
+					Short:          "Shows a member's redeem usage, cool-down and freeze status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+						{ProtoField: "member"},
+					},
+				},
+				{
+					RpcMethod:      "Attestations",
+					Use:            "attestations [member]",
+					Short:          "Lists a member's KYC attestations and effective level",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "member"},
+					},
+				},
+				{
+					RpcMethod:      "KycRequirement",
+					Use:            "kyc-requirement [partner-id]",
+					Short:          "Shows a partner's KYC requirement for payouts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod:      "BlockedAddress",
+					Use:            "blocked-address [address]",
+					Short:          "Shows whether an address is on the blocklist",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "BlockedAddresses",
+					Use:       "blocked-addresses",
+					Short:     "Lists the blocklist",
+				},
+				{
+					RpcMethod:      "LiquidityStatus",
+					Use:            "liquidity-status [partner-id]",
+					Short:          "Shows a partner's liquidity thresholds, level and earn pause",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod:      "PartnerLiquidityHistory",
+					Use:            "partner-liquidity-history [partner-id] [from] [to]",
+					Short:          "Lists a partner's liquidity checkpoints between two heights, oldest first; a zero to means up to the latest",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+						{ProtoField: "from"},
+						{ProtoField: "to"},
+					},
+				},
+				{
+					RpcMethod: "PauseStatus",
+					Use:       "pause-status",
+					Short:     "Shows which operations are paused",
+				},
+				{
+					RpcMethod:      "PointsEscrow",
+					Use:            "points-escrow [channel-id] [partner-id]",
+					Short:          "Shows the partner points escrowed for a rewardpoints channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel_id"},
+						{ProtoField: "partner_id"},
+					},
+				},
+				{
+					RpcMethod:      "PointsVouchers",
+					Use:            "points-vouchers [holder]",
+					Short:          "Lists the vouchers a member received over rewardpoints channels",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "holder"},
+					},
+				},
+				{
+					RpcMethod:      "RemoteTreasury",
+					Use:            "remote-treasury [partner-id]",
+					Short:          "Shows a partner's remote treasury and its interchain account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partner_id"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service: modulev1.Msg_ServiceDesc.ServiceName,
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "UpdateParams",
@@ -75,6 +259,259 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "points"},
 					},
 				},
+				{
+					RpcMethod:      "DisablePartner",
+					Use:            "disable-partner [partner-id]",
+					Short:          "Disable a partner and revoke its member sponsorships",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+					},
+				},
+				{
+					RpcMethod:      "SponsorMembers",
+					Use:            "sponsor-members [partner-id] [spend-limit] [members]",
+					Short:          "Grant members a fee allowance from the partner treasury",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "spendLimit"},
+						{ProtoField: "members", Varargs: true},
+					},
+				},
+				{
+					RpcMethod:      "SetReceiptKey",
+					Use:            "set-receipt-key [partner-id] [key-type] [pub-key]",
+					Short:          "Register the key a partner signs earn receipts with",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "keyType"},
+						{ProtoField: "pubKey"},
+					},
+				},
+				{
+					RpcMethod: "ClaimReceipt",
+					Skip:      true, // receipts are submitted with the receipt package or a JSON tx
+				},
+				{
+					RpcMethod: "BatchEarnPoints",
+					Skip:      true, // batches are submitted as a JSON tx
+				},
+				{
+					RpcMethod:      "ReversePointsEarn",
+					Use:            "reverse-points-earn [partner-id] [reference-id]",
+					Short:          "Claw back points issued under an earn reference id",
+					Long:           "Claw back points issued under an earn reference id. Without --points whatever is left of the earn is reversed.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "referenceId"},
+					},
+				},
+				{
+					RpcMethod:      "RecordObligation",
+					Use:            "record-obligation [payer-id] [payee-id] [amount] [currency]",
+					Short:          "Record an amount the payer partner owes the payee partner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "payerId"},
+						{ProtoField: "payeeId"},
+						{ProtoField: "amount"},
+						{ProtoField: "currency"},
+					},
+				},
+				{
+					RpcMethod:      "FundSettlementEscrow",
+					Use:            "fund-settlement-escrow [partner-id] [amount]",
+					Short:          "Deposit coins from the partner treasury into settlement escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod:      "WithdrawSettlementEscrow",
+					Use:            "withdraw-settlement-escrow [partner-id] [amount]",
+					Short:          "Withdraw coins from settlement escrow to the partner treasury",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod:      "ConfirmSettlement",
+					Use:            "confirm-settlement [settlement-id] [method]",
+					Short:          "Close a settlement as paid offchain (--offchain-ref) or from escrow (--payment)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "settlementId"},
+						{ProtoField: "method"},
+					},
+				},
+				{
+					RpcMethod:      "RedeemPoints",
+					Use:            "redeem-points [partner-id] [points]",
+					Short:          "Redeem points from your balance with a partner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "points"},
+					},
+				},
+				{
+					RpcMethod:      "SubmitRate",
+					Use:            "submit-rate [base] [quote] [rate]",
+					Short:          "Submit an exchange rate as an oracle feeder",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "base"},
+						{ProtoField: "quote"},
+						{ProtoField: "rate"},
+					},
+				},
+				{
+					RpcMethod:      "SetTransferPolicy",
+					Use:            "set-transfer-policy [partner-id] [mode]",
+					Short:          "Set a partner's member transfer policy (--allowlist, --fee-rate, --daily-cap)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "mode"},
+					},
+				},
+				{
+					RpcMethod:      "TransferPoints",
+					Use:            "transfer-points [partner-id] [to] [amount]",
+					Short:          "Send points to another member of a partner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "to"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod:      "SetVelocityLimits",
+					Use:            "set-velocity-limits [partner-id]",
+					Short:          "Set a partner's redeem velocity limits (--max-redeem-per-tx, --max-redeem-per-day, --max-redeem-per-window, --window-seconds, --new-member-cooldown-seconds)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+					},
+				},
+				{
+					RpcMethod:      "FreezeMember",
+					Use:            "freeze-member [member] [reason]",
+					Short:          "Block a member from redeeming and sending points",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "member"},
+						{ProtoField: "reason"},
+					},
+				},
+				{
+					RpcMethod:      "UnfreezeMember",
+					Use:            "unfreeze-member [member]",
+					Short:          "Lift a member freeze",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "member"},
+					},
+				},
+				{
+					RpcMethod:      "RecordAttestation",
+					Use:            "record-attestation [member] [level] [expires] [jurisdiction] [evidence-hash]",
+					Short:          "Record a KYC attestation of a member as an accredited attestor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "member"},
+						{ProtoField: "level"},
+						{ProtoField: "expires"},
+						{ProtoField: "jurisdiction"},
+						{ProtoField: "evidenceHash"},
+					},
+				},
+				{
+					RpcMethod:      "RevokeAttestation",
+					Use:            "revoke-attestation [member]",
+					Short:          "Revoke your attestation of a member",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "member"},
+					},
+				},
+				{
+					RpcMethod:      "SetKycRequirement",
+					Use:            "set-kyc-requirement [partner-id] [threshold] [min-level]",
+					Short:          "Set the attestation level a partner requires for payouts (--jurisdictions)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "threshold"},
+						{ProtoField: "minLevel"},
+					},
+				},
+				{
+					RpcMethod:      "BlockAddress",
+					Use:            "block-address [address] [reason] [note]",
+					Short:          "Add an address to the blocklist as an admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "reason"},
+						// a note flag would clash with the tx --note flag
+						{ProtoField: "note", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "UnblockAddress",
+					Use:            "unblock-address [address] [reason] [note]",
+					Short:          "Remove an address from the blocklist as an admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "reason"},
+						// a note flag would clash with the tx --note flag
+						{ProtoField: "note", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "SetLiquidityThresholds",
+					Use:            "set-liquidity-thresholds [partner-id] [warning] [critical]",
+					Short:          "Set a partner's low liquidity thresholds (--auto-pause-earn)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "warning"},
+						{ProtoField: "critical"},
+					},
+				},
+				{
+					RpcMethod:      "SetPaused",
+					Use:            "set-paused [operation] [paused]",
+					Short:          "Pause an operation as an emergency admin, or resume it through governance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "operation"},
+						{ProtoField: "paused"},
+					},
+				},
+				{
+					RpcMethod:      "SendPoints",
+					Use:            "send-points [partner-id] [source-channel] [receiver] [points]",
+					Short:          "Send partner points to a member on another chain over a rewardpoints channel",
+					Long:           "Set --voucher to send points held as a voucher received on the channel back to the chain of their partner.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "sourceChannel"},
+						{ProtoField: "receiver"},
+						{ProtoField: "points"},
+					},
+				},
+				{
+					RpcMethod:      "RegisterRemoteTreasury",
+					Use:            "register-remote-treasury [partner-id] [connection-id] [escrow-address] [denom] [currency] [exponent]",
+					Short:          "Register an interchain account that tops up a partner's liquidity from its treasury on another chain",
+					Long:           "Set --top-up-threshold and --top-up-amount to top up automatically whenever the partner's available liquidity drops below the threshold.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "connectionId"},
+						{ProtoField: "escrowAddress"},
+						{ProtoField: "denom"},
+						{ProtoField: "currency"},
+						{ProtoField: "exponent"},
+					},
+				},
+				{
+					RpcMethod:      "ExecuteTopUp",
+					Use:            "execute-top-up [partner-id] [amount]",
+					Short:          "Have a partner's interchain account send amount to its escrow; liquidity is credited once the transfer is acknowledged",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "partnerId"},
+						{ProtoField: "amount"},
+					},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
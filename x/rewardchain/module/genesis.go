@@ -1,6 +1,7 @@
 package rewardchain
 
 import (
+	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"rewardchain/x/rewardchain/keeper"
@@ -26,6 +27,180 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if maxID > 0 {
 		k.SetPartnerCounter(ctx, maxID)
 	}
+
+	for _, s := range genState.Sponsorships {
+		if err := k.SetSponsorship(ctx, s); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, b := range genState.MemberBalances {
+		member, err := sdk.AccAddressFromBech32(b.Member)
+		if err != nil {
+			panic(err)
+		}
+		points, err := math.LegacyNewDecFromStr(b.Points)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetMemberBalance(ctx, b.PartnerId, member, points); err != nil {
+			panic(err)
+		}
+	}
+	for _, rk := range genState.ReceiptKeys {
+		if err := k.SetReceiptKey(ctx, rk); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range genState.ClaimedReceipts {
+		if err := k.SetClaimedReceipt(ctx, c); err != nil {
+			panic(err)
+		}
+	}
+	for _, r := range genState.EarnRecords {
+		if err := k.SetEarnRecord(ctx, r); err != nil {
+			panic(err)
+		}
+	}
+	for _, d := range genState.MemberDebts {
+		member, err := sdk.AccAddressFromBech32(d.Member)
+		if err != nil {
+			panic(err)
+		}
+		points, err := math.LegacyNewDecFromStr(d.Points)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetMemberDebt(ctx, d.PartnerId, member, points); err != nil {
+			panic(err)
+		}
+	}
+	for _, o := range genState.Obligations {
+		if err := k.SetObligation(ctx, o); err != nil {
+			panic(err)
+		}
+	}
+	for _, s := range genState.Settlements {
+		if err := k.SetSettlement(ctx, s); err != nil {
+			panic(err)
+		}
+	}
+	for _, e := range genState.SettlementEscrows {
+		if err := k.SetSettlementEscrow(ctx, e.PartnerId, e.Balance); err != nil {
+			panic(err)
+		}
+	}
+	for _, r := range genState.ExchangeRates {
+		if err := k.SetExchangeRate(ctx, r); err != nil {
+			panic(err)
+		}
+	}
+	for _, s := range genState.RateSubmissions {
+		if err := k.SetRateSubmission(ctx, s); err != nil {
+			panic(err)
+		}
+	}
+	for _, tp := range genState.TransferPolicies {
+		if err := k.SetTransferPolicy(ctx, tp); err != nil {
+			panic(err)
+		}
+	}
+	for _, t := range genState.PointsTransfers {
+		if err := k.SetPointsTransfer(ctx, t); err != nil {
+			panic(err)
+		}
+	}
+	for _, u := range genState.TransferUsages {
+		if err := k.SetTransferUsage(ctx, u); err != nil {
+			panic(err)
+		}
+	}
+	for _, l := range genState.VelocityLimits {
+		if err := k.SetVelocityLimits(ctx, l); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range genState.VelocityCounters {
+		if err := k.SetVelocityCounter(ctx, c); err != nil {
+			panic(err)
+		}
+	}
+	for _, m := range genState.MemberSince {
+		if err := k.SetMemberSince(ctx, m); err != nil {
+			panic(err)
+		}
+	}
+	for _, f := range genState.FrozenMembers {
+		if err := k.SetFrozenMember(ctx, f); err != nil {
+			panic(err)
+		}
+	}
+	for _, a := range genState.Attestations {
+		if err := k.SetAttestation(ctx, a); err != nil {
+			panic(err)
+		}
+	}
+	for _, r := range genState.KycRequirements {
+		if err := k.SetKycRequirement(ctx, r); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range genState.KycPayouts {
+		if err := k.SetKycPayout(ctx, c); err != nil {
+			panic(err)
+		}
+	}
+	for _, b := range genState.BlockedAddresses {
+		if err := k.SetBlockedAddress(ctx, b); err != nil {
+			panic(err)
+		}
+	}
+	for _, t := range genState.LiquidityThresholds {
+		if err := k.SetLiquidityThresholds(ctx, t); err != nil {
+			panic(err)
+		}
+	}
+	for _, st := range genState.LiquidityStatuses {
+		if err := k.SetLiquidityStatus(ctx, st); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range genState.LiquidityCheckpoints {
+		if err := k.SetLiquidityCheckpoint(ctx, c); err != nil {
+			panic(err)
+		}
+	}
+	for _, r := range genState.ClientRefs {
+		if err := k.SetClientRefRecord(ctx, r); err != nil {
+			panic(err)
+		}
+	}
+
+	portID := genState.PortId
+	if portID == "" {
+		portID = types.PortID
+	}
+	k.SetPort(ctx, portID)
+	if k.ShouldBound(ctx, portID) {
+		if err := k.BindPort(ctx, portID); err != nil {
+			panic(err)
+		}
+	}
+	for _, e := range genState.PointsEscrows {
+		if err := k.SetPointsEscrow(ctx, e); err != nil {
+			panic(err)
+		}
+	}
+	for _, v := range genState.PointsVouchers {
+		if err := k.SetPointsVoucher(ctx, v); err != nil {
+			panic(err)
+		}
+	}
+	for _, rt := range genState.RemoteTreasuries {
+		if err := k.SetRemoteTreasury(ctx, rt); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -40,5 +215,38 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.Partners = partners
 
+	for _, p := range partners {
+		genesis.Sponsorships = append(genesis.Sponsorships, k.GetPartnerSponsorships(ctx, p.Id)...)
+	}
+	genesis.MemberBalances = k.GetAllMemberBalances(ctx)
+	genesis.ReceiptKeys = k.GetAllReceiptKeys(ctx)
+	genesis.ClaimedReceipts = k.GetAllClaimedReceipts(ctx)
+	genesis.EarnRecords = k.GetAllEarnRecords(ctx)
+	genesis.ClientRefs = k.GetAllClientRefRecords(ctx)
+	genesis.MemberDebts = k.GetAllMemberDebts(ctx)
+	genesis.Obligations = k.GetAllObligations(ctx)
+	genesis.Settlements = k.GetAllSettlements(ctx)
+	genesis.SettlementEscrows = k.GetAllSettlementEscrows(ctx)
+	genesis.ExchangeRates = k.GetAllExchangeRates(ctx)
+	genesis.RateSubmissions = k.GetAllRateSubmissions(ctx)
+	genesis.TransferPolicies = k.GetAllTransferPolicies(ctx)
+	genesis.PointsTransfers = k.GetAllPointsTransfers(ctx)
+	genesis.TransferUsages = k.GetAllTransferUsages(ctx)
+	genesis.VelocityLimits = k.GetAllVelocityLimits(ctx)
+	genesis.VelocityCounters = k.GetAllVelocityCounters(ctx)
+	genesis.MemberSince = k.GetAllMemberSince(ctx)
+	genesis.FrozenMembers = k.GetAllFrozenMembers(ctx)
+	genesis.Attestations = k.GetAllAttestations(ctx)
+	genesis.KycRequirements = k.GetAllKycRequirements(ctx)
+	genesis.KycPayouts = k.GetAllKycPayouts(ctx)
+	genesis.BlockedAddresses = k.GetAllBlockedAddresses(ctx)
+	genesis.LiquidityThresholds = k.GetAllLiquidityThresholds(ctx)
+	genesis.LiquidityStatuses = k.GetAllLiquidityStatuses(ctx)
+	genesis.LiquidityCheckpoints = k.GetAllLiquidityCheckpoints(ctx)
+	genesis.PortId = k.GetPort(ctx)
+	genesis.PointsEscrows = k.GetAllPointsEscrows(ctx)
+	genesis.PointsVouchers = k.GetAllPointsVouchers(ctx)
+	genesis.RemoteTreasuries = k.GetAllRemoteTreasuries(ctx)
+
 	return genesis
 }
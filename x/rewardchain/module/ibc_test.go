@@ -0,0 +1,245 @@
+package rewardchain_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	math "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/app"
+	"rewardchain/x/rewardchain/types"
+)
+
+// newCoordinator starts two in-process rewardchain chains.
+func newCoordinator(t *testing.T) *ibctesting.Coordinator {
+	t.Helper()
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		a, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		if err != nil {
+			panic(err)
+		}
+		return a, a.DefaultGenesis()
+	}
+	return ibctesting.NewCoordinator(t, 2)
+}
+
+func setupRewardpointsPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	t.Helper()
+	coord := newCoordinator(t)
+	path := ibctesting.NewPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	for _, ep := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		ep.ChannelConfig.PortID = types.PortID
+		ep.ChannelConfig.Version = types.Version
+		ep.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coord.Setup(path)
+	return coord, path
+}
+
+func rewardchainApp(chain *ibctesting.TestChain) *app.App {
+	return chain.App.(*app.App)
+}
+
+func TestRewardpointsTransfer(t *testing.T) {
+	coord, path := setupRewardpointsPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	keeperA, keeperB := rewardchainApp(chainA).RewardchainKeeper, rewardchainApp(chainB).RewardchainKeeper
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainB.SenderAccount.GetAddress()
+	require.NoError(t, keeperA.SetPartner(chainA.GetContext(), types.Partner{
+		Id:                 1,
+		Name:               "Acme",
+		Country:            "IN",
+		Currency:           "INR",
+		TotalLiquidity:     "1000",
+		AvailableLiquidity: "1000",
+		RedeemCostPerPoint: "0.5",
+	}))
+	require.NoError(t, keeperA.SetMemberBalance(chainA.GetContext(), 1, sender, math.LegacyNewDec(100)))
+
+	send := func(points string, timeout uint64) channeltypes.Packet {
+		res, err := chainA.SendMsgs(&types.MsgSendPoints{
+			Creator:          sender.String(),
+			PartnerId:        1,
+			SourceChannel:    path.EndpointA.ChannelID,
+			Receiver:         receiver.String(),
+			Points:           points,
+			TimeoutTimestamp: timeout,
+		})
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		return packet
+	}
+	balanceA := func() math.LegacyDec {
+		return keeperA.GetMemberBalance(chainA.GetContext(), 1, sender)
+	}
+	escrowA := func() math.LegacyDec {
+		return keeperA.GetPointsEscrow(chainA.GetContext(), path.EndpointA.ChannelID, 1)
+	}
+
+	// points are escrowed on send and minted as vouchers on receive
+	packet := send("40", 0)
+	require.True(t, balanceA().Equal(math.LegacyNewDec(60)))
+	require.True(t, escrowA().Equal(math.LegacyNewDec(40)))
+	require.NoError(t, path.RelayPacket(packet))
+
+	v, found := keeperB.GetPointsVoucher(chainB.GetContext(), receiver, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+	require.True(t, found)
+	require.Equal(t, "40.000000000000000000", v.Points)
+	require.Equal(t, "0.5", v.SourceRate)
+	require.True(t, escrowA().Equal(math.LegacyNewDec(40)))
+
+	// an error acknowledgement refunds the sender
+	require.NoError(t, keeperB.SetBlockedAddress(chainB.GetContext(), types.BlockedAddress{
+		Address: receiver.String(),
+		Reason:  types.BLOCK_REASON_FRAUD,
+	}))
+	packet = send("10", 0)
+	require.True(t, balanceA().Equal(math.LegacyNewDec(50)))
+	require.NoError(t, path.RelayPacket(packet))
+	require.True(t, balanceA().Equal(math.LegacyNewDec(60)))
+	require.True(t, escrowA().Equal(math.LegacyNewDec(40)))
+	v, _ = keeperB.GetPointsVoucher(chainB.GetContext(), receiver, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+	require.Equal(t, "40.000000000000000000", v.Points)
+
+	// a timed out packet refunds the sender
+	timeout := uint64(chainB.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	packet = send("20", timeout)
+	require.True(t, balanceA().Equal(math.LegacyNewDec(40)))
+	coord.IncrementTimeBy(2 * time.Minute)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.True(t, balanceA().Equal(math.LegacyNewDec(60)))
+	require.True(t, escrowA().Equal(math.LegacyNewDec(40)))
+
+	// sending more than the balance fails before anything is escrowed
+	_, err := chainA.SendMsgs(&types.MsgSendPoints{
+		Creator:       sender.String(),
+		PartnerId:     1,
+		SourceChannel: path.EndpointA.ChannelID,
+		Receiver:      receiver.String(),
+		Points:        "61",
+	})
+	require.ErrorContains(t, err, types.ErrInsufficientPoints.Error())
+	require.True(t, escrowA().Equal(math.LegacyNewDec(40)))
+
+	// sends are gated like redemptions
+	sendErr := func(points string) error {
+		_, err := chainA.SendMsgs(types.NewMsgSendPoints(sender.String(), 1, path.EndpointA.ChannelID, receiver.String(), points, 0, false))
+		return err
+	}
+	params := keeperA.GetParams(chainA.GetContext())
+	params.Paused.Redeem = true
+	require.NoError(t, keeperA.SetParams(chainA.GetContext(), params))
+	require.ErrorContains(t, sendErr("1"), types.ErrOperationPaused.Error())
+	params.Paused.Redeem = false
+	require.NoError(t, keeperA.SetParams(chainA.GetContext(), params))
+
+	require.NoError(t, keeperA.SetVelocityLimits(chainA.GetContext(), types.VelocityLimits{PartnerId: 1, MaxRedeemPerTx: "5"}))
+	require.ErrorContains(t, sendErr("6"), types.ErrVelocityLimit.Error())
+	require.NoError(t, keeperA.SetVelocityLimits(chainA.GetContext(), types.VelocityLimits{PartnerId: 1}))
+
+	// all 70 points sent so far, refunded ones included, count towards the
+	// KYC threshold
+	require.NoError(t, keeperA.SetKycRequirement(chainA.GetContext(), types.KycRequirement{PartnerId: 1, Threshold: "75", MinLevel: 1}))
+	require.ErrorContains(t, sendErr("6"), types.ErrKycRequired.Error())
+	require.NoError(t, sendErr("5"))
+	require.True(t, balanceA().Equal(math.LegacyNewDec(55)))
+}
+
+func TestRewardpointsReturn(t *testing.T) {
+	coord, path := setupRewardpointsPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	keeperA, keeperB := rewardchainApp(chainA).RewardchainKeeper, rewardchainApp(chainB).RewardchainKeeper
+
+	member := chainA.SenderAccount.GetAddress()
+	holder := chainB.SenderAccount.GetAddress()
+	require.NoError(t, keeperA.SetPartner(chainA.GetContext(), types.Partner{
+		Id:                 1,
+		Name:               "Acme",
+		Country:            "IN",
+		Currency:           "INR",
+		TotalLiquidity:     "1000",
+		AvailableLiquidity: "1000",
+		RedeemCostPerPoint: "0.5",
+	}))
+	require.NoError(t, keeperA.SetMemberBalance(chainA.GetContext(), 1, member, math.LegacyNewDec(100)))
+
+	relay := func(chain *ibctesting.TestChain, msg *types.MsgSendPoints) channeltypes.Packet {
+		res, err := chain.SendMsgs(msg)
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		return packet
+	}
+	balanceA := func() math.LegacyDec {
+		return keeperA.GetMemberBalance(chainA.GetContext(), 1, member)
+	}
+	escrowA := func() math.LegacyDec {
+		return keeperA.GetPointsEscrow(chainA.GetContext(), path.EndpointA.ChannelID, 1)
+	}
+	voucherB := func() string {
+		v, _ := keeperB.GetPointsVoucher(chainB.GetContext(), holder, types.PortID, path.EndpointB.ChannelID, 1)
+		return v.Points
+	}
+	sendBack := func(points string, timeout uint64) *types.MsgSendPoints {
+		return types.NewMsgSendPoints(holder.String(), 1, path.EndpointB.ChannelID, member.String(), points, timeout, true)
+	}
+
+	require.NoError(t, path.RelayPacket(relay(chainA, types.NewMsgSendPoints(member.String(), 1, path.EndpointA.ChannelID, holder.String(), "40", 0, false))))
+	require.Equal(t, "40.000000000000000000", voucherB())
+
+	// sending back burns the voucher and releases the escrow to the receiver
+	packet := relay(chainB, sendBack("15", 0))
+	require.Equal(t, "25.000000000000000000", voucherB())
+	require.NoError(t, path.RelayPacket(packet))
+	require.True(t, balanceA().Equal(math.LegacyNewDec(75)))
+	require.True(t, escrowA().Equal(math.LegacyNewDec(25)))
+
+	// no more than the voucher holds can be sent back
+	_, err := chainB.SendMsgs(sendBack("26", 0))
+	require.ErrorContains(t, err, types.ErrInsufficientPoints.Error())
+
+	// a rejected return re-issues the voucher
+	require.NoError(t, keeperA.SetBlockedAddress(chainA.GetContext(), types.BlockedAddress{
+		Address: member.String(),
+		Reason:  types.BLOCK_REASON_FRAUD,
+	}))
+	packet = relay(chainB, sendBack("10", 0))
+	require.Equal(t, "15.000000000000000000", voucherB())
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, "25.000000000000000000", voucherB())
+	require.True(t, escrowA().Equal(math.LegacyNewDec(25)))
+	keeperA.RemoveBlockedAddress(chainA.GetContext(), member)
+
+	// and so does a timed out one
+	timeout := uint64(chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	packet = relay(chainB, sendBack("10", timeout))
+	require.Equal(t, "15.000000000000000000", voucherB())
+	coord.IncrementTimeBy(2 * time.Minute)
+	require.NoError(t, path.EndpointB.UpdateClient())
+	require.NoError(t, path.EndpointB.TimeoutPacket(packet))
+	require.Equal(t, "25.000000000000000000", voucherB())
+	require.True(t, balanceA().Equal(math.LegacyNewDec(75)))
+}
+
+func TestRewardpointsChannelVersion(t *testing.T) {
+	coord, path := setupRewardpointsPath(t)
+
+	path = ibctesting.NewPath(path.EndpointA.Chain, path.EndpointB.Chain)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = "ics20-1"
+	path.EndpointB.ChannelConfig.Version = "ics20-1"
+	coord.SetupConnections(path)
+	require.Error(t, path.EndpointA.ChanOpenInit())
+}
@@ -0,0 +1,124 @@
+package rewardchain_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestRemoteTreasuryTopUp(t *testing.T) {
+	coord := newCoordinator(t)
+	chainA, chainB := coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewPath(chainA, chainB)
+	coord.SetupConnections(path)
+
+	path.EndpointA.ChannelConfig.PortID = icatypes.ControllerPortPrefix + types.RemoteTreasuryOwner(1)
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+
+	appA, appB := rewardchainApp(chainA), rewardchainApp(chainB)
+	k := appA.RewardchainKeeper
+	admin := chainA.SenderAccount.GetAddress().String()
+	params := k.GetParams(chainA.GetContext())
+	params.AdminAddresses = []string{admin}
+	require.NoError(t, k.SetParams(chainA.GetContext(), params))
+	require.NoError(t, k.SetPartner(chainA.GetContext(), types.Partner{
+		Id:                 1,
+		Name:               "Acme",
+		Country:            "US",
+		Currency:           "USD",
+		TotalLiquidity:     "100",
+		AvailableLiquidity: "100",
+		RedeemCostPerPoint: "0.01",
+	}))
+
+	escrow := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	usd := types.DenomCurrency{Denom: sdk.DefaultBondDenom, Currency: "USD", Exponent: 6}
+	res, err := chainA.SendMsgs(types.NewMsgRegisterRemoteTreasury(admin, 1, path.EndpointA.ConnectionID, escrow.String(), usd, "", ""))
+	require.NoError(t, err)
+	path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.Events)
+	require.NoError(t, err)
+	// the fee middleware wraps the version the controller proposes
+	path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+	path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+
+	// top-ups need an open channel
+	_, err = chainA.SendMsgs(types.NewMsgExecuteTopUp(admin, 1, "1000000"))
+	require.ErrorContains(t, err, types.ErrRemoteTreasuryNotReady.Error())
+
+	require.NoError(t, path.EndpointB.ChanOpenTry())
+	require.NoError(t, path.EndpointA.ChanOpenAck())
+	require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+	rt, found := k.GetRemoteTreasury(chainA.GetContext(), 1)
+	require.True(t, found)
+	icaAddress, channelID, ok := k.RemoteTreasuryAccount(chainA.GetContext(), rt)
+	require.True(t, ok)
+	require.Equal(t, path.EndpointA.ChannelID, channelID)
+
+	_, err = chainB.SendMsgs(banktypes.NewMsgSend(
+		chainB.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(icaAddress),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 8_000_000)),
+	))
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointA.UpdateClient())
+
+	topUp := func(amount string) channeltypes.Packet {
+		res, err := chainA.SendMsgs(types.NewMsgExecuteTopUp(admin, 1, amount))
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		return packet
+	}
+	partner := func() types.Partner {
+		p, _ := k.GetPartner(chainA.GetContext(), 1)
+		return p
+	}
+	escrowBalance := func() math.Int {
+		return appB.BankKeeper.GetBalance(chainB.GetContext(), escrow, sdk.DefaultBondDenom).Amount
+	}
+
+	// liquidity is only credited once the host acknowledges the transfer
+	packet := topUp("5000000")
+	require.Equal(t, "100", partner().AvailableLiquidity)
+	_, err = chainA.SendMsgs(types.NewMsgExecuteTopUp(admin, 1, "1000000"))
+	require.ErrorContains(t, err, types.ErrTopUpPending.Error())
+
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, "600.000000000000000000", partner().AvailableLiquidity)
+	require.Equal(t, math.NewInt(5_000_000), escrowBalance())
+	rt, _ = k.GetRemoteTreasury(chainA.GetContext(), 1)
+	require.Zero(t, rt.PendingSequence)
+
+	// a transfer the host rejects acknowledges with an error and credits nothing
+	require.NoError(t, path.RelayPacket(topUp("5000000")))
+	require.Equal(t, "600.000000000000000000", partner().AvailableLiquidity)
+	require.Equal(t, math.NewInt(5_000_000), escrowBalance())
+	rt, _ = k.GetRemoteTreasury(chainA.GetContext(), 1)
+	require.Zero(t, rt.PendingSequence)
+
+	// with a threshold set, the end blocker tops up once liquidity runs low
+	_, err = chainA.SendMsgs(types.NewMsgRegisterRemoteTreasury(admin, 1, path.EndpointA.ConnectionID, escrow.String(), usd, "500", "2000000"))
+	require.NoError(t, err)
+	ctx := chainA.GetContext()
+	p := partner()
+	p.AvailableLiquidity = "400"
+	require.NoError(t, k.SetPartner(ctx, p))
+	k.AutoTopUp(ctx)
+	packet, err = ibctesting.ParsePacketFromEvents(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	coord.CommitBlock(chainA)
+
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, "600.000000000000000000", partner().AvailableLiquidity)
+	require.Equal(t, math.NewInt(7_000_000), escrowBalance())
+}
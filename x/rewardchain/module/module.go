@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,11 +17,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
 	// this line is used by starport scaffolding # 1
 
 	modulev1 "rewardchain/api/rewardchain/rewardchain/module"
+	"rewardchain/x/rewardchain/client/cli"
 	"rewardchain/x/rewardchain/keeper"
 	"rewardchain/x/rewardchain/types"
 )
@@ -87,6 +92,18 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	}
 }
 
+// GetTxCmd returns the module's hand-written tx commands, which autocli
+// enhances with the generated ones.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
+// GetQueryCmd returns the module's hand-written query commands, which autocli
+// enhances with the generated ones.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // ----------------------------------------------------------------------------
 // AppModule
 // ----------------------------------------------------------------------------
@@ -151,8 +168,8 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
-	return nil
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -180,8 +197,14 @@ type ModuleInputs struct {
 	Config       *modulev1.Module
 	Logger       log.Logger
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	AccountKeeper  types.AccountKeeper
+	BankKeeper     types.BankKeeper
+	DistrKeeper    types.DistributionKeeper
+	FeegrantKeeper feegrantkeeper.Keeper
+
+	IBCKeeperFn           func() *ibckeeper.Keeper                   `optional:"true"`
+	CapabilityScopedFn    func(string) capabilitykeeper.ScopedKeeper `optional:"true"`
+	ICAControllerKeeperFn func() types.ICAControllerKeeper           `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -202,6 +225,14 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.StoreService,
 		in.Logger,
 		authority.String(),
+		in.AccountKeeper,
+		in.BankKeeper,
+		in.DistrKeeper,
+		in.FeegrantKeeper,
+		feegrantkeeper.NewMsgServerImpl(in.FeegrantKeeper),
+		in.IBCKeeperFn,
+		in.CapabilityScopedFn,
+		in.ICAControllerKeeperFn,
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -0,0 +1,98 @@
+package rewardchain
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"rewardchain/x/rewardchain/keeper"
+)
+
+var _ porttypes.IBCModule = ICAControllerModule{}
+
+// ICAControllerModule is the authentication module beneath the interchain
+// accounts controller middleware. It receives the callbacks of the accounts
+// registered for remote treasuries and settles their top-ups. The middleware
+// owns the channel handshake and capabilities.
+type ICAControllerModule struct {
+	keeper keeper.Keeper
+}
+
+// NewICAControllerModule creates the interchain accounts authentication module.
+func NewICAControllerModule(k keeper.Keeper) ICAControllerModule {
+	return ICAControllerModule{keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The controller has
+// already validated the version and the returned one is discarded.
+func (ICAControllerModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (ICAControllerModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (ICAControllerModule) OnChanOpenAck(ctx sdk.Context, portID, channelID, _ string, counterpartyVersion string) error {
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (ICAControllerModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (ICAControllerModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (ICAControllerModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. Controller chains never
+// receive packets on interchain account channels.
+func (ICAControllerModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im ICAControllerModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal interchain account packet acknowledgement: %v", err)
+	}
+	return im.keeper.OnTopUpAcknowledgement(ctx, packet, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im ICAControllerModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.keeper.OnTopUpTimeout(ctx, packet)
+}
@@ -0,0 +1,126 @@
+package rewardchain
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"rewardchain/x/rewardchain/keeper"
+	"rewardchain/x/rewardchain/types"
+)
+
+var (
+	_ porttypes.IBCModule        = TransferMiddleware{}
+	_ porttypes.UpgradableModule = TransferMiddleware{}
+)
+
+// TransferMiddleware sits on top of the ICS-20 transfer application and runs
+// the rewardchain action of a transfer memo with the received tokens.
+// Transfers without a rewardchain memo pass through untouched.
+type TransferMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewTransferMiddleware wraps the transfer application app.
+func NewTransferMiddleware(app porttypes.IBCModule, k keeper.Keeper) TransferMiddleware {
+	return TransferMiddleware{IBCModule: app, keeper: k}
+}
+
+// OnRecvPacket implements the IBCModule interface. The tokens of a memo
+// transfer are received by an address derived from the channel and sender
+// and then spent by the memo action. Core IBC discards the state changes of
+// a packet with an error acknowledgement, so a failing action leaves no
+// tokens behind and the sender is refunded.
+func (im TransferMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	memo, ok, err := types.ParseTransferMemo(data.Memo)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return im.errorAck(ctx, packet, err)
+	}
+
+	receiver := types.TransferMemoReceiver(packet.DestinationChannel, data.Sender)
+	data.Receiver = receiver.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return im.errorAck(ctx, packet, errorsmod.Wrapf(types.ErrInvalidTransferMemo, "invalid amount %q", data.Amount))
+	}
+	coin := sdk.NewCoin(receivedDenom(packet, data.Denom), amount)
+	if _, err := im.keeper.ExecuteTransferMemo(ctx, memo, receiver, coin); err != nil {
+		return im.errorAck(ctx, packet, err)
+	}
+	return ack
+}
+
+func (im TransferMiddleware) errorAck(ctx sdk.Context, packet channeltypes.Packet, err error) ibcexported.Acknowledgement {
+	im.keeper.Logger().Error(fmt.Sprintf("transfer memo: %s sequence %d", err.Error(), packet.Sequence))
+	return channeltypes.NewErrorAcknowledgement(err)
+}
+
+// receivedDenom returns the local denom the transfer application credits
+// for a packet denom, following the ICS-20 prefixing rules.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	var trace transfertypes.DenomTrace
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, denom) {
+		prefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		trace = transfertypes.ParseDenomTrace(denom[len(prefix):])
+	} else {
+		trace = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, denom))
+	}
+	return trace.IBCDenom()
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface.
+func (im TransferMiddleware) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface.
+func (im TransferMiddleware) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, counterpartyVersion string) (string, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface.
+func (im TransferMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface.
+func (im TransferMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		panic(errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack"))
+	}
+	cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
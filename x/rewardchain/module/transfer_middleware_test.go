@@ -0,0 +1,122 @@
+package rewardchain_test
+
+import (
+	"fmt"
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestTransferMemo(t *testing.T) {
+	coord := newCoordinator(t)
+	path := ibctesting.NewTransferPath(coord.GetChain(ibctesting.GetChainID(1)), coord.GetChain(ibctesting.GetChainID(2)))
+	coord.Setup(path)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	appA, appB := rewardchainApp(chainA), rewardchainApp(chainB)
+
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	params := appB.RewardchainKeeper.GetParams(chainB.GetContext())
+	params.TransferDenoms = []types.DenomCurrency{{Denom: voucher, Currency: "USD", Exponent: 6}}
+	require.NoError(t, appB.RewardchainKeeper.SetParams(chainB.GetContext(), params))
+	require.NoError(t, appB.RewardchainKeeper.SetPartner(chainB.GetContext(), types.Partner{
+		Id:                 1,
+		Name:               "Acme",
+		Country:            "US",
+		Currency:           "USD",
+		TotalLiquidity:     "100",
+		AvailableLiquidity: "100",
+		RedeemCostPerPoint: "0.01",
+	}))
+
+	sender := chainA.SenderAccount.GetAddress()
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	transfer := func(memo string) error {
+		res, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, 2_000_000),
+			sender.String(), chainB.SenderAccount.GetAddress().String(),
+			chainB.GetTimeoutHeight(), 0, memo,
+		))
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		return path.RelayPacket(packet)
+	}
+	senderBalance := func() math.Int {
+		return appA.BankKeeper.GetBalance(chainA.GetContext(), sender, sdk.DefaultBondDenom).Amount
+	}
+	moduleBalance := func() math.Int {
+		return appB.BankKeeper.GetBalance(chainB.GetContext(), authtypes.NewModuleAddress(types.ModuleName), voucher).Amount
+	}
+
+	// 2 USD buy 200 points at 0.01 USD each
+	require.NoError(t, transfer(fmt.Sprintf(`{"rewardchain":{"partner_id":1,"action":"buy_points","recipient":%q}}`, member.String())))
+	p, _ := appB.RewardchainKeeper.GetPartner(chainB.GetContext(), 1)
+	require.Equal(t, "300.000000000000000000", p.TotalLiquidity)
+	require.Equal(t, "100.000000000000000000", p.AvailableLiquidity)
+	require.True(t, appB.RewardchainKeeper.GetMemberBalance(chainB.GetContext(), 1, member).Equal(math.LegacyNewDec(200)))
+	require.Equal(t, math.NewInt(2_000_000), moduleBalance())
+
+	require.NoError(t, transfer(`{"rewardchain":{"partner_id":1,"action":"add_liquidity"}}`))
+	p, _ = appB.RewardchainKeeper.GetPartner(chainB.GetContext(), 1)
+	require.Equal(t, "500.000000000000000000", p.TotalLiquidity)
+	require.Equal(t, "300.000000000000000000", p.AvailableLiquidity)
+	require.Equal(t, math.NewInt(4_000_000), moduleBalance())
+
+	// a failing action acknowledges with an error and refunds the sender
+	before := senderBalance()
+	require.NoError(t, transfer(`{"rewardchain":{"partner_id":7,"action":"add_liquidity"}}`))
+	require.Equal(t, before.String(), senderBalance().String())
+	require.Equal(t, math.NewInt(4_000_000), moduleBalance())
+
+	before = senderBalance()
+	require.NoError(t, transfer(`{"rewardchain":{"partner_id":1,"action":"mint"}}`))
+	require.Equal(t, before.String(), senderBalance().String())
+
+	// points cannot be bought while earning with the partner is paused
+	require.NoError(t, appB.RewardchainKeeper.SetLiquidityStatus(chainB.GetContext(), types.LiquidityStatus{PartnerId: 1, EarnPaused: true}))
+	before = senderBalance()
+	require.NoError(t, transfer(fmt.Sprintf(`{"rewardchain":{"partner_id":1,"action":"buy_points","recipient":%q}}`, member.String())))
+	require.Equal(t, before.String(), senderBalance().String())
+	require.True(t, appB.RewardchainKeeper.GetMemberBalance(chainB.GetContext(), 1, member).Equal(math.LegacyNewDec(200)))
+	require.Equal(t, math.NewInt(4_000_000), moduleBalance())
+
+	// transfers without a rewardchain memo are untouched
+	require.NoError(t, transfer(`{"forward":{}}`))
+	require.Equal(t, math.NewInt(2_000_000), appB.BankKeeper.GetBalance(chainB.GetContext(), chainB.SenderAccount.GetAddress(), voucher).Amount)
+}
+
+func TestParseTransferMemo(t *testing.T) {
+	member := sample.AccAddress()
+	for _, tc := range []struct {
+		name string
+		memo string
+		ok   bool
+		err  error
+	}{
+		{name: "empty", memo: ""},
+		{name: "plain text", memo: "invoice 42"},
+		{name: "other middleware", memo: `{"wasm":{}}`},
+		{name: "add liquidity", memo: `{"rewardchain":{"partner_id":1,"action":"add_liquidity"}}`, ok: true},
+		{name: "buy points", memo: fmt.Sprintf(`{"rewardchain":{"partner_id":1,"action":"buy_points","recipient":%q}}`, member), ok: true},
+		{name: "buy points without recipient", memo: `{"rewardchain":{"partner_id":1,"action":"buy_points"}}`, ok: true, err: types.ErrInvalidTransferMemo},
+		{name: "zero partner", memo: `{"rewardchain":{"action":"add_liquidity"}}`, ok: true, err: types.ErrInvalidTransferMemo},
+		{name: "unknown field", memo: `{"rewardchain":{"partner_id":1,"action":"add_liquidity","amount":"5"}}`, ok: true, err: types.ErrInvalidTransferMemo},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok, err := types.ParseTransferMemo(tc.memo)
+			require.Equal(t, tc.ok, ok)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
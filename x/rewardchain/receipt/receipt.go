@@ -0,0 +1,137 @@
+// Package receipt builds and signs rewardchain earn receipts.
+//
+// A partner's point of sale signs a receipt for every purchase with the key
+// registered through MsgSetReceiptKey and hands the receipt and signature to
+// the member, who submits them on chain with MsgClaimReceipt:
+//
+//	signer, err := receipt.NewSigner("rewardchain", partnerID, privKey)
+//	r, sig, err := signer.Sign(member, "120", receipt.NewNonce(), time.Now().Add(24*time.Hour))
+//	msg := receipt.ClaimMsg(r, sig)
+package receipt
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"rewardchain/x/rewardchain/types"
+)
+
+// Signer signs earn receipts on behalf of one partner.
+type Signer struct {
+	chainID   string
+	partnerID uint64
+	key       cryptotypes.PrivKey
+	keyType   string
+}
+
+// NewSigner returns a Signer for the partner on the given chain. The key must
+// be an ed25519 or secp256k1 private key.
+func NewSigner(chainID string, partnerID uint64, key cryptotypes.PrivKey) (*Signer, error) {
+	if chainID == "" {
+		return nil, fmt.Errorf("chain id is required")
+	}
+	if partnerID == 0 {
+		return nil, fmt.Errorf("partner id must be > 0")
+	}
+
+	var keyType string
+	switch key.(type) {
+	case *ed25519.PrivKey:
+		keyType = types.ReceiptKeyTypeEd25519
+	case *secp256k1.PrivKey:
+		keyType = types.ReceiptKeyTypeSecp256k1
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+
+	return &Signer{
+		chainID:   chainID,
+		partnerID: partnerID,
+		key:       key,
+		keyType:   keyType,
+	}, nil
+}
+
+// KeyType returns the key type to register with MsgSetReceiptKey.
+func (s *Signer) KeyType() string {
+	return s.keyType
+}
+
+// PubKey returns the public key bytes to register with MsgSetReceiptKey.
+func (s *Signer) PubKey() []byte {
+	return s.key.PubKey().Bytes()
+}
+
+// SetReceiptKeyMsg returns the message that registers the signer's key for
+// the partner. treasury must be the partner's treasury account.
+func (s *Signer) SetReceiptKeyMsg(treasury string) *types.MsgSetReceiptKey {
+	return types.NewMsgSetReceiptKey(treasury, s.partnerID, s.keyType, s.PubKey())
+}
+
+// Sign builds a receipt crediting points to member and signs it.
+func (s *Signer) Sign(member, points, nonce string, expiry time.Time) (types.Receipt, []byte, error) {
+	r := types.Receipt{
+		PartnerId: s.partnerID,
+		Member:    member,
+		Points:    points,
+		Nonce:     nonce,
+		Expiry:    expiry.Unix(),
+	}
+	sig, err := s.SignReceipt(r)
+	if err != nil {
+		return types.Receipt{}, nil, err
+	}
+	return r, sig, nil
+}
+
+// SignReceipt signs an already built receipt.
+func (s *Signer) SignReceipt(r types.Receipt) ([]byte, error) {
+	if r.PartnerId != s.partnerID {
+		return nil, fmt.Errorf("receipt is for partner %d, signer is for partner %d", r.PartnerId, s.partnerID)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	signBytes, err := types.ReceiptSignBytes(s.chainID, r)
+	if err != nil {
+		return nil, err
+	}
+	return s.key.Sign(signBytes)
+}
+
+// Verify checks a receipt signature the same way the chain does, apart from
+// the expiry and replay checks which depend on chain state.
+func Verify(chainID, keyType string, pubKey []byte, r types.Receipt, sig []byte) error {
+	pk, err := types.ReceiptPubKey(keyType, pubKey)
+	if err != nil {
+		return err
+	}
+	signBytes, err := types.ReceiptSignBytes(chainID, r)
+	if err != nil {
+		return err
+	}
+	if !pk.VerifySignature(signBytes, sig) {
+		return types.ErrInvalidReceiptSig
+	}
+	return nil
+}
+
+// ClaimMsg returns the message a member submits to claim a receipt.
+func ClaimMsg(r types.Receipt, sig []byte) *types.MsgClaimReceipt {
+	return types.NewMsgClaimReceipt(r.Member, r, sig)
+}
+
+// NewNonce returns a random receipt nonce.
+func NewNonce() string {
+	bz := make([]byte, 16)
+	if _, err := rand.Read(bz); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(bz)
+}
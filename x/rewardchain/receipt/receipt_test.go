@@ -0,0 +1,78 @@
+package receipt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/receipt"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	member := sample.AccAddress()
+	expiry := time.Unix(1_700_000_000, 0)
+
+	for _, key := range []cryptotypes.PrivKey{ed25519.GenPrivKey(), secp256k1.GenPrivKey()} {
+		signer, err := receipt.NewSigner("rewardchain", 7, key)
+		require.NoError(t, err)
+		require.Equal(t, key.PubKey().Bytes(), signer.PubKey())
+
+		r, sig, err := signer.Sign(member, "120", receipt.NewNonce(), expiry)
+		require.NoError(t, err)
+		require.Equal(t, uint64(7), r.PartnerId)
+		require.Equal(t, expiry.Unix(), r.Expiry)
+		require.NoError(t, receipt.Verify("rewardchain", signer.KeyType(), signer.PubKey(), r, sig))
+
+		// the signature covers every field and the chain id
+		tampered := r
+		tampered.Points = "1200"
+		require.ErrorIs(t, receipt.Verify("rewardchain", signer.KeyType(), signer.PubKey(), tampered, sig), types.ErrInvalidReceiptSig)
+		tampered = r
+		tampered.Expiry++
+		require.ErrorIs(t, receipt.Verify("rewardchain", signer.KeyType(), signer.PubKey(), tampered, sig), types.ErrInvalidReceiptSig)
+		require.ErrorIs(t, receipt.Verify("other-chain", signer.KeyType(), signer.PubKey(), r, sig), types.ErrInvalidReceiptSig)
+
+		msg := receipt.ClaimMsg(r, sig)
+		require.Equal(t, member, msg.Creator)
+		require.NoError(t, msg.ValidateBasic())
+	}
+}
+
+func TestSignRejectsInvalidReceipts(t *testing.T) {
+	key := ed25519.GenPrivKey()
+	signer, err := receipt.NewSigner("rewardchain", 7, key)
+	require.NoError(t, err)
+	member := sample.AccAddress()
+
+	// a receipt needs an expiry
+	_, _, err = signer.Sign(member, "1", "n-1", time.Unix(0, 0))
+	require.ErrorContains(t, err, "expiry is required")
+
+	_, err = signer.SignReceipt(types.Receipt{PartnerId: 8, Member: member, Points: "1", Nonce: "n-1", Expiry: 1})
+	require.ErrorContains(t, err, "signer is for partner 7")
+
+	long := make([]byte, types.MaxReceiptNonceLength+1)
+	for i := range long {
+		long[i] = 'n'
+	}
+	_, _, err = signer.Sign(member, "1", string(long), time.Unix(1, 0))
+	require.ErrorContains(t, err, "nonce must be")
+
+	_, err = receipt.NewSigner("", 7, key)
+	require.Error(t, err)
+	_, err = receipt.NewSigner("rewardchain", 0, key)
+	require.Error(t, err)
+	r1, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	_, err = receipt.NewSigner("rewardchain", 7, r1)
+	require.ErrorContains(t, err, "unsupported key type")
+
+	require.NotEqual(t, receipt.NewNonce(), receipt.NewNonce())
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// MaxAttestationLevel bounds the levels attestors may record.
+	MaxAttestationLevel = 10
+
+	// KycWindowSeconds is the rolling window over which payouts are summed
+	// against a KYC threshold, and so how long payout counters are kept.
+	KycWindowSeconds = SecondsPerDay
+)
+
+// AttestationMemberPrefix returns the key prefix of a member's attestations.
+func AttestationMemberPrefix(member sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(member)
+}
+
+// AttestationKey returns the key of an attestor's attestation of a member.
+func AttestationKey(member, attestor sdk.AccAddress) []byte {
+	return append(AttestationMemberPrefix(member), address.MustLengthPrefix(attestor)...)
+}
+
+// KycPayoutPrefix returns the key prefix of a member's payout counters with a
+// partner. Payout counters share the layout of the velocity counters.
+func KycPayoutPrefix(partnerID uint64, member sdk.AccAddress) []byte {
+	return VelocityCounterPrefix(partnerID, member)
+}
+
+// KycPayoutKey returns the key of a member's payout counter for a bucket.
+func KycPayoutKey(partnerID uint64, member sdk.AccAddress, bucket int64) []byte {
+	return VelocityCounterKey(partnerID, member, bucket)
+}
+
+// KycPayoutExpiryKey returns the pruning queue key of a payout counter.
+func KycPayoutExpiryKey(expires int64, partnerID uint64, member sdk.AccAddress, bucket int64) []byte {
+	return VelocityExpiryKey(expires, partnerID, member, bucket)
+}
+
+// ValidateEvidenceHash checks a hex encoded SHA-256 digest.
+func ValidateEvidenceHash(h string) error {
+	bz, err := hex.DecodeString(h)
+	if err != nil || len(bz) != 32 {
+		return fmt.Errorf("evidence_hash must be a hex encoded SHA-256 digest")
+	}
+	return nil
+}
+
+// Validate performs stateless checks on an attestation.
+func (a Attestation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Member); err != nil {
+		return fmt.Errorf("invalid member address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Attestor); err != nil {
+		return fmt.Errorf("invalid attestor address: %w", err)
+	}
+	if a.Level == 0 || a.Level > MaxAttestationLevel {
+		return fmt.Errorf("level must be between 1 and %d", MaxAttestationLevel)
+	}
+	if a.Expires <= 0 {
+		return fmt.Errorf("expires must be > 0")
+	}
+	if err := ValidateCountryCode(a.Jurisdiction); err != nil {
+		return err
+	}
+	return ValidateEvidenceHash(a.EvidenceHash)
+}
+
+// PayoutThreshold returns the largest amount a member may be paid out within
+// KycWindowSeconds without an attestation.
+func (r KycRequirement) PayoutThreshold() math.LegacyDec {
+	d, _ := parseLimit(r.Threshold)
+	return d
+}
+
+// Accepts reports whether the requirement accepts attestations from
+// jurisdiction.
+func (r KycRequirement) Accepts(jurisdiction string) bool {
+	if len(r.Jurisdictions) == 0 {
+		return true
+	}
+	for _, j := range r.Jurisdictions {
+		if j == jurisdiction {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs stateless checks on a KYC requirement.
+func (r KycRequirement) Validate() error {
+	if r.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	if _, err := parseLimit(r.Threshold); err != nil {
+		return fmt.Errorf("invalid threshold: %w", err)
+	}
+	if r.MinLevel > MaxAttestationLevel {
+		return fmt.Errorf("min_level must be <= %d", MaxAttestationLevel)
+	}
+	seen := make(map[string]struct{}, len(r.Jurisdictions))
+	for _, j := range r.Jurisdictions {
+		if err := ValidateCountryCode(j); err != nil {
+			return err
+		}
+		if _, ok := seen[j]; ok {
+			return fmt.Errorf("duplicate jurisdiction %s", j)
+		}
+		seen[j] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxBlockNoteLength bounds the free-form note kept with a blocklist entry.
+const MaxBlockNoteLength = 256
+
+// ValidateBlockReason checks the reason code given when listing an address.
+func ValidateBlockReason(r BlockReason) error {
+	if _, ok := BlockReason_name[int32(r)]; !ok {
+		return fmt.Errorf("unknown reason code %d", r)
+	}
+	if r == BLOCK_REASON_UNSPECIFIED || r == BLOCK_REASON_RESOLVED {
+		return fmt.Errorf("reason %s cannot be used to block an address", r)
+	}
+	return nil
+}
+
+// ValidateUnblockReason checks the reason code given when delisting an
+// address.
+func ValidateUnblockReason(r BlockReason) error {
+	if _, ok := BlockReason_name[int32(r)]; !ok {
+		return fmt.Errorf("unknown reason code %d", r)
+	}
+	if r == BLOCK_REASON_UNSPECIFIED {
+		return fmt.Errorf("a reason code is required")
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a blocklist entry.
+func (b BlockedAddress) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if err := ValidateBlockReason(b.Reason); err != nil {
+		return err
+	}
+	if len(b.Note) > MaxBlockNoteLength {
+		return fmt.Errorf("note longer than %d bytes", MaxBlockNoteLength)
+	}
+	if b.ListedBy != "" {
+		if _, err := sdk.AccAddressFromBech32(b.ListedBy); err != nil {
+			return fmt.Errorf("invalid listed_by address: %w", err)
+		}
+	}
+	return nil
+}
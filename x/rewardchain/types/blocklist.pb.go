@@ -0,0 +1,552 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/blocklist.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlockReason is the reason code recorded when an address is listed on or
+// removed from the blocklist.
+type BlockReason int32
+
+const (
+	BLOCK_REASON_UNSPECIFIED BlockReason = 0
+	BLOCK_REASON_SANCTIONS   BlockReason = 1
+	BLOCK_REASON_FRAUD       BlockReason = 2
+	BLOCK_REASON_COURT_ORDER BlockReason = 3
+	BLOCK_REASON_COMPROMISED BlockReason = 4
+	// BLOCK_REASON_RESOLVED is only valid when delisting an address.
+	BLOCK_REASON_RESOLVED BlockReason = 5
+	BLOCK_REASON_OTHER    BlockReason = 6
+)
+
+var BlockReason_name = map[int32]string{
+	0: "BLOCK_REASON_UNSPECIFIED",
+	1: "BLOCK_REASON_SANCTIONS",
+	2: "BLOCK_REASON_FRAUD",
+	3: "BLOCK_REASON_COURT_ORDER",
+	4: "BLOCK_REASON_COMPROMISED",
+	5: "BLOCK_REASON_RESOLVED",
+	6: "BLOCK_REASON_OTHER",
+}
+
+var BlockReason_value = map[string]int32{
+	"BLOCK_REASON_UNSPECIFIED": 0,
+	"BLOCK_REASON_SANCTIONS":   1,
+	"BLOCK_REASON_FRAUD":       2,
+	"BLOCK_REASON_COURT_ORDER": 3,
+	"BLOCK_REASON_COMPROMISED": 4,
+	"BLOCK_REASON_RESOLVED":    5,
+	"BLOCK_REASON_OTHER":       6,
+}
+
+func (x BlockReason) String() string {
+	return proto.EnumName(BlockReason_name, int32(x))
+}
+
+func (BlockReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c22a43e6dff700d, []int{0}
+}
+
+// BlockedAddress is an address that may not sign rewardchain messages or
+// receive points transfers.
+type BlockedAddress struct {
+	Address string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason  BlockReason `protobuf:"varint,2,opt,name=reason,proto3,enum=rewardchain.rewardchain.BlockReason" json:"reason,omitempty"`
+	Note    string      `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	// listed_by is the admin or governance authority that listed the address.
+	ListedBy string `protobuf:"bytes,4,opt,name=listed_by,json=listedBy,proto3" json:"listed_by,omitempty"`
+	Height   int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *BlockedAddress) Reset()         { *m = BlockedAddress{} }
+func (m *BlockedAddress) String() string { return proto.CompactTextString(m) }
+func (*BlockedAddress) ProtoMessage()    {}
+func (*BlockedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c22a43e6dff700d, []int{0}
+}
+func (m *BlockedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAddress.Merge(m, src)
+}
+func (m *BlockedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAddress proto.InternalMessageInfo
+
+func (m *BlockedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlockedAddress) GetReason() BlockReason {
+	if m != nil {
+		return m.Reason
+	}
+	return BLOCK_REASON_UNSPECIFIED
+}
+
+func (m *BlockedAddress) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *BlockedAddress) GetListedBy() string {
+	if m != nil {
+		return m.ListedBy
+	}
+	return ""
+}
+
+func (m *BlockedAddress) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("rewardchain.rewardchain.BlockReason", BlockReason_name, BlockReason_value)
+	proto.RegisterType((*BlockedAddress)(nil), "rewardchain.rewardchain.BlockedAddress")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/blocklist.proto", fileDescriptor_1c22a43e6dff700d)
+}
+
+var fileDescriptor_1c22a43e6dff700d = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcd, 0xaa, 0xd3, 0x40,
+	0x18, 0xcd, 0xdc, 0xe6, 0x46, 0xef, 0x08, 0x97, 0x30, 0xd4, 0x9a, 0x06, 0x89, 0x45, 0x04, 0x8b,
+	0x60, 0x0a, 0x15, 0x17, 0x82, 0x9b, 0xfc, 0x4c, 0x31, 0xd8, 0x66, 0xca, 0xa4, 0x75, 0xe1, 0x26,
+	0xa4, 0xcd, 0x90, 0x06, 0x6b, 0xa6, 0x24, 0x01, 0xed, 0x1b, 0xb8, 0xf4, 0x1d, 0x7c, 0x05, 0x1f,
+	0xc2, 0x65, 0x11, 0x17, 0x2e, 0xa5, 0xc5, 0xf7, 0x90, 0xfc, 0x08, 0x09, 0x55, 0xee, 0xee, 0xcc,
+	0x77, 0xce, 0x77, 0xce, 0x37, 0x70, 0xe0, 0xe3, 0x94, 0x7d, 0x08, 0xd2, 0x70, 0xbd, 0x09, 0xe2,
+	0x64, 0xd4, 0xc4, 0xab, 0x2d, 0x5f, 0xbf, 0xdb, 0xc6, 0x59, 0xae, 0xef, 0x52, 0x9e, 0x73, 0x74,
+	0xaf, 0x41, 0xea, 0x0d, 0xac, 0xf6, 0xd7, 0x3c, 0x7b, 0xcf, 0x33, 0xbf, 0x94, 0x8d, 0xaa, 0x47,
+	0xb5, 0xa3, 0x76, 0x23, 0x1e, 0xf1, 0x6a, 0x5e, 0xa0, 0x6a, 0xfa, 0xf0, 0x37, 0x80, 0xd7, 0x66,
+	0xe1, 0xce, 0x42, 0x23, 0x0c, 0x53, 0x96, 0x65, 0x68, 0x0c, 0x6f, 0x05, 0x15, 0x54, 0xc0, 0x00,
+	0x0c, 0xaf, 0x4c, 0xe5, 0xfb, 0xd7, 0xa7, 0xdd, 0xda, 0xab, 0x16, 0x79, 0x79, 0x1a, 0x27, 0x11,
+	0xfd, 0x2b, 0x44, 0x2f, 0xa1, 0x94, 0xb2, 0x20, 0xe3, 0x89, 0x72, 0x31, 0x00, 0xc3, 0xeb, 0xf1,
+	0x23, 0xfd, 0x3f, 0x17, 0xea, 0x65, 0x18, 0x2d, 0xb5, 0xb4, 0xde, 0x41, 0x08, 0x8a, 0x09, 0xcf,
+	0x99, 0xd2, 0x29, 0xe2, 0x68, 0x89, 0xd1, 0x73, 0x78, 0x55, 0x7c, 0x98, 0x85, 0xfe, 0x6a, 0xaf,
+	0x88, 0x37, 0xdc, 0x71, 0xbb, 0x92, 0x9a, 0x7b, 0xd4, 0x83, 0xd2, 0x86, 0xc5, 0xd1, 0x26, 0x57,
+	0x2e, 0x07, 0x60, 0xd8, 0xa1, 0xf5, 0xeb, 0xc9, 0x0f, 0x00, 0xef, 0x34, 0xa2, 0xd1, 0x7d, 0xa8,
+	0x98, 0x53, 0x62, 0xbd, 0xf6, 0x29, 0x36, 0x3c, 0xe2, 0xfa, 0x4b, 0xd7, 0x9b, 0x63, 0xcb, 0x99,
+	0x38, 0xd8, 0x96, 0x05, 0xa4, 0xc2, 0x5e, 0x8b, 0xf5, 0x0c, 0xd7, 0x5a, 0x38, 0xc4, 0xf5, 0x64,
+	0x80, 0x7a, 0x10, 0xb5, 0xb8, 0x09, 0x35, 0x96, 0xb6, 0x7c, 0x71, 0xe6, 0x68, 0x91, 0x25, 0x5d,
+	0xf8, 0x84, 0xda, 0x98, 0xca, 0x9d, 0x7f, 0xb0, 0xb3, 0x39, 0x25, 0x33, 0xc7, 0xc3, 0xb6, 0x2c,
+	0xa2, 0x3e, 0xbc, 0xdb, 0x62, 0x29, 0xf6, 0xc8, 0xf4, 0x0d, 0xb6, 0xe5, 0xcb, 0xb3, 0x38, 0xb2,
+	0x78, 0x85, 0xa9, 0x2c, 0xa9, 0xe2, 0xa7, 0x2f, 0x9a, 0x60, 0xbe, 0xf8, 0x76, 0xd4, 0xc0, 0xe1,
+	0xa8, 0x81, 0x5f, 0x47, 0x0d, 0x7c, 0x3e, 0x69, 0xc2, 0xe1, 0xa4, 0x09, 0x3f, 0x4f, 0x9a, 0xf0,
+	0xf6, 0x41, 0xb3, 0x3f, 0x1f, 0x5b, 0x6d, 0xca, 0xf7, 0x3b, 0x96, 0xad, 0xa4, 0xb2, 0x00, 0xcf,
+	0xfe, 0x0c, 0x00, 0xe5, 0x84, 0xa9, 0xb2, 0x75, 0x02, 0x00, 0x00,
+}
+
+func (m *BlockedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlocklist(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ListedBy) > 0 {
+		i -= len(m.ListedBy)
+		copy(dAtA[i:], m.ListedBy)
+		i = encodeVarintBlocklist(dAtA, i, uint64(len(m.ListedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintBlocklist(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintBlocklist(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBlocklist(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlocklist(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlocklist(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBlocklist(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovBlocklist(uint64(m.Reason))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovBlocklist(uint64(l))
+	}
+	l = len(m.ListedBy)
+	if l > 0 {
+		n += 1 + l + sovBlocklist(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBlocklist(uint64(m.Height))
+	}
+	return n
+}
+
+func sovBlocklist(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlocklist(x uint64) (n int) {
+	return sovBlocklist(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlocklist
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= BlockReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlocklist(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlocklist
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlocklist(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlocklist
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlocklist
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlocklist
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlocklist
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlocklist
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlocklist        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlocklist          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlocklist = fmt.Errorf("proto: unexpected end of group")
+)
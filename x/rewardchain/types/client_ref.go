@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MaxClientRefLength bounds the size of a client_ref.
+const MaxClientRefLength = 128
+
+// ValidateClientRef checks an optional client_ref. Empty is allowed.
+func ValidateClientRef(ref string) error {
+	if len(ref) > MaxClientRefLength {
+		return fmt.Errorf("client_ref must be at most %d bytes", MaxClientRefLength)
+	}
+	for _, c := range ref {
+		if c < 0x21 || c > 0x7e || c == '/' {
+			return fmt.Errorf("client_ref must be printable ASCII without spaces or '/'")
+		}
+	}
+	return nil
+}
+
+// ClientRefKey returns the key of a signer's client_ref record.
+func ClientRefKey(signer sdk.AccAddress, ref string) []byte {
+	return append(address.MustLengthPrefix(signer), []byte(ref)...)
+}
+
+// ClientRefExpiryKey returns the pruning queue key of a client_ref record.
+func ClientRefExpiryKey(expiresHeight int64, signer sdk.AccAddress, ref string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiresHeight)), ClientRefKey(signer, ref)...)
+}
+
+// Validate performs stateless checks on a stored client_ref record.
+func (r ClientRefRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %w", err)
+	}
+	if r.ClientRef == "" {
+		return fmt.Errorf("client_ref is required")
+	}
+	if err := ValidateClientRef(r.ClientRef); err != nil {
+		return err
+	}
+	if r.MsgTypeUrl == "" || r.Response == nil {
+		return fmt.Errorf("message type and response are required")
+	}
+	return nil
+}
@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/client_ref.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClientRefRecord is the outcome of a message a signer tagged with a
+// client_ref. A retried message with the same (signer, client_ref) gets the
+// stored response instead of executing again.
+type ClientRefRecord struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ClientRef string `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`
+	// msg_type_url and msg_hash identify the message that used the ref.
+	MsgTypeUrl string     `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	MsgHash    []byte     `protobuf:"bytes,4,opt,name=msg_hash,json=msgHash,proto3" json:"msg_hash,omitempty"`
+	Response   *types.Any `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+	Height     int64      `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// expires_height is the height at which the record is pruned.
+	ExpiresHeight int64 `protobuf:"varint,7,opt,name=expires_height,json=expiresHeight,proto3" json:"expires_height,omitempty"`
+}
+
+func (m *ClientRefRecord) Reset()         { *m = ClientRefRecord{} }
+func (m *ClientRefRecord) String() string { return proto.CompactTextString(m) }
+func (*ClientRefRecord) ProtoMessage()    {}
+func (*ClientRefRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46e4c123754ea4d2, []int{0}
+}
+func (m *ClientRefRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientRefRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientRefRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientRefRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientRefRecord.Merge(m, src)
+}
+func (m *ClientRefRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientRefRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientRefRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientRefRecord proto.InternalMessageInfo
+
+func (m *ClientRefRecord) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *ClientRefRecord) GetClientRef() string {
+	if m != nil {
+		return m.ClientRef
+	}
+	return ""
+}
+
+func (m *ClientRefRecord) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ClientRefRecord) GetMsgHash() []byte {
+	if m != nil {
+		return m.MsgHash
+	}
+	return nil
+}
+
+func (m *ClientRefRecord) GetResponse() *types.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *ClientRefRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ClientRefRecord) GetExpiresHeight() int64 {
+	if m != nil {
+		return m.ExpiresHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ClientRefRecord)(nil), "rewardchain.rewardchain.ClientRefRecord")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/client_ref.proto", fileDescriptor_46e4c123754ea4d2)
+}
+
+var fileDescriptor_46e4c123754ea4d2 = []byte{
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x19, 0xb8, 0xb7, 0xc0, 0x5c, 0xee, 0xbd, 0xc9, 0x84, 0x68, 0x21, 0xb1, 0x36, 0x26,
+	0x26, 0xdd, 0xd8, 0x12, 0x5d, 0xb9, 0x04, 0x37, 0xac, 0xab, 0x6e, 0xdc, 0x34, 0xa5, 0x3d, 0x4c,
+	0x9b, 0xb4, 0x9d, 0x66, 0x4e, 0x89, 0xf4, 0x2d, 0x78, 0x18, 0x1f, 0xc2, 0x25, 0x71, 0xe5, 0xd2,
+	0xc0, 0x8b, 0x18, 0xa6, 0x45, 0xeb, 0x6e, 0xfe, 0xf3, 0x7f, 0xe7, 0xcc, 0xf9, 0x73, 0xa8, 0x25,
+	0xe1, 0xd9, 0x97, 0x61, 0x10, 0xf9, 0x71, 0xe6, 0x34, 0xdf, 0x41, 0x12, 0x43, 0x56, 0x78, 0x12,
+	0x96, 0x76, 0x2e, 0x45, 0x21, 0xd8, 0x69, 0xc3, 0xb5, 0x1b, 0xef, 0xf1, 0x28, 0x10, 0x98, 0x0a,
+	0xf4, 0x14, 0xe6, 0x54, 0xa2, 0xea, 0x19, 0x8f, 0xb8, 0x10, 0x3c, 0x01, 0x47, 0xa9, 0xc5, 0x6a,
+	0xe9, 0xf8, 0x59, 0x59, 0x59, 0x17, 0x9b, 0x36, 0xfd, 0x7f, 0xa7, 0xfe, 0x70, 0x61, 0xe9, 0x42,
+	0x20, 0x64, 0xc8, 0x26, 0x54, 0xc3, 0x98, 0x67, 0x20, 0x75, 0x62, 0x12, 0xab, 0x3f, 0xd3, 0xdf,
+	0x5e, 0xae, 0x86, 0xf5, 0xc0, 0x69, 0x18, 0x4a, 0x40, 0xbc, 0x2f, 0x64, 0x9c, 0x71, 0xb7, 0xe6,
+	0xd8, 0x19, 0xa5, 0xdf, 0x8b, 0xea, 0xed, 0x43, 0x97, 0xdb, 0x0f, 0x8e, 0x63, 0x99, 0x49, 0x07,
+	0x29, 0x72, 0xaf, 0x28, 0x73, 0xf0, 0x56, 0x32, 0xd1, 0x3b, 0x0a, 0xa0, 0x29, 0xf2, 0x87, 0x32,
+	0x87, 0x47, 0x99, 0xb0, 0x11, 0xed, 0x1d, 0x88, 0xc8, 0xc7, 0x48, 0xff, 0x65, 0x12, 0x6b, 0xe0,
+	0x76, 0x53, 0xe4, 0x73, 0x1f, 0x23, 0x36, 0xa1, 0x3d, 0x09, 0x98, 0x8b, 0x0c, 0x41, 0xff, 0x6d,
+	0x12, 0xeb, 0xcf, 0xf5, 0xd0, 0xae, 0xf2, 0xd8, 0xc7, 0x3c, 0xf6, 0x34, 0x2b, 0xdd, 0x2f, 0x8a,
+	0x9d, 0x50, 0x2d, 0x82, 0x98, 0x47, 0x85, 0xae, 0x99, 0xc4, 0xea, 0xb8, 0xb5, 0x62, 0x97, 0xf4,
+	0x1f, 0xac, 0xf3, 0x58, 0x02, 0x7a, 0xb5, 0xdf, 0x55, 0xfe, 0xdf, 0xba, 0x3a, 0x57, 0xc5, 0xd9,
+	0xed, 0xeb, 0xce, 0x20, 0xdb, 0x9d, 0x41, 0x3e, 0x76, 0x06, 0xd9, 0xec, 0x8d, 0xd6, 0x76, 0x6f,
+	0xb4, 0xde, 0xf7, 0x46, 0xeb, 0xe9, 0xbc, 0x79, 0x99, 0xf5, 0x8f, 0x3b, 0x1d, 0xb2, 0xe1, 0x42,
+	0x53, 0x1b, 0xdd, 0x7c, 0x0e, 0x00, 0x0d, 0xdd, 0xd9, 0x1f, 0xcf, 0x01, 0x00, 0x00,
+}
+
+func (m *ClientRefRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientRefRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientRefRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresHeight != 0 {
+		i = encodeVarintClientRef(dAtA, i, uint64(m.ExpiresHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintClientRef(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClientRef(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgHash) > 0 {
+		i -= len(m.MsgHash)
+		copy(dAtA[i:], m.MsgHash)
+		i = encodeVarintClientRef(dAtA, i, uint64(len(m.MsgHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintClientRef(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientRef) > 0 {
+		i -= len(m.ClientRef)
+		copy(dAtA[i:], m.ClientRef)
+		i = encodeVarintClientRef(dAtA, i, uint64(len(m.ClientRef)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintClientRef(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClientRef(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClientRef(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientRefRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovClientRef(uint64(l))
+	}
+	l = len(m.ClientRef)
+	if l > 0 {
+		n += 1 + l + sovClientRef(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovClientRef(uint64(l))
+	}
+	l = len(m.MsgHash)
+	if l > 0 {
+		n += 1 + l + sovClientRef(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovClientRef(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovClientRef(uint64(m.Height))
+	}
+	if m.ExpiresHeight != 0 {
+		n += 1 + sovClientRef(uint64(m.ExpiresHeight))
+	}
+	return n
+}
+
+func sovClientRef(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClientRef(x uint64) (n int) {
+	return sovClientRef(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientRefRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClientRef
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientRefRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientRefRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgHash = append(m.MsgHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MsgHash == nil {
+				m.MsgHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.Any{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresHeight", wireType)
+			}
+			m.ExpiresHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClientRef(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClientRef
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClientRef(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClientRef
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClientRef
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClientRef
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClientRef
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClientRef
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClientRef        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClientRef          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClientRef = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,6 +11,67 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreatePartner{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDisablePartner{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSponsorMembers{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetReceiptKey{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimReceipt{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchEarnPoints{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReversePointsEarn{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRecordObligation{},
+		&MsgFundSettlementEscrow{},
+		&MsgWithdrawSettlementEscrow{},
+		&MsgConfirmSettlement{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRedeemPoints{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitRate{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetTransferPolicy{},
+		&MsgTransferPoints{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetVelocityLimits{},
+		&MsgFreezeMember{},
+		&MsgUnfreezeMember{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRecordAttestation{},
+		&MsgRevokeAttestation{},
+		&MsgSetKycRequirement{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBlockAddress{},
+		&MsgUnblockAddress{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetLiquidityThresholds{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPaused{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendPoints{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterRemoteTreasury{},
+		&MsgExecuteTopUp{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxReferenceIDLength bounds the size of an earn reference id.
+const MaxReferenceIDLength = 128
+
+// EarnRecordKey returns the key of an earn record. The key doubles as the
+// per-partner reference id dedup index.
+func EarnRecordKey(partnerID uint64, referenceID string) []byte {
+	return append(PartnerKey(partnerID), []byte(referenceID)...)
+}
+
+// ValidateReferenceID checks the length of an earn reference id.
+func ValidateReferenceID(id string) error {
+	if id == "" || len(id) > MaxReferenceIDLength {
+		return fmt.Errorf("reference id must be 1-%d bytes", MaxReferenceIDLength)
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a batch entry and returns the parsed
+// member and points.
+func (e EarnEntry) Validate() (sdk.AccAddress, math.LegacyDec, error) {
+	if err := ValidateReferenceID(e.ReferenceId); err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+	member, err := sdk.AccAddressFromBech32(e.Member)
+	if err != nil {
+		return nil, math.LegacyDec{}, fmt.Errorf("invalid member address: %w", err)
+	}
+	points, err := math.LegacyNewDecFromStr(strings.TrimSpace(e.Points))
+	if err != nil {
+		return nil, math.LegacyDec{}, fmt.Errorf("invalid points: %w", err)
+	}
+	if !points.IsPositive() {
+		return nil, math.LegacyDec{}, fmt.Errorf("points must be > 0")
+	}
+	return member, points, nil
+}
+
+// Validate performs stateless checks on a stored earn record.
+func (r EarnRecord) Validate() error {
+	if r.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	_, _, err := EarnEntry{Member: r.Member, Points: r.Points, ReferenceId: r.ReferenceId}.Validate()
+	return err
+}
@@ -0,0 +1,550 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/earn.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EarnRecord records points a partner issued to a member under a
+// partner-scoped reference id. Reference ids are unique per partner.
+type EarnRecord struct {
+	PartnerId   uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	ReferenceId string `protobuf:"bytes,2,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Member      string `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+	Points      string `protobuf:"bytes,4,opt,name=points,proto3" json:"points,omitempty"`
+	Height      int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// reversed is the part of points clawed back so far.
+	Reversed string `protobuf:"bytes,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
+}
+
+func (m *EarnRecord) Reset()         { *m = EarnRecord{} }
+func (m *EarnRecord) String() string { return proto.CompactTextString(m) }
+func (*EarnRecord) ProtoMessage()    {}
+func (*EarnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbab9804a92d4ae6, []int{0}
+}
+func (m *EarnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EarnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EarnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EarnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarnRecord.Merge(m, src)
+}
+func (m *EarnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EarnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarnRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarnRecord proto.InternalMessageInfo
+
+func (m *EarnRecord) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *EarnRecord) GetReferenceId() string {
+	if m != nil {
+		return m.ReferenceId
+	}
+	return ""
+}
+
+func (m *EarnRecord) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *EarnRecord) GetPoints() string {
+	if m != nil {
+		return m.Points
+	}
+	return ""
+}
+
+func (m *EarnRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EarnRecord) GetReversed() string {
+	if m != nil {
+		return m.Reversed
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EarnRecord)(nil), "rewardchain.rewardchain.EarnRecord")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/earn.proto", fileDescriptor_bbab9804a92d4ae6)
+}
+
+var fileDescriptor_bbab9804a92d4ae6 = []byte{
+	// 267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x63, 0x5a, 0x22, 0x6a, 0x98, 0x2c, 0x04, 0xa6, 0x12, 0x26, 0x74, 0xca, 0x42, 0x8b,
+	0xc4, 0xc4, 0x48, 0x25, 0x86, 0xae, 0x61, 0x63, 0xa9, 0xdc, 0xf8, 0x68, 0x3c, 0xc4, 0x8e, 0xce,
+	0x16, 0x7f, 0xde, 0x82, 0x87, 0xe1, 0x21, 0x18, 0x18, 0x2a, 0x26, 0x46, 0x94, 0xbc, 0x08, 0x4a,
+	0x6a, 0x55, 0x61, 0xbb, 0xdf, 0xcf, 0xdf, 0xf9, 0xa4, 0x8f, 0x4e, 0x10, 0x5e, 0x24, 0xaa, 0xbc,
+	0x90, 0xda, 0xcc, 0xfa, 0x33, 0x48, 0x34, 0xd3, 0x0a, 0xad, 0xb7, 0xec, 0xb4, 0xe7, 0xa7, 0xbd,
+	0x79, 0x7c, 0x96, 0x5b, 0x57, 0x5a, 0xb7, 0xec, 0x62, 0xb3, 0x2d, 0x6c, 0x77, 0x26, 0x5f, 0x84,
+	0xd2, 0x7b, 0x89, 0x26, 0x83, 0xdc, 0xa2, 0x62, 0xe7, 0x94, 0x56, 0x12, 0xbd, 0x01, 0x5c, 0x6a,
+	0xc5, 0x49, 0x42, 0xd2, 0x61, 0x36, 0x0a, 0x66, 0xa1, 0xd8, 0x25, 0x3d, 0x42, 0x78, 0x02, 0x04,
+	0x93, 0x43, 0x1b, 0xd8, 0x4b, 0x48, 0x3a, 0xca, 0x0e, 0x77, 0x6e, 0xa1, 0xd8, 0x35, 0x8d, 0x4b,
+	0x28, 0x57, 0x80, 0x7c, 0xd0, 0x3e, 0xce, 0xf9, 0xf7, 0xc7, 0xd5, 0x71, 0x38, 0x79, 0xa7, 0x14,
+	0x82, 0x73, 0x0f, 0x1e, 0xb5, 0x59, 0x67, 0x21, 0xc7, 0x4e, 0x68, 0x5c, 0x59, 0x6d, 0xbc, 0xe3,
+	0xc3, 0xee, 0xbb, 0x40, 0xad, 0x2f, 0x40, 0xaf, 0x0b, 0xcf, 0xf7, 0x13, 0x92, 0x0e, 0xb2, 0x40,
+	0x6c, 0x4c, 0x0f, 0x10, 0x9e, 0x01, 0x1d, 0x28, 0x1e, 0x77, 0x1b, 0x3b, 0x9e, 0xdf, 0x7e, 0xd6,
+	0x82, 0x6c, 0x6a, 0x41, 0x7e, 0x6b, 0x41, 0xde, 0x1b, 0x11, 0x6d, 0x1a, 0x11, 0xfd, 0x34, 0x22,
+	0x7a, 0xbc, 0xe8, 0x97, 0xf6, 0xfa, 0xaf, 0x42, 0xff, 0x56, 0x81, 0x5b, 0xc5, 0x5d, 0x21, 0x37,
+	0x7f, 0x03, 0x00, 0x69, 0xed, 0x9f, 0x4d, 0x6a, 0x01, 0x00, 0x00,
+}
+
+func (m *EarnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EarnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EarnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reversed) > 0 {
+		i -= len(m.Reversed)
+		copy(dAtA[i:], m.Reversed)
+		i = encodeVarintEarn(dAtA, i, uint64(len(m.Reversed)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintEarn(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Points) > 0 {
+		i -= len(m.Points)
+		copy(dAtA[i:], m.Points)
+		i = encodeVarintEarn(dAtA, i, uint64(len(m.Points)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintEarn(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintEarn(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintEarn(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEarn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEarn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EarnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovEarn(uint64(m.PartnerId))
+	}
+	l = len(m.ReferenceId)
+	if l > 0 {
+		n += 1 + l + sovEarn(uint64(l))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovEarn(uint64(l))
+	}
+	l = len(m.Points)
+	if l > 0 {
+		n += 1 + l + sovEarn(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEarn(uint64(m.Height))
+	}
+	l = len(m.Reversed)
+	if l > 0 {
+		n += 1 + l + sovEarn(uint64(l))
+	}
+	return n
+}
+
+func sovEarn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEarn(x uint64) (n int) {
+	return sovEarn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EarnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEarn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEarn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEarn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEarn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEarn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEarn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEarn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reversed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEarn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEarn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reversed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEarn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEarn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEarn(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEarn
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEarn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEarn
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEarn
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEarn
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEarn        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEarn          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEarn = fmt.Errorf("proto: unexpected end of group")
+)
@@ -11,8 +11,78 @@ var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
 
-	ErrInvalidPartner  = sdkerrors.Register(ModuleName, 1200, "invalid partner")
-	ErrPartnerNotFound = sdkerrors.Register(ModuleName, 1201, "partner not found")
-	ErrPartnerDisabled = sdkerrors.Register(ModuleName, 1202, "partner disabled")
-	ErrUnauthorized    = sdkerrors.Register(ModuleName, 1203, "unauthorized")
+	ErrInvalidPartner   = sdkerrors.Register(ModuleName, 1200, "invalid partner")
+	ErrPartnerNotFound  = sdkerrors.Register(ModuleName, 1201, "partner not found")
+	ErrPartnerDisabled  = sdkerrors.Register(ModuleName, 1202, "partner disabled")
+	ErrUnauthorized     = sdkerrors.Register(ModuleName, 1203, "unauthorized")
+	ErrCurrencyMismatch = sdkerrors.Register(ModuleName, 1204, "currency does not match partner currency")
+
+	ErrInvalidSponsorship = sdkerrors.Register(ModuleName, 1300, "invalid sponsorship")
+
+	ErrInvalidReceipt        = sdkerrors.Register(ModuleName, 1400, "invalid receipt")
+	ErrReceiptKeyNotFound    = sdkerrors.Register(ModuleName, 1401, "receipt key not found")
+	ErrInvalidReceiptSig     = sdkerrors.Register(ModuleName, 1402, "invalid receipt signature")
+	ErrReceiptExpired        = sdkerrors.Register(ModuleName, 1403, "receipt expired")
+	ErrReceiptAlreadyClaimed = sdkerrors.Register(ModuleName, 1404, "receipt already claimed")
+	ErrInsufficientLiquidity = sdkerrors.Register(ModuleName, 1405, "insufficient partner liquidity")
+
+	ErrInvalidEarn          = sdkerrors.Register(ModuleName, 1500, "invalid earn")
+	ErrDuplicateReferenceID = sdkerrors.Register(ModuleName, 1501, "duplicate earn reference id")
+	ErrBatchTooLarge        = sdkerrors.Register(ModuleName, 1502, "earn batch too large")
+	ErrEarnNotFound         = sdkerrors.Register(ModuleName, 1503, "earn reference id not found")
+	ErrInvalidReversal      = sdkerrors.Register(ModuleName, 1504, "invalid earn reversal")
+
+	ErrInvalidClientRef  = sdkerrors.Register(ModuleName, 1600, "invalid client ref")
+	ErrClientRefConflict = sdkerrors.Register(ModuleName, 1601, "client ref already used by another message")
+
+	ErrInvalidObligation   = sdkerrors.Register(ModuleName, 1700, "invalid obligation")
+	ErrSettlementNotFound  = sdkerrors.Register(ModuleName, 1701, "settlement not found")
+	ErrSettlementClosed    = sdkerrors.Register(ModuleName, 1702, "settlement already closed")
+	ErrInsufficientEscrow  = sdkerrors.Register(ModuleName, 1703, "insufficient settlement escrow")
+	ErrInvalidSettlementOp = sdkerrors.Register(ModuleName, 1704, "invalid settlement confirmation")
+	ErrInsufficientPayment = sdkerrors.Register(ModuleName, 1705, "settlement payment below the settlement amount")
+
+	ErrInvalidFee         = sdkerrors.Register(ModuleName, 1800, "invalid fee")
+	ErrInvalidRedeem      = sdkerrors.Register(ModuleName, 1801, "invalid redeem")
+	ErrInsufficientPoints = sdkerrors.Register(ModuleName, 1802, "insufficient member points")
+
+	ErrInvalidRate  = sdkerrors.Register(ModuleName, 1900, "invalid exchange rate")
+	ErrRateNotFound = sdkerrors.Register(ModuleName, 1901, "exchange rate not found")
+	ErrStaleRate    = sdkerrors.Register(ModuleName, 1902, "exchange rate is stale")
+
+	ErrInvalidTransfer     = sdkerrors.Register(ModuleName, 2000, "invalid points transfer")
+	ErrTransferDisabled    = sdkerrors.Register(ModuleName, 2001, "points transfers are disabled for this partner")
+	ErrTransferNotAllowed  = sdkerrors.Register(ModuleName, 2002, "member is not on the partner transfer allowlist")
+	ErrTransferCapExceeded = sdkerrors.Register(ModuleName, 2003, "daily transfer cap exceeded")
+
+	ErrVelocityLimit         = sdkerrors.Register(ModuleName, 2100, "member velocity limit exceeded")
+	ErrMemberFrozen          = sdkerrors.Register(ModuleName, 2101, "member is frozen")
+	ErrInvalidVelocityLimits = sdkerrors.Register(ModuleName, 2102, "invalid velocity limits")
+
+	ErrInvalidAttestation  = sdkerrors.Register(ModuleName, 2200, "invalid attestation")
+	ErrAttestationNotFound = sdkerrors.Register(ModuleName, 2201, "attestation not found")
+	ErrKycRequired         = sdkerrors.Register(ModuleName, 2202, "member attestation level too low for payout")
+
+	ErrAddressBlocked    = sdkerrors.Register(ModuleName, 2300, "address is blocked")
+	ErrInvalidBlock      = sdkerrors.Register(ModuleName, 2301, "invalid blocklist entry")
+	ErrAddressNotBlocked = sdkerrors.Register(ModuleName, 2302, "address is not blocked")
+
+	ErrInvalidLiquidityThresholds = sdkerrors.Register(ModuleName, 2400, "invalid liquidity thresholds")
+	ErrEarnPaused                 = sdkerrors.Register(ModuleName, 2401, "earning is paused for low partner liquidity")
+
+	ErrOperationPaused = sdkerrors.Register(ModuleName, 2500, "operation is paused")
+	ErrInvalidPause    = sdkerrors.Register(ModuleName, 2501, "invalid pause request")
+
+	ErrInvalidPacket            = sdkerrors.Register(ModuleName, 2600, "invalid rewardpoints packet")
+	ErrInvalidVersion           = sdkerrors.Register(ModuleName, 2601, "invalid rewardpoints version")
+	ErrInvalidChannel           = sdkerrors.Register(ModuleName, 2602, "invalid rewardpoints channel")
+	ErrInsufficientPointsEscrow = sdkerrors.Register(ModuleName, 2603, "insufficient escrowed points")
+
+	ErrInvalidTransferMemo = sdkerrors.Register(ModuleName, 2700, "invalid rewardchain transfer memo")
+	ErrDenomNotAccepted    = sdkerrors.Register(ModuleName, 2701, "denom is not accepted by transfer memos")
+
+	ErrInvalidRemoteTreasury  = sdkerrors.Register(ModuleName, 2800, "invalid remote treasury")
+	ErrRemoteTreasuryNotFound = sdkerrors.Register(ModuleName, 2801, "remote treasury not found")
+	ErrRemoteTreasuryNotReady = sdkerrors.Register(ModuleName, 2802, "remote treasury interchain account is not open")
+	ErrTopUpPending           = sdkerrors.Register(ModuleName, 2803, "a top-up is already in flight")
 )
@@ -3,6 +3,10 @@ package types
 import (
 	"fmt"
 	"strings"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // this line is used by starport scaffolding # genesis/types/import
@@ -14,8 +18,49 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:   DefaultParams(),
-		Partners: []Partner{},
+		Params:       DefaultParams(),
+		Partners:     []Partner{},
+		Sponsorships: []Sponsorship{},
+
+		MemberBalances:  []MemberBalance{},
+		ReceiptKeys:     []ReceiptKey{},
+		ClaimedReceipts: []ClaimedReceipt{},
+		EarnRecords:     []EarnRecord{},
+		ClientRefs:      []ClientRefRecord{},
+		MemberDebts:     []MemberDebt{},
+
+		Obligations:       []Obligation{},
+		Settlements:       []Settlement{},
+		SettlementEscrows: []SettlementEscrow{},
+
+		ExchangeRates:   []ExchangeRate{},
+		RateSubmissions: []RateSubmission{},
+
+		TransferPolicies: []TransferPolicy{},
+		PointsTransfers:  []PointsTransfer{},
+		TransferUsages:   []TransferUsage{},
+
+		VelocityLimits:   []VelocityLimits{},
+		VelocityCounters: []VelocityCounter{},
+		MemberSince:      []MemberSince{},
+		FrozenMembers:    []FrozenMember{},
+
+		Attestations:    []Attestation{},
+		KycRequirements: []KycRequirement{},
+		KycPayouts:      []KycPayout{},
+
+		BlockedAddresses: []BlockedAddress{},
+
+		LiquidityThresholds: []LiquidityThresholds{},
+		LiquidityStatuses:   []LiquidityStatus{},
+
+		LiquidityCheckpoints: []LiquidityCheckpoint{},
+
+		PortId:         PortID,
+		PointsEscrows:  []PointsEscrow{},
+		PointsVouchers: []PointsVoucher{},
+
+		RemoteTreasuries: []RemoteTreasury{},
 	}
 }
 
@@ -31,14 +76,446 @@ func (gs GenesisState) Validate() error {
 		if strings.TrimSpace(p.Name) == "" {
 			return fmt.Errorf("partner name is required (id=%d)", p.Id)
 		}
+		// partners created before country codes were enforced may carry a
+		// free-form country; only new partners must use an ISO code
 		if strings.TrimSpace(p.Country) == "" {
 			return fmt.Errorf("partner country is required (id=%d)", p.Id)
 		}
+		if p.Currency != "" {
+			if err := ValidateCurrencyCode(p.Currency); err != nil {
+				return fmt.Errorf("invalid partner %d: %w", p.Id, err)
+			}
+		}
 		if _, ok := seen[p.Id]; ok {
 			return fmt.Errorf("duplicate partner id %d", p.Id)
 		}
 		seen[p.Id] = struct{}{}
 	}
 
+	sponsored := make(map[string]struct{}, len(gs.Sponsorships))
+	for _, s := range gs.Sponsorships {
+		if _, ok := seen[s.PartnerId]; !ok {
+			return fmt.Errorf("sponsorship references unknown partner %d", s.PartnerId)
+		}
+		if _, err := sdk.AccAddressFromBech32(s.Member); err != nil {
+			return fmt.Errorf("invalid sponsored member %q: %w", s.Member, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(s.Granter); err != nil {
+			return fmt.Errorf("invalid sponsorship granter %q: %w", s.Granter, err)
+		}
+		key := fmt.Sprintf("%d/%s", s.PartnerId, s.Member)
+		if _, ok := sponsored[key]; ok {
+			return fmt.Errorf("duplicate sponsorship for member %s of partner %d", s.Member, s.PartnerId)
+		}
+		sponsored[key] = struct{}{}
+	}
+
+	balances := make(map[string]struct{}, len(gs.MemberBalances))
+	for _, b := range gs.MemberBalances {
+		if _, ok := seen[b.PartnerId]; !ok {
+			return fmt.Errorf("member balance references unknown partner %d", b.PartnerId)
+		}
+		if _, err := sdk.AccAddressFromBech32(b.Member); err != nil {
+			return fmt.Errorf("invalid member address %q: %w", b.Member, err)
+		}
+		if _, err := math.LegacyNewDecFromStr(b.Points); err != nil {
+			return fmt.Errorf("invalid points for member %s: %w", b.Member, err)
+		}
+		key := fmt.Sprintf("%d/%s", b.PartnerId, b.Member)
+		if _, ok := balances[key]; ok {
+			return fmt.Errorf("duplicate balance for member %s of partner %d", b.Member, b.PartnerId)
+		}
+		balances[key] = struct{}{}
+	}
+
+	debts := make(map[string]struct{}, len(gs.MemberDebts))
+	for _, d := range gs.MemberDebts {
+		if _, ok := seen[d.PartnerId]; !ok {
+			return fmt.Errorf("member debt references unknown partner %d", d.PartnerId)
+		}
+		if _, err := sdk.AccAddressFromBech32(d.Member); err != nil {
+			return fmt.Errorf("invalid member address %q: %w", d.Member, err)
+		}
+		if points, err := math.LegacyNewDecFromStr(d.Points); err != nil || !points.IsPositive() {
+			return fmt.Errorf("invalid debt for member %s", d.Member)
+		}
+		key := fmt.Sprintf("%d/%s", d.PartnerId, d.Member)
+		if _, ok := debts[key]; ok {
+			return fmt.Errorf("duplicate debt for member %s of partner %d", d.Member, d.PartnerId)
+		}
+		debts[key] = struct{}{}
+	}
+
+	obligations := make(map[uint64]struct{}, len(gs.Obligations))
+	for _, o := range gs.Obligations {
+		if err := o.Validate(); err != nil {
+			return fmt.Errorf("invalid obligation %d: %w", o.Id, err)
+		}
+		if _, ok := obligations[o.Id]; ok || o.Id == 0 {
+			return fmt.Errorf("duplicate or zero obligation id %d", o.Id)
+		}
+		obligations[o.Id] = struct{}{}
+	}
+
+	settlements := make(map[uint64]struct{}, len(gs.Settlements))
+	for _, s := range gs.Settlements {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("invalid settlement %d: %w", s.Id, err)
+		}
+		if _, ok := settlements[s.Id]; ok {
+			return fmt.Errorf("duplicate settlement id %d", s.Id)
+		}
+		settlements[s.Id] = struct{}{}
+	}
+
+	escrows := make(map[uint64]struct{}, len(gs.SettlementEscrows))
+	for _, e := range gs.SettlementEscrows {
+		if _, ok := seen[e.PartnerId]; !ok {
+			return fmt.Errorf("settlement escrow references unknown partner %d", e.PartnerId)
+		}
+		if !e.Balance.IsValid() {
+			return fmt.Errorf("invalid settlement escrow of partner %d", e.PartnerId)
+		}
+		if _, ok := escrows[e.PartnerId]; ok {
+			return fmt.Errorf("duplicate settlement escrow of partner %d", e.PartnerId)
+		}
+		escrows[e.PartnerId] = struct{}{}
+	}
+
+	rates := make(map[string]struct{}, len(gs.ExchangeRates))
+	for _, r := range gs.ExchangeRates {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid exchange rate %s/%s: %w", r.Base, r.Quote, err)
+		}
+		key := r.Base + "/" + r.Quote
+		if _, ok := rates[key]; ok {
+			return fmt.Errorf("duplicate exchange rate %s", key)
+		}
+		rates[key] = struct{}{}
+	}
+
+	submissions := make(map[string]struct{}, len(gs.RateSubmissions))
+	for _, s := range gs.RateSubmissions {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("invalid rate submission %s/%s: %w", s.Base, s.Quote, err)
+		}
+		key := s.Base + "/" + s.Quote + "/" + s.Feeder
+		if _, ok := submissions[key]; ok {
+			return fmt.Errorf("duplicate rate submission %s", key)
+		}
+		submissions[key] = struct{}{}
+	}
+
+	policies := make(map[uint64]struct{}, len(gs.TransferPolicies))
+	for _, tp := range gs.TransferPolicies {
+		if _, ok := seen[tp.PartnerId]; !ok {
+			return fmt.Errorf("transfer policy references unknown partner %d", tp.PartnerId)
+		}
+		if err := tp.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer policy of partner %d: %w", tp.PartnerId, err)
+		}
+		if _, ok := policies[tp.PartnerId]; ok {
+			return fmt.Errorf("duplicate transfer policy of partner %d", tp.PartnerId)
+		}
+		policies[tp.PartnerId] = struct{}{}
+	}
+
+	transfers := make(map[uint64]struct{}, len(gs.PointsTransfers))
+	for _, t := range gs.PointsTransfers {
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("invalid points transfer %d: %w", t.Id, err)
+		}
+		if _, ok := transfers[t.Id]; ok {
+			return fmt.Errorf("duplicate points transfer id %d", t.Id)
+		}
+		transfers[t.Id] = struct{}{}
+	}
+
+	usages := make(map[string]struct{}, len(gs.TransferUsages))
+	for _, u := range gs.TransferUsages {
+		if _, err := sdk.AccAddressFromBech32(u.Member); err != nil {
+			return fmt.Errorf("invalid transfer usage member %q: %w", u.Member, err)
+		}
+		key := fmt.Sprintf("%d/%s", u.PartnerId, u.Member)
+		if _, ok := usages[key]; ok {
+			return fmt.Errorf("duplicate transfer usage for member %s of partner %d", u.Member, u.PartnerId)
+		}
+		usages[key] = struct{}{}
+	}
+
+	limits := make(map[uint64]struct{}, len(gs.VelocityLimits))
+	for _, l := range gs.VelocityLimits {
+		if _, ok := seen[l.PartnerId]; !ok {
+			return fmt.Errorf("velocity limits reference unknown partner %d", l.PartnerId)
+		}
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("invalid velocity limits of partner %d: %w", l.PartnerId, err)
+		}
+		if _, ok := limits[l.PartnerId]; ok {
+			return fmt.Errorf("duplicate velocity limits of partner %d", l.PartnerId)
+		}
+		limits[l.PartnerId] = struct{}{}
+	}
+
+	counters := make(map[string]struct{}, len(gs.VelocityCounters))
+	for _, c := range gs.VelocityCounters {
+		if _, err := sdk.AccAddressFromBech32(c.Member); err != nil {
+			return fmt.Errorf("invalid velocity counter member %q: %w", c.Member, err)
+		}
+		key := fmt.Sprintf("%d/%s/%d", c.PartnerId, c.Member, c.Bucket)
+		if _, ok := counters[key]; ok {
+			return fmt.Errorf("duplicate velocity counter %s", key)
+		}
+		counters[key] = struct{}{}
+	}
+
+	since := make(map[string]struct{}, len(gs.MemberSince))
+	for _, m := range gs.MemberSince {
+		if _, err := sdk.AccAddressFromBech32(m.Member); err != nil {
+			return fmt.Errorf("invalid member_since member %q: %w", m.Member, err)
+		}
+		key := fmt.Sprintf("%d/%s", m.PartnerId, m.Member)
+		if _, ok := since[key]; ok {
+			return fmt.Errorf("duplicate member_since for member %s of partner %d", m.Member, m.PartnerId)
+		}
+		since[key] = struct{}{}
+	}
+
+	frozen := make(map[string]struct{}, len(gs.FrozenMembers))
+	for _, f := range gs.FrozenMembers {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if _, ok := frozen[f.Member]; ok {
+			return fmt.Errorf("duplicate frozen member %s", f.Member)
+		}
+		frozen[f.Member] = struct{}{}
+	}
+
+	attestations := make(map[string]struct{}, len(gs.Attestations))
+	for _, a := range gs.Attestations {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid attestation of %s: %w", a.Member, err)
+		}
+		key := a.Member + "/" + a.Attestor
+		if _, ok := attestations[key]; ok {
+			return fmt.Errorf("duplicate attestation of %s by %s", a.Member, a.Attestor)
+		}
+		attestations[key] = struct{}{}
+	}
+
+	kyc := make(map[uint64]struct{}, len(gs.KycRequirements))
+	for _, r := range gs.KycRequirements {
+		if _, ok := seen[r.PartnerId]; !ok {
+			return fmt.Errorf("kyc requirement references unknown partner %d", r.PartnerId)
+		}
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid kyc requirement of partner %d: %w", r.PartnerId, err)
+		}
+		if _, ok := kyc[r.PartnerId]; ok {
+			return fmt.Errorf("duplicate kyc requirement of partner %d", r.PartnerId)
+		}
+		kyc[r.PartnerId] = struct{}{}
+	}
+
+	payouts := make(map[string]struct{}, len(gs.KycPayouts))
+	for _, c := range gs.KycPayouts {
+		if _, err := sdk.AccAddressFromBech32(c.Member); err != nil {
+			return fmt.Errorf("invalid kyc payout member %q: %w", c.Member, err)
+		}
+		key := fmt.Sprintf("%d/%s/%d", c.PartnerId, c.Member, c.Bucket)
+		if _, ok := payouts[key]; ok {
+			return fmt.Errorf("duplicate kyc payout %s", key)
+		}
+		payouts[key] = struct{}{}
+	}
+
+	blocked := make(map[string]struct{}, len(gs.BlockedAddresses))
+	for _, b := range gs.BlockedAddresses {
+		if err := b.Validate(); err != nil {
+			return fmt.Errorf("invalid blocklist entry %s: %w", b.Address, err)
+		}
+		if _, ok := blocked[b.Address]; ok {
+			return fmt.Errorf("duplicate blocklist entry %s", b.Address)
+		}
+		blocked[b.Address] = struct{}{}
+	}
+
+	thresholds := make(map[uint64]struct{}, len(gs.LiquidityThresholds))
+	for _, t := range gs.LiquidityThresholds {
+		if _, ok := seen[t.PartnerId]; !ok {
+			return fmt.Errorf("liquidity thresholds reference unknown partner %d", t.PartnerId)
+		}
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("invalid liquidity thresholds of partner %d: %w", t.PartnerId, err)
+		}
+		if _, ok := thresholds[t.PartnerId]; ok {
+			return fmt.Errorf("duplicate liquidity thresholds of partner %d", t.PartnerId)
+		}
+		thresholds[t.PartnerId] = struct{}{}
+	}
+
+	liquidityStatuses := make(map[uint64]struct{}, len(gs.LiquidityStatuses))
+	for _, st := range gs.LiquidityStatuses {
+		if _, ok := seen[st.PartnerId]; !ok {
+			return fmt.Errorf("liquidity status references unknown partner %d", st.PartnerId)
+		}
+		if _, ok := LiquidityLevel_name[int32(st.Level)]; !ok {
+			return fmt.Errorf("invalid liquidity level %d of partner %d", st.Level, st.PartnerId)
+		}
+		if _, ok := liquidityStatuses[st.PartnerId]; ok {
+			return fmt.Errorf("duplicate liquidity status of partner %d", st.PartnerId)
+		}
+		liquidityStatuses[st.PartnerId] = struct{}{}
+	}
+
+	checkpoints := make(map[string]struct{}, len(gs.LiquidityCheckpoints))
+	for _, c := range gs.LiquidityCheckpoints {
+		if _, ok := seen[c.PartnerId]; !ok {
+			return fmt.Errorf("liquidity checkpoint references unknown partner %d", c.PartnerId)
+		}
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("invalid liquidity checkpoint of partner %d: %w", c.PartnerId, err)
+		}
+		key := fmt.Sprintf("%d/%d", c.PartnerId, c.Height)
+		if _, ok := checkpoints[key]; ok {
+			return fmt.Errorf("duplicate liquidity checkpoint of partner %d at height %d", c.PartnerId, c.Height)
+		}
+		checkpoints[key] = struct{}{}
+	}
+
+	// An empty port_id binds the default port.
+	if gs.PortId != "" {
+		if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+			return fmt.Errorf("invalid port_id: %w", err)
+		}
+	}
+	pointsEscrows := make(map[string]struct{}, len(gs.PointsEscrows))
+	for _, e := range gs.PointsEscrows {
+		if _, ok := seen[e.PartnerId]; !ok {
+			return fmt.Errorf("points escrow references unknown partner %d", e.PartnerId)
+		}
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid points escrow of partner %d: %w", e.PartnerId, err)
+		}
+		key := fmt.Sprintf("%s/%d", e.ChannelId, e.PartnerId)
+		if _, ok := pointsEscrows[key]; ok {
+			return fmt.Errorf("duplicate points escrow %s", key)
+		}
+		pointsEscrows[key] = struct{}{}
+	}
+	vouchers := make(map[string]struct{}, len(gs.PointsVouchers))
+	for _, v := range gs.PointsVouchers {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("invalid points voucher of %s: %w", v.Holder, err)
+		}
+		key := fmt.Sprintf("%s/%s/%s/%d", v.Holder, v.PortId, v.ChannelId, v.PartnerId)
+		if _, ok := vouchers[key]; ok {
+			return fmt.Errorf("duplicate points voucher %s", key)
+		}
+		vouchers[key] = struct{}{}
+	}
+	remoteTreasuries := make(map[uint64]struct{}, len(gs.RemoteTreasuries))
+	for _, rt := range gs.RemoteTreasuries {
+		if _, ok := seen[rt.PartnerId]; !ok {
+			return fmt.Errorf("remote treasury references unknown partner %d", rt.PartnerId)
+		}
+		if err := rt.Validate(); err != nil {
+			return fmt.Errorf("invalid remote treasury of partner %d: %w", rt.PartnerId, err)
+		}
+		if _, ok := remoteTreasuries[rt.PartnerId]; ok {
+			return fmt.Errorf("duplicate remote treasury for partner %d", rt.PartnerId)
+		}
+		remoteTreasuries[rt.PartnerId] = struct{}{}
+	}
+
+	receiptKeys := make(map[uint64]struct{}, len(gs.ReceiptKeys))
+	for _, rk := range gs.ReceiptKeys {
+		if _, ok := seen[rk.PartnerId]; !ok {
+			return fmt.Errorf("receipt key references unknown partner %d", rk.PartnerId)
+		}
+		if _, err := ReceiptPubKey(rk.KeyType, rk.PubKey); err != nil {
+			return fmt.Errorf("invalid receipt key for partner %d: %w", rk.PartnerId, err)
+		}
+		if _, ok := receiptKeys[rk.PartnerId]; ok {
+			return fmt.Errorf("duplicate receipt key for partner %d", rk.PartnerId)
+		}
+		receiptKeys[rk.PartnerId] = struct{}{}
+	}
+
+	claimed := make(map[string]struct{}, len(gs.ClaimedReceipts))
+	for _, c := range gs.ClaimedReceipts {
+		key := fmt.Sprintf("%d/%s", c.PartnerId, c.Nonce)
+		if c.Nonce == "" {
+			return fmt.Errorf("claimed receipt of partner %d has no nonce", c.PartnerId)
+		}
+		if _, ok := claimed[key]; ok {
+			return fmt.Errorf("duplicate claimed receipt %s", key)
+		}
+		claimed[key] = struct{}{}
+	}
+
+	earned := make(map[string]struct{}, len(gs.EarnRecords))
+	for _, r := range gs.EarnRecords {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid earn record %q: %w", r.ReferenceId, err)
+		}
+		key := fmt.Sprintf("%d/%s", r.PartnerId, r.ReferenceId)
+		if _, ok := earned[key]; ok {
+			return fmt.Errorf("duplicate earn record %s", key)
+		}
+		earned[key] = struct{}{}
+	}
+
+	clientRefs := make(map[string]struct{}, len(gs.ClientRefs))
+	for _, r := range gs.ClientRefs {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid client_ref record %q: %w", r.ClientRef, err)
+		}
+		key := r.Signer + "/" + r.ClientRef
+		if _, ok := clientRefs[key]; ok {
+			return fmt.Errorf("duplicate client_ref record %s", key)
+		}
+		clientRefs[key] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
+
+// AddAdmin appends an address to the genesis admin allowlist.
+func (gs *GenesisState) AddAdmin(addr string) error {
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return fmt.Errorf("invalid admin address %q: %w", addr, err)
+	}
+	for _, a := range gs.Params.AdminAddresses {
+		if a == addr {
+			return fmt.Errorf("%s is already an admin", addr)
+		}
+	}
+	gs.Params.AdminAddresses = append(gs.Params.AdminAddresses, addr)
+	return nil
+}
+
+// AddPartner adds p under the id after the highest genesis partner id, which
+// is where InitGenesis continues the partner counter, and returns that id. Unlike
+// partners already in the genesis, p must use an ISO country code.
+func (gs *GenesisState) AddPartner(p Partner) (uint64, error) {
+	var maxID uint64
+	for _, existing := range gs.Partners {
+		maxID = max(maxID, existing.Id)
+	}
+	p.Id = maxID + 1
+	if _, err := sdk.AccAddressFromBech32(p.Treasury); err != nil {
+		return 0, fmt.Errorf("invalid treasury address %q: %w", p.Treasury, err)
+	}
+	if err := ValidateCountryCode(p.Country); err != nil {
+		return 0, err
+	}
+
+	gs.Partners = append(gs.Partners, p)
+	if err := gs.Validate(); err != nil {
+		gs.Partners = gs.Partners[:len(gs.Partners)-1]
+		return 0, err
+	}
+	return p.Id, nil
+}
@@ -30,6 +30,44 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// partners is the initial list of partners to load at genesis.
 	Partners []Partner `protobuf:"bytes,2,rep,name=partners,proto3" json:"partners"`
+	// sponsorships are the member fee sponsorships funded by partner treasuries.
+	Sponsorships []Sponsorship `protobuf:"bytes,3,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// member_balances are the members' points balances per partner.
+	MemberBalances []MemberBalance `protobuf:"bytes,4,rep,name=member_balances,json=memberBalances,proto3" json:"member_balances"`
+	// receipt_keys are the partners' receipt signing keys.
+	ReceiptKeys []ReceiptKey `protobuf:"bytes,5,rep,name=receipt_keys,json=receiptKeys,proto3" json:"receipt_keys"`
+	// claimed_receipts are the receipt nonces that were already claimed.
+	ClaimedReceipts []ClaimedReceipt `protobuf:"bytes,6,rep,name=claimed_receipts,json=claimedReceipts,proto3" json:"claimed_receipts"`
+	// earn_records are the points issued under partner reference ids.
+	EarnRecords []EarnRecord `protobuf:"bytes,7,rep,name=earn_records,json=earnRecords,proto3" json:"earn_records"`
+	// client_refs are the idempotency records not yet pruned.
+	ClientRefs  []ClientRefRecord `protobuf:"bytes,8,rep,name=client_refs,json=clientRefs,proto3" json:"client_refs"`
+	MemberDebts []MemberDebt      `protobuf:"bytes,9,rep,name=member_debts,json=memberDebts,proto3" json:"member_debts"`
+	// obligations are recorded but not yet netted into settlements.
+	Obligations         []Obligation          `protobuf:"bytes,10,rep,name=obligations,proto3" json:"obligations"`
+	Settlements         []Settlement          `protobuf:"bytes,11,rep,name=settlements,proto3" json:"settlements"`
+	SettlementEscrows   []SettlementEscrow    `protobuf:"bytes,12,rep,name=settlement_escrows,json=settlementEscrows,proto3" json:"settlement_escrows"`
+	ExchangeRates       []ExchangeRate        `protobuf:"bytes,13,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates"`
+	RateSubmissions     []RateSubmission      `protobuf:"bytes,14,rep,name=rate_submissions,json=rateSubmissions,proto3" json:"rate_submissions"`
+	TransferPolicies    []TransferPolicy      `protobuf:"bytes,15,rep,name=transfer_policies,json=transferPolicies,proto3" json:"transfer_policies"`
+	PointsTransfers     []PointsTransfer      `protobuf:"bytes,16,rep,name=points_transfers,json=pointsTransfers,proto3" json:"points_transfers"`
+	TransferUsages      []TransferUsage       `protobuf:"bytes,17,rep,name=transfer_usages,json=transferUsages,proto3" json:"transfer_usages"`
+	VelocityLimits      []VelocityLimits      `protobuf:"bytes,18,rep,name=velocity_limits,json=velocityLimits,proto3" json:"velocity_limits"`
+	VelocityCounters    []VelocityCounter     `protobuf:"bytes,19,rep,name=velocity_counters,json=velocityCounters,proto3" json:"velocity_counters"`
+	MemberSince         []MemberSince         `protobuf:"bytes,20,rep,name=member_since,json=memberSince,proto3" json:"member_since"`
+	FrozenMembers       []FrozenMember        `protobuf:"bytes,21,rep,name=frozen_members,json=frozenMembers,proto3" json:"frozen_members"`
+	Attestations        []Attestation         `protobuf:"bytes,22,rep,name=attestations,proto3" json:"attestations"`
+	KycRequirements     []KycRequirement      `protobuf:"bytes,23,rep,name=kyc_requirements,json=kycRequirements,proto3" json:"kyc_requirements"`
+	KycPayouts          []KycPayout           `protobuf:"bytes,32,rep,name=kyc_payouts,json=kycPayouts,proto3" json:"kyc_payouts"`
+	BlockedAddresses    []BlockedAddress      `protobuf:"bytes,24,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses"`
+	LiquidityThresholds []LiquidityThresholds `protobuf:"bytes,25,rep,name=liquidity_thresholds,json=liquidityThresholds,proto3" json:"liquidity_thresholds"`
+	LiquidityStatuses   []LiquidityStatus     `protobuf:"bytes,26,rep,name=liquidity_statuses,json=liquidityStatuses,proto3" json:"liquidity_statuses"`
+	// port_id is the port the rewardpoints IBC application binds to.
+	PortId               string                `protobuf:"bytes,27,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	PointsEscrows        []PointsEscrow        `protobuf:"bytes,28,rep,name=points_escrows,json=pointsEscrows,proto3" json:"points_escrows"`
+	PointsVouchers       []PointsVoucher       `protobuf:"bytes,29,rep,name=points_vouchers,json=pointsVouchers,proto3" json:"points_vouchers"`
+	RemoteTreasuries     []RemoteTreasury      `protobuf:"bytes,30,rep,name=remote_treasuries,json=remoteTreasuries,proto3" json:"remote_treasuries"`
+	LiquidityCheckpoints []LiquidityCheckpoint `protobuf:"bytes,31,rep,name=liquidity_checkpoints,json=liquidityCheckpoints,proto3" json:"liquidity_checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +117,216 @@ func (m *GenesisState) GetPartners() []Partner {
 	return nil
 }
 
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMemberBalances() []MemberBalance {
+	if m != nil {
+		return m.MemberBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiptKeys() []ReceiptKey {
+	if m != nil {
+		return m.ReceiptKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimedReceipts() []ClaimedReceipt {
+	if m != nil {
+		return m.ClaimedReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEarnRecords() []EarnRecord {
+	if m != nil {
+		return m.EarnRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClientRefs() []ClientRefRecord {
+	if m != nil {
+		return m.ClientRefs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMemberDebts() []MemberDebt {
+	if m != nil {
+		return m.MemberDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObligations() []Obligation {
+	if m != nil {
+		return m.Obligations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlements() []Settlement {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlementEscrows() []SettlementEscrow {
+	if m != nil {
+		return m.SettlementEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRates() []ExchangeRate {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateSubmissions() []RateSubmission {
+	if m != nil {
+		return m.RateSubmissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferPolicies() []TransferPolicy {
+	if m != nil {
+		return m.TransferPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPointsTransfers() []PointsTransfer {
+	if m != nil {
+		return m.PointsTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferUsages() []TransferUsage {
+	if m != nil {
+		return m.TransferUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVelocityLimits() []VelocityLimits {
+	if m != nil {
+		return m.VelocityLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVelocityCounters() []VelocityCounter {
+	if m != nil {
+		return m.VelocityCounters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMemberSince() []MemberSince {
+	if m != nil {
+		return m.MemberSince
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenMembers() []FrozenMember {
+	if m != nil {
+		return m.FrozenMembers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKycRequirements() []KycRequirement {
+	if m != nil {
+		return m.KycRequirements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKycPayouts() []KycPayout {
+	if m != nil {
+		return m.KycPayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedAddresses() []BlockedAddress {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidityThresholds() []LiquidityThresholds {
+	if m != nil {
+		return m.LiquidityThresholds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidityStatuses() []LiquidityStatus {
+	if m != nil {
+		return m.LiquidityStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetPointsEscrows() []PointsEscrow {
+	if m != nil {
+		return m.PointsEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPointsVouchers() []PointsVoucher {
+	if m != nil {
+		return m.PointsVouchers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRemoteTreasuries() []RemoteTreasury {
+	if m != nil {
+		return m.RemoteTreasuries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidityCheckpoints() []LiquidityCheckpoint {
+	if m != nil {
+		return m.LiquidityCheckpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "rewardchain.rewardchain.GenesisState")
 }
@@ -88,22 +336,76 @@ func init() {
 }
 
 var fileDescriptor_8dd0f2d2cdb4aa54 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4a, 0x2d, 0x4f,
-	0x2c, 0x4a, 0x49, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x47, 0x66, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67,
-	0x16, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x23, 0x49, 0xe9, 0x21, 0xb1, 0xa5, 0x04,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xad, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x55, 0x70, 0x59, 0x54, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xb5,
-	0x47, 0x4a, 0x15, 0x8f, 0xaa, 0x92, 0xbc, 0xd4, 0x22, 0x88, 0x32, 0xa5, 0x69, 0x8c, 0x5c, 0x3c,
-	0xee, 0x10, 0x07, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x41, 0xcc, 0x91, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xe1, 0x60, 0xbd, 0x00, 0xb0, 0x32, 0x27, 0xce,
-	0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x29, 0xe4, 0xc4, 0xc5,
-	0x01, 0xb5, 0xa5, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x01, 0x9f, 0x29, 0x20, 0x85,
-	0x4e, 0x2c, 0x20, 0x63, 0x82, 0xe0, 0xfa, 0x9c, 0x2c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x1e, 0xd9, 0x37, 0x15, 0x28, 0x7e, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x7b, 0xcd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xb1, 0x08, 0x43, 0x92, 0x01,
-	0x00, 0x00,
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x6e, 0xdc, 0x36,
+	0x17, 0xf5, 0x7c, 0xc9, 0xe7, 0x24, 0x1a, 0xff, 0x32, 0x4e, 0xcd, 0xba, 0xed, 0xd8, 0x70, 0xe3,
+	0xc4, 0x09, 0x5a, 0x1b, 0x48, 0x57, 0x5d, 0x66, 0xdc, 0xb4, 0x08, 0xec, 0x34, 0xc6, 0x8c, 0x63,
+	0x14, 0x29, 0x5a, 0x81, 0x23, 0x5d, 0xcf, 0x10, 0x23, 0x89, 0x0a, 0x2f, 0xc7, 0xc9, 0xf4, 0x29,
+	0xfa, 0x18, 0x5d, 0xf6, 0x31, 0xb2, 0xcc, 0xb2, 0xab, 0xa2, 0xb0, 0x17, 0x7d, 0x8c, 0x16, 0xa4,
+	0x48, 0x8d, 0xd4, 0x40, 0x66, 0x36, 0x86, 0x7c, 0x74, 0xce, 0xe1, 0x15, 0xef, 0xe5, 0x19, 0x06,
+	0x3b, 0x12, 0x5e, 0x33, 0x19, 0x47, 0x23, 0xc6, 0xb3, 0xfd, 0xea, 0xf3, 0x10, 0x32, 0x40, 0x8e,
+	0x7b, 0xb9, 0x14, 0x4a, 0x90, 0xf5, 0xca, 0xab, 0xbd, 0xca, 0xf3, 0xc6, 0x2a, 0x4b, 0x79, 0x26,
+	0xf6, 0xcd, 0xdf, 0x82, 0xbb, 0xb1, 0x36, 0x14, 0x43, 0x61, 0x1e, 0xf7, 0xf5, 0x93, 0x45, 0xef,
+	0x36, 0x2d, 0x94, 0x33, 0xc9, 0x52, 0xbb, 0xce, 0xc6, 0x83, 0x26, 0x16, 0x53, 0x0a, 0x50, 0x31,
+	0xc5, 0x45, 0x66, 0xa9, 0xf7, 0x9b, 0xa8, 0x83, 0x44, 0x44, 0xe3, 0x84, 0xa3, 0xb2, 0xc4, 0xdd,
+	0x26, 0x62, 0x94, 0x70, 0xc8, 0x54, 0x28, 0xe1, 0xcc, 0x32, 0xb7, 0x9b, 0x98, 0xc0, 0xa4, 0x77,
+	0xd9, 0x84, 0xbf, 0x9a, 0xf0, 0x98, 0xab, 0xa9, 0xef, 0x83, 0x53, 0x48, 0x07, 0x20, 0x7d, 0x2c,
+	0x21, 0x59, 0x94, 0x80, 0x65, 0xed, 0x5c, 0xb1, 0x79, 0x2a, 0x03, 0xe9, 0xa3, 0x49, 0x88, 0x80,
+	0xe7, 0x6e, 0x43, 0xbe, 0x6c, 0xa6, 0xa5, 0x42, 0x41, 0xa8, 0x24, 0x30, 0x9c, 0x48, 0xf7, 0x21,
+	0x0f, 0x9b, 0xe9, 0xfa, 0x39, 0x17, 0x3c, 0x53, 0xe8, 0xdb, 0x6b, 0x04, 0xa5, 0x12, 0x48, 0x21,
+	0x53, 0xbe, 0x4e, 0x63, 0x2e, 0x32, 0x14, 0x12, 0x47, 0x3c, 0xb7, 0xd4, 0x7b, 0x4d, 0x54, 0x25,
+	0x59, 0x86, 0x67, 0x20, 0x7d, 0xbc, 0x73, 0x48, 0x44, 0x54, 0x76, 0x66, 0xfb, 0x9f, 0xb5, 0x60,
+	0xe1, 0xbb, 0x62, 0xbc, 0xfb, 0x8a, 0x29, 0x20, 0xdd, 0x60, 0xbe, 0x98, 0x42, 0xda, 0xda, 0x6a,
+	0xed, 0xb6, 0x1f, 0x6d, 0xee, 0x35, 0x8c, 0xfb, 0xde, 0xb1, 0xa1, 0x75, 0x6f, 0xbd, 0xfd, 0x73,
+	0x73, 0xee, 0xb7, 0xbf, 0x7f, 0x7f, 0xd8, 0xea, 0x59, 0x25, 0xe9, 0x06, 0x37, 0x6d, 0x33, 0x90,
+	0xfe, 0x6f, 0xeb, 0xda, 0x6e, 0xfb, 0xd1, 0xd6, 0x55, 0x2e, 0x9a, 0xd8, 0xbd, 0xae, 0x6d, 0x7a,
+	0xa5, 0x8e, 0x7c, 0x1f, 0x2c, 0x54, 0xbe, 0x1e, 0xe9, 0x35, 0xe3, 0x73, 0xb7, 0xd1, 0xa7, 0x3f,
+	0x23, 0x5b, 0xaf, 0x9a, 0x9e, 0xbc, 0x08, 0x96, 0x8b, 0x61, 0x0b, 0x07, 0x2c, 0x61, 0x59, 0x04,
+	0x48, 0xaf, 0x1b, 0xcb, 0x7b, 0x8d, 0x96, 0xcf, 0x0c, 0xbf, 0x5b, 0xd0, 0xad, 0xe9, 0x52, 0x5a,
+	0x05, 0x91, 0x1c, 0x05, 0x0b, 0x76, 0xa0, 0xc2, 0x31, 0x4c, 0x91, 0xfe, 0xdf, 0x78, 0x7e, 0xde,
+	0xe8, 0xd9, 0x2b, 0xc8, 0x87, 0x30, 0xb5, 0x86, 0x6d, 0x59, 0x22, 0x48, 0x7e, 0x08, 0x56, 0xa2,
+	0x84, 0xf1, 0x14, 0xe2, 0xd0, 0xc2, 0x48, 0xe7, 0x8d, 0xe3, 0xfd, 0x46, 0xc7, 0x83, 0x42, 0x60,
+	0x8d, 0xad, 0xeb, 0x72, 0x54, 0x43, 0x4d, 0x9d, 0xc0, 0x64, 0xa6, 0x6d, 0x85, 0x8c, 0x91, 0xde,
+	0xf0, 0xd4, 0xf9, 0x84, 0xc9, 0xac, 0x67, 0xb8, 0xae, 0x4e, 0x28, 0x11, 0x24, 0xcf, 0x83, 0xf6,
+	0x2c, 0x30, 0x90, 0xde, 0x34, 0x66, 0xbb, 0x57, 0x94, 0xa8, 0xb9, 0x3d, 0x38, 0xab, 0x39, 0x06,
+	0x91, 0x83, 0x4d, 0x79, 0xb6, 0x3b, 0x31, 0x0c, 0x14, 0xd2, 0x5b, 0x9e, 0xf2, 0x8a, 0xd6, 0x7c,
+	0x03, 0x03, 0xf7, 0xc1, 0xed, 0xb4, 0x44, 0x90, 0x1c, 0x06, 0x6d, 0x31, 0x48, 0xf8, 0xd0, 0x44,
+	0x24, 0xd2, 0xc0, 0x63, 0xf6, 0xbc, 0xe4, 0x3a, 0xb3, 0x8a, 0x5a, 0x9b, 0xcd, 0x0e, 0x2c, 0xd2,
+	0xb6, 0xc7, 0xac, 0x5f, 0x72, 0x9d, 0x59, 0x45, 0x4d, 0x7e, 0x0e, 0xc8, 0xec, 0xdf, 0x10, 0x30,
+	0x92, 0xe2, 0x35, 0xd2, 0x05, 0xe3, 0xf9, 0xe0, 0x03, 0x3c, 0x9f, 0x18, 0x85, 0x75, 0x5e, 0xc5,
+	0xff, 0xe0, 0x48, 0x7a, 0xc1, 0x12, 0xbc, 0x89, 0x46, 0x2c, 0x1b, 0x42, 0x28, 0x99, 0x02, 0xa4,
+	0x8b, 0xc6, 0x7b, 0xa7, 0xb9, 0xd1, 0x96, 0xde, 0x63, 0xca, 0xcd, 0xf8, 0x22, 0x54, 0x30, 0x33,
+	0x94, 0xda, 0x2a, 0xc4, 0xc9, 0x20, 0xe5, 0x88, 0x66, 0x4b, 0x97, 0x3c, 0x43, 0xa9, 0x95, 0xfd,
+	0x92, 0xef, 0x86, 0x52, 0xd6, 0x50, 0x24, 0x2f, 0x83, 0x55, 0x17, 0x5b, 0x61, 0x2e, 0x12, 0x1e,
+	0x71, 0x40, 0xba, 0xec, 0xb1, 0x3e, 0xb1, 0x8a, 0x63, 0x2d, 0x70, 0xa7, 0x68, 0x45, 0x55, 0x51,
+	0x5e, 0x54, 0x5d, 0xa4, 0x71, 0xe8, 0x5e, 0x21, 0x5d, 0xf1, 0x58, 0x1f, 0x1b, 0x81, 0x5b, 0xc0,
+	0x55, 0x9d, 0xd7, 0x50, 0x93, 0x24, 0x65, 0xd5, 0x13, 0x64, 0x43, 0x40, 0xba, 0xea, 0x49, 0x12,
+	0x27, 0x7e, 0xa1, 0xe9, 0x2e, 0x49, 0x54, 0x15, 0x44, 0x72, 0x1a, 0x2c, 0xbb, 0x6c, 0x0e, 0x13,
+	0x9e, 0x72, 0x85, 0x94, 0x78, 0xea, 0x3d, 0xb5, 0xfc, 0x23, 0x43, 0x77, 0xbe, 0xe7, 0x35, 0x94,
+	0xfc, 0x18, 0xac, 0x96, 0xbe, 0x91, 0x98, 0x64, 0x4a, 0xef, 0xc4, 0x6d, 0xcf, 0x89, 0x75, 0xce,
+	0x07, 0x85, 0xc0, 0xed, 0xf2, 0x79, 0x1d, 0x46, 0xf2, 0xac, 0x3c, 0xb7, 0xc8, 0xb3, 0x08, 0xe8,
+	0x9a, 0x27, 0xa5, 0x8b, 0x73, 0xdb, 0xe7, 0xb3, 0x40, 0x6d, 0xa7, 0x33, 0x48, 0x8f, 0xef, 0x99,
+	0x14, 0xbf, 0x40, 0x16, 0x16, 0x28, 0xd2, 0x3b, 0x9e, 0xf1, 0xfd, 0xd6, 0xd0, 0x6d, 0x52, 0xdb,
+	0xf1, 0x3d, 0xab, 0x60, 0xe6, 0x87, 0xa4, 0x72, 0x61, 0x42, 0xfa, 0x91, 0xa7, 0xc4, 0xc7, 0x33,
+	0xb2, 0xfb, 0x21, 0xa9, 0xea, 0xf5, 0x60, 0x8d, 0xa7, 0x51, 0x28, 0xe1, 0xd5, 0x84, 0x4b, 0x1b,
+	0x0a, 0xeb, 0x9e, 0x46, 0x1d, 0x4e, 0xa3, 0xde, 0x8c, 0xef, 0x06, 0x6b, 0x5c, 0x43, 0x91, 0x3c,
+	0x0d, 0xda, 0xda, 0x39, 0x67, 0x53, 0x31, 0x51, 0x48, 0xb7, 0x8c, 0xe9, 0xf6, 0x55, 0xa6, 0xc7,
+	0x86, 0xea, 0xf2, 0x74, 0xec, 0x00, 0x73, 0xb2, 0xcc, 0xd5, 0x0f, 0xe2, 0x90, 0xc5, 0xb1, 0x04,
+	0x44, 0x40, 0x4a, 0x3d, 0x55, 0x76, 0x0b, 0xc5, 0xe3, 0x42, 0xe0, 0x7a, 0x3e, 0xa8, 0xa1, 0x80,
+	0x04, 0x82, 0xb5, 0xf2, 0x7e, 0x17, 0xaa, 0x91, 0x04, 0x1c, 0x89, 0x24, 0x46, 0xfa, 0xb1, 0xb1,
+	0xff, 0xa2, 0xd1, 0xfe, 0xc8, 0x89, 0x4e, 0x4a, 0x8d, 0x5d, 0xe3, 0x76, 0xf2, 0xfe, 0x2b, 0xf2,
+	0x53, 0x40, 0x66, 0xcb, 0xe8, 0xdd, 0x9f, 0xe8, 0x6f, 0xd8, 0xf0, 0x0c, 0x6e, 0xb9, 0x48, 0xdf,
+	0x28, 0x5c, 0x52, 0x26, 0x75, 0x18, 0x90, 0xac, 0x07, 0x37, 0x72, 0x21, 0x55, 0xc8, 0x63, 0xfa,
+	0xc9, 0x56, 0x6b, 0xf7, 0x56, 0x6f, 0x5e, 0xff, 0xfb, 0x34, 0xd6, 0x33, 0x68, 0x83, 0xc3, 0xc5,
+	0xf3, 0xa7, 0x9e, 0x19, 0x2c, 0x62, 0xa3, 0x16, 0xcd, 0x8b, 0x79, 0x05, 0x33, 0x91, 0x61, 0x3d,
+	0xcf, 0xc5, 0x24, 0x1a, 0xe9, 0xc1, 0xfe, 0xcc, 0x13, 0x19, 0x85, 0xe9, 0x69, 0x41, 0x77, 0x47,
+	0x3b, 0xaf, 0x82, 0xa6, 0xcb, 0xf5, 0x6b, 0xaa, 0xce, 0xcf, 0x8e, 0x2f, 0x9a, 0x8d, 0xe2, 0xc4,
+	0xde, 0x6b, 0x5d, 0x97, 0x65, 0x15, 0xd5, 0xf9, 0x39, 0x0c, 0xee, 0xcc, 0xb6, 0x3f, 0x1a, 0x41,
+	0x34, 0x2e, 0x16, 0xa7, 0x9b, 0x1f, 0xda, 0xe6, 0x83, 0x52, 0x64, 0x17, 0x59, 0x4b, 0xde, 0x7f,
+	0x85, 0xdd, 0xaf, 0xdf, 0x5e, 0x74, 0x5a, 0xef, 0x2e, 0x3a, 0xad, 0xbf, 0x2e, 0x3a, 0xad, 0x5f,
+	0x2f, 0x3b, 0x73, 0xef, 0x2e, 0x3b, 0x73, 0x7f, 0x5c, 0x76, 0xe6, 0x5e, 0x6e, 0x56, 0xef, 0xad,
+	0x6f, 0xea, 0xb7, 0xdd, 0x69, 0x0e, 0x38, 0x98, 0x37, 0x77, 0xd8, 0xaf, 0xfe, 0x1d, 0x00, 0x33,
+	0xa0, 0xf5, 0x92, 0xb9, 0x0d, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,99 +428,1573 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Partners) > 0 {
-		for iNdEx := len(m.Partners) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.KycPayouts) > 0 {
+		for iNdEx := len(m.KycPayouts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Partners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.KycPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.LiquidityCheckpoints) > 0 {
+		for iNdEx := len(m.LiquidityCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.RemoteTreasuries) > 0 {
+		for iNdEx := len(m.RemoteTreasuries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteTreasuries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.PointsVouchers) > 0 {
+		for iNdEx := len(m.PointsVouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointsVouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.PointsEscrows) > 0 {
+		for iNdEx := len(m.PointsEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointsEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.LiquidityStatuses) > 0 {
+		for iNdEx := len(m.LiquidityStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.LiquidityThresholds) > 0 {
+		for iNdEx := len(m.LiquidityThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.KycRequirements) > 0 {
+		for iNdEx := len(m.KycRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KycRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.FrozenMembers) > 0 {
+		for iNdEx := len(m.FrozenMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.MemberSince) > 0 {
+		for iNdEx := len(m.MemberSince) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberSince[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.VelocityCounters) > 0 {
+		for iNdEx := len(m.VelocityCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.VelocityLimits) > 0 {
+		for iNdEx := len(m.VelocityLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for iNdEx := len(m.TransferUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PointsTransfers) > 0 {
+		for iNdEx := len(m.PointsTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PointsTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TransferPolicies) > 0 {
+		for iNdEx := len(m.TransferPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RateSubmissions) > 0 {
+		for iNdEx := len(m.RateSubmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateSubmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SettlementEscrows) > 0 {
+		for iNdEx := len(m.SettlementEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Obligations) > 0 {
+		for iNdEx := len(m.Obligations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Obligations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MemberDebts) > 0 {
+		for iNdEx := len(m.MemberDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ClientRefs) > 0 {
+		for iNdEx := len(m.ClientRefs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientRefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EarnRecords) > 0 {
+		for iNdEx := len(m.EarnRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarnRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClaimedReceipts) > 0 {
+		for iNdEx := len(m.ClaimedReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReceiptKeys) > 0 {
+		for iNdEx := len(m.ReceiptKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MemberBalances) > 0 {
+		for iNdEx := len(m.MemberBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Partners) > 0 {
+		for iNdEx := len(m.Partners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Partners) > 0 {
+		for _, e := range m.Partners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MemberBalances) > 0 {
+		for _, e := range m.MemberBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptKeys) > 0 {
+		for _, e := range m.ReceiptKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimedReceipts) > 0 {
+		for _, e := range m.ClaimedReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EarnRecords) > 0 {
+		for _, e := range m.EarnRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClientRefs) > 0 {
+		for _, e := range m.ClientRefs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MemberDebts) > 0 {
+		for _, e := range m.MemberDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Obligations) > 0 {
+		for _, e := range m.Obligations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementEscrows) > 0 {
+		for _, e := range m.SettlementEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateSubmissions) > 0 {
+		for _, e := range m.RateSubmissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferPolicies) > 0 {
+		for _, e := range m.TransferPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PointsTransfers) > 0 {
+		for _, e := range m.PointsTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for _, e := range m.TransferUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VelocityLimits) > 0 {
+		for _, e := range m.VelocityLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VelocityCounters) > 0 {
+		for _, e := range m.VelocityCounters {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MemberSince) > 0 {
+		for _, e := range m.MemberSince {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenMembers) > 0 {
+		for _, e := range m.FrozenMembers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KycRequirements) > 0 {
+		for _, e := range m.KycRequirements {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, e := range m.BlockedAddresses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityThresholds) > 0 {
+		for _, e := range m.LiquidityThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityStatuses) > 0 {
+		for _, e := range m.LiquidityStatuses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PointsEscrows) > 0 {
+		for _, e := range m.PointsEscrows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PointsVouchers) > 0 {
+		for _, e := range m.PointsVouchers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RemoteTreasuries) > 0 {
+		for _, e := range m.RemoteTreasuries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityCheckpoints) > 0 {
+		for _, e := range m.LiquidityCheckpoints {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KycPayouts) > 0 {
+		for _, e := range m.KycPayouts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partners = append(m.Partners, Partner{})
+			if err := m.Partners[len(m.Partners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberBalances = append(m.MemberBalances, MemberBalance{})
+			if err := m.MemberBalances[len(m.MemberBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptKeys = append(m.ReceiptKeys, ReceiptKey{})
+			if err := m.ReceiptKeys[len(m.ReceiptKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedReceipts = append(m.ClaimedReceipts, ClaimedReceipt{})
+			if err := m.ClaimedReceipts[len(m.ClaimedReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarnRecords = append(m.EarnRecords, EarnRecord{})
+			if err := m.EarnRecords[len(m.EarnRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRefs = append(m.ClientRefs, ClientRefRecord{})
+			if err := m.ClientRefs[len(m.ClientRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberDebts = append(m.MemberDebts, MemberDebt{})
+			if err := m.MemberDebts[len(m.MemberDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obligations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Obligations = append(m.Obligations, Obligation{})
+			if err := m.Obligations[len(m.Obligations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, Settlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementEscrows = append(m.SettlementEscrows, SettlementEscrow{})
+			if err := m.SettlementEscrows[len(m.SettlementEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRate{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSubmissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateSubmissions = append(m.RateSubmissions, RateSubmission{})
+			if err := m.RateSubmissions[len(m.RateSubmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferPolicies = append(m.TransferPolicies, TransferPolicy{})
+			if err := m.TransferPolicies[len(m.TransferPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointsTransfers = append(m.PointsTransfers, PointsTransfer{})
+			if err := m.PointsTransfers[len(m.PointsTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferUsages = append(m.TransferUsages, TransferUsage{})
+			if err := m.TransferUsages[len(m.TransferUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityLimits = append(m.VelocityLimits, VelocityLimits{})
+			if err := m.VelocityLimits[len(m.VelocityLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityCounters = append(m.VelocityCounters, VelocityCounter{})
+			if err := m.VelocityCounters[len(m.VelocityCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberSince = append(m.MemberSince, MemberSince{})
+			if err := m.MemberSince[len(m.MemberSince)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenMembers = append(m.FrozenMembers, FrozenMember{})
+			if err := m.FrozenMembers[len(m.FrozenMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KycRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KycRequirements = append(m.KycRequirements, KycRequirement{})
+			if err := m.KycRequirements[len(m.KycRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, BlockedAddress{})
+			if err := m.BlockedAddresses[len(m.BlockedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Partners) > 0 {
-		for _, e := range m.Partners {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LiquidityThresholds = append(m.LiquidityThresholds, LiquidityThresholds{})
+			if err := m.LiquidityThresholds[len(m.LiquidityThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -245,13 +2021,46 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LiquidityStatuses = append(m.LiquidityStatuses, LiquidityStatus{})
+			if err := m.LiquidityStatuses[len(m.LiquidityStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsEscrows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -278,8 +2087,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Partners = append(m.Partners, Partner{})
-			if err := m.Partners[len(m.Partners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PointsEscrows = append(m.PointsEscrows, PointsEscrow{})
+			if err := m.PointsEscrows[len(m.PointsEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointsVouchers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointsVouchers = append(m.PointsVouchers, PointsVoucher{})
+			if err := m.PointsVouchers[len(m.PointsVouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTreasuries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteTreasuries = append(m.RemoteTreasuries, RemoteTreasury{})
+			if err := m.RemoteTreasuries[len(m.RemoteTreasuries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityCheckpoints = append(m.LiquidityCheckpoints, LiquidityCheckpoint{})
+			if err := m.LiquidityCheckpoints[len(m.LiquidityCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KycPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KycPayouts = append(m.KycPayouts, KycPayout{})
+			if err := m.KycPayouts[len(m.KycPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
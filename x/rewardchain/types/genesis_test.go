@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"rewardchain/testutil/sample"
 	"rewardchain/x/rewardchain/types"
 
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,15 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "liquidity checkpoint of unknown partner",
+			genState: &types.GenesisState{
+				LiquidityCheckpoints: []types.LiquidityCheckpoint{
+					{PartnerId: 1, Height: 10, TotalLiquidity: "1", AvailableLiquidity: "1", OnHoldLiquidity: "0"},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -40,3 +50,31 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_AddAdminAndPartner(t *testing.T) {
+	gs := types.DefaultGenesis()
+	admin := sample.AccAddress()
+	require.NoError(t, gs.AddAdmin(admin))
+	require.ErrorContains(t, gs.AddAdmin(admin), "already an admin")
+	require.Error(t, gs.AddAdmin("not-an-address"))
+	require.Equal(t, []string{admin}, gs.Params.AdminAddresses)
+
+	// ids continue after the highest partner id
+	gs.Partners = append(gs.Partners, types.Partner{Id: 7, Name: "Acme", Country: "US", Currency: "USD"})
+	id, err := gs.AddPartner(types.Partner{Name: "Globex", Country: "GB", Currency: "GBP", Treasury: admin})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), id)
+
+	_, err = gs.AddPartner(types.Partner{Name: "Initech", Country: "XX", Currency: "EUR", Treasury: admin})
+	require.Error(t, err)
+	_, err = gs.AddPartner(types.Partner{Name: "Initech", Country: "DE", Currency: "EUR"})
+	require.ErrorContains(t, err, "invalid treasury address")
+	require.Len(t, gs.Partners, 2)
+	require.NoError(t, gs.Validate())
+
+	// legacy partners with a free-form country still validate
+	gs.Partners = append(gs.Partners, types.Partner{Id: 9, Name: "Legacy", Country: "Mumbai"})
+	require.NoError(t, gs.Validate())
+	gs.Partners[2].Country = ""
+	require.ErrorContains(t, gs.Validate(), "partner country is required")
+}
@@ -9,6 +9,12 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_rewardchain"
+
+	// PortID is the default port the rewardpoints IBC application binds to.
+	PortID = "rewardpoints"
+
+	// Version is the rewardpoints IBC application version.
+	Version = "rewardpoints-1"
 )
 
 var (
@@ -16,6 +22,59 @@ var (
 
 	PartnerCountKey  = []byte("p_rewardchain_partner_count")
 	PartnerKeyPrefix = []byte("p_rewardchain_partner/")
+
+	SponsorshipKeyPrefix = []byte("p_rewardchain_sponsorship/")
+
+	MemberBalanceKeyPrefix  = []byte("p_rewardchain_member_balance/")
+	MemberDebtKeyPrefix     = []byte("p_rewardchain_member_debt/")
+	ReceiptKeyKeyPrefix     = []byte("p_rewardchain_receipt_key/")
+	ClaimedReceiptKeyPrefix = []byte("p_rewardchain_claimed_receipt/")
+
+	ClaimedReceiptExpiryKeyPrefix = []byte("p_rewardchain_claimed_receipt_expiry/")
+
+	EarnRecordKeyPrefix = []byte("p_rewardchain_earn_record/")
+
+	ClientRefKeyPrefix       = []byte("p_rewardchain_client_ref/")
+	ClientRefExpiryKeyPrefix = []byte("p_rewardchain_client_ref_expiry/")
+
+	ObligationCountKey        = []byte("p_rewardchain_obligation_count")
+	ObligationKeyPrefix       = []byte("p_rewardchain_obligation/")
+	SettlementCountKey        = []byte("p_rewardchain_settlement_count")
+	SettlementKeyPrefix       = []byte("p_rewardchain_settlement/")
+	SettlementIndexKeyPrefix  = []byte("p_rewardchain_settlement_index/")
+	SettlementEscrowKeyPrefix = []byte("p_rewardchain_settlement_escrow/")
+
+	RateSubmissionKeyPrefix = []byte("p_rewardchain_rate_submission/")
+	ExchangeRateKeyPrefix   = []byte("p_rewardchain_exchange_rate/")
+
+	TransferPolicyKeyPrefix = []byte("p_rewardchain_transfer_policy/")
+	TransferCountKey        = []byte("p_rewardchain_transfer_count")
+	TransferKeyPrefix       = []byte("p_rewardchain_transfer/")
+	TransferIndexKeyPrefix  = []byte("p_rewardchain_transfer_index/")
+	TransferUsageKeyPrefix  = []byte("p_rewardchain_transfer_usage/")
+
+	VelocityLimitsKeyPrefix  = []byte("p_rewardchain_velocity_limits/")
+	VelocityCounterKeyPrefix = []byte("p_rewardchain_velocity_counter/")
+	VelocityExpiryKeyPrefix  = []byte("p_rewardchain_velocity_expiry/")
+	MemberSinceKeyPrefix     = []byte("p_rewardchain_member_since/")
+	FrozenMemberKeyPrefix    = []byte("p_rewardchain_frozen_member/")
+
+	AttestationKeyPrefix     = []byte("p_rewardchain_attestation/")
+	KycRequirementKeyPrefix  = []byte("p_rewardchain_kyc_requirement/")
+	KycPayoutKeyPrefix       = []byte("p_rewardchain_kyc_payout/")
+	KycPayoutExpiryKeyPrefix = []byte("p_rewardchain_kyc_payout_expiry/")
+
+	BlockedAddressKeyPrefix = []byte("p_rewardchain_blocked_address/")
+
+	LiquidityThresholdsKeyPrefix = []byte("p_rewardchain_liquidity_thresholds/")
+	LiquidityStatusKeyPrefix     = []byte("p_rewardchain_liquidity_status/")
+	LiquidityCheckpointKeyPrefix = []byte("p_rewardchain_liquidity_checkpoint/")
+
+	PortKey                = []byte("p_rewardchain_port")
+	PointsEscrowKeyPrefix  = []byte("p_rewardchain_points_escrow/")
+	PointsVoucherKeyPrefix = []byte("p_rewardchain_points_voucher/")
+
+	RemoteTreasuryKeyPrefix = []byte("p_rewardchain_remote_treasury/")
 )
 
 func KeyPrefix(p string) []byte {
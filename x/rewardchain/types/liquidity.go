@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	math "cosmossdk.io/math"
+)
+
+// WarningLevel returns the warning threshold, or zero for none.
+func (t LiquidityThresholds) WarningLevel() math.LegacyDec {
+	d, _ := parseLimit(t.Warning)
+	return d
+}
+
+// CriticalLevel returns the critical threshold, or zero for none.
+func (t LiquidityThresholds) CriticalLevel() math.LegacyDec {
+	d, _ := parseLimit(t.Critical)
+	return d
+}
+
+// Level returns the liquidity level of an available liquidity amount. A
+// partner is at a level once its liquidity drops below the threshold.
+func (t LiquidityThresholds) Level(available math.LegacyDec) LiquidityLevel {
+	if c := t.CriticalLevel(); c.IsPositive() && available.LT(c) {
+		return LIQUIDITY_LEVEL_CRITICAL
+	}
+	if w := t.WarningLevel(); w.IsPositive() && available.LT(w) {
+		return LIQUIDITY_LEVEL_WARNING
+	}
+	return LIQUIDITY_LEVEL_NORMAL
+}
+
+// Validate performs stateless checks on liquidity thresholds.
+func (t LiquidityThresholds) Validate() error {
+	if t.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	warning, err := parseLimit(t.Warning)
+	if err != nil {
+		return fmt.Errorf("invalid warning: %w", err)
+	}
+	critical, err := parseLimit(t.Critical)
+	if err != nil {
+		return fmt.Errorf("invalid critical: %w", err)
+	}
+	if warning.IsPositive() && critical.GT(warning) {
+		return fmt.Errorf("critical %s is above warning %s", critical, warning)
+	}
+	if t.AutoPauseEarn && !critical.IsPositive() {
+		return fmt.Errorf("auto_pause_earn needs a critical threshold")
+	}
+	return nil
+}
+
+// LiquidityCheckpointKey returns the store key of a slot of a partner's
+// checkpoint ring: the partner key followed by the big endian slot.
+func LiquidityCheckpointKey(partnerID, slot uint64) []byte {
+	return binary.BigEndian.AppendUint64(PartnerKey(partnerID), slot)
+}
+
+// LiquidityCheckpointSlot returns the ring slot of the checkpoint taken at
+// height. Consecutive checkpoints take consecutive slots, so the checkpoint
+// written into a slot replaces the one from slots checkpoints earlier.
+func LiquidityCheckpointSlot(height, interval int64, slots uint64) uint64 {
+	return uint64(height/interval) % slots
+}
+
+// Validate performs stateless checks on a liquidity checkpoint.
+func (c LiquidityCheckpoint) Validate() error {
+	if c.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	if c.Height <= 0 {
+		return fmt.Errorf("height must be > 0")
+	}
+	for name, amount := range map[string]string{
+		"total_liquidity":     c.TotalLiquidity,
+		"available_liquidity": c.AvailableLiquidity,
+		"on_hold_liquidity":   c.OnHoldLiquidity,
+	} {
+		if _, err := math.LegacyNewDecFromStr(amount); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1145 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/liquidity.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LiquidityLevel is where a partner's available liquidity stands against its
+// thresholds.
+type LiquidityLevel int32
+
+const (
+	LIQUIDITY_LEVEL_NORMAL   LiquidityLevel = 0
+	LIQUIDITY_LEVEL_WARNING  LiquidityLevel = 1
+	LIQUIDITY_LEVEL_CRITICAL LiquidityLevel = 2
+)
+
+var LiquidityLevel_name = map[int32]string{
+	0: "LIQUIDITY_LEVEL_NORMAL",
+	1: "LIQUIDITY_LEVEL_WARNING",
+	2: "LIQUIDITY_LEVEL_CRITICAL",
+}
+
+var LiquidityLevel_value = map[string]int32{
+	"LIQUIDITY_LEVEL_NORMAL":   0,
+	"LIQUIDITY_LEVEL_WARNING":  1,
+	"LIQUIDITY_LEVEL_CRITICAL": 2,
+}
+
+func (x LiquidityLevel) String() string {
+	return proto.EnumName(LiquidityLevel_name, int32(x))
+}
+
+func (LiquidityLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8c5b7f234ed5, []int{0}
+}
+
+// LiquidityThresholds are the available liquidity levels below which a
+// partner is warned. Empty or zero disables a threshold.
+type LiquidityThresholds struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Warning   string `protobuf:"bytes,2,opt,name=warning,proto3" json:"warning,omitempty"`
+	Critical  string `protobuf:"bytes,3,opt,name=critical,proto3" json:"critical,omitempty"`
+	// auto_pause_earn pauses earning with the partner while its liquidity is
+	// critical. Redeeming stays possible.
+	AutoPauseEarn bool `protobuf:"varint,4,opt,name=auto_pause_earn,json=autoPauseEarn,proto3" json:"auto_pause_earn,omitempty"`
+}
+
+func (m *LiquidityThresholds) Reset()         { *m = LiquidityThresholds{} }
+func (m *LiquidityThresholds) String() string { return proto.CompactTextString(m) }
+func (*LiquidityThresholds) ProtoMessage()    {}
+func (*LiquidityThresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8c5b7f234ed5, []int{0}
+}
+func (m *LiquidityThresholds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityThresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityThresholds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityThresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityThresholds.Merge(m, src)
+}
+func (m *LiquidityThresholds) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityThresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityThresholds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityThresholds proto.InternalMessageInfo
+
+func (m *LiquidityThresholds) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *LiquidityThresholds) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
+func (m *LiquidityThresholds) GetCritical() string {
+	if m != nil {
+		return m.Critical
+	}
+	return ""
+}
+
+func (m *LiquidityThresholds) GetAutoPauseEarn() bool {
+	if m != nil {
+		return m.AutoPauseEarn
+	}
+	return false
+}
+
+// LiquidityStatus is the last liquidity level recorded for a partner.
+type LiquidityStatus struct {
+	PartnerId  uint64         `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Level      LiquidityLevel `protobuf:"varint,2,opt,name=level,proto3,enum=rewardchain.rewardchain.LiquidityLevel" json:"level,omitempty"`
+	EarnPaused bool           `protobuf:"varint,3,opt,name=earn_paused,json=earnPaused,proto3" json:"earn_paused,omitempty"`
+	// height is the block at which the level last changed.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *LiquidityStatus) Reset()         { *m = LiquidityStatus{} }
+func (m *LiquidityStatus) String() string { return proto.CompactTextString(m) }
+func (*LiquidityStatus) ProtoMessage()    {}
+func (*LiquidityStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8c5b7f234ed5, []int{1}
+}
+func (m *LiquidityStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityStatus.Merge(m, src)
+}
+func (m *LiquidityStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityStatus proto.InternalMessageInfo
+
+func (m *LiquidityStatus) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *LiquidityStatus) GetLevel() LiquidityLevel {
+	if m != nil {
+		return m.Level
+	}
+	return LIQUIDITY_LEVEL_NORMAL
+}
+
+func (m *LiquidityStatus) GetEarnPaused() bool {
+	if m != nil {
+		return m.EarnPaused
+	}
+	return false
+}
+
+func (m *LiquidityStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// LiquidityCheckpoint is a partner's liquidity as of the end of a block.
+type LiquidityCheckpoint struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Height    int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time in unix seconds.
+	Time               int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	TotalLiquidity     string `protobuf:"bytes,4,opt,name=total_liquidity,json=totalLiquidity,proto3" json:"total_liquidity,omitempty"`
+	AvailableLiquidity string `protobuf:"bytes,5,opt,name=available_liquidity,json=availableLiquidity,proto3" json:"available_liquidity,omitempty"`
+	OnHoldLiquidity    string `protobuf:"bytes,6,opt,name=on_hold_liquidity,json=onHoldLiquidity,proto3" json:"on_hold_liquidity,omitempty"`
+}
+
+func (m *LiquidityCheckpoint) Reset()         { *m = LiquidityCheckpoint{} }
+func (m *LiquidityCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*LiquidityCheckpoint) ProtoMessage()    {}
+func (*LiquidityCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72cf8c5b7f234ed5, []int{2}
+}
+func (m *LiquidityCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityCheckpoint.Merge(m, src)
+}
+func (m *LiquidityCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityCheckpoint proto.InternalMessageInfo
+
+func (m *LiquidityCheckpoint) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *LiquidityCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LiquidityCheckpoint) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *LiquidityCheckpoint) GetTotalLiquidity() string {
+	if m != nil {
+		return m.TotalLiquidity
+	}
+	return ""
+}
+
+func (m *LiquidityCheckpoint) GetAvailableLiquidity() string {
+	if m != nil {
+		return m.AvailableLiquidity
+	}
+	return ""
+}
+
+func (m *LiquidityCheckpoint) GetOnHoldLiquidity() string {
+	if m != nil {
+		return m.OnHoldLiquidity
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("rewardchain.rewardchain.LiquidityLevel", LiquidityLevel_name, LiquidityLevel_value)
+	proto.RegisterType((*LiquidityThresholds)(nil), "rewardchain.rewardchain.LiquidityThresholds")
+	proto.RegisterType((*LiquidityStatus)(nil), "rewardchain.rewardchain.LiquidityStatus")
+	proto.RegisterType((*LiquidityCheckpoint)(nil), "rewardchain.rewardchain.LiquidityCheckpoint")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/liquidity.proto", fileDescriptor_72cf8c5b7f234ed5)
+}
+
+var fileDescriptor_72cf8c5b7f234ed5 = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x77, 0x80, 0x22, 0x3c, 0x23, 0xe0, 0xd4, 0xb4, 0x1b, 0xd4, 0x2d, 0xe1, 0x20, 0xa4,
+	0x07, 0x48, 0xf4, 0xe4, 0xc1, 0x03, 0x22, 0xd1, 0x4d, 0x56, 0xd4, 0x11, 0x35, 0x7a, 0xd9, 0x4c,
+	0xd9, 0x09, 0x3b, 0x71, 0x3a, 0xb3, 0x0e, 0x43, 0x6b, 0xbf, 0x81, 0x47, 0xe3, 0x57, 0xf0, 0xe2,
+	0x47, 0xf1, 0xd8, 0xa3, 0x47, 0x03, 0x89, 0x9f, 0xc3, 0xec, 0xd0, 0x6e, 0xb7, 0x4d, 0x1a, 0x6e,
+	0xef, 0xfd, 0xff, 0xff, 0x79, 0xfb, 0xcb, 0x9b, 0x59, 0xe8, 0x68, 0x76, 0x4c, 0x75, 0x34, 0x8d,
+	0x29, 0x97, 0xfd, 0x7c, 0x2d, 0xf8, 0x97, 0x05, 0x8f, 0xb8, 0x39, 0xe9, 0x25, 0x5a, 0x19, 0x85,
+	0x77, 0x73, 0x66, 0x2f, 0x57, 0x37, 0xef, 0xcc, 0xd4, 0x4c, 0xd9, 0x4c, 0x3f, 0xad, 0xd6, 0xf1,
+	0xf6, 0x0f, 0x04, 0xdb, 0xc1, 0xf9, 0x88, 0x49, 0xac, 0xd9, 0x3c, 0x56, 0x22, 0x9a, 0xe3, 0xfb,
+	0x00, 0x09, 0xd5, 0x46, 0x32, 0x1d, 0xf2, 0xc8, 0x45, 0x2d, 0xd4, 0x2d, 0x91, 0xea, 0x99, 0xe2,
+	0x47, 0xd8, 0x85, 0x1b, 0xc7, 0x54, 0x4b, 0x2e, 0x67, 0x6e, 0xa1, 0x85, 0xba, 0x55, 0x72, 0xde,
+	0xe2, 0x26, 0x54, 0xa6, 0x9a, 0x1b, 0x3e, 0xa5, 0xc2, 0x2d, 0x5a, 0x2b, 0xeb, 0xf1, 0x03, 0xa8,
+	0xd3, 0x85, 0x51, 0x61, 0x42, 0x17, 0x73, 0x16, 0x32, 0xaa, 0xa5, 0x5b, 0x6a, 0xa1, 0x6e, 0x85,
+	0xdc, 0x4a, 0xe5, 0xd7, 0xa9, 0x3a, 0xa2, 0x5a, 0xb6, 0x7f, 0x21, 0xa8, 0x67, 0x50, 0x6f, 0x0d,
+	0x35, 0x8b, 0x8d, 0x40, 0x4f, 0x60, 0x4b, 0xb0, 0x23, 0x26, 0x2c, 0x4e, 0xed, 0x61, 0xa7, 0x77,
+	0xcd, 0x1a, 0x7a, 0xd9, 0xdc, 0x20, 0x8d, 0x93, 0xf5, 0x29, 0xbc, 0x07, 0x37, 0x53, 0x9c, 0x35,
+	0x59, 0x64, 0xc1, 0x2b, 0x04, 0x52, 0xc9, 0x52, 0x45, 0x78, 0x07, 0xca, 0x31, 0xe3, 0xb3, 0xd8,
+	0x58, 0xe2, 0x22, 0x39, 0xeb, 0xda, 0xff, 0xf2, 0xfb, 0x1b, 0xc6, 0x6c, 0xfa, 0x39, 0x51, 0x5c,
+	0x9a, 0x4d, 0xb8, 0x17, 0xe3, 0x0a, 0xf9, 0x71, 0x18, 0x43, 0xc9, 0xf0, 0x43, 0x66, 0x01, 0x8a,
+	0xc4, 0xd6, 0xb8, 0x03, 0x75, 0xa3, 0x0c, 0x15, 0x61, 0x76, 0xd5, 0x96, 0xa1, 0x4a, 0x6a, 0x56,
+	0xce, 0xbe, 0x8e, 0xfb, 0xb0, 0x4d, 0x8f, 0x28, 0x17, 0xf4, 0x40, 0xb0, 0x5c, 0x78, 0xcb, 0x86,
+	0x71, 0x66, 0x5d, 0x1c, 0xd8, 0x87, 0xdb, 0x4a, 0x86, 0xe9, 0x85, 0xe7, 0xe2, 0x65, 0x1b, 0xaf,
+	0x2b, 0xf9, 0x42, 0x89, 0x28, 0xcb, 0xee, 0x1f, 0x42, 0xed, 0xf2, 0xea, 0x70, 0x13, 0x76, 0x02,
+	0xff, 0xcd, 0x3b, 0xff, 0x99, 0x3f, 0xf9, 0x18, 0x06, 0xa3, 0xf7, 0xa3, 0x20, 0x1c, 0xbf, 0x22,
+	0x2f, 0x07, 0x41, 0xc3, 0xc1, 0x77, 0x61, 0xf7, 0xaa, 0xf7, 0x61, 0x40, 0xc6, 0xfe, 0xf8, 0x79,
+	0x03, 0xe1, 0x7b, 0xe0, 0x5e, 0x35, 0x87, 0xc4, 0x9f, 0xf8, 0xc3, 0x41, 0xd0, 0x28, 0x34, 0x4b,
+	0xdf, 0x7e, 0x7a, 0xce, 0xd3, 0xc7, 0xbf, 0x97, 0x1e, 0x3a, 0x5d, 0x7a, 0xe8, 0xef, 0xd2, 0x43,
+	0xdf, 0x57, 0x9e, 0x73, 0xba, 0xf2, 0x9c, 0x3f, 0x2b, 0xcf, 0xf9, 0xb4, 0x97, 0x7f, 0xfd, 0x5f,
+	0x2f, 0xfd, 0x0b, 0xe6, 0x24, 0x61, 0xf3, 0x83, 0xb2, 0x7d, 0xd9, 0x8f, 0xfe, 0x0f, 0x00, 0xa5,
+	0xb5, 0xbc, 0x52, 0x33, 0x03, 0x00, 0x00,
+}
+
+func (m *LiquidityThresholds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityThresholds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityThresholds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoPauseEarn {
+		i--
+		if m.AutoPauseEarn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Critical) > 0 {
+		i -= len(m.Critical)
+		copy(dAtA[i:], m.Critical)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Critical)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EarnPaused {
+		i--
+		if m.EarnPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Level != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OnHoldLiquidity) > 0 {
+		i -= len(m.OnHoldLiquidity)
+		copy(dAtA[i:], m.OnHoldLiquidity)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.OnHoldLiquidity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AvailableLiquidity) > 0 {
+		i -= len(m.AvailableLiquidity)
+		copy(dAtA[i:], m.AvailableLiquidity)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.AvailableLiquidity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalLiquidity) > 0 {
+		i -= len(m.TotalLiquidity)
+		copy(dAtA[i:], m.TotalLiquidity)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.TotalLiquidity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidityThresholds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PartnerId))
+	}
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.Critical)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	if m.AutoPauseEarn {
+		n += 2
+	}
+	return n
+}
+
+func (m *LiquidityStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PartnerId))
+	}
+	if m.Level != 0 {
+		n += 1 + sovLiquidity(uint64(m.Level))
+	}
+	if m.EarnPaused {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LiquidityCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PartnerId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovLiquidity(uint64(m.Time))
+	}
+	l = len(m.TotalLiquidity)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.AvailableLiquidity)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = len(m.OnHoldLiquidity)
+	if l > 0 {
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	return n
+}
+
+func sovLiquidity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidity(x uint64) (n int) {
+	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LiquidityThresholds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityThresholds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Critical", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Critical = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPauseEarn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoPauseEarn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= LiquidityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EarnPaused = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalLiquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableLiquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnHoldLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnHoldLiquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiquidity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiquidity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiquidity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiquidity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiquidity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiquidity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiquidity = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MemberBalanceKey returns the key of a member's points balance with a partner.
+func MemberBalanceKey(partnerID uint64, member sdk.AccAddress) []byte {
+	return append(PartnerKey(partnerID), address.MustLengthPrefix(member)...)
+}
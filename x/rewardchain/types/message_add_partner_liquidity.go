@@ -28,6 +28,12 @@ func (msg *MsgAddPartnerLiquidity) ValidateBasic() error {
 	if len(msg.Amount) == 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is required")
 	}
+	if err := ValidateCurrencyCode(NormalizeCode(msg.Currency)); err != nil {
+		return errorsmod.Wrap(ErrInvalidPartner, err.Error())
+	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
 	return nil
 }
 
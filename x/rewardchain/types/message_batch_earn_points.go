@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBatchEarnPoints{}
+
+func NewMsgBatchEarnPoints(creator string, partnerID uint64, entries []EarnEntry, partial bool) *MsgBatchEarnPoints {
+	return &MsgBatchEarnPoints{
+		Creator:   creator,
+		PartnerId: partnerID,
+		Entries:   entries,
+		Partial:   partial,
+	}
+}
+
+// ValidateBasic only checks the batch envelope. Entries are checked by the
+// handler so that, in partial mode, a bad entry fails on its own.
+func (msg *MsgBatchEarnPoints) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "partnerId must be > 0")
+	}
+	if len(msg.Entries) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "entries are required")
+	}
+	if !msg.Partial {
+		for i, e := range msg.Entries {
+			if _, _, err := e.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidEarn, "entry %d: %s", i, err)
+			}
+		}
+	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
+	return nil
+}
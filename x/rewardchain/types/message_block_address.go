@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgBlockAddress{}
+	_ sdk.Msg = &MsgUnblockAddress{}
+)
+
+func NewMsgBlockAddress(creator, addr string, reason BlockReason, note string) *MsgBlockAddress {
+	return &MsgBlockAddress{
+		Creator: creator,
+		Address: addr,
+		Reason:  reason,
+		Note:    note,
+	}
+}
+
+// Entry returns the blocklist entry the message lists at height.
+func (msg *MsgBlockAddress) Entry(height int64) BlockedAddress {
+	return BlockedAddress{
+		Address:  msg.Address,
+		Reason:   msg.Reason,
+		Note:     msg.Note,
+		ListedBy: msg.Creator,
+		Height:   height,
+	}
+}
+
+func (msg *MsgBlockAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Entry(0).Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidBlock, err.Error())
+	}
+	return nil
+}
+
+func NewMsgUnblockAddress(creator, addr string, reason BlockReason, note string) *MsgUnblockAddress {
+	return &MsgUnblockAddress{
+		Creator: creator,
+		Address: addr,
+		Reason:  reason,
+		Note:    note,
+	}
+}
+
+func (msg *MsgUnblockAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if err := ValidateUnblockReason(msg.Reason); err != nil {
+		return errorsmod.Wrap(ErrInvalidBlock, err.Error())
+	}
+	if len(msg.Note) > MaxBlockNoteLength {
+		return errorsmod.Wrapf(ErrInvalidBlock, "note longer than %d bytes", MaxBlockNoteLength)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgClaimReceipt{}
+
+func NewMsgClaimReceipt(creator string, receipt Receipt, signature []byte) *MsgClaimReceipt {
+	return &MsgClaimReceipt{
+		Creator:   creator,
+		Receipt:   receipt,
+		Signature: signature,
+	}
+}
+
+func (msg *MsgClaimReceipt) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Receipt.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidReceipt, err.Error())
+	}
+	if msg.Receipt.Member != msg.Creator {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "receipt was issued to another member")
+	}
+	if len(msg.Signature) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signature is required")
+	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
+	return nil
+}
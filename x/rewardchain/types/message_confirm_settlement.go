@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgConfirmSettlement{}
+
+func NewMsgConfirmSettlement(creator string, settlementID uint64, method, offchainRef string, payment sdk.Coins) *MsgConfirmSettlement {
+	return &MsgConfirmSettlement{
+		Creator:      creator,
+		SettlementId: settlementID,
+		Method:       method,
+		OffchainRef:  offchainRef,
+		Payment:      payment,
+	}
+}
+
+func (msg *MsgConfirmSettlement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.SettlementId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "settlementId must be > 0")
+	}
+	switch msg.Method {
+	case SettlementMethodOffchain:
+		if msg.OffchainRef == "" {
+			return errorsmod.Wrap(ErrInvalidSettlementOp, "offchainRef is required for offchain settlements")
+		}
+	case SettlementMethodEscrow:
+		if !msg.Payment.IsValid() || msg.Payment.IsZero() {
+			return errorsmod.Wrap(ErrInvalidSettlementOp, "payment must be positive for escrow settlements")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidSettlementOp, "method must be %s or %s", SettlementMethodOffchain, SettlementMethodEscrow)
+	}
+	return nil
+}
@@ -26,5 +26,14 @@ func (msg *MsgCreatePartner) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := ValidateCountryCode(NormalizeCode(msg.Country)); err != nil {
+		return errorsmod.Wrap(ErrInvalidPartner, err.Error())
+	}
+	if err := ValidateCurrencyCode(NormalizeCode(msg.Currency)); err != nil {
+		return errorsmod.Wrap(ErrInvalidPartner, err.Error())
+	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
 	return nil
 }
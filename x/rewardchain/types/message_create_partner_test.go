@@ -21,10 +21,28 @@ func TestMsgCreatePartner_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "city instead of country code",
+			msg: MsgCreatePartner{
+				Creator:  sample.AccAddress(),
+				Country:  "Mumbai",
+				Currency: "INR",
+			},
+			err: ErrInvalidPartner,
+		}, {
+			name: "unknown currency",
+			msg: MsgCreatePartner{
+				Creator:  sample.AccAddress(),
+				Country:  "IN",
+				Currency: "ABC",
+			},
+			err: ErrInvalidPartner,
 		}, {
 			name: "valid address",
 			msg: MsgCreatePartner{
-				Creator: sample.AccAddress(),
+				Creator:  sample.AccAddress(),
+				Country:  "in",
+				Currency: "inr",
 			},
 		},
 	}
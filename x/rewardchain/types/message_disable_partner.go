@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDisablePartner{}
+
+func NewMsgDisablePartner(creator string, partnerId uint64) *MsgDisablePartner {
+	return &MsgDisablePartner{
+		Creator:   creator,
+		PartnerId: partnerId,
+	}
+}
+
+func (msg *MsgDisablePartner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "partner_id must be > 0")
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgExecuteTopUp{}
+
+func NewMsgExecuteTopUp(creator string, partnerID uint64, amount string) *MsgExecuteTopUp {
+	return &MsgExecuteTopUp{
+		Creator:   creator,
+		PartnerId: partnerID,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgExecuteTopUp) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(ErrInvalidRemoteTreasury, "partner_id must be > 0")
+	}
+	if _, err := ParseTopUpAmount(msg.Amount); err != nil {
+		return errorsmod.Wrap(ErrInvalidRemoteTreasury, err.Error())
+	}
+	return nil
+}
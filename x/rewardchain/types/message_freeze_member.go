@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxFreezeReasonLength bounds the reason recorded with a member freeze.
+const MaxFreezeReasonLength = 256
+
+var (
+	_ sdk.Msg = &MsgFreezeMember{}
+	_ sdk.Msg = &MsgUnfreezeMember{}
+)
+
+func NewMsgFreezeMember(creator, member, reason string) *MsgFreezeMember {
+	return &MsgFreezeMember{
+		Creator: creator,
+		Member:  member,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgFreezeMember) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+	}
+	if len(msg.Reason) > MaxFreezeReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason exceeds %d bytes", MaxFreezeReasonLength)
+	}
+	return nil
+}
+
+func NewMsgUnfreezeMember(creator, member string) *MsgUnfreezeMember {
+	return &MsgUnfreezeMember{
+		Creator: creator,
+		Member:  member,
+	}
+}
+
+func (msg *MsgUnfreezeMember) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+	}
+	return nil
+}
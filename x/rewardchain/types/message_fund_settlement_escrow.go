@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFundSettlementEscrow{}
+
+func NewMsgFundSettlementEscrow(creator string, partnerID uint64, amount sdk.Coins) *MsgFundSettlementEscrow {
+	return &MsgFundSettlementEscrow{
+		Creator:   creator,
+		PartnerId: partnerID,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgFundSettlementEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "partnerId must be > 0")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRecordAttestation{}
+	_ sdk.Msg = &MsgRevokeAttestation{}
+)
+
+func NewMsgRecordAttestation(creator, member string, level uint32, expires int64, jurisdiction, evidenceHash string) *MsgRecordAttestation {
+	return &MsgRecordAttestation{
+		Creator:      creator,
+		Member:       member,
+		Level:        level,
+		Expires:      expires,
+		Jurisdiction: jurisdiction,
+		EvidenceHash: evidenceHash,
+	}
+}
+
+// Attestation returns the attestation the message records at height.
+func (msg *MsgRecordAttestation) Attestation(height int64) Attestation {
+	return Attestation{
+		Member:       msg.Member,
+		Attestor:     msg.Creator,
+		Level:        msg.Level,
+		Expires:      msg.Expires,
+		Jurisdiction: msg.Jurisdiction,
+		EvidenceHash: msg.EvidenceHash,
+		Height:       height,
+	}
+}
+
+func (msg *MsgRecordAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Attestation(0).Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAttestation, err.Error())
+	}
+	return nil
+}
+
+func NewMsgRevokeAttestation(creator, member string) *MsgRevokeAttestation {
+	return &MsgRevokeAttestation{
+		Creator: creator,
+		Member:  member,
+	}
+}
+
+func (msg *MsgRevokeAttestation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRecordObligation{}
+
+func NewMsgRecordObligation(creator string, payerID, payeeID uint64, amount, currency, reference string) *MsgRecordObligation {
+	return &MsgRecordObligation{
+		Creator:   creator,
+		PayerId:   payerID,
+		PayeeId:   payeeID,
+		Amount:    amount,
+		Currency:  currency,
+		Reference: reference,
+	}
+}
+
+func (msg *MsgRecordObligation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	o := Obligation{
+		PayerId:  msg.PayerId,
+		PayeeId:  msg.PayeeId,
+		Amount:   msg.Amount,
+		Currency: msg.Currency,
+	}
+	if err := o.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidObligation, err.Error())
+	}
+	return nil
+}
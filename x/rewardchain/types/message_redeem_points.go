@@ -0,0 +1,37 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRedeemPoints{}
+
+func NewMsgRedeemPoints(creator string, partnerID uint64, points string) *MsgRedeemPoints {
+	return &MsgRedeemPoints{
+		Creator:   creator,
+		PartnerId: partnerID,
+		Points:    points,
+	}
+}
+
+func (msg *MsgRedeemPoints) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "partnerId must be > 0")
+	}
+	points, err := math.LegacyNewDecFromStr(strings.TrimSpace(msg.Points))
+	if err != nil || !points.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidRedeem, "points must be a positive decimal")
+	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRegisterRemoteTreasury{}
+
+func NewMsgRegisterRemoteTreasury(creator string, partnerID uint64, connectionID, escrowAddress string, denom DenomCurrency, topUpThreshold, topUpAmount string) *MsgRegisterRemoteTreasury {
+	return &MsgRegisterRemoteTreasury{
+		Creator:        creator,
+		PartnerId:      partnerID,
+		ConnectionId:   connectionID,
+		EscrowAddress:  escrowAddress,
+		Denom:          denom.Denom,
+		Currency:       denom.Currency,
+		Exponent:       denom.Exponent,
+		TopUpThreshold: topUpThreshold,
+		TopUpAmount:    topUpAmount,
+	}
+}
+
+// RemoteTreasury returns the remote treasury the message configures.
+func (msg *MsgRegisterRemoteTreasury) RemoteTreasury() RemoteTreasury {
+	return RemoteTreasury{
+		PartnerId:     msg.PartnerId,
+		ConnectionId:  msg.ConnectionId,
+		Owner:         RemoteTreasuryOwner(msg.PartnerId),
+		EscrowAddress: msg.EscrowAddress,
+		Denom: DenomCurrency{
+			Denom:    msg.Denom,
+			Currency: NormalizeCode(msg.Currency),
+			Exponent: msg.Exponent,
+		},
+		TopUpThreshold: msg.TopUpThreshold,
+		TopUpAmount:    msg.TopUpAmount,
+	}
+}
+
+func (msg *MsgRegisterRemoteTreasury) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.RemoteTreasury().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRemoteTreasury, err.Error())
+	}
+	return nil
+}
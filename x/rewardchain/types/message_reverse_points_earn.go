@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReversePointsEarn{}
+
+func NewMsgReversePointsEarn(creator string, partnerID uint64, referenceID, points, reason string) *MsgReversePointsEarn {
+	return &MsgReversePointsEarn{
+		Creator:     creator,
+		PartnerId:   partnerID,
+		ReferenceId: referenceID,
+		Points:      points,
+		Reason:      reason,
+	}
+}
+
+func (msg *MsgReversePointsEarn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "partnerId must be > 0")
+	}
+	if err := ValidateReferenceID(msg.ReferenceId); err != nil {
+		return errorsmod.Wrap(ErrInvalidReversal, err.Error())
+	}
+	if s := strings.TrimSpace(msg.Points); s != "" {
+		points, err := math.LegacyNewDecFromStr(s)
+		if err != nil || !points.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidReversal, "points must be a positive decimal")
+		}
+	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgSendPoints{}
+
+func NewMsgSendPoints(creator string, partnerID uint64, sourceChannel, receiver, points string, timeoutTimestamp uint64, voucher bool) *MsgSendPoints {
+	return &MsgSendPoints{
+		Creator:          creator,
+		PartnerId:        partnerID,
+		SourceChannel:    sourceChannel,
+		Receiver:         receiver,
+		Points:           points,
+		TimeoutTimestamp: timeoutTimestamp,
+		Voucher:          voucher,
+	}
+}
+
+func (msg *MsgSendPoints) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "partner_id must be > 0")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(ErrInvalidChannel, err.Error())
+	}
+	// The receiver is an address on the other chain, which may use another
+	// bech32 prefix.
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "receiver is required")
+	}
+	points, err := math.LegacyNewDecFromStr(strings.TrimSpace(msg.Points))
+	if err != nil || !points.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPacket, "points must be a positive decimal")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetKycRequirement{}
+
+func NewMsgSetKycRequirement(creator string, partnerId uint64, threshold string, minLevel uint32, jurisdictions []string) *MsgSetKycRequirement {
+	return &MsgSetKycRequirement{
+		Creator:       creator,
+		PartnerId:     partnerId,
+		Threshold:     threshold,
+		MinLevel:      minLevel,
+		Jurisdictions: jurisdictions,
+	}
+}
+
+// Requirement returns the KYC requirement the message sets.
+func (msg *MsgSetKycRequirement) Requirement() KycRequirement {
+	return KycRequirement{
+		PartnerId:     msg.PartnerId,
+		Threshold:     msg.Threshold,
+		MinLevel:      msg.MinLevel,
+		Jurisdictions: msg.Jurisdictions,
+	}
+}
+
+func (msg *MsgSetKycRequirement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Requirement().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAttestation, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetLiquidityThresholds{}
+
+func NewMsgSetLiquidityThresholds(creator string, partnerID uint64, warning, critical string, autoPauseEarn bool) *MsgSetLiquidityThresholds {
+	return &MsgSetLiquidityThresholds{
+		Creator:       creator,
+		PartnerId:     partnerID,
+		Warning:       warning,
+		Critical:      critical,
+		AutoPauseEarn: autoPauseEarn,
+	}
+}
+
+// Thresholds returns the thresholds the message sets.
+func (msg *MsgSetLiquidityThresholds) Thresholds() LiquidityThresholds {
+	return LiquidityThresholds{
+		PartnerId:     msg.PartnerId,
+		Warning:       msg.Warning,
+		Critical:      msg.Critical,
+		AutoPauseEarn: msg.AutoPauseEarn,
+	}
+}
+
+func (msg *MsgSetLiquidityThresholds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Thresholds().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidLiquidityThresholds, err.Error())
+	}
+	return nil
+}
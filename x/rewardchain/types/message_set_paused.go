@@ -0,0 +1,27 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetPaused{}
+
+func NewMsgSetPaused(creator string, op Operation, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Creator:   creator,
+		Operation: op,
+		Paused:    paused,
+	}
+}
+
+func (msg *MsgSetPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateOperation(msg.Operation); err != nil {
+		return errorsmod.Wrap(ErrInvalidPause, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetReceiptKey{}
+
+func NewMsgSetReceiptKey(creator string, partnerId uint64, keyType string, pubKey []byte) *MsgSetReceiptKey {
+	return &MsgSetReceiptKey{
+		Creator:   creator,
+		PartnerId: partnerId,
+		KeyType:   keyType,
+		PubKey:    pubKey,
+	}
+}
+
+func (msg *MsgSetReceiptKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "partner_id must be > 0")
+	}
+	if _, err := ReceiptPubKey(msg.KeyType, msg.PubKey); err != nil {
+		return errorsmod.Wrap(ErrInvalidReceipt, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetTransferPolicy{}
+
+func NewMsgSetTransferPolicy(creator string, partnerId uint64, mode TransferMode, allowlist []string, feeRate, dailyCap string) *MsgSetTransferPolicy {
+	return &MsgSetTransferPolicy{
+		Creator:   creator,
+		PartnerId: partnerId,
+		Mode:      mode,
+		Allowlist: allowlist,
+		FeeRate:   feeRate,
+		DailyCap:  dailyCap,
+	}
+}
+
+// Policy returns the transfer policy the message sets.
+func (msg *MsgSetTransferPolicy) Policy() TransferPolicy {
+	return TransferPolicy{
+		PartnerId: msg.PartnerId,
+		Mode:      msg.Mode,
+		Allowlist: msg.Allowlist,
+		FeeRate:   msg.FeeRate,
+		DailyCap:  msg.DailyCap,
+	}
+}
+
+func (msg *MsgSetTransferPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Policy().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidTransfer, err.Error())
+	}
+	return nil
+}
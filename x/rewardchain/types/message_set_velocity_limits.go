@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetVelocityLimits{}
+
+func NewMsgSetVelocityLimits(creator string, partnerId uint64, perTx, perDay, perWindow string, windowSeconds, cooldownSeconds uint64) *MsgSetVelocityLimits {
+	return &MsgSetVelocityLimits{
+		Creator:                  creator,
+		PartnerId:                partnerId,
+		MaxRedeemPerTx:           perTx,
+		MaxRedeemPerDay:          perDay,
+		MaxRedeemPerWindow:       perWindow,
+		WindowSeconds:            windowSeconds,
+		NewMemberCooldownSeconds: cooldownSeconds,
+	}
+}
+
+// Limits returns the velocity limits the message sets.
+func (msg *MsgSetVelocityLimits) Limits() VelocityLimits {
+	return VelocityLimits{
+		PartnerId:                msg.PartnerId,
+		MaxRedeemPerTx:           msg.MaxRedeemPerTx,
+		MaxRedeemPerDay:          msg.MaxRedeemPerDay,
+		MaxRedeemPerWindow:       msg.MaxRedeemPerWindow,
+		WindowSeconds:            msg.WindowSeconds,
+		NewMemberCooldownSeconds: msg.NewMemberCooldownSeconds,
+	}
+}
+
+func (msg *MsgSetVelocityLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Limits().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidVelocityLimits, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSponsorMembers{}
+
+func NewMsgSponsorMembers(creator string, partnerId uint64, members []string, spendLimit sdk.Coins) *MsgSponsorMembers {
+	return &MsgSponsorMembers{
+		Creator:    creator,
+		PartnerId:  partnerId,
+		Members:    members,
+		SpendLimit: spendLimit,
+	}
+}
+
+func (msg *MsgSponsorMembers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "partner_id must be > 0")
+	}
+	if len(msg.Members) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one member is required")
+	}
+	seen := make(map[string]struct{}, len(msg.Members))
+	for _, m := range msg.Members {
+		if _, err := sdk.AccAddressFromBech32(m); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address (%s)", err)
+		}
+		if m == msg.Creator {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "treasury cannot sponsor itself")
+		}
+		if _, ok := seen[m]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate member %s", m)
+		}
+		seen[m] = struct{}{}
+	}
+	if !msg.SpendLimit.IsValid() || msg.SpendLimit.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "spend_limit must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"rewardchain/testutil/sample"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSponsorMembers_ValidateBasic(t *testing.T) {
+	treasury := sample.AccAddress()
+	member := sample.AccAddress()
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	tests := []struct {
+		name string
+		msg  MsgSponsorMembers
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSponsorMembers{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no members",
+			msg: MsgSponsorMembers{
+				Creator:    treasury,
+				PartnerId:  1,
+				SpendLimit: limit,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "self sponsorship",
+			msg: MsgSponsorMembers{
+				Creator:    treasury,
+				PartnerId:  1,
+				Members:    []string{treasury},
+				SpendLimit: limit,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate member",
+			msg: MsgSponsorMembers{
+				Creator:    treasury,
+				PartnerId:  1,
+				Members:    []string{member, member},
+				SpendLimit: limit,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty spend limit",
+			msg: MsgSponsorMembers{
+				Creator:   treasury,
+				PartnerId: 1,
+				Members:   []string{member},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid",
+			msg: MsgSponsorMembers{
+				Creator:    treasury,
+				PartnerId:  1,
+				Members:    []string{member},
+				SpendLimit: limit,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitRate{}
+
+func NewMsgSubmitRate(creator, base, quote, rate string) *MsgSubmitRate {
+	return &MsgSubmitRate{
+		Creator: creator,
+		Base:    base,
+		Quote:   quote,
+		Rate:    rate,
+	}
+}
+
+func (msg *MsgSubmitRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := validatePair(msg.Base, msg.Quote); err != nil {
+		return errorsmod.Wrap(ErrInvalidRate, err.Error())
+	}
+	if _, err := ParseRate(msg.Rate); err != nil {
+		return errorsmod.Wrap(ErrInvalidRate, err.Error())
+	}
+	return nil
+}
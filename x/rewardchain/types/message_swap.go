@@ -30,6 +30,9 @@ func (msg *MsgSwap) ValidateBasic() error {
 	if msg.Points == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "points is required")
 	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
 	return nil
 }
 
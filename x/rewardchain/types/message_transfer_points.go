@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTransferPoints{}
+
+func NewMsgTransferPoints(creator string, partnerId uint64, to, amount string) *MsgTransferPoints {
+	return &MsgTransferPoints{
+		Creator:   creator,
+		PartnerId: partnerId,
+		To:        to,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgTransferPoints) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.To); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.To == msg.Creator {
+		return errorsmod.Wrap(ErrInvalidTransfer, "cannot transfer to self")
+	}
+	if msg.PartnerId == 0 {
+		return errorsmod.Wrap(ErrInvalidTransfer, "partner_id must be > 0")
+	}
+	amount, err := math.LegacyNewDecFromStr(strings.TrimSpace(msg.Amount))
+	if err != nil || !amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidTransfer, "amount must be a positive decimal")
+	}
+	if err := ValidateClientRef(msg.ClientRef); err != nil {
+		return errorsmod.Wrap(ErrInvalidClientRef, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// CurrencyPair returns the store key segment of a currency pair.
+func CurrencyPair(base, quote string) []byte {
+	return []byte(base + "/" + quote + "/")
+}
+
+// ExchangeRateKey returns the key of the aggregated rate of a pair.
+func ExchangeRateKey(base, quote string) []byte {
+	return CurrencyPair(base, quote)
+}
+
+// RateSubmissionKey returns the key of a feeder's submission for a pair.
+func RateSubmissionKey(base, quote string, feeder sdk.AccAddress) []byte {
+	return append(CurrencyPair(base, quote), address.MustLengthPrefix(feeder)...)
+}
+
+// ParseRate parses a positive exchange rate.
+func ParseRate(s string) (math.LegacyDec, error) {
+	rate, err := math.LegacyNewDecFromStr(strings.TrimSpace(s))
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !rate.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("rate must be > 0")
+	}
+	return rate, nil
+}
+
+// validatePair checks the two codes of a currency pair.
+func validatePair(base, quote string) error {
+	if err := ValidateCurrencyCode(base); err != nil {
+		return err
+	}
+	if err := ValidateCurrencyCode(quote); err != nil {
+		return err
+	}
+	if base == quote {
+		return fmt.Errorf("base and quote must differ")
+	}
+	return nil
+}
+
+// Validate performs stateless checks on an aggregated rate.
+func (r ExchangeRate) Validate() error {
+	if err := validatePair(r.Base, r.Quote); err != nil {
+		return err
+	}
+	_, err := ParseRate(r.Rate)
+	return err
+}
+
+// Validate performs stateless checks on a rate submission.
+func (s RateSubmission) Validate() error {
+	if err := validatePair(s.Base, s.Quote); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Feeder); err != nil {
+		return fmt.Errorf("invalid feeder address: %w", err)
+	}
+	_, err := ParseRate(s.Rate)
+	return err
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
+	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -14,9 +16,56 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+const (
+	// DefaultMaxBatchEarnEntries is the default cap on MsgBatchEarnPoints entries.
+	DefaultMaxBatchEarnEntries uint32 = 500
+
+	// DefaultClientRefTTLBlocks keeps client_ref outcomes for about a week of
+	// 6 second blocks.
+	DefaultClientRefTTLBlocks uint64 = 100_800
+
+	// DefaultSettlementEpochBlocks nets obligations about once a day.
+	DefaultSettlementEpochBlocks uint64 = 14_400
+
+	// DefaultOracleWindowBlocks aggregates exchange rates every 10 minutes.
+	DefaultOracleWindowBlocks uint64 = 100
+
+	// DefaultOracleMaxRateAgeBlocks lets a rate be used for about an hour.
+	DefaultOracleMaxRateAgeBlocks uint64 = 600
+
+	// DefaultLiquidityCheckpointIntervalBlocks checkpoints partner liquidity
+	// about once a day.
+	DefaultLiquidityCheckpointIntervalBlocks uint64 = 14_400
+
+	// DefaultLiquidityCheckpointRetention keeps a bit over a year of daily
+	// checkpoints.
+	DefaultLiquidityCheckpointRetention uint32 = 400
+
+	// MaxLiquidityCheckpointRetention bounds liquidity_checkpoint_retention.
+	MaxLiquidityCheckpointRetention uint32 = 10_000
+)
+
+// MaxFeeRate bounds swap_fee_rate and redeem_fee_rate.
+var MaxFeeRate = math.LegacyNewDecWithPrec(2, 1)
+
 // NewParams creates a new Params instance
 func NewParams(adminAddresses []string) Params {
-	return Params{AdminAddresses: adminAddresses}
+	return Params{
+		AdminAddresses:         adminAddresses,
+		MaxBatchEarnEntries:    DefaultMaxBatchEarnEntries,
+		ClientRefTtlBlocks:     DefaultClientRefTTLBlocks,
+		SettlementEpochBlocks:  DefaultSettlementEpochBlocks,
+		SwapFeeRate:            "0",
+		RedeemFeeRate:          "0",
+		FeeDenom:               sdk.DefaultBondDenom,
+		FeeRecipient:           FEE_RECIPIENT_COMMUNITY_POOL,
+		OracleWindowBlocks:     DefaultOracleWindowBlocks,
+		OracleMinSubmissions:   1,
+		OracleMaxRateAgeBlocks: DefaultOracleMaxRateAgeBlocks,
+
+		LiquidityCheckpointIntervalBlocks: DefaultLiquidityCheckpointIntervalBlocks,
+		LiquidityCheckpointRetention:      DefaultLiquidityCheckpointRetention,
+	}
 }
 
 // DefaultParams returns a default set of parameters
@@ -42,5 +91,227 @@ func (p Params) Validate() error {
 		}
 		seen[a] = struct{}{}
 	}
+
+	for name, rate := range map[string]string{"swap_fee_rate": p.SwapFeeRate, "redeem_fee_rate": p.RedeemFeeRate} {
+		if _, err := parseFeeRate(rate); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+	if p.FeeDenom != "" {
+		if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+			return fmt.Errorf("invalid fee_denom: %w", err)
+		}
+	}
+	if _, ok := FeeRecipient_name[int32(p.FeeRecipient)]; !ok {
+		return fmt.Errorf("unknown fee_recipient %d", p.FeeRecipient)
+	}
+	if p.FeeRecipient == FEE_RECIPIENT_TREASURY {
+		if _, err := sdk.AccAddressFromBech32(p.FeeTreasury); err != nil {
+			return fmt.Errorf("invalid fee_treasury: %w", err)
+		}
+	}
+
+	feeders := make(map[string]struct{}, len(p.OracleFeeders))
+	for _, f := range p.OracleFeeders {
+		if _, err := sdk.AccAddressFromBech32(f); err != nil {
+			return fmt.Errorf("invalid oracle feeder %q: %w", f, err)
+		}
+		if _, ok := feeders[f]; ok {
+			return fmt.Errorf("duplicate oracle feeder %q", f)
+		}
+		feeders[f] = struct{}{}
+	}
+	if len(p.OracleFeeders) > 0 && int(p.OracleMinSubmissions) > len(p.OracleFeeders) {
+		return fmt.Errorf("oracle_min_submissions %d exceeds %d feeders", p.OracleMinSubmissions, len(p.OracleFeeders))
+	}
+
+	attestors := make(map[string]struct{}, len(p.Attestors))
+	for _, a := range p.Attestors {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return fmt.Errorf("invalid attestor %q: %w", a, err)
+		}
+		if _, ok := attestors[a]; ok {
+			return fmt.Errorf("duplicate attestor %q", a)
+		}
+		attestors[a] = struct{}{}
+	}
+
+	emergency := make(map[string]struct{}, len(p.EmergencyAdmins))
+	for _, a := range p.EmergencyAdmins {
+		if _, ok := seen[a]; !ok {
+			return fmt.Errorf("emergency admin %q is not an admin", a)
+		}
+		if _, ok := emergency[a]; ok {
+			return fmt.Errorf("duplicate emergency admin %q", a)
+		}
+		emergency[a] = struct{}{}
+	}
+
+	denoms := make(map[string]struct{}, len(p.TransferDenoms))
+	for _, d := range p.TransferDenoms {
+		if err := sdk.ValidateDenom(d.Denom); err != nil {
+			return fmt.Errorf("invalid transfer denom %q: %w", d.Denom, err)
+		}
+		if _, ok := denoms[d.Denom]; ok {
+			return fmt.Errorf("duplicate transfer denom %q", d.Denom)
+		}
+		denoms[d.Denom] = struct{}{}
+		if err := ValidateCurrencyCode(d.Currency); err != nil {
+			return fmt.Errorf("invalid currency for transfer denom %q: %w", d.Denom, err)
+		}
+		if d.Exponent > math.LegacyPrecision {
+			return fmt.Errorf("exponent for transfer denom %q must be <= %d", d.Denom, math.LegacyPrecision)
+		}
+	}
+
+	if p.LiquidityCheckpointRetention > MaxLiquidityCheckpointRetention {
+		return fmt.Errorf("liquidity_checkpoint_retention must be <= %d", MaxLiquidityCheckpointRetention)
+	}
 	return nil
 }
+
+// TransferDenom returns the currency mapping of a token transfer memos may
+// spend.
+func (p Params) TransferDenom(denom string) (DenomCurrency, bool) {
+	for _, d := range p.TransferDenoms {
+		if d.Denom == denom {
+			return d, true
+		}
+	}
+	return DenomCurrency{}, false
+}
+
+// IsEmergencyAdmin reports whether addr may pause operations.
+func (p Params) IsEmergencyAdmin(addr string) bool {
+	for _, a := range p.EmergencyAdmins {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAttestor reports whether addr may record KYC attestations.
+func (p Params) IsAttestor(addr string) bool {
+	for _, a := range p.Attestors {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOracleFeeder reports whether addr may submit exchange rates.
+func (p Params) IsOracleFeeder(addr string) bool {
+	for _, f := range p.OracleFeeders {
+		if f == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// OracleWindow returns the rate aggregation window in blocks. Zero selects
+// the default.
+func (p Params) OracleWindow() int64 {
+	if p.OracleWindowBlocks == 0 {
+		return int64(DefaultOracleWindowBlocks)
+	}
+	return int64(p.OracleWindowBlocks)
+}
+
+// OracleMinFeeders returns how many submissions a pair needs per window.
+func (p Params) OracleMinFeeders() int {
+	if p.OracleMinSubmissions == 0 {
+		return 1
+	}
+	return int(p.OracleMinSubmissions)
+}
+
+// OracleMaxRateAge returns how many blocks an aggregated rate stays usable.
+// Zero selects the default.
+func (p Params) OracleMaxRateAge() int64 {
+	if p.OracleMaxRateAgeBlocks == 0 {
+		return int64(DefaultOracleMaxRateAgeBlocks)
+	}
+	return int64(p.OracleMaxRateAgeBlocks)
+}
+
+// LiquidityCheckpointInterval returns how many blocks apart partner liquidity
+// is checkpointed, or zero when checkpoints are off.
+func (p Params) LiquidityCheckpointInterval() int64 {
+	return int64(p.LiquidityCheckpointIntervalBlocks)
+}
+
+// LiquidityCheckpointSlots returns how many checkpoints are kept per partner.
+// Zero selects the default.
+func (p Params) LiquidityCheckpointSlots() uint64 {
+	if p.LiquidityCheckpointRetention == 0 {
+		return uint64(DefaultLiquidityCheckpointRetention)
+	}
+	return uint64(p.LiquidityCheckpointRetention)
+}
+
+// SwapFee returns the swap fee rate, or zero when unset.
+func (p Params) SwapFee() math.LegacyDec {
+	rate, _ := parseFeeRate(p.SwapFeeRate)
+	return rate
+}
+
+// RedeemFee returns the redeem fee rate, or zero when unset.
+func (p Params) RedeemFee() math.LegacyDec {
+	rate, _ := parseFeeRate(p.RedeemFeeRate)
+	return rate
+}
+
+// FeeCoinDenom returns the denom fees are paid in.
+func (p Params) FeeCoinDenom() string {
+	if p.FeeDenom == "" {
+		return sdk.DefaultBondDenom
+	}
+	return p.FeeDenom
+}
+
+// parseFeeRate parses a fee rate, treating empty as zero, and checks it lies
+// within [0, MaxFeeRate].
+func parseFeeRate(s string) (math.LegacyDec, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	rate, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	if rate.IsNegative() || rate.GT(MaxFeeRate) {
+		return math.LegacyZeroDec(), fmt.Errorf("must be between 0 and %s", MaxFeeRate)
+	}
+	return rate, nil
+}
+
+// BatchEarnLimit returns the maximum entries of a MsgBatchEarnPoints. Zero
+// selects the default.
+func (p Params) BatchEarnLimit() int {
+	if p.MaxBatchEarnEntries == 0 {
+		return int(DefaultMaxBatchEarnEntries)
+	}
+	return int(p.MaxBatchEarnEntries)
+}
+
+// ClientRefTTL returns how many blocks client_ref outcomes are kept. Zero
+// selects the default.
+func (p Params) ClientRefTTL() int64 {
+	if p.ClientRefTtlBlocks == 0 {
+		return int64(DefaultClientRefTTLBlocks)
+	}
+	return int64(p.ClientRefTtlBlocks)
+}
+
+// SettlementEpoch returns how many blocks apart obligations are netted. Zero
+// selects the default.
+func (p Params) SettlementEpoch() int64 {
+	if p.SettlementEpochBlocks == 0 {
+		return int64(DefaultSettlementEpochBlocks)
+	}
+	return int64(p.SettlementEpochBlocks)
+}
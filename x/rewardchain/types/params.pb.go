@@ -25,17 +25,288 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeRecipient selects where swap and redeem fees go.
+type FeeRecipient int32
+
+const (
+	// FEE_RECIPIENT_UNSPECIFIED behaves like FEE_RECIPIENT_COMMUNITY_POOL.
+	FEE_RECIPIENT_UNSPECIFIED    FeeRecipient = 0
+	FEE_RECIPIENT_COMMUNITY_POOL FeeRecipient = 1
+	FEE_RECIPIENT_BURN           FeeRecipient = 2
+	FEE_RECIPIENT_TREASURY       FeeRecipient = 3
+)
+
+var FeeRecipient_name = map[int32]string{
+	0: "FEE_RECIPIENT_UNSPECIFIED",
+	1: "FEE_RECIPIENT_COMMUNITY_POOL",
+	2: "FEE_RECIPIENT_BURN",
+	3: "FEE_RECIPIENT_TREASURY",
+}
+
+var FeeRecipient_value = map[string]int32{
+	"FEE_RECIPIENT_UNSPECIFIED":    0,
+	"FEE_RECIPIENT_COMMUNITY_POOL": 1,
+	"FEE_RECIPIENT_BURN":           2,
+	"FEE_RECIPIENT_TREASURY":       3,
+}
+
+func (x FeeRecipient) String() string {
+	return proto.EnumName(FeeRecipient_name, int32(x))
+}
+
+func (FeeRecipient) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1a15a27b28cf06a8, []int{0}
+}
+
+// Operation is a rewardchain operation that can be paused on its own.
+type Operation int32
+
+const (
+	OPERATION_UNSPECIFIED          Operation = 0
+	OPERATION_CREATE_PARTNER       Operation = 1
+	OPERATION_ADD_LIQUIDITY        Operation = 2
+	OPERATION_SWAP_POINTS_TO_TOKEN Operation = 3
+	OPERATION_SWAP_TOKEN_TO_POINTS Operation = 4
+	OPERATION_EARN                 Operation = 5
+	OPERATION_REDEEM               Operation = 6
+)
+
+var Operation_name = map[int32]string{
+	0: "OPERATION_UNSPECIFIED",
+	1: "OPERATION_CREATE_PARTNER",
+	2: "OPERATION_ADD_LIQUIDITY",
+	3: "OPERATION_SWAP_POINTS_TO_TOKEN",
+	4: "OPERATION_SWAP_TOKEN_TO_POINTS",
+	5: "OPERATION_EARN",
+	6: "OPERATION_REDEEM",
+}
+
+var Operation_value = map[string]int32{
+	"OPERATION_UNSPECIFIED":          0,
+	"OPERATION_CREATE_PARTNER":       1,
+	"OPERATION_ADD_LIQUIDITY":        2,
+	"OPERATION_SWAP_POINTS_TO_TOKEN": 3,
+	"OPERATION_SWAP_TOKEN_TO_POINTS": 4,
+	"OPERATION_EARN":                 5,
+	"OPERATION_REDEEM":               6,
+}
+
+func (x Operation) String() string {
+	return proto.EnumName(Operation_name, int32(x))
+}
+
+func (Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1a15a27b28cf06a8, []int{1}
+}
+
+// PauseMatrix records which operations are paused.
+type PauseMatrix struct {
+	CreatePartner     bool `protobuf:"varint,1,opt,name=create_partner,json=createPartner,proto3" json:"create_partner,omitempty"`
+	AddLiquidity      bool `protobuf:"varint,2,opt,name=add_liquidity,json=addLiquidity,proto3" json:"add_liquidity,omitempty"`
+	SwapPointsToToken bool `protobuf:"varint,3,opt,name=swap_points_to_token,json=swapPointsToToken,proto3" json:"swap_points_to_token,omitempty"`
+	SwapTokenToPoints bool `protobuf:"varint,4,opt,name=swap_token_to_points,json=swapTokenToPoints,proto3" json:"swap_token_to_points,omitempty"`
+	// earn covers batch earns and receipt claims.
+	Earn   bool `protobuf:"varint,5,opt,name=earn,proto3" json:"earn,omitempty"`
+	Redeem bool `protobuf:"varint,6,opt,name=redeem,proto3" json:"redeem,omitempty"`
+}
+
+func (m *PauseMatrix) Reset()         { *m = PauseMatrix{} }
+func (m *PauseMatrix) String() string { return proto.CompactTextString(m) }
+func (*PauseMatrix) ProtoMessage()    {}
+func (*PauseMatrix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a15a27b28cf06a8, []int{0}
+}
+func (m *PauseMatrix) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseMatrix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseMatrix.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseMatrix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseMatrix.Merge(m, src)
+}
+func (m *PauseMatrix) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseMatrix) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseMatrix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseMatrix proto.InternalMessageInfo
+
+func (m *PauseMatrix) GetCreatePartner() bool {
+	if m != nil {
+		return m.CreatePartner
+	}
+	return false
+}
+
+func (m *PauseMatrix) GetAddLiquidity() bool {
+	if m != nil {
+		return m.AddLiquidity
+	}
+	return false
+}
+
+func (m *PauseMatrix) GetSwapPointsToToken() bool {
+	if m != nil {
+		return m.SwapPointsToToken
+	}
+	return false
+}
+
+func (m *PauseMatrix) GetSwapTokenToPoints() bool {
+	if m != nil {
+		return m.SwapTokenToPoints
+	}
+	return false
+}
+
+func (m *PauseMatrix) GetEarn() bool {
+	if m != nil {
+		return m.Earn
+	}
+	return false
+}
+
+func (m *PauseMatrix) GetRedeem() bool {
+	if m != nil {
+		return m.Redeem
+	}
+	return false
+}
+
+// DenomCurrency maps a token received over ICS-20 to the currency it is
+// worth, so transfer memos can fund partners with it.
+type DenomCurrency struct {
+	// denom is the local denom of the token, e.g. ibc/27394FB0...
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// exponent is the number of decimals between the denom and one unit of
+	// currency, e.g. 6 for uusdc.
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *DenomCurrency) Reset()         { *m = DenomCurrency{} }
+func (m *DenomCurrency) String() string { return proto.CompactTextString(m) }
+func (*DenomCurrency) ProtoMessage()    {}
+func (*DenomCurrency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a15a27b28cf06a8, []int{1}
+}
+func (m *DenomCurrency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCurrency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCurrency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCurrency.Merge(m, src)
+}
+func (m *DenomCurrency) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCurrency) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCurrency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCurrency proto.InternalMessageInfo
+
+func (m *DenomCurrency) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomCurrency) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *DenomCurrency) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// admin_addresses is the allowlist of accounts permitted to create/disable/update partners.
 	AdminAddresses []string `protobuf:"bytes,1,rep,name=admin_addresses,json=adminAddresses,proto3" json:"admin_addresses,omitempty"`
+	// max_batch_earn_entries caps the entries of a MsgBatchEarnPoints.
+	MaxBatchEarnEntries uint32 `protobuf:"varint,2,opt,name=max_batch_earn_entries,json=maxBatchEarnEntries,proto3" json:"max_batch_earn_entries,omitempty"`
+	// client_ref_ttl_blocks is how long client_ref outcomes are kept.
+	ClientRefTtlBlocks uint64 `protobuf:"varint,3,opt,name=client_ref_ttl_blocks,json=clientRefTtlBlocks,proto3" json:"client_ref_ttl_blocks,omitempty"`
+	// settlement_epoch_blocks is how often obligations are netted into
+	// settlements.
+	SettlementEpochBlocks uint64 `protobuf:"varint,4,opt,name=settlement_epoch_blocks,json=settlementEpochBlocks,proto3" json:"settlement_epoch_blocks,omitempty"`
+	// swap_fee_rate and redeem_fee_rate are the fractions of the swapped or
+	// redeemed points value charged as a fee. Empty means no fee.
+	SwapFeeRate   string `protobuf:"bytes,5,opt,name=swap_fee_rate,json=swapFeeRate,proto3" json:"swap_fee_rate,omitempty"`
+	RedeemFeeRate string `protobuf:"bytes,6,opt,name=redeem_fee_rate,json=redeemFeeRate,proto3" json:"redeem_fee_rate,omitempty"`
+	// fee_denom is the coin fees are paid in. A partner's
+	// redeem_cost_per_point is read as the fee_denom price of one point.
+	FeeDenom     string       `protobuf:"bytes,7,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	FeeRecipient FeeRecipient `protobuf:"varint,8,opt,name=fee_recipient,json=feeRecipient,proto3,enum=rewardchain.rewardchain.FeeRecipient" json:"fee_recipient,omitempty"`
+	// fee_treasury receives fees when fee_recipient is FEE_RECIPIENT_TREASURY.
+	FeeTreasury string `protobuf:"bytes,9,opt,name=fee_treasury,json=feeTreasury,proto3" json:"fee_treasury,omitempty"`
+	// oracle_feeders may submit exchange rates.
+	OracleFeeders []string `protobuf:"bytes,10,rep,name=oracle_feeders,json=oracleFeeders,proto3" json:"oracle_feeders,omitempty"`
+	// oracle_window_blocks is how often submissions are aggregated. Only
+	// submissions made within the window count.
+	OracleWindowBlocks uint64 `protobuf:"varint,11,opt,name=oracle_window_blocks,json=oracleWindowBlocks,proto3" json:"oracle_window_blocks,omitempty"`
+	// oracle_min_submissions is the fewest feeders a pair needs in a window
+	// for a new rate to be set.
+	OracleMinSubmissions uint32 `protobuf:"varint,12,opt,name=oracle_min_submissions,json=oracleMinSubmissions,proto3" json:"oracle_min_submissions,omitempty"`
+	// oracle_max_rate_age_blocks is how long an aggregated rate may be used.
+	OracleMaxRateAgeBlocks uint64 `protobuf:"varint,13,opt,name=oracle_max_rate_age_blocks,json=oracleMaxRateAgeBlocks,proto3" json:"oracle_max_rate_age_blocks,omitempty"`
+	// attestors are the accredited addresses that may record KYC attestations.
+	Attestors []string `protobuf:"bytes,14,rep,name=attestors,proto3" json:"attestors,omitempty"`
+	// paused is the pause matrix. Emergency admins can pause operations with
+	// MsgSetPaused; only governance can resume them.
+	Paused PauseMatrix `protobuf:"bytes,15,opt,name=paused,proto3" json:"paused"`
+	// emergency_admins are the admins allowed to pause operations.
+	EmergencyAdmins []string `protobuf:"bytes,16,rep,name=emergency_admins,json=emergencyAdmins,proto3" json:"emergency_admins,omitempty"`
+	// transfer_denoms are the tokens ICS-20 transfer memos may spend on partner
+	// liquidity or points, and the tokens settlements may be paid with from
+	// escrow.
+	TransferDenoms []DenomCurrency `protobuf:"bytes,17,rep,name=transfer_denoms,json=transferDenoms,proto3" json:"transfer_denoms"`
+	// liquidity_checkpoint_interval_blocks is how often every partner's
+	// liquidity is checkpointed. Zero turns checkpoints off; checkpoints
+	// imported from genesis are then still kept, in the slots of the default
+	// interval of 14400 blocks.
+	LiquidityCheckpointIntervalBlocks uint64 `protobuf:"varint,18,opt,name=liquidity_checkpoint_interval_blocks,json=liquidityCheckpointIntervalBlocks,proto3" json:"liquidity_checkpoint_interval_blocks,omitempty"`
+	// liquidity_checkpoint_retention is how many checkpoints are kept per
+	// partner. Newer checkpoints overwrite the oldest.
+	LiquidityCheckpointRetention uint32 `protobuf:"varint,19,opt,name=liquidity_checkpoint_retention,json=liquidityCheckpointRetention,proto3" json:"liquidity_checkpoint_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1a15a27b28cf06a8, []int{0}
+	return fileDescriptor_1a15a27b28cf06a8, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,7 +342,137 @@ func (m *Params) GetAdminAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetMaxBatchEarnEntries() uint32 {
+	if m != nil {
+		return m.MaxBatchEarnEntries
+	}
+	return 0
+}
+
+func (m *Params) GetClientRefTtlBlocks() uint64 {
+	if m != nil {
+		return m.ClientRefTtlBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSettlementEpochBlocks() uint64 {
+	if m != nil {
+		return m.SettlementEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSwapFeeRate() string {
+	if m != nil {
+		return m.SwapFeeRate
+	}
+	return ""
+}
+
+func (m *Params) GetRedeemFeeRate() string {
+	if m != nil {
+		return m.RedeemFeeRate
+	}
+	return ""
+}
+
+func (m *Params) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetFeeRecipient() FeeRecipient {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return FEE_RECIPIENT_UNSPECIFIED
+}
+
+func (m *Params) GetFeeTreasury() string {
+	if m != nil {
+		return m.FeeTreasury
+	}
+	return ""
+}
+
+func (m *Params) GetOracleFeeders() []string {
+	if m != nil {
+		return m.OracleFeeders
+	}
+	return nil
+}
+
+func (m *Params) GetOracleWindowBlocks() uint64 {
+	if m != nil {
+		return m.OracleWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetOracleMinSubmissions() uint32 {
+	if m != nil {
+		return m.OracleMinSubmissions
+	}
+	return 0
+}
+
+func (m *Params) GetOracleMaxRateAgeBlocks() uint64 {
+	if m != nil {
+		return m.OracleMaxRateAgeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAttestors() []string {
+	if m != nil {
+		return m.Attestors
+	}
+	return nil
+}
+
+func (m *Params) GetPaused() PauseMatrix {
+	if m != nil {
+		return m.Paused
+	}
+	return PauseMatrix{}
+}
+
+func (m *Params) GetEmergencyAdmins() []string {
+	if m != nil {
+		return m.EmergencyAdmins
+	}
+	return nil
+}
+
+func (m *Params) GetTransferDenoms() []DenomCurrency {
+	if m != nil {
+		return m.TransferDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetLiquidityCheckpointIntervalBlocks() uint64 {
+	if m != nil {
+		return m.LiquidityCheckpointIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetLiquidityCheckpointRetention() uint32 {
+	if m != nil {
+		return m.LiquidityCheckpointRetention
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("rewardchain.rewardchain.FeeRecipient", FeeRecipient_name, FeeRecipient_value)
+	proto.RegisterEnum("rewardchain.rewardchain.Operation", Operation_name, Operation_value)
+	proto.RegisterType((*PauseMatrix)(nil), "rewardchain.rewardchain.PauseMatrix")
+	proto.RegisterType((*DenomCurrency)(nil), "rewardchain.rewardchain.DenomCurrency")
 	proto.RegisterType((*Params)(nil), "rewardchain.rewardchain.Params")
 }
 
@@ -80,23 +481,146 @@ func init() {
 }
 
 var fileDescriptor_1a15a27b28cf06a8 = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4a, 0x2d, 0x4f,
-	0x2c, 0x4a, 0x49, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x47, 0x66, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x16,
-	0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x23, 0xc9, 0xe8, 0x21, 0xb1, 0xa5, 0x04, 0x13,
-	0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xad, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e,
-	0x71, 0x3c, 0x98, 0xa7, 0x0f, 0xe1, 0x40, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0x21, 0xe2, 0x20,
-	0x16, 0x44, 0x54, 0xa9, 0x8c, 0x8b, 0x2d, 0x00, 0x6c, 0x99, 0x90, 0x23, 0x17, 0x7f, 0x62, 0x4a,
-	0x6e, 0x66, 0x5e, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x6a, 0xb1, 0x04, 0xa3, 0x02,
-	0xb3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0xa3, 0x1c, 0x21, 0x72, 0xc1, 0x25,
-	0x45, 0x99, 0x79, 0xe9, 0x41, 0x7c, 0x60, 0x0d, 0x8e, 0x30, 0xf5, 0x56, 0x9a, 0x2f, 0x16, 0xc8,
-	0x33, 0x76, 0x3d, 0xdf, 0xa0, 0xa5, 0x80, 0xec, 0x99, 0x0a, 0x14, 0xaf, 0x41, 0x6c, 0x73, 0xb2,
-	0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96,
-	0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x79, 0xdc, 0x7a, 0x4b, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x2e, 0x37, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x19,
-	0x4a, 0xb6, 0x3e, 0x01, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcd, 0x52, 0x2b, 0x45,
+	0x14, 0xce, 0x40, 0x88, 0xa4, 0x43, 0xc2, 0xd0, 0x37, 0xc0, 0x90, 0x8b, 0x21, 0x22, 0xf7, 0x16,
+	0x52, 0x25, 0x28, 0xd7, 0xb2, 0x4a, 0x5c, 0x58, 0x93, 0x64, 0xa8, 0x8a, 0x92, 0x1f, 0x27, 0x93,
+	0xba, 0x85, 0x9b, 0xae, 0x66, 0xe6, 0x24, 0x4c, 0x91, 0xcc, 0xc4, 0xee, 0x46, 0xc2, 0x1b, 0xa8,
+	0x2b, 0x1f, 0xc0, 0x85, 0x55, 0xbe, 0x80, 0x0b, 0x1f, 0xe2, 0x2e, 0x6f, 0xb9, 0xd2, 0x8d, 0x65,
+	0xc1, 0x42, 0x37, 0xbe, 0x83, 0x35, 0xdd, 0x93, 0x1f, 0x2c, 0xb8, 0x6c, 0x52, 0x7d, 0xce, 0xf7,
+	0x7d, 0x9d, 0x73, 0xfa, 0xeb, 0x3e, 0x83, 0x76, 0x18, 0x5c, 0x51, 0xe6, 0xb9, 0xe7, 0xd4, 0x0f,
+	0x0e, 0x66, 0xd7, 0x43, 0xca, 0xe8, 0x80, 0xef, 0x0f, 0x59, 0x28, 0x42, 0xbc, 0x3e, 0x83, 0xec,
+	0xcf, 0xac, 0x0b, 0x2b, 0x74, 0xe0, 0x07, 0xe1, 0x81, 0xfc, 0x55, 0xdc, 0xc2, 0x86, 0x1b, 0xf2,
+	0x41, 0xc8, 0x89, 0x8c, 0x0e, 0x54, 0x10, 0x43, 0xf9, 0x5e, 0xd8, 0x0b, 0x55, 0x3e, 0x5a, 0xa9,
+	0xec, 0xf6, 0xbf, 0x1a, 0xca, 0xb4, 0xe8, 0x25, 0x87, 0x3a, 0x15, 0xcc, 0x1f, 0xe1, 0x67, 0x28,
+	0xe7, 0x32, 0xa0, 0x02, 0xc8, 0x90, 0x32, 0x11, 0x00, 0x33, 0xb4, 0x92, 0xb6, 0xbb, 0x68, 0x67,
+	0x55, 0xb6, 0xa5, 0x92, 0xf8, 0x5d, 0x94, 0xa5, 0x9e, 0x47, 0xfa, 0xfe, 0xd7, 0x97, 0xbe, 0xe7,
+	0x8b, 0x6b, 0x63, 0x4e, 0xb2, 0x96, 0xa8, 0xe7, 0x9d, 0x8c, 0x73, 0xf8, 0x00, 0xe5, 0xf9, 0x15,
+	0x1d, 0x92, 0x61, 0xe8, 0x07, 0x82, 0x13, 0x11, 0x12, 0x11, 0x5e, 0x40, 0x60, 0xcc, 0x4b, 0xee,
+	0x4a, 0x84, 0xb5, 0x24, 0xe4, 0x84, 0x4e, 0x04, 0x4c, 0x04, 0x92, 0x16, 0xf1, 0x95, 0xd2, 0x48,
+	0x4e, 0x05, 0x92, 0xe8, 0x84, 0x4a, 0x87, 0x31, 0x4a, 0x02, 0x65, 0x81, 0xb1, 0x20, 0x09, 0x72,
+	0x8d, 0xd7, 0x50, 0x8a, 0x81, 0x07, 0x30, 0x30, 0x52, 0x32, 0x1b, 0x47, 0x47, 0xc9, 0x7f, 0x7e,
+	0xda, 0xd2, 0xb6, 0x5d, 0x94, 0xad, 0x42, 0x10, 0x0e, 0x2a, 0x97, 0x8c, 0x41, 0xe0, 0x5e, 0xe3,
+	0x3c, 0x5a, 0xf0, 0xa2, 0x84, 0xec, 0x33, 0x6d, 0xab, 0x00, 0x17, 0xd0, 0xa2, 0x1b, 0x33, 0x64,
+	0x6b, 0x69, 0x7b, 0x12, 0x47, 0x18, 0x8c, 0x86, 0x61, 0x00, 0x81, 0x90, 0xad, 0x64, 0xed, 0x49,
+	0x1c, 0xff, 0xc9, 0x8f, 0x69, 0x94, 0x6a, 0x49, 0x0b, 0xb1, 0x89, 0x96, 0xa9, 0x37, 0xf0, 0x03,
+	0x42, 0x3d, 0x8f, 0x01, 0xe7, 0xc0, 0x0d, 0xad, 0x34, 0xbf, 0x9b, 0x2e, 0x1b, 0xbf, 0xfd, 0xfa,
+	0x7e, 0x3e, 0x36, 0xc8, 0x54, 0x58, 0x5b, 0x30, 0x3f, 0xe8, 0xd9, 0x39, 0x29, 0x30, 0xc7, 0x7c,
+	0xfc, 0x02, 0xad, 0x0d, 0xe8, 0x88, 0x9c, 0x51, 0xe1, 0x9e, 0x93, 0xa8, 0x45, 0x02, 0x81, 0x60,
+	0x3e, 0x70, 0x59, 0x59, 0xd6, 0x7e, 0x32, 0xa0, 0xa3, 0x72, 0x04, 0x5a, 0x94, 0x05, 0x96, 0x82,
+	0xf0, 0x87, 0x68, 0xd5, 0xed, 0xfb, 0x10, 0x08, 0xc2, 0xa0, 0x4b, 0x84, 0xe8, 0x93, 0xb3, 0x7e,
+	0xe8, 0x5e, 0x70, 0x59, 0x71, 0xd2, 0xc6, 0x0a, 0xb4, 0xa1, 0xeb, 0x88, 0x7e, 0x59, 0x22, 0xf8,
+	0x63, 0xb4, 0xce, 0x41, 0x88, 0x3e, 0x0c, 0x22, 0x19, 0x0c, 0x43, 0xf7, 0x7c, 0x2c, 0x4a, 0x4a,
+	0xd1, 0xea, 0x14, 0xb6, 0x22, 0x34, 0xd6, 0x6d, 0xa3, 0xac, 0x74, 0xad, 0x0b, 0x40, 0x18, 0x15,
+	0x20, 0xdd, 0x48, 0xdb, 0x99, 0x28, 0x79, 0x0c, 0x60, 0x53, 0x01, 0xf8, 0x39, 0x5a, 0x56, 0x36,
+	0x4c, 0x59, 0x29, 0xc9, 0xca, 0xaa, 0xf4, 0x98, 0xf7, 0x14, 0xa5, 0x23, 0x82, 0x72, 0xe4, 0x2d,
+	0x75, 0xf0, 0x5d, 0x00, 0x69, 0x19, 0xfe, 0x1c, 0x65, 0xa5, 0x1a, 0x5c, 0x7f, 0x18, 0x55, 0x6f,
+	0x2c, 0x96, 0xb4, 0xdd, 0xdc, 0xe1, 0xb3, 0xfd, 0x07, 0x1e, 0xc8, 0x7e, 0xb4, 0xeb, 0x98, 0x6c,
+	0x2f, 0x75, 0x67, 0x22, 0xfc, 0x29, 0x8a, 0x62, 0x22, 0x18, 0x50, 0x7e, 0xc9, 0xae, 0x8d, 0x74,
+	0x49, 0x7b, 0xa3, 0x29, 0x99, 0x2e, 0x80, 0x13, 0x93, 0xf1, 0x67, 0x28, 0x17, 0x32, 0xea, 0xf6,
+	0x21, 0xea, 0xc6, 0x03, 0xc6, 0x0d, 0xf4, 0x88, 0xa7, 0x59, 0xc5, 0x3f, 0x56, 0x74, 0xfc, 0x01,
+	0xca, 0xc7, 0x1b, 0x5c, 0xf9, 0x81, 0x17, 0x5e, 0x8d, 0xcf, 0x39, 0xa3, 0xcc, 0x51, 0xd8, 0x4b,
+	0x09, 0xc5, 0x87, 0xfc, 0x11, 0x5a, 0x8b, 0x15, 0xd1, 0x65, 0xe2, 0x97, 0x67, 0x03, 0x9f, 0x73,
+	0x3f, 0x0c, 0xb8, 0xb1, 0x24, 0x2f, 0x41, 0xbc, 0x5f, 0xdd, 0x0f, 0xda, 0x53, 0x0c, 0x1f, 0xa1,
+	0xc2, 0x58, 0x45, 0x47, 0xf2, 0xd8, 0x09, 0xed, 0xc1, 0xf8, 0xdf, 0xb2, 0xf2, 0xdf, 0xe2, 0x7d,
+	0xeb, 0x74, 0x14, 0x39, 0x60, 0xf6, 0x60, 0x72, 0x1d, 0xd2, 0x54, 0x08, 0xe0, 0x22, 0x64, 0xdc,
+	0xc8, 0x3d, 0xd2, 0xdf, 0x94, 0x8a, 0xcb, 0x28, 0x35, 0x8c, 0x06, 0x8a, 0x67, 0x2c, 0x97, 0xb4,
+	0xdd, 0xcc, 0xe1, 0xce, 0x83, 0xf6, 0xcc, 0xcc, 0x9d, 0x72, 0xf2, 0xd5, 0x9f, 0x5b, 0x09, 0x3b,
+	0x56, 0xe2, 0x0a, 0xd2, 0x61, 0x00, 0xac, 0x17, 0xbd, 0x37, 0x22, 0x9f, 0x03, 0x37, 0xf4, 0x47,
+	0x4a, 0x58, 0x9e, 0x28, 0x4c, 0x29, 0xc0, 0x1d, 0xb4, 0x2c, 0x18, 0x0d, 0x78, 0x17, 0x98, 0xba,
+	0x50, 0xdc, 0x58, 0x29, 0xcd, 0xef, 0x66, 0x0e, 0x9f, 0x3f, 0x58, 0xd1, 0x9d, 0xd1, 0x10, 0xd7,
+	0x94, 0x1b, 0x6f, 0x22, 0x41, 0x8e, 0x9b, 0x68, 0x67, 0x32, 0xf6, 0x88, 0x7b, 0x0e, 0xee, 0x85,
+	0x9c, 0x52, 0xc4, 0x0f, 0x04, 0xb0, 0x6f, 0xe8, 0xe4, 0xa1, 0x61, 0x79, 0xba, 0xef, 0x4c, 0xb8,
+	0x95, 0x09, 0xb5, 0x16, 0x33, 0xe3, 0x83, 0xae, 0xa2, 0xe2, 0xbd, 0x1b, 0x32, 0x10, 0x10, 0x08,
+	0x3f, 0x0c, 0x8c, 0x27, 0xd2, 0xe2, 0xcd, 0x7b, 0xb6, 0xb2, 0xc7, 0x9c, 0xa3, 0xf7, 0xa2, 0xc9,
+	0xf3, 0xfd, 0xdf, 0xbf, 0xec, 0x95, 0x66, 0x3f, 0x24, 0xa3, 0x3b, 0x9f, 0x15, 0x35, 0x93, 0xf6,
+	0xbe, 0xd3, 0xd0, 0xd2, 0xec, 0xd3, 0xc0, 0x6f, 0xa3, 0x8d, 0x63, 0xcb, 0x22, 0xb6, 0x55, 0xa9,
+	0xb5, 0x6a, 0x56, 0xc3, 0x21, 0x9d, 0x46, 0xbb, 0x65, 0x55, 0x6a, 0xc7, 0x35, 0xab, 0xaa, 0x27,
+	0x70, 0x09, 0x6d, 0xde, 0x85, 0x2b, 0xcd, 0x7a, 0xbd, 0xd3, 0xa8, 0x39, 0xa7, 0xa4, 0xd5, 0x6c,
+	0x9e, 0xe8, 0x1a, 0x5e, 0x43, 0xf8, 0x2e, 0xa3, 0xdc, 0xb1, 0x1b, 0xfa, 0x1c, 0x2e, 0xa0, 0xb5,
+	0xbb, 0x79, 0xc7, 0xb6, 0xcc, 0x76, 0xc7, 0x3e, 0xd5, 0xe7, 0x0b, 0xc9, 0x6f, 0x7f, 0x2e, 0x26,
+	0xf6, 0xfe, 0xd0, 0x50, 0xba, 0x39, 0x04, 0x46, 0xa3, 0x26, 0xf0, 0x06, 0x5a, 0x6d, 0xb6, 0x2c,
+	0xdb, 0x74, 0x6a, 0xcd, 0xc6, 0xff, 0x8a, 0xd8, 0x44, 0xc6, 0x14, 0xaa, 0xd8, 0x96, 0xe9, 0x58,
+	0xa4, 0x65, 0xda, 0x4e, 0xc3, 0xb2, 0x75, 0x0d, 0x3f, 0x45, 0xeb, 0x53, 0xd4, 0xac, 0x56, 0xc9,
+	0x49, 0xed, 0xcb, 0x4e, 0xad, 0x5a, 0x73, 0x4e, 0xf5, 0x39, 0xbc, 0x8d, 0x8a, 0x53, 0xb0, 0xfd,
+	0xd2, 0x6c, 0x91, 0x56, 0xb3, 0xd6, 0x70, 0xda, 0xc4, 0x69, 0x12, 0xa7, 0xf9, 0x85, 0xd5, 0xd0,
+	0xe7, 0xef, 0xe1, 0x48, 0x24, 0xa2, 0x28, 0xb2, 0x9e, 0xc4, 0x18, 0xe5, 0xa6, 0x1c, 0xcb, 0xb4,
+	0x1b, 0xfa, 0x02, 0xce, 0x23, 0x7d, 0x9a, 0xb3, 0xad, 0xaa, 0x65, 0xd5, 0xf5, 0x94, 0xea, 0xad,
+	0xfc, 0xc9, 0xab, 0x9b, 0xa2, 0xf6, 0xfa, 0xa6, 0xa8, 0xfd, 0x75, 0x53, 0xd4, 0x7e, 0xb8, 0x2d,
+	0x26, 0x5e, 0xdf, 0x16, 0x13, 0xbf, 0xdf, 0x16, 0x13, 0x5f, 0x6d, 0x3d, 0xec, 0x91, 0xb8, 0x1e,
+	0x02, 0x3f, 0x4b, 0xc9, 0xaf, 0xf3, 0x8b, 0xff, 0x06, 0x00, 0xf0, 0x06, 0x33, 0xfb, 0x22, 0x08,
+	0x00, 0x00,
+}
+
+func (this *PauseMatrix) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseMatrix)
+	if !ok {
+		that2, ok := that.(PauseMatrix)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreatePartner != that1.CreatePartner {
+		return false
+	}
+	if this.AddLiquidity != that1.AddLiquidity {
+		return false
+	}
+	if this.SwapPointsToToken != that1.SwapPointsToToken {
+		return false
+	}
+	if this.SwapTokenToPoints != that1.SwapTokenToPoints {
+		return false
+	}
+	if this.Earn != that1.Earn {
+		return false
+	}
+	if this.Redeem != that1.Redeem {
+		return false
+	}
+	return true
 }
+func (this *DenomCurrency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*DenomCurrency)
+	if !ok {
+		that2, ok := that.(DenomCurrency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Currency != that1.Currency {
+		return false
+	}
+	if this.Exponent != that1.Exponent {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -124,29 +648,360 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxBatchEarnEntries != that1.MaxBatchEarnEntries {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdminAddresses) > 0 {
+	if this.ClientRefTtlBlocks != that1.ClientRefTtlBlocks {
+		return false
+	}
+	if this.SettlementEpochBlocks != that1.SettlementEpochBlocks {
+		return false
+	}
+	if this.SwapFeeRate != that1.SwapFeeRate {
+		return false
+	}
+	if this.RedeemFeeRate != that1.RedeemFeeRate {
+		return false
+	}
+	if this.FeeDenom != that1.FeeDenom {
+		return false
+	}
+	if this.FeeRecipient != that1.FeeRecipient {
+		return false
+	}
+	if this.FeeTreasury != that1.FeeTreasury {
+		return false
+	}
+	if len(this.OracleFeeders) != len(that1.OracleFeeders) {
+		return false
+	}
+	for i := range this.OracleFeeders {
+		if this.OracleFeeders[i] != that1.OracleFeeders[i] {
+			return false
+		}
+	}
+	if this.OracleWindowBlocks != that1.OracleWindowBlocks {
+		return false
+	}
+	if this.OracleMinSubmissions != that1.OracleMinSubmissions {
+		return false
+	}
+	if this.OracleMaxRateAgeBlocks != that1.OracleMaxRateAgeBlocks {
+		return false
+	}
+	if len(this.Attestors) != len(that1.Attestors) {
+		return false
+	}
+	for i := range this.Attestors {
+		if this.Attestors[i] != that1.Attestors[i] {
+			return false
+		}
+	}
+	if !this.Paused.Equal(&that1.Paused) {
+		return false
+	}
+	if len(this.EmergencyAdmins) != len(that1.EmergencyAdmins) {
+		return false
+	}
+	for i := range this.EmergencyAdmins {
+		if this.EmergencyAdmins[i] != that1.EmergencyAdmins[i] {
+			return false
+		}
+	}
+	if len(this.TransferDenoms) != len(that1.TransferDenoms) {
+		return false
+	}
+	for i := range this.TransferDenoms {
+		if !this.TransferDenoms[i].Equal(&that1.TransferDenoms[i]) {
+			return false
+		}
+	}
+	if this.LiquidityCheckpointIntervalBlocks != that1.LiquidityCheckpointIntervalBlocks {
+		return false
+	}
+	if this.LiquidityCheckpointRetention != that1.LiquidityCheckpointRetention {
+		return false
+	}
+	return true
+}
+func (m *PauseMatrix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseMatrix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseMatrix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Redeem {
+		i--
+		if m.Redeem {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Earn {
+		i--
+		if m.Earn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SwapTokenToPoints {
+		i--
+		if m.SwapTokenToPoints {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SwapPointsToToken {
+		i--
+		if m.SwapPointsToToken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AddLiquidity {
+		i--
+		if m.AddLiquidity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CreatePartner {
+		i--
+		if m.CreatePartner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomCurrency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCurrency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCurrency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidityCheckpointRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LiquidityCheckpointRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.LiquidityCheckpointIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LiquidityCheckpointIntervalBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.TransferDenoms) > 0 {
+		for iNdEx := len(m.TransferDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.EmergencyAdmins) > 0 {
+		for iNdEx := len(m.EmergencyAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EmergencyAdmins[iNdEx])
+			copy(dAtA[i:], m.EmergencyAdmins[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.EmergencyAdmins[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.Attestors) > 0 {
+		for iNdEx := len(m.Attestors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestors[iNdEx])
+			copy(dAtA[i:], m.Attestors[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Attestors[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.OracleMaxRateAgeBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OracleMaxRateAgeBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.OracleMinSubmissions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OracleMinSubmissions))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.OracleWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OracleWindowBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.OracleFeeders) > 0 {
+		for iNdEx := len(m.OracleFeeders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OracleFeeders[iNdEx])
+			copy(dAtA[i:], m.OracleFeeders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.OracleFeeders[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.FeeTreasury) > 0 {
+		i -= len(m.FeeTreasury)
+		copy(dAtA[i:], m.FeeTreasury)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeTreasury)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FeeRecipient != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeRecipient))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RedeemFeeRate) > 0 {
+		i -= len(m.RedeemFeeRate)
+		copy(dAtA[i:], m.RedeemFeeRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RedeemFeeRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SwapFeeRate) > 0 {
+		i -= len(m.SwapFeeRate)
+		copy(dAtA[i:], m.SwapFeeRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SwapFeeRate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SettlementEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettlementEpochBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ClientRefTtlBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ClientRefTtlBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBatchEarnEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchEarnEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AdminAddresses) > 0 {
 		for iNdEx := len(m.AdminAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AdminAddresses[iNdEx])
 			copy(dAtA[i:], m.AdminAddresses[iNdEx])
@@ -169,6 +1024,53 @@ func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *PauseMatrix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatePartner {
+		n += 2
+	}
+	if m.AddLiquidity {
+		n += 2
+	}
+	if m.SwapPointsToToken {
+		n += 2
+	}
+	if m.SwapTokenToPoints {
+		n += 2
+	}
+	if m.Earn {
+		n += 2
+	}
+	if m.Redeem {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomCurrency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovParams(uint64(m.Exponent))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -181,6 +1083,75 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.MaxBatchEarnEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchEarnEntries))
+	}
+	if m.ClientRefTtlBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ClientRefTtlBlocks))
+	}
+	if m.SettlementEpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SettlementEpochBlocks))
+	}
+	l = len(m.SwapFeeRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.RedeemFeeRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FeeRecipient != 0 {
+		n += 1 + sovParams(uint64(m.FeeRecipient))
+	}
+	l = len(m.FeeTreasury)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.OracleFeeders) > 0 {
+		for _, s := range m.OracleFeeders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.OracleWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.OracleWindowBlocks))
+	}
+	if m.OracleMinSubmissions != 0 {
+		n += 1 + sovParams(uint64(m.OracleMinSubmissions))
+	}
+	if m.OracleMaxRateAgeBlocks != 0 {
+		n += 1 + sovParams(uint64(m.OracleMaxRateAgeBlocks))
+	}
+	if len(m.Attestors) > 0 {
+		for _, s := range m.Attestors {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.Paused.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.EmergencyAdmins) > 0 {
+		for _, s := range m.EmergencyAdmins {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.TransferDenoms) > 0 {
+		for _, e := range m.TransferDenoms {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.LiquidityCheckpointIntervalBlocks != 0 {
+		n += 2 + sovParams(uint64(m.LiquidityCheckpointIntervalBlocks))
+	}
+	if m.LiquidityCheckpointRetention != 0 {
+		n += 2 + sovParams(uint64(m.LiquidityCheckpointRetention))
+	}
 	return n
 }
 
@@ -190,7 +1161,7 @@ func sovParams(x uint64) (n int) {
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PauseMatrix) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -213,17 +1184,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseMatrix: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseMatrix: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatePartner", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -233,24 +1204,789 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
+			m.CreatePartner = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddLiquidity", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AddLiquidity = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPointsToToken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapPointsToToken = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTokenToPoints", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapTokenToPoints = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Earn = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeem", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Redeem = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomCurrency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomCurrency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomCurrency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddresses = append(m.AdminAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchEarnEntries", wireType)
+			}
+			m.MaxBatchEarnEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchEarnEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRefTtlBlocks", wireType)
+			}
+			m.ClientRefTtlBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientRefTtlBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementEpochBlocks", wireType)
+			}
+			m.SettlementEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapFeeRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemFeeRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			m.FeeRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeRecipient |= FeeRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTreasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTreasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleFeeders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleFeeders = append(m.OracleFeeders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleWindowBlocks", wireType)
+			}
+			m.OracleWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMinSubmissions", wireType)
+			}
+			m.OracleMinSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleMinSubmissions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMaxRateAgeBlocks", wireType)
+			}
+			m.OracleMaxRateAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleMaxRateAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestors = append(m.Attestors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAdmins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAdmins = append(m.EmergencyAdmins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferDenoms = append(m.TransferDenoms, DenomCurrency{})
+			if err := m.TransferDenoms[len(m.TransferDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCheckpointIntervalBlocks", wireType)
+			}
+			m.LiquidityCheckpointIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityCheckpointIntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCheckpointRetention", wireType)
+			}
+			m.LiquidityCheckpointRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityCheckpointRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.AdminAddresses = append(m.AdminAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -39,6 +40,13 @@ type Partner struct {
 	RedeemCostPerPoint string `protobuf:"bytes,11,opt,name=redeem_cost_per_point,json=redeemCostPerPoint,proto3" json:"redeem_cost_per_point,omitempty"`
 	StartsFrom         string `protobuf:"bytes,12,opt,name=starts_from,json=startsFrom,proto3" json:"starts_from,omitempty"`
 	EndsBefore         string `protobuf:"bytes,13,opt,name=ends_before,json=endsBefore,proto3" json:"ends_before,omitempty"`
+	// treasury is the account that funds the partner's member sponsorships.
+	Treasury string `protobuf:"bytes,14,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// negative_balance_limit is how far below zero an earn reversal may take a
+	// member balance. Any shortfall beyond it is recorded as member debt.
+	NegativeBalanceLimit string `protobuf:"bytes,15,opt,name=negative_balance_limit,json=negativeBalanceLimit,proto3" json:"negative_balance_limit,omitempty"`
+	// currency is the ISO 4217 code the partner's liquidity is denominated in.
+	Currency string `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
 func (m *Partner) Reset()         { *m = Partner{} }
@@ -165,6 +173,27 @@ func (m *Partner) GetEndsBefore() string {
 	return ""
 }
 
+func (m *Partner) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *Partner) GetNegativeBalanceLimit() string {
+	if m != nil {
+		return m.NegativeBalanceLimit
+	}
+	return ""
+}
+
+func (m *Partner) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Partner)(nil), "rewardchain.rewardchain.Partner")
 }
@@ -174,33 +203,38 @@ func init() {
 }
 
 var fileDescriptor_cefbdf3311045880 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x8e, 0xd2, 0x40,
-	0x1c, 0xc7, 0x29, 0x20, 0x7f, 0x06, 0x05, 0x19, 0x34, 0x4e, 0x38, 0x14, 0x34, 0x51, 0x09, 0x89,
-	0x34, 0xc6, 0x93, 0x1e, 0x31, 0x31, 0x1e, 0x3c, 0x10, 0x8e, 0x5e, 0x9a, 0xa1, 0x33, 0xc0, 0x24,
-	0xed, 0xfc, 0xea, 0x74, 0x50, 0xfb, 0x0a, 0x9e, 0x7c, 0x94, 0x7d, 0x8c, 0x3d, 0x72, 0xdc, 0xe3,
-	0x06, 0x0e, 0x7b, 0xda, 0x77, 0xd8, 0xcc, 0xcc, 0x02, 0x65, 0x93, 0xbd, 0x34, 0xdf, 0x3f, 0x9f,
-	0x6f, 0xd3, 0xa4, 0x3f, 0xf4, 0x56, 0xf1, 0x3f, 0x54, 0xb1, 0x68, 0x4d, 0x85, 0x0c, 0x8a, 0x3a,
-	0xa5, 0x4a, 0x4b, 0xae, 0x26, 0xa9, 0x02, 0x0d, 0xf8, 0x55, 0xa1, 0x9a, 0x14, 0x74, 0xbf, 0x4b,
-	0x13, 0x21, 0x21, 0xb0, 0x4f, 0xc7, 0xf6, 0x5f, 0xac, 0x60, 0x05, 0x56, 0x06, 0x46, 0xb9, 0xf4,
-	0xcd, 0x6d, 0x05, 0xd5, 0x67, 0xee, 0x9d, 0xb8, 0x8d, 0xca, 0x82, 0x11, 0x6f, 0xe8, 0x8d, 0xaa,
-	0xf3, 0xb2, 0x60, 0x18, 0xa3, 0xaa, 0xa4, 0x09, 0x27, 0xe5, 0xa1, 0x37, 0x6a, 0xce, 0xad, 0xc6,
-	0x7d, 0xd4, 0x88, 0xa8, 0xe6, 0x2b, 0x50, 0x39, 0xa9, 0xd8, 0xfc, 0xe8, 0x4d, 0x17, 0x43, 0x44,
-	0xb5, 0x00, 0x49, 0xaa, 0xae, 0x3b, 0x78, 0x4c, 0x50, 0x3d, 0x82, 0x8d, 0xd4, 0x2a, 0x27, 0x4f,
-	0x6c, 0x75, 0xb0, 0x66, 0xc5, 0x44, 0x46, 0x17, 0x31, 0x67, 0xa4, 0x36, 0xf4, 0x46, 0x8d, 0xf9,
-	0xd1, 0xe3, 0xf7, 0xa8, 0xa3, 0x41, 0xd3, 0x38, 0x8c, 0xc5, 0xaf, 0x8d, 0x60, 0x42, 0xe7, 0xa4,
-	0x6e, 0xd7, 0x6d, 0x1b, 0xff, 0x38, 0xa4, 0x38, 0x40, 0x3d, 0xfa, 0x9b, 0x8a, 0xd8, 0xcc, 0x0a,
-	0x70, 0xc3, 0xc2, 0xf8, 0x58, 0x9d, 0x06, 0x63, 0xd4, 0x05, 0x19, 0xae, 0x21, 0x66, 0x05, 0xbc,
-	0x69, 0xf1, 0x0e, 0xc8, 0xef, 0x10, 0xb3, 0x13, 0xfb, 0x01, 0xf5, 0x38, 0x55, 0x32, 0x8c, 0x20,
-	0xd3, 0x61, 0xca, 0x55, 0x98, 0x82, 0x90, 0x9a, 0x20, 0x4b, 0x3f, 0x37, 0xd5, 0x57, 0xc8, 0xf4,
-	0x8c, 0xab, 0x99, 0xc9, 0xf1, 0x47, 0xf4, 0x52, 0x71, 0xc6, 0x79, 0xf2, 0x70, 0xd0, 0x72, 0x5f,
-	0xe3, 0xca, 0xb3, 0xc9, 0x00, 0xb5, 0x32, 0x4d, 0x95, 0xce, 0xc2, 0xa5, 0x82, 0x84, 0x3c, 0xb5,
-	0x20, 0x72, 0xd1, 0x37, 0x05, 0x89, 0x01, 0xb8, 0x64, 0x59, 0xb8, 0xe0, 0x4b, 0x50, 0x9c, 0x3c,
-	0x73, 0x80, 0x89, 0xa6, 0x36, 0xf9, 0xf2, 0xee, 0xdf, 0xcd, 0xc5, 0xf8, 0x75, 0xf1, 0x52, 0xfe,
-	0x9e, 0xdd, 0xcd, 0xfd, 0x3f, 0x9e, 0x7e, 0xbe, 0xdc, 0xf9, 0xde, 0x76, 0xe7, 0x7b, 0xd7, 0x3b,
-	0xdf, 0xfb, 0xbf, 0xf7, 0x4b, 0xdb, 0xbd, 0x5f, 0xba, 0xda, 0xfb, 0xa5, 0x9f, 0x83, 0xc7, 0xc7,
-	0x3a, 0x4f, 0x79, 0xb6, 0xa8, 0xd9, 0x8b, 0xf9, 0x74, 0x17, 0x00, 0x00, 0xff, 0xff, 0x01, 0x3c,
-	0x37, 0xea, 0x9c, 0x02, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0x13, 0x31,
+	0x1c, 0xc5, 0x73, 0x21, 0x34, 0xa9, 0x0b, 0x49, 0xeb, 0x06, 0x30, 0x19, 0xae, 0x01, 0x09, 0x88,
+	0x2a, 0xb5, 0x11, 0xa2, 0x0b, 0x6c, 0x04, 0x09, 0x31, 0x74, 0x88, 0xc2, 0xc6, 0x72, 0x72, 0xce,
+	0x6e, 0x6a, 0xe9, 0xce, 0xff, 0xf0, 0xb7, 0x53, 0xb8, 0xaf, 0xc0, 0xc4, 0x47, 0x61, 0xe0, 0x43,
+	0x30, 0x56, 0x4c, 0x8c, 0x28, 0x19, 0xf8, 0x04, 0xec, 0xc8, 0xf6, 0x25, 0xbd, 0x22, 0x75, 0x89,
+	0xfe, 0xef, 0xfd, 0xde, 0x93, 0xa3, 0xd3, 0x23, 0x4f, 0x50, 0x7e, 0xe2, 0x28, 0xd2, 0x73, 0xae,
+	0xf4, 0xb0, 0x7a, 0xcf, 0x39, 0x5a, 0x2d, 0xf1, 0x78, 0x8e, 0x60, 0x81, 0x3e, 0xa8, 0xa0, 0xe3,
+	0xca, 0xdd, 0xdb, 0xe3, 0xb9, 0xd2, 0x30, 0xf4, 0xbf, 0x21, 0xdb, 0x7b, 0x98, 0x82, 0xc9, 0xc1,
+	0x24, 0x5e, 0x0d, 0x83, 0x28, 0x51, 0x77, 0x06, 0x33, 0x08, 0xbe, 0xbb, 0x82, 0xfb, 0xf8, 0x6f,
+	0x83, 0x34, 0xc7, 0xe1, 0x39, 0xda, 0x26, 0x75, 0x25, 0x58, 0xd4, 0x8f, 0x06, 0x8d, 0x49, 0x5d,
+	0x09, 0x4a, 0x49, 0x43, 0xf3, 0x5c, 0xb2, 0x7a, 0x3f, 0x1a, 0x6c, 0x4f, 0xfc, 0x4d, 0x7b, 0xa4,
+	0x95, 0x72, 0x2b, 0x67, 0x80, 0x05, 0xbb, 0xe5, 0xfd, 0x8d, 0x76, 0x2c, 0x83, 0x94, 0x5b, 0x05,
+	0x9a, 0x35, 0x02, 0x5b, 0x6b, 0xca, 0x48, 0x33, 0x85, 0x85, 0xb6, 0x58, 0xb0, 0xdb, 0x1e, 0xad,
+	0xa5, 0x6b, 0x09, 0x65, 0xf8, 0x34, 0x93, 0x82, 0x6d, 0xf5, 0xa3, 0x41, 0x6b, 0xb2, 0xd1, 0xf4,
+	0x19, 0xe9, 0x58, 0xb0, 0x3c, 0x4b, 0x32, 0xf5, 0x71, 0xa1, 0x84, 0xb2, 0x05, 0x6b, 0xfa, 0x76,
+	0xdb, 0xdb, 0xa7, 0x6b, 0x97, 0x0e, 0xc9, 0x3e, 0xbf, 0xe0, 0x2a, 0x73, 0xb5, 0x4a, 0xb8, 0xe5,
+	0xc3, 0x74, 0x83, 0xae, 0x0a, 0x87, 0x64, 0x0f, 0x74, 0x72, 0x0e, 0x99, 0xa8, 0xc4, 0xb7, 0x7d,
+	0xbc, 0x03, 0xfa, 0x1d, 0x64, 0xe2, 0x2a, 0x7b, 0x44, 0xf6, 0x25, 0x47, 0x9d, 0xa4, 0x60, 0x6c,
+	0x32, 0x97, 0x98, 0xcc, 0x41, 0x69, 0xcb, 0x88, 0x4f, 0xef, 0x3a, 0xf4, 0x06, 0x8c, 0x1d, 0x4b,
+	0x1c, 0x3b, 0x9f, 0x3e, 0x27, 0xf7, 0x50, 0x0a, 0x29, 0xf3, 0xff, 0x0b, 0x3b, 0xe1, 0xdf, 0x04,
+	0x78, 0xad, 0x72, 0x40, 0x76, 0x8c, 0xe5, 0x68, 0x4d, 0x72, 0x86, 0x90, 0xb3, 0x3b, 0x3e, 0x48,
+	0x82, 0xf5, 0x16, 0x21, 0x77, 0x01, 0xa9, 0x85, 0x49, 0xa6, 0xf2, 0x0c, 0x50, 0xb2, 0xbb, 0x21,
+	0xe0, 0xac, 0x91, 0x77, 0xe8, 0x09, 0x69, 0x59, 0x94, 0xdc, 0x2c, 0xb0, 0x60, 0x6d, 0x47, 0x47,
+	0xec, 0xe7, 0xf7, 0xa3, 0x6e, 0xb9, 0x80, 0xd7, 0x42, 0xa0, 0x34, 0xe6, 0xbd, 0x45, 0xa5, 0x67,
+	0x93, 0x4d, 0x92, 0x9e, 0x90, 0xfb, 0x5a, 0xce, 0xb8, 0x55, 0x17, 0x32, 0x99, 0xf2, 0x8c, 0xeb,
+	0xd4, 0x7d, 0xbd, 0x5c, 0x59, 0xd6, 0xf1, 0x2f, 0x74, 0xd7, 0x74, 0x14, 0xe0, 0xa9, 0x63, 0x7e,
+	0x03, 0x0b, 0x44, 0xa9, 0xd3, 0x82, 0xed, 0x96, 0x1b, 0x28, 0xf5, 0xab, 0xa7, 0x5f, 0xfe, 0x7c,
+	0x3b, 0x7c, 0x54, 0x1d, 0xf3, 0xe7, 0x6b, 0xd3, 0x2e, 0xb7, 0x36, 0x7a, 0xf9, 0x63, 0x19, 0x47,
+	0x97, 0xcb, 0x38, 0xfa, 0xbd, 0x8c, 0xa3, 0xaf, 0xab, 0xb8, 0x76, 0xb9, 0x8a, 0x6b, 0xbf, 0x56,
+	0x71, 0xed, 0xc3, 0xc1, 0xcd, 0x65, 0x5b, 0xcc, 0xa5, 0x99, 0x6e, 0xf9, 0xe5, 0xbe, 0xf8, 0x37,
+	0x00, 0xbd, 0x55, 0xd6, 0x5e, 0x3f, 0x03, 0x00, 0x00,
 }
 
 func (m *Partner) Marshal() (dAtA []byte, err error) {
@@ -223,6 +257,29 @@ func (m *Partner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintPartner(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.NegativeBalanceLimit) > 0 {
+		i -= len(m.NegativeBalanceLimit)
+		copy(dAtA[i:], m.NegativeBalanceLimit)
+		i = encodeVarintPartner(dAtA, i, uint64(len(m.NegativeBalanceLimit)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintPartner(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.EndsBefore) > 0 {
 		i -= len(m.EndsBefore)
 		copy(dAtA[i:], m.EndsBefore)
@@ -385,6 +442,18 @@ func (m *Partner) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPartner(uint64(l))
 	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovPartner(uint64(l))
+	}
+	l = len(m.NegativeBalanceLimit)
+	if l > 0 {
+		n += 1 + l + sovPartner(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 2 + l + sovPartner(uint64(l))
+	}
 	return n
 }
 
@@ -814,6 +883,102 @@ func (m *Partner) Unmarshal(dAtA []byte) error {
 			}
 			m.EndsBefore = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NegativeBalanceLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NegativeBalanceLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPartner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPartner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPartner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPartner(dAtA[iNdEx:])
@@ -0,0 +1,69 @@
+package types
+
+import "fmt"
+
+// ValidateOperation checks that op names a pausable operation.
+func ValidateOperation(op Operation) error {
+	if _, ok := Operation_name[int32(op)]; !ok || op == OPERATION_UNSPECIFIED {
+		return fmt.Errorf("unknown operation %d", op)
+	}
+	return nil
+}
+
+// flag returns the matrix entry of an operation, or nil for an unknown one.
+func (m *PauseMatrix) flag(op Operation) *bool {
+	switch op {
+	case OPERATION_CREATE_PARTNER:
+		return &m.CreatePartner
+	case OPERATION_ADD_LIQUIDITY:
+		return &m.AddLiquidity
+	case OPERATION_SWAP_POINTS_TO_TOKEN:
+		return &m.SwapPointsToToken
+	case OPERATION_SWAP_TOKEN_TO_POINTS:
+		return &m.SwapTokenToPoints
+	case OPERATION_EARN:
+		return &m.Earn
+	case OPERATION_REDEEM:
+		return &m.Redeem
+	}
+	return nil
+}
+
+// IsPaused reports whether an operation is paused.
+func (m PauseMatrix) IsPaused(op Operation) bool {
+	f := m.flag(op)
+	return f != nil && *f
+}
+
+// SetPaused pauses or resumes an operation.
+func (m *PauseMatrix) SetPaused(op Operation, paused bool) error {
+	f := m.flag(op)
+	if f == nil {
+		return fmt.Errorf("unknown operation %d", op)
+	}
+	*f = paused
+	return nil
+}
+
+// PausedOperations returns the paused operations in enum order.
+func (m PauseMatrix) PausedOperations() []Operation {
+	out := make([]Operation, 0)
+	for op := OPERATION_CREATE_PARTNER; op <= OPERATION_REDEEM; op++ {
+		if m.IsPaused(op) {
+			out = append(out, op)
+		}
+	}
+	return out
+}
+
+// SwapOperation returns the operation of a swap route, or
+// OPERATION_UNSPECIFIED for an unknown route.
+func SwapOperation(route string) Operation {
+	switch route {
+	case "points_to_token":
+		return OPERATION_SWAP_POINTS_TO_TOKEN
+	case "token_to_points":
+		return OPERATION_SWAP_TOKEN_TO_POINTS
+	}
+	return OPERATION_UNSPECIFIED
+}
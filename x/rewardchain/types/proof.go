@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+)
+
+// StoreQueryPath is the ABCI query path that reads a raw key of the module
+// store. With prove=true the response carries the ICS-23 proof of the value.
+const StoreQueryPath = "/store/" + StoreKey + "/key"
+
+// PartnerStoreKey returns the module store key of a partner record:
+// PartnerKeyPrefix followed by the big endian partner id. The value is the
+// protobuf encoded Partner.
+func PartnerStoreKey(id uint64) []byte {
+	return append(bytes.Clone(PartnerKeyPrefix), PartnerKey(id)...)
+}
+
+// MemberBalanceStoreKey returns the module store key of a member balance:
+// MemberBalanceKeyPrefix, the big endian partner id and the length prefixed
+// member address. The value is the protobuf encoded MemberBalance; zero
+// balances are not stored.
+func MemberBalanceStoreKey(partnerID uint64, member sdk.AccAddress) []byte {
+	return append(bytes.Clone(MemberBalanceKeyPrefix), MemberBalanceKey(partnerID, member)...)
+}
+
+// StoreProof is a value of the module store as of a height together with the
+// proof linking it to the app hash. The state after Height is committed by
+// the app hash in the header of block Height+1. A nil Value means the key was
+// absent and Proof shows that.
+type StoreProof struct {
+	Height int64               `json:"height"`
+	Key    []byte              `json:"key"`
+	Value  []byte              `json:"value"`
+	Proof  *cmtcrypto.ProofOps `json:"proof"`
+}
+
+// Verify checks the ICS-23 proof of p, through the module store's root up to
+// appHash.
+func (p StoreProof) Verify(appHash []byte) error {
+	proof, err := commitmenttypes.ConvertProofs(p.Proof)
+	if err != nil {
+		return err
+	}
+	root := commitmenttypes.NewMerkleRoot(appHash)
+	path := commitmenttypes.NewMerklePath(StoreKey, string(p.Key))
+	if p.Value == nil {
+		return proof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, path)
+	}
+	return proof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, path, p.Value)
+}
+
+// VerifyPartnerProof checks that p proves partner id under appHash and
+// returns the partner. A partner absent at the height yields
+// ErrPartnerNotFound once its absence is proven.
+func VerifyPartnerProof(appHash []byte, id uint64, p StoreProof) (Partner, error) {
+	if !bytes.Equal(p.Key, PartnerStoreKey(id)) {
+		return Partner{}, errors.New("proof is not for the key of the partner")
+	}
+	if err := p.Verify(appHash); err != nil {
+		return Partner{}, err
+	}
+	if p.Value == nil {
+		return Partner{}, errorsmod.Wrapf(ErrPartnerNotFound, "partner %d at height %d", id, p.Height)
+	}
+
+	var partner Partner
+	if err := partner.Unmarshal(p.Value); err != nil {
+		return Partner{}, err
+	}
+	if partner.Id != id {
+		return Partner{}, fmt.Errorf("proven partner has id %d", partner.Id)
+	}
+	return partner, nil
+}
+
+// VerifyMemberBalanceProof checks that p proves the member's balance with the
+// partner under appHash and returns it. A proven absent balance is zero.
+func VerifyMemberBalanceProof(appHash []byte, partnerID uint64, member sdk.AccAddress, p StoreProof) (MemberBalance, error) {
+	if !bytes.Equal(p.Key, MemberBalanceStoreKey(partnerID, member)) {
+		return MemberBalance{}, errors.New("proof is not for the key of the member balance")
+	}
+	if err := p.Verify(appHash); err != nil {
+		return MemberBalance{}, err
+	}
+	if p.Value == nil {
+		return MemberBalance{PartnerId: partnerID, Member: member.String(), Points: math.LegacyZeroDec().String()}, nil
+	}
+
+	var b MemberBalance
+	if err := b.Unmarshal(p.Value); err != nil {
+		return MemberBalance{}, err
+	}
+	if b.PartnerId != partnerID || b.Member != member.String() {
+		return MemberBalance{}, fmt.Errorf("proven balance is of member %s with partner %d", b.Member, b.PartnerId)
+	}
+	return b, nil
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"rewardchain/testutil/sample"
+	"rewardchain/x/rewardchain/types"
+)
+
+func TestVerifyProofs(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	// a second store makes the proof go through the multistore's tree
+	ms.MountStoreWithDB(storetypes.NewKVStoreKey("other"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	member := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	partner := types.Partner{Id: 1, Name: "Acme", Country: "US"}
+	balance := types.MemberBalance{PartnerId: 1, Member: member.String(), Points: "42.000000000000000000"}
+	partnerBz, err := partner.Marshal()
+	require.NoError(t, err)
+	balanceBz, err := balance.Marshal()
+	require.NoError(t, err)
+	store := ms.GetKVStore(key)
+	store.Set(types.PartnerStoreKey(1), partnerBz)
+	store.Set(types.MemberBalanceStoreKey(1, member), balanceBz)
+	// a balance stored under another member's key
+	store.Set(types.MemberBalanceStoreKey(2, member), balanceBz)
+	ms.Commit()
+	// proofs need a height of 2
+	appHash := ms.Commit().Hash
+
+	prove := func(key []byte) types.StoreProof {
+		res, err := ms.Query(&storetypes.RequestQuery{Path: "/" + types.StoreKey + "/key", Data: key, Height: 2, Prove: true})
+		require.NoError(t, err)
+		p := types.StoreProof{Height: res.Height, Key: key, Proof: res.ProofOps}
+		if len(res.Value) > 0 {
+			p.Value = res.Value
+		}
+		return p
+	}
+
+	proof := prove(types.PartnerStoreKey(1))
+	got, err := types.VerifyPartnerProof(appHash, 1, proof)
+	require.NoError(t, err)
+	require.Equal(t, partner, got)
+
+	// a proof only holds for its own key, value and app hash
+	_, err = types.VerifyPartnerProof(appHash, 2, proof)
+	require.ErrorContains(t, err, "not for the key of the partner")
+	_, err = types.VerifyPartnerProof([]byte("other app hash"), 1, proof)
+	require.Error(t, err)
+	tampered := proof
+	tampered.Value = append([]byte{}, proof.Value...)
+	tampered.Value[len(tampered.Value)-1]++
+	_, err = types.VerifyPartnerProof(appHash, 1, tampered)
+	require.Error(t, err)
+
+	// absence is proven too
+	_, err = types.VerifyPartnerProof(appHash, 2, prove(types.PartnerStoreKey(2)))
+	require.ErrorIs(t, err, types.ErrPartnerNotFound)
+	absent := prove(types.PartnerStoreKey(2))
+	absent.Value = partnerBz
+	_, err = types.VerifyPartnerProof(appHash, 2, absent)
+	require.Error(t, err)
+
+	gotBalance, err := types.VerifyMemberBalanceProof(appHash, 1, member, prove(types.MemberBalanceStoreKey(1, member)))
+	require.NoError(t, err)
+	require.Equal(t, balance, gotBalance)
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	gotBalance, err = types.VerifyMemberBalanceProof(appHash, 1, other, prove(types.MemberBalanceStoreKey(1, other)))
+	require.NoError(t, err)
+	require.Equal(t, "0.000000000000000000", gotBalance.Points)
+	_, err = types.VerifyMemberBalanceProof(appHash, 2, member, prove(types.MemberBalanceStoreKey(2, member)))
+	require.ErrorContains(t, err, "proven balance is of member")
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -160,6 +162,8 @@ func (m *QueryPartnerRequest) GetId() uint64 {
 
 type QueryPartnerResponse struct {
 	Partner Partner `protobuf:"bytes,1,opt,name=partner,proto3" json:"partner"`
+	// earn_paused is set while earning is paused for low liquidity.
+	EarnPaused bool `protobuf:"varint,2,opt,name=earn_paused,json=earnPaused,proto3" json:"earn_paused,omitempty"`
 }
 
 func (m *QueryPartnerResponse) Reset()         { *m = QueryPartnerResponse{} }
@@ -202,6 +206,13 @@ func (m *QueryPartnerResponse) GetPartner() Partner {
 	return Partner{}
 }
 
+func (m *QueryPartnerResponse) GetEarnPaused() bool {
+	if m != nil {
+		return m.EarnPaused
+	}
+	return false
+}
+
 type QueryPartnersRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// include_disabled controls whether disabled partners are returned.
@@ -307,522 +318,10957 @@ func (m *QueryPartnersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "rewardchain.rewardchain.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "rewardchain.rewardchain.QueryParamsResponse")
-	proto.RegisterType((*QueryPartnerRequest)(nil), "rewardchain.rewardchain.QueryPartnerRequest")
-	proto.RegisterType((*QueryPartnerResponse)(nil), "rewardchain.rewardchain.QueryPartnerResponse")
-	proto.RegisterType((*QueryPartnersRequest)(nil), "rewardchain.rewardchain.QueryPartnersRequest")
-	proto.RegisterType((*QueryPartnersResponse)(nil), "rewardchain.rewardchain.QueryPartnersResponse")
+type QueryPartnerSponsorshipsRequest struct {
+	PartnerId  uint64             `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("rewardchain/rewardchain/query.proto", fileDescriptor_424a07031d53c8ff)
+func (m *QueryPartnerSponsorshipsRequest) Reset()         { *m = QueryPartnerSponsorshipsRequest{} }
+func (m *QueryPartnerSponsorshipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPartnerSponsorshipsRequest) ProtoMessage()    {}
+func (*QueryPartnerSponsorshipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{6}
 }
-
-var fileDescriptor_424a07031d53c8ff = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x69, 0x4d, 0xe3, 0x08, 0xfe, 0x18, 0x23, 0x96, 0x20, 0x9b, 0x74, 0x6b, 0xdb,
-	0xb4, 0xea, 0x0c, 0xad, 0x27, 0x6f, 0x12, 0x44, 0xaf, 0x75, 0x4f, 0xea, 0x45, 0x26, 0xd9, 0x61,
-	0x1d, 0x48, 0x66, 0xb6, 0x3b, 0x1b, 0xb5, 0x88, 0x17, 0x6f, 0x82, 0x07, 0x41, 0xf1, 0x2f, 0xf0,
-	0xe0, 0xd1, 0xbf, 0x42, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0x08, 0xfe, 0x1b, 0xb2, 0x33, 0x2f,
-	0xe9, 0xae, 0x92, 0x1f, 0xbd, 0x84, 0x61, 0xe6, 0xfb, 0xde, 0xfb, 0x7c, 0xdf, 0x7b, 0x59, 0xbc,
-	0x9e, 0x88, 0x17, 0x3c, 0x09, 0xbb, 0xcf, 0xb8, 0x54, 0x2c, 0x7f, 0x3e, 0x18, 0x88, 0xe4, 0x90,
-	0xc6, 0x89, 0x4e, 0x35, 0xb9, 0x9a, 0x7b, 0xa0, 0xb9, 0x73, 0xfd, 0x12, 0xef, 0x4b, 0xa5, 0x99,
-	0xfd, 0x75, 0xda, 0x7a, 0x2d, 0xd2, 0x91, 0xb6, 0x47, 0x96, 0x9d, 0xe0, 0xf6, 0x5a, 0xa4, 0x75,
-	0xd4, 0x13, 0x8c, 0xc7, 0x92, 0x71, 0xa5, 0x74, 0xca, 0x53, 0xa9, 0x95, 0x81, 0xd7, 0x9d, 0xae,
-	0x36, 0x7d, 0x6d, 0x58, 0x87, 0x1b, 0xe1, 0x0a, 0xb3, 0xe7, 0xbb, 0x1d, 0x91, 0xf2, 0x5d, 0x16,
-	0xf3, 0x48, 0x2a, 0x2b, 0x06, 0xed, 0xf5, 0x69, 0xc0, 0x31, 0x4f, 0x78, 0x7f, 0x9c, 0x71, 0x63,
-	0x86, 0x2a, 0x55, 0x22, 0x71, 0x32, 0xbf, 0x86, 0xc9, 0xc3, 0xac, 0xdc, 0xbe, 0x8d, 0x0d, 0xc4,
-	0xc1, 0x40, 0x98, 0xd4, 0x7f, 0x8c, 0x2f, 0x17, 0x6e, 0x4d, 0xac, 0x95, 0x11, 0xa4, 0x8d, 0x2b,
-	0xae, 0xc6, 0x2a, 0x6a, 0xa2, 0xd6, 0xb9, 0xbd, 0x06, 0x9d, 0xd2, 0x16, 0xea, 0x02, 0xdb, 0x67,
-	0x8f, 0x7e, 0x36, 0x4a, 0x5f, 0xfe, 0x7c, 0xdd, 0x41, 0x01, 0x44, 0xfa, 0x1b, 0x27, 0xa9, 0x33,
-	0x0c, 0xa8, 0x48, 0xce, 0xe3, 0xb2, 0x0c, 0x6d, 0xda, 0xe5, 0xa0, 0x2c, 0x43, 0xff, 0x11, 0xae,
-	0x15, 0x65, 0x80, 0x70, 0x17, 0xaf, 0x80, 0x01, 0x60, 0x68, 0xce, 0x62, 0xc8, 0x74, 0xed, 0xe5,
-	0x0c, 0x22, 0x18, 0x87, 0xf9, 0x6f, 0x51, 0x31, 0xf5, 0xd8, 0x34, 0xb9, 0x8f, 0xf1, 0x49, 0xaf,
-	0x21, 0xfb, 0x26, 0x75, 0x83, 0xa1, 0xd9, 0x60, 0xa8, 0xdb, 0x08, 0x18, 0x0c, 0xdd, 0xe7, 0x91,
-	0x80, 0xd8, 0x20, 0x17, 0x49, 0xb6, 0xf1, 0x45, 0xa9, 0xba, 0xbd, 0x41, 0x28, 0x9e, 0x86, 0xd2,
-	0xf0, 0x4e, 0x4f, 0x84, 0xab, 0xe5, 0x26, 0x6a, 0x55, 0x83, 0x0b, 0x70, 0x7f, 0x0f, 0xae, 0xfd,
-	0xcf, 0x08, 0x5f, 0xf9, 0x87, 0x65, 0xd2, 0xea, 0x2a, 0x00, 0x67, 0xcd, 0x5e, 0x3a, 0x85, 0xd1,
-	0x49, 0x1c, 0x79, 0x50, 0x30, 0x54, 0xb6, 0x86, 0xb6, 0xe6, 0x1a, 0x72, 0x00, 0x79, 0x47, 0x7b,
-	0xdf, 0x96, 0xf0, 0x19, 0x8b, 0x49, 0xde, 0x21, 0x5c, 0x71, 0xb3, 0x25, 0x37, 0xa6, 0xf2, 0xfc,
-	0xbf, 0x50, 0xf5, 0x9b, 0x8b, 0x89, 0x5d, 0x6d, 0x7f, 0xeb, 0xcd, 0xf7, 0xdf, 0x1f, 0xca, 0x6b,
-	0xa4, 0xc1, 0x66, 0xaf, 0x3a, 0xf9, 0x84, 0xf0, 0x0a, 0xb8, 0x27, 0xf3, 0x4b, 0xe4, 0xf6, 0xad,
-	0x7e, 0x6b, 0x41, 0x35, 0x10, 0x51, 0x4b, 0xd4, 0x22, 0x9b, 0x6c, 0xce, 0xdf, 0xca, 0xb0, 0x57,
-	0x32, 0x7c, 0x4d, 0x3e, 0x22, 0x5c, 0x1d, 0xcf, 0x94, 0x2c, 0x56, 0x6b, 0xd2, 0x2b, 0xba, 0xa8,
-	0x1c, 0xd8, 0xb6, 0x2d, 0xdb, 0x3a, 0x59, 0x9b, 0xcb, 0xd6, 0xbe, 0x73, 0x34, 0xf4, 0xd0, 0xf1,
-	0xd0, 0x43, 0xbf, 0x86, 0x1e, 0x7a, 0x3f, 0xf2, 0x4a, 0xc7, 0x23, 0xaf, 0xf4, 0x63, 0xe4, 0x95,
-	0x9e, 0x34, 0xf2, 0xfa, 0x97, 0x85, 0xe8, 0xf4, 0x30, 0x16, 0xa6, 0x53, 0xb1, 0xdf, 0x8b, 0xdb,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x32, 0x50, 0x0c, 0x04, 0x2f, 0x05, 0x00, 0x00,
+func (m *QueryPartnerSponsorshipsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPartnerSponsorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPartnerSponsorshipsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPartnerSponsorshipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartnerSponsorshipsRequest.Merge(m, src)
+}
+func (m *QueryPartnerSponsorshipsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPartnerSponsorshipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartnerSponsorshipsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPartnerSponsorshipsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Partner queries a single partner by id.
-	Partner(ctx context.Context, in *QueryPartnerRequest, opts ...grpc.CallOption) (*QueryPartnerResponse, error)
-	// Partners lists partners.
-	Partners(ctx context.Context, in *QueryPartnersRequest, opts ...grpc.CallOption) (*QueryPartnersResponse, error)
+func (m *QueryPartnerSponsorshipsRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPartnerSponsorshipsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// SponsorshipStatus is a sponsorship together with its remaining allowance.
+type SponsorshipStatus struct {
+	Sponsorship Sponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
+	// remaining is the unspent part of the spend limit. It is empty when the
+	// allowance has been used up or revoked.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SponsorshipStatus) Reset()         { *m = SponsorshipStatus{} }
+func (m *SponsorshipStatus) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipStatus) ProtoMessage()    {}
+func (*SponsorshipStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{7}
+}
+func (m *SponsorshipStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SponsorshipStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipStatus.Merge(m, src)
+}
+func (m *SponsorshipStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) Partner(ctx context.Context, in *QueryPartnerRequest, opts ...grpc.CallOption) (*QueryPartnerResponse, error) {
-	out := new(QueryPartnerResponse)
-	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Partner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SponsorshipStatus proto.InternalMessageInfo
+
+func (m *SponsorshipStatus) GetSponsorship() Sponsorship {
+	if m != nil {
+		return m.Sponsorship
 	}
-	return out, nil
+	return Sponsorship{}
 }
 
-func (c *queryClient) Partners(ctx context.Context, in *QueryPartnersRequest, opts ...grpc.CallOption) (*QueryPartnersResponse, error) {
-	out := new(QueryPartnersResponse)
-	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Partners", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SponsorshipStatus) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Partner queries a single partner by id.
-	Partner(context.Context, *QueryPartnerRequest) (*QueryPartnerResponse, error)
-	// Partners lists partners.
-	Partners(context.Context, *QueryPartnersRequest) (*QueryPartnersResponse, error)
+type QueryPartnerSponsorshipsResponse struct {
+	Sponsorships []SponsorshipStatus `protobuf:"bytes,1,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// total_remaining sums the remaining allowance over the returned page.
+	TotalRemaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_remaining,json=totalRemaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_remaining"`
+	Pagination     *query.PageResponse                      `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPartnerSponsorshipsResponse) Reset()         { *m = QueryPartnerSponsorshipsResponse{} }
+func (m *QueryPartnerSponsorshipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPartnerSponsorshipsResponse) ProtoMessage()    {}
+func (*QueryPartnerSponsorshipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPartnerSponsorshipsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Partner(ctx context.Context, req *QueryPartnerRequest) (*QueryPartnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Partner not implemented")
+func (m *QueryPartnerSponsorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPartnerSponsorshipsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Partners(ctx context.Context, req *QueryPartnersRequest) (*QueryPartnersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Partners not implemented")
+func (m *QueryPartnerSponsorshipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartnerSponsorshipsResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPartnerSponsorshipsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPartnerSponsorshipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartnerSponsorshipsResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rewardchain.rewardchain.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPartnerSponsorshipsResponse proto.InternalMessageInfo
+
+func (m *QueryPartnerSponsorshipsResponse) GetSponsorships() []SponsorshipStatus {
+	if m != nil {
+		return m.Sponsorships
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Partner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPartnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Partner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rewardchain.rewardchain.Query/Partner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Partner(ctx, req.(*QueryPartnerRequest))
+func (m *QueryPartnerSponsorshipsResponse) GetTotalRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRemaining
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Partners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPartnersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Partners(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rewardchain.rewardchain.Query/Partners",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Partners(ctx, req.(*QueryPartnersRequest))
+func (m *QueryPartnerSponsorshipsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rewardchain.rewardchain.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Partner",
-			Handler:    _Query_Partner_Handler,
-		},
-		{
-			MethodName: "Partners",
-			Handler:    _Query_Partners_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "rewardchain/rewardchain/query.proto",
+type QueryMemberBalanceRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMemberBalanceRequest) Reset()         { *m = QueryMemberBalanceRequest{} }
+func (m *QueryMemberBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberBalanceRequest) ProtoMessage()    {}
+func (*QueryMemberBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{9}
+}
+func (m *QueryMemberBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMemberBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberBalanceRequest.Merge(m, src)
+}
+func (m *QueryMemberBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMemberBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryMemberBalanceRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMemberBalanceRequest) GetMember() string {
+	if m != nil {
+		return m.Member
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMemberBalanceResponse struct {
+	Balance MemberBalance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// debt is the points the member owes the partner from earn reversals.
+	Debt string `protobuf:"bytes,2,opt,name=debt,proto3" json:"debt,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QueryMemberBalanceResponse) Reset()         { *m = QueryMemberBalanceResponse{} }
+func (m *QueryMemberBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberBalanceResponse) ProtoMessage()    {}
+func (*QueryMemberBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{10}
+}
+func (m *QueryMemberBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPartnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMemberBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberBalanceResponse.Merge(m, src)
 }
-
-func (m *QueryPartnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMemberBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPartnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryMemberBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryMemberBalanceResponse) GetBalance() MemberBalance {
+	if m != nil {
+		return m.Balance
 	}
-	return len(dAtA) - i, nil
+	return MemberBalance{}
 }
 
-func (m *QueryPartnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMemberBalanceResponse) GetDebt() string {
+	if m != nil {
+		return m.Debt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPartnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryReceiptKeyRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
 }
 
-func (m *QueryPartnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Partner.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryReceiptKeyRequest) Reset()         { *m = QueryReceiptKeyRequest{} }
+func (m *QueryReceiptKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptKeyRequest) ProtoMessage()    {}
+func (*QueryReceiptKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{11}
+}
+func (m *QueryReceiptKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryReceiptKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptKeyRequest.Merge(m, src)
+}
+func (m *QueryReceiptKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptKeyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPartnersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReceiptKeyRequest proto.InternalMessageInfo
+
+func (m *QueryReceiptKeyRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPartnersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryReceiptKeyResponse struct {
+	ReceiptKey ReceiptKey `protobuf:"bytes,1,opt,name=receipt_key,json=receiptKey,proto3" json:"receipt_key"`
 }
 
-func (m *QueryPartnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludeDisabled {
-		i--
-		if m.IncludeDisabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryReceiptKeyResponse) Reset()         { *m = QueryReceiptKeyResponse{} }
+func (m *QueryReceiptKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptKeyResponse) ProtoMessage()    {}
+func (*QueryReceiptKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{12}
+}
+func (m *QueryReceiptKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryReceiptKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptKeyResponse.Merge(m, src)
+}
+func (m *QueryReceiptKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPartnersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReceiptKeyResponse proto.InternalMessageInfo
+
+func (m *QueryReceiptKeyResponse) GetReceiptKey() ReceiptKey {
+	if m != nil {
+		return m.ReceiptKey
 	}
-	return dAtA[:n], nil
+	return ReceiptKey{}
 }
 
-func (m *QueryPartnersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClientRefRequest struct {
+	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ClientRef string `protobuf:"bytes,2,opt,name=client_ref,json=clientRef,proto3" json:"client_ref,omitempty"`
 }
 
-func (m *QueryPartnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Partners) > 0 {
-		for iNdEx := len(m.Partners) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Partners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryClientRefRequest) Reset()         { *m = QueryClientRefRequest{} }
+func (m *QueryClientRefRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientRefRequest) ProtoMessage()    {}
+func (*QueryClientRefRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{13}
+}
+func (m *QueryClientRefRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientRefRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientRefRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClientRefRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientRefRequest.Merge(m, src)
+}
+func (m *QueryClientRefRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientRefRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientRefRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryClientRefRequest proto.InternalMessageInfo
+
+func (m *QueryClientRefRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryClientRefRequest) GetClientRef() string {
+	if m != nil {
+		return m.ClientRef
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryClientRefResponse struct {
+	Record ClientRefRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 }
 
-func (m *QueryPartnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryClientRefResponse) Reset()         { *m = QueryClientRefResponse{} }
+func (m *QueryClientRefResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientRefResponse) ProtoMessage()    {}
+func (*QueryClientRefResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{14}
+}
+func (m *QueryClientRefResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientRefResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientRefResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryClientRefResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientRefResponse.Merge(m, src)
+}
+func (m *QueryClientRefResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientRefResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientRefResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPartnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryClientRefResponse proto.InternalMessageInfo
+
+func (m *QueryClientRefResponse) GetRecord() ClientRefRecord {
+	if m != nil {
+		return m.Record
 	}
-	var l int
-	_ = l
-	l = m.Partner.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ClientRefRecord{}
 }
 
-func (m *QueryPartnersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+type QuerySettlementRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QuerySettlementRequest) Reset()         { *m = QuerySettlementRequest{} }
+func (m *QuerySettlementRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementRequest) ProtoMessage()    {}
+func (*QuerySettlementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{15}
+}
+func (m *QuerySettlementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.IncludeDisabled {
-		n += 2
+}
+func (m *QuerySettlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementRequest.Merge(m, src)
+}
+func (m *QuerySettlementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlementRequest proto.InternalMessageInfo
+
+func (m *QuerySettlementRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *QueryPartnersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QuerySettlementResponse struct {
+	Settlement Settlement `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement"`
+}
+
+func (m *QuerySettlementResponse) Reset()         { *m = QuerySettlementResponse{} }
+func (m *QuerySettlementResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementResponse) ProtoMessage()    {}
+func (*QuerySettlementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{16}
+}
+func (m *QuerySettlementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Partners) > 0 {
-		for _, e := range m.Partners {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementResponse.Merge(m, src)
+}
+func (m *QuerySettlementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlementResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementResponse) GetSettlement() Settlement {
+	if m != nil {
+		return m.Settlement
+	}
+	return Settlement{}
+}
+
+type QueryPartnerSettlementsRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	// closed lists settled records instead of open ones.
+	Closed     bool               `protobuf:"varint,2,opt,name=closed,proto3" json:"closed,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPartnerSettlementsRequest) Reset()         { *m = QueryPartnerSettlementsRequest{} }
+func (m *QueryPartnerSettlementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPartnerSettlementsRequest) ProtoMessage()    {}
+func (*QueryPartnerSettlementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{17}
+}
+func (m *QueryPartnerSettlementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPartnerSettlementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPartnerSettlementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryPartnerSettlementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartnerSettlementsRequest.Merge(m, src)
+}
+func (m *QueryPartnerSettlementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPartnerSettlementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartnerSettlementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPartnerSettlementsRequest proto.InternalMessageInfo
+
+func (m *QueryPartnerSettlementsRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
 	}
-	return n
+	return 0
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPartnerSettlementsRequest) GetClosed() bool {
+	if m != nil {
+		return m.Closed
+	}
+	return false
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPartnerSettlementsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPartnerSettlementsResponse struct {
+	Settlements []Settlement        `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPartnerSettlementsResponse) Reset()         { *m = QueryPartnerSettlementsResponse{} }
+func (m *QueryPartnerSettlementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPartnerSettlementsResponse) ProtoMessage()    {}
+func (*QueryPartnerSettlementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{18}
+}
+func (m *QueryPartnerSettlementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPartnerSettlementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPartnerSettlementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPartnerSettlementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartnerSettlementsResponse.Merge(m, src)
+}
+func (m *QueryPartnerSettlementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPartnerSettlementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartnerSettlementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPartnerSettlementsResponse proto.InternalMessageInfo
+
+func (m *QueryPartnerSettlementsResponse) GetSettlements() []Settlement {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+func (m *QueryPartnerSettlementsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySettlementEscrowRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+}
+
+func (m *QuerySettlementEscrowRequest) Reset()         { *m = QuerySettlementEscrowRequest{} }
+func (m *QuerySettlementEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementEscrowRequest) ProtoMessage()    {}
+func (*QuerySettlementEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{19}
+}
+func (m *QuerySettlementEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlementEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementEscrowRequest.Merge(m, src)
+}
+func (m *QuerySettlementEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlementEscrowRequest proto.InternalMessageInfo
+
+func (m *QuerySettlementEscrowRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+type QuerySettlementEscrowResponse struct {
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *QuerySettlementEscrowResponse) Reset()         { *m = QuerySettlementEscrowResponse{} }
+func (m *QuerySettlementEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettlementEscrowResponse) ProtoMessage()    {}
+func (*QuerySettlementEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{20}
+}
+func (m *QuerySettlementEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettlementEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettlementEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettlementEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettlementEscrowResponse.Merge(m, src)
+}
+func (m *QuerySettlementEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettlementEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettlementEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettlementEscrowResponse proto.InternalMessageInfo
+
+func (m *QuerySettlementEscrowResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+type QueryExchangeRateRequest struct {
+	Base  string `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *QueryExchangeRateRequest) Reset()         { *m = QueryExchangeRateRequest{} }
+func (m *QueryExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateRequest) ProtoMessage()    {}
+func (*QueryExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{21}
+}
+func (m *QueryExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
+
+func (m *QueryExchangeRateRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *QueryExchangeRateRequest) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+type QueryExchangeRateResponse struct {
+	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// height is when the underlying rate was aggregated.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// stale is set when the rate is older than oracle_max_rate_age_blocks.
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
+func (m *QueryExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateResponse) ProtoMessage()    {}
+func (*QueryExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{22}
+}
+func (m *QueryExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRateResponse) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *QueryExchangeRateResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+type QueryExchangeRatesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExchangeRatesRequest) Reset()         { *m = QueryExchangeRatesRequest{} }
+func (m *QueryExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{23}
+}
+func (m *QueryExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRatesRequest proto.InternalMessageInfo
+
+func (m *QueryExchangeRatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryExchangeRatesResponse struct {
+	Rates      []ExchangeRate      `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesResponse{} }
+func (m *QueryExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{24}
+}
+func (m *QueryExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRatesResponse.Merge(m, src)
+}
+func (m *QueryExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRatesResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRatesResponse) GetRates() []ExchangeRate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *QueryExchangeRatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTransferPolicyRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+}
+
+func (m *QueryTransferPolicyRequest) Reset()         { *m = QueryTransferPolicyRequest{} }
+func (m *QueryTransferPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferPolicyRequest) ProtoMessage()    {}
+func (*QueryTransferPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{25}
+}
+func (m *QueryTransferPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferPolicyRequest.Merge(m, src)
+}
+func (m *QueryTransferPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryTransferPolicyRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+type QueryTransferPolicyResponse struct {
+	Policy TransferPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryTransferPolicyResponse) Reset()         { *m = QueryTransferPolicyResponse{} }
+func (m *QueryTransferPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferPolicyResponse) ProtoMessage()    {}
+func (*QueryTransferPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{26}
+}
+func (m *QueryTransferPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferPolicyResponse.Merge(m, src)
+}
+func (m *QueryTransferPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryTransferPolicyResponse) GetPolicy() TransferPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return TransferPolicy{}
+}
+
+type QueryMemberTransfersRequest struct {
+	Member     string             `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	PartnerId  uint64             `protobuf:"varint,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMemberTransfersRequest) Reset()         { *m = QueryMemberTransfersRequest{} }
+func (m *QueryMemberTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberTransfersRequest) ProtoMessage()    {}
+func (*QueryMemberTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{27}
+}
+func (m *QueryMemberTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemberTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberTransfersRequest.Merge(m, src)
+}
+func (m *QueryMemberTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemberTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryMemberTransfersRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *QueryMemberTransfersRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *QueryMemberTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMemberTransfersResponse struct {
+	Transfers  []PointsTransfer    `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMemberTransfersResponse) Reset()         { *m = QueryMemberTransfersResponse{} }
+func (m *QueryMemberTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberTransfersResponse) ProtoMessage()    {}
+func (*QueryMemberTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{28}
+}
+func (m *QueryMemberTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemberTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberTransfersResponse.Merge(m, src)
+}
+func (m *QueryMemberTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemberTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryMemberTransfersResponse) GetTransfers() []PointsTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *QueryMemberTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVelocityLimitsRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+}
+
+func (m *QueryVelocityLimitsRequest) Reset()         { *m = QueryVelocityLimitsRequest{} }
+func (m *QueryVelocityLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVelocityLimitsRequest) ProtoMessage()    {}
+func (*QueryVelocityLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{29}
+}
+func (m *QueryVelocityLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVelocityLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVelocityLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVelocityLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVelocityLimitsRequest.Merge(m, src)
+}
+func (m *QueryVelocityLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVelocityLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVelocityLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVelocityLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryVelocityLimitsRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+type QueryVelocityLimitsResponse struct {
+	Limits VelocityLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits"`
+}
+
+func (m *QueryVelocityLimitsResponse) Reset()         { *m = QueryVelocityLimitsResponse{} }
+func (m *QueryVelocityLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVelocityLimitsResponse) ProtoMessage()    {}
+func (*QueryVelocityLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{30}
+}
+func (m *QueryVelocityLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVelocityLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVelocityLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVelocityLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVelocityLimitsResponse.Merge(m, src)
+}
+func (m *QueryVelocityLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVelocityLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVelocityLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVelocityLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryVelocityLimitsResponse) GetLimits() VelocityLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return VelocityLimits{}
+}
+
+type QueryMemberVelocityRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (m *QueryMemberVelocityRequest) Reset()         { *m = QueryMemberVelocityRequest{} }
+func (m *QueryMemberVelocityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberVelocityRequest) ProtoMessage()    {}
+func (*QueryMemberVelocityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{31}
+}
+func (m *QueryMemberVelocityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberVelocityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberVelocityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemberVelocityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberVelocityRequest.Merge(m, src)
+}
+func (m *QueryMemberVelocityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberVelocityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberVelocityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemberVelocityRequest proto.InternalMessageInfo
+
+func (m *QueryMemberVelocityRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *QueryMemberVelocityRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+type QueryMemberVelocityResponse struct {
+	RedeemedToday    string `protobuf:"bytes,1,opt,name=redeemed_today,json=redeemedToday,proto3" json:"redeemed_today,omitempty"`
+	RedeemedInWindow string `protobuf:"bytes,2,opt,name=redeemed_in_window,json=redeemedInWindow,proto3" json:"redeemed_in_window,omitempty"`
+	// member_since is when the member first received points, in unix seconds.
+	// Zero when unknown.
+	MemberSince  int64  `protobuf:"varint,3,opt,name=member_since,json=memberSince,proto3" json:"member_since,omitempty"`
+	CooldownEnds int64  `protobuf:"varint,4,opt,name=cooldown_ends,json=cooldownEnds,proto3" json:"cooldown_ends,omitempty"`
+	Frozen       bool   `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	FreezeReason string `protobuf:"bytes,6,opt,name=freeze_reason,json=freezeReason,proto3" json:"freeze_reason,omitempty"`
+}
+
+func (m *QueryMemberVelocityResponse) Reset()         { *m = QueryMemberVelocityResponse{} }
+func (m *QueryMemberVelocityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMemberVelocityResponse) ProtoMessage()    {}
+func (*QueryMemberVelocityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{32}
+}
+func (m *QueryMemberVelocityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMemberVelocityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMemberVelocityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMemberVelocityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMemberVelocityResponse.Merge(m, src)
+}
+func (m *QueryMemberVelocityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMemberVelocityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMemberVelocityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMemberVelocityResponse proto.InternalMessageInfo
+
+func (m *QueryMemberVelocityResponse) GetRedeemedToday() string {
+	if m != nil {
+		return m.RedeemedToday
+	}
+	return ""
+}
+
+func (m *QueryMemberVelocityResponse) GetRedeemedInWindow() string {
+	if m != nil {
+		return m.RedeemedInWindow
+	}
+	return ""
+}
+
+func (m *QueryMemberVelocityResponse) GetMemberSince() int64 {
+	if m != nil {
+		return m.MemberSince
+	}
+	return 0
+}
+
+func (m *QueryMemberVelocityResponse) GetCooldownEnds() int64 {
+	if m != nil {
+		return m.CooldownEnds
+	}
+	return 0
+}
+
+func (m *QueryMemberVelocityResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *QueryMemberVelocityResponse) GetFreezeReason() string {
+	if m != nil {
+		return m.FreezeReason
+	}
+	return ""
+}
+
+type QueryAttestationsRequest struct {
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (m *QueryAttestationsRequest) Reset()         { *m = QueryAttestationsRequest{} }
+func (m *QueryAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsRequest) ProtoMessage()    {}
+func (*QueryAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{33}
+}
+func (m *QueryAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsRequest.Merge(m, src)
+}
+func (m *QueryAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsRequest) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+type QueryAttestationsResponse struct {
+	Attestations []Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	// level is the highest level among unexpired attestations from current
+	// attestors.
+	Level uint32 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *QueryAttestationsResponse) Reset()         { *m = QueryAttestationsResponse{} }
+func (m *QueryAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsResponse) ProtoMessage()    {}
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{34}
+}
+func (m *QueryAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsResponse.Merge(m, src)
+}
+func (m *QueryAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsResponse) GetAttestations() []Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *QueryAttestationsResponse) GetLevel() uint32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+type QueryKycRequirementRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+}
+
+func (m *QueryKycRequirementRequest) Reset()         { *m = QueryKycRequirementRequest{} }
+func (m *QueryKycRequirementRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKycRequirementRequest) ProtoMessage()    {}
+func (*QueryKycRequirementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{35}
+}
+func (m *QueryKycRequirementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKycRequirementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKycRequirementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryKycRequirementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKycRequirementRequest.Merge(m, src)
+}
+func (m *QueryKycRequirementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKycRequirementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKycRequirementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKycRequirementRequest proto.InternalMessageInfo
+
+func (m *QueryKycRequirementRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+type QueryKycRequirementResponse struct {
+	Requirement KycRequirement `protobuf:"bytes,1,opt,name=requirement,proto3" json:"requirement"`
+}
+
+func (m *QueryKycRequirementResponse) Reset()         { *m = QueryKycRequirementResponse{} }
+func (m *QueryKycRequirementResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKycRequirementResponse) ProtoMessage()    {}
+func (*QueryKycRequirementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{36}
+}
+func (m *QueryKycRequirementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKycRequirementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKycRequirementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryKycRequirementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKycRequirementResponse.Merge(m, src)
+}
+func (m *QueryKycRequirementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKycRequirementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKycRequirementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKycRequirementResponse proto.InternalMessageInfo
+
+func (m *QueryKycRequirementResponse) GetRequirement() KycRequirement {
+	if m != nil {
+		return m.Requirement
+	}
+	return KycRequirement{}
+}
+
+type QueryBlockedAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBlockedAddressRequest) Reset()         { *m = QueryBlockedAddressRequest{} }
+func (m *QueryBlockedAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{37}
+}
+func (m *QueryBlockedAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryBlockedAddressResponse struct {
+	Blocked bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// entry is empty unless blocked is set.
+	Entry BlockedAddress `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *QueryBlockedAddressResponse) Reset()         { *m = QueryBlockedAddressResponse{} }
+func (m *QueryBlockedAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{38}
+}
+func (m *QueryBlockedAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressResponse) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
+	}
+	return false
+}
+
+func (m *QueryBlockedAddressResponse) GetEntry() BlockedAddress {
+	if m != nil {
+		return m.Entry
+	}
+	return BlockedAddress{}
+}
+
+type QueryBlockedAddressesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesRequest) Reset()         { *m = QueryBlockedAddressesRequest{} }
+func (m *QueryBlockedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesRequest) ProtoMessage()    {}
+func (*QueryBlockedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{39}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesRequest.Merge(m, src)
+}
+func (m *QueryBlockedAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBlockedAddressesResponse struct {
+	Entries    []BlockedAddress    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAddressesResponse) Reset()         { *m = QueryBlockedAddressesResponse{} }
+func (m *QueryBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAddressesResponse) ProtoMessage()    {}
+func (*QueryBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{40}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAddressesResponse.Merge(m, src)
+}
+func (m *QueryBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAddressesResponse) GetEntries() []BlockedAddress {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryBlockedAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryLiquidityStatusRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+}
+
+func (m *QueryLiquidityStatusRequest) Reset()         { *m = QueryLiquidityStatusRequest{} }
+func (m *QueryLiquidityStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityStatusRequest) ProtoMessage()    {}
+func (*QueryLiquidityStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{41}
+}
+func (m *QueryLiquidityStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityStatusRequest.Merge(m, src)
+}
+func (m *QueryLiquidityStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityStatusRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityStatusRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+type QueryLiquidityStatusResponse struct {
+	Thresholds         LiquidityThresholds `protobuf:"bytes,1,opt,name=thresholds,proto3" json:"thresholds"`
+	Status             LiquidityStatus     `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	AvailableLiquidity string              `protobuf:"bytes,3,opt,name=available_liquidity,json=availableLiquidity,proto3" json:"available_liquidity,omitempty"`
+}
+
+func (m *QueryLiquidityStatusResponse) Reset()         { *m = QueryLiquidityStatusResponse{} }
+func (m *QueryLiquidityStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityStatusResponse) ProtoMessage()    {}
+func (*QueryLiquidityStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{42}
+}
+func (m *QueryLiquidityStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityStatusResponse.Merge(m, src)
+}
+func (m *QueryLiquidityStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityStatusResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityStatusResponse) GetThresholds() LiquidityThresholds {
+	if m != nil {
+		return m.Thresholds
+	}
+	return LiquidityThresholds{}
+}
+
+func (m *QueryLiquidityStatusResponse) GetStatus() LiquidityStatus {
+	if m != nil {
+		return m.Status
+	}
+	return LiquidityStatus{}
+}
+
+func (m *QueryLiquidityStatusResponse) GetAvailableLiquidity() string {
+	if m != nil {
+		return m.AvailableLiquidity
+	}
+	return ""
+}
+
+type QueryPartnerLiquidityHistoryRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	// from and to bound the checkpoint heights, inclusive. Zero to means up to
+	// the latest checkpoint.
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (m *QueryPartnerLiquidityHistoryRequest) Reset()         { *m = QueryPartnerLiquidityHistoryRequest{} }
+func (m *QueryPartnerLiquidityHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPartnerLiquidityHistoryRequest) ProtoMessage()    {}
+func (*QueryPartnerLiquidityHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{43}
+}
+func (m *QueryPartnerLiquidityHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPartnerLiquidityHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPartnerLiquidityHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPartnerLiquidityHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartnerLiquidityHistoryRequest.Merge(m, src)
+}
+func (m *QueryPartnerLiquidityHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPartnerLiquidityHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartnerLiquidityHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPartnerLiquidityHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPartnerLiquidityHistoryRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *QueryPartnerLiquidityHistoryRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *QueryPartnerLiquidityHistoryRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type QueryPartnerLiquidityHistoryResponse struct {
+	Checkpoints []LiquidityCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+}
+
+func (m *QueryPartnerLiquidityHistoryResponse) Reset()         { *m = QueryPartnerLiquidityHistoryResponse{} }
+func (m *QueryPartnerLiquidityHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPartnerLiquidityHistoryResponse) ProtoMessage()    {}
+func (*QueryPartnerLiquidityHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{44}
+}
+func (m *QueryPartnerLiquidityHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPartnerLiquidityHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPartnerLiquidityHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPartnerLiquidityHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPartnerLiquidityHistoryResponse.Merge(m, src)
+}
+func (m *QueryPartnerLiquidityHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPartnerLiquidityHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPartnerLiquidityHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPartnerLiquidityHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPartnerLiquidityHistoryResponse) GetCheckpoints() []LiquidityCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+type QueryPauseStatusRequest struct {
+}
+
+func (m *QueryPauseStatusRequest) Reset()         { *m = QueryPauseStatusRequest{} }
+func (m *QueryPauseStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusRequest) ProtoMessage()    {}
+func (*QueryPauseStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{45}
+}
+func (m *QueryPauseStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusRequest.Merge(m, src)
+}
+func (m *QueryPauseStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusRequest proto.InternalMessageInfo
+
+type QueryPauseStatusResponse struct {
+	Paused PauseMatrix `protobuf:"bytes,1,opt,name=paused,proto3" json:"paused"`
+	// paused_operations lists the paused operations.
+	PausedOperations []Operation `protobuf:"varint,2,rep,packed,name=paused_operations,json=pausedOperations,proto3,enum=rewardchain.rewardchain.Operation" json:"paused_operations,omitempty"`
+	EmergencyAdmins  []string    `protobuf:"bytes,3,rep,name=emergency_admins,json=emergencyAdmins,proto3" json:"emergency_admins,omitempty"`
+}
+
+func (m *QueryPauseStatusResponse) Reset()         { *m = QueryPauseStatusResponse{} }
+func (m *QueryPauseStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusResponse) ProtoMessage()    {}
+func (*QueryPauseStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{46}
+}
+func (m *QueryPauseStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusResponse.Merge(m, src)
+}
+func (m *QueryPauseStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStatusResponse) GetPaused() PauseMatrix {
+	if m != nil {
+		return m.Paused
+	}
+	return PauseMatrix{}
+}
+
+func (m *QueryPauseStatusResponse) GetPausedOperations() []Operation {
+	if m != nil {
+		return m.PausedOperations
+	}
+	return nil
+}
+
+func (m *QueryPauseStatusResponse) GetEmergencyAdmins() []string {
+	if m != nil {
+		return m.EmergencyAdmins
+	}
+	return nil
+}
+
+type QueryPointsEscrowRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PartnerId uint64 `protobuf:"varint,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+}
+
+func (m *QueryPointsEscrowRequest) Reset()         { *m = QueryPointsEscrowRequest{} }
+func (m *QueryPointsEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPointsEscrowRequest) ProtoMessage()    {}
+func (*QueryPointsEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{47}
+}
+func (m *QueryPointsEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPointsEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPointsEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPointsEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPointsEscrowRequest.Merge(m, src)
+}
+func (m *QueryPointsEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPointsEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPointsEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPointsEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryPointsEscrowRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPointsEscrowRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+type QueryPointsEscrowResponse struct {
+	Points string `protobuf:"bytes,1,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (m *QueryPointsEscrowResponse) Reset()         { *m = QueryPointsEscrowResponse{} }
+func (m *QueryPointsEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPointsEscrowResponse) ProtoMessage()    {}
+func (*QueryPointsEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{48}
+}
+func (m *QueryPointsEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPointsEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPointsEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPointsEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPointsEscrowResponse.Merge(m, src)
+}
+func (m *QueryPointsEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPointsEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPointsEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPointsEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryPointsEscrowResponse) GetPoints() string {
+	if m != nil {
+		return m.Points
+	}
+	return ""
+}
+
+type QueryPointsVouchersRequest struct {
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+}
+
+func (m *QueryPointsVouchersRequest) Reset()         { *m = QueryPointsVouchersRequest{} }
+func (m *QueryPointsVouchersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPointsVouchersRequest) ProtoMessage()    {}
+func (*QueryPointsVouchersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{49}
+}
+func (m *QueryPointsVouchersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPointsVouchersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPointsVouchersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPointsVouchersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPointsVouchersRequest.Merge(m, src)
+}
+func (m *QueryPointsVouchersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPointsVouchersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPointsVouchersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPointsVouchersRequest proto.InternalMessageInfo
+
+func (m *QueryPointsVouchersRequest) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+type QueryPointsVouchersResponse struct {
+	Vouchers []PointsVoucher `protobuf:"bytes,1,rep,name=vouchers,proto3" json:"vouchers"`
+}
+
+func (m *QueryPointsVouchersResponse) Reset()         { *m = QueryPointsVouchersResponse{} }
+func (m *QueryPointsVouchersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPointsVouchersResponse) ProtoMessage()    {}
+func (*QueryPointsVouchersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{50}
+}
+func (m *QueryPointsVouchersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPointsVouchersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPointsVouchersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPointsVouchersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPointsVouchersResponse.Merge(m, src)
+}
+func (m *QueryPointsVouchersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPointsVouchersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPointsVouchersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPointsVouchersResponse proto.InternalMessageInfo
+
+func (m *QueryPointsVouchersResponse) GetVouchers() []PointsVoucher {
+	if m != nil {
+		return m.Vouchers
+	}
+	return nil
+}
+
+type QueryRemoteTreasuryRequest struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+}
+
+func (m *QueryRemoteTreasuryRequest) Reset()         { *m = QueryRemoteTreasuryRequest{} }
+func (m *QueryRemoteTreasuryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemoteTreasuryRequest) ProtoMessage()    {}
+func (*QueryRemoteTreasuryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{51}
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemoteTreasuryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemoteTreasuryRequest.Merge(m, src)
+}
+func (m *QueryRemoteTreasuryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemoteTreasuryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemoteTreasuryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemoteTreasuryRequest proto.InternalMessageInfo
+
+func (m *QueryRemoteTreasuryRequest) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+type QueryRemoteTreasuryResponse struct {
+	Treasury RemoteTreasury `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury"`
+	// account_address and channel_id are empty until the interchain account
+	// channel is open.
+	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	ChannelId      string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryRemoteTreasuryResponse) Reset()         { *m = QueryRemoteTreasuryResponse{} }
+func (m *QueryRemoteTreasuryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemoteTreasuryResponse) ProtoMessage()    {}
+func (*QueryRemoteTreasuryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_424a07031d53c8ff, []int{52}
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemoteTreasuryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemoteTreasuryResponse.Merge(m, src)
+}
+func (m *QueryRemoteTreasuryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemoteTreasuryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemoteTreasuryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemoteTreasuryResponse proto.InternalMessageInfo
+
+func (m *QueryRemoteTreasuryResponse) GetTreasury() RemoteTreasury {
+	if m != nil {
+		return m.Treasury
+	}
+	return RemoteTreasury{}
+}
+
+func (m *QueryRemoteTreasuryResponse) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *QueryRemoteTreasuryResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "rewardchain.rewardchain.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "rewardchain.rewardchain.QueryParamsResponse")
+	proto.RegisterType((*QueryPartnerRequest)(nil), "rewardchain.rewardchain.QueryPartnerRequest")
+	proto.RegisterType((*QueryPartnerResponse)(nil), "rewardchain.rewardchain.QueryPartnerResponse")
+	proto.RegisterType((*QueryPartnersRequest)(nil), "rewardchain.rewardchain.QueryPartnersRequest")
+	proto.RegisterType((*QueryPartnersResponse)(nil), "rewardchain.rewardchain.QueryPartnersResponse")
+	proto.RegisterType((*QueryPartnerSponsorshipsRequest)(nil), "rewardchain.rewardchain.QueryPartnerSponsorshipsRequest")
+	proto.RegisterType((*SponsorshipStatus)(nil), "rewardchain.rewardchain.SponsorshipStatus")
+	proto.RegisterType((*QueryPartnerSponsorshipsResponse)(nil), "rewardchain.rewardchain.QueryPartnerSponsorshipsResponse")
+	proto.RegisterType((*QueryMemberBalanceRequest)(nil), "rewardchain.rewardchain.QueryMemberBalanceRequest")
+	proto.RegisterType((*QueryMemberBalanceResponse)(nil), "rewardchain.rewardchain.QueryMemberBalanceResponse")
+	proto.RegisterType((*QueryReceiptKeyRequest)(nil), "rewardchain.rewardchain.QueryReceiptKeyRequest")
+	proto.RegisterType((*QueryReceiptKeyResponse)(nil), "rewardchain.rewardchain.QueryReceiptKeyResponse")
+	proto.RegisterType((*QueryClientRefRequest)(nil), "rewardchain.rewardchain.QueryClientRefRequest")
+	proto.RegisterType((*QueryClientRefResponse)(nil), "rewardchain.rewardchain.QueryClientRefResponse")
+	proto.RegisterType((*QuerySettlementRequest)(nil), "rewardchain.rewardchain.QuerySettlementRequest")
+	proto.RegisterType((*QuerySettlementResponse)(nil), "rewardchain.rewardchain.QuerySettlementResponse")
+	proto.RegisterType((*QueryPartnerSettlementsRequest)(nil), "rewardchain.rewardchain.QueryPartnerSettlementsRequest")
+	proto.RegisterType((*QueryPartnerSettlementsResponse)(nil), "rewardchain.rewardchain.QueryPartnerSettlementsResponse")
+	proto.RegisterType((*QuerySettlementEscrowRequest)(nil), "rewardchain.rewardchain.QuerySettlementEscrowRequest")
+	proto.RegisterType((*QuerySettlementEscrowResponse)(nil), "rewardchain.rewardchain.QuerySettlementEscrowResponse")
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "rewardchain.rewardchain.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "rewardchain.rewardchain.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "rewardchain.rewardchain.QueryExchangeRatesRequest")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "rewardchain.rewardchain.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryTransferPolicyRequest)(nil), "rewardchain.rewardchain.QueryTransferPolicyRequest")
+	proto.RegisterType((*QueryTransferPolicyResponse)(nil), "rewardchain.rewardchain.QueryTransferPolicyResponse")
+	proto.RegisterType((*QueryMemberTransfersRequest)(nil), "rewardchain.rewardchain.QueryMemberTransfersRequest")
+	proto.RegisterType((*QueryMemberTransfersResponse)(nil), "rewardchain.rewardchain.QueryMemberTransfersResponse")
+	proto.RegisterType((*QueryVelocityLimitsRequest)(nil), "rewardchain.rewardchain.QueryVelocityLimitsRequest")
+	proto.RegisterType((*QueryVelocityLimitsResponse)(nil), "rewardchain.rewardchain.QueryVelocityLimitsResponse")
+	proto.RegisterType((*QueryMemberVelocityRequest)(nil), "rewardchain.rewardchain.QueryMemberVelocityRequest")
+	proto.RegisterType((*QueryMemberVelocityResponse)(nil), "rewardchain.rewardchain.QueryMemberVelocityResponse")
+	proto.RegisterType((*QueryAttestationsRequest)(nil), "rewardchain.rewardchain.QueryAttestationsRequest")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "rewardchain.rewardchain.QueryAttestationsResponse")
+	proto.RegisterType((*QueryKycRequirementRequest)(nil), "rewardchain.rewardchain.QueryKycRequirementRequest")
+	proto.RegisterType((*QueryKycRequirementResponse)(nil), "rewardchain.rewardchain.QueryKycRequirementResponse")
+	proto.RegisterType((*QueryBlockedAddressRequest)(nil), "rewardchain.rewardchain.QueryBlockedAddressRequest")
+	proto.RegisterType((*QueryBlockedAddressResponse)(nil), "rewardchain.rewardchain.QueryBlockedAddressResponse")
+	proto.RegisterType((*QueryBlockedAddressesRequest)(nil), "rewardchain.rewardchain.QueryBlockedAddressesRequest")
+	proto.RegisterType((*QueryBlockedAddressesResponse)(nil), "rewardchain.rewardchain.QueryBlockedAddressesResponse")
+	proto.RegisterType((*QueryLiquidityStatusRequest)(nil), "rewardchain.rewardchain.QueryLiquidityStatusRequest")
+	proto.RegisterType((*QueryLiquidityStatusResponse)(nil), "rewardchain.rewardchain.QueryLiquidityStatusResponse")
+	proto.RegisterType((*QueryPartnerLiquidityHistoryRequest)(nil), "rewardchain.rewardchain.QueryPartnerLiquidityHistoryRequest")
+	proto.RegisterType((*QueryPartnerLiquidityHistoryResponse)(nil), "rewardchain.rewardchain.QueryPartnerLiquidityHistoryResponse")
+	proto.RegisterType((*QueryPauseStatusRequest)(nil), "rewardchain.rewardchain.QueryPauseStatusRequest")
+	proto.RegisterType((*QueryPauseStatusResponse)(nil), "rewardchain.rewardchain.QueryPauseStatusResponse")
+	proto.RegisterType((*QueryPointsEscrowRequest)(nil), "rewardchain.rewardchain.QueryPointsEscrowRequest")
+	proto.RegisterType((*QueryPointsEscrowResponse)(nil), "rewardchain.rewardchain.QueryPointsEscrowResponse")
+	proto.RegisterType((*QueryPointsVouchersRequest)(nil), "rewardchain.rewardchain.QueryPointsVouchersRequest")
+	proto.RegisterType((*QueryPointsVouchersResponse)(nil), "rewardchain.rewardchain.QueryPointsVouchersResponse")
+	proto.RegisterType((*QueryRemoteTreasuryRequest)(nil), "rewardchain.rewardchain.QueryRemoteTreasuryRequest")
+	proto.RegisterType((*QueryRemoteTreasuryResponse)(nil), "rewardchain.rewardchain.QueryRemoteTreasuryResponse")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/query.proto", fileDescriptor_424a07031d53c8ff)
+}
+
+var fileDescriptor_424a07031d53c8ff = []byte{
+	// 2760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x6f, 0xdc, 0xd6,
+	0xf5, 0x36, 0x25, 0x59, 0x8f, 0x23, 0x59, 0x72, 0x6e, 0xfc, 0xb3, 0x47, 0x74, 0x22, 0xd9, 0xf4,
+	0x43, 0xb2, 0x63, 0x0f, 0x6d, 0x59, 0x76, 0x9c, 0xd8, 0xce, 0xcf, 0x96, 0xe3, 0x57, 0x6c, 0xc7,
+	0x2a, 0x65, 0xa4, 0x0f, 0xa0, 0x98, 0x52, 0xe4, 0xd5, 0x0c, 0xeb, 0x19, 0x72, 0x4c, 0x72, 0x6c,
+	0x2b, 0x8a, 0x16, 0xed, 0xaa, 0x45, 0xb3, 0x28, 0xd0, 0xa2, 0xab, 0x02, 0x45, 0x8b, 0x2e, 0xd2,
+	0x02, 0x05, 0x1c, 0xb4, 0x85, 0x17, 0x5d, 0x76, 0xd1, 0xb4, 0x69, 0xd1, 0x00, 0xdd, 0x14, 0x5d,
+	0xb4, 0x81, 0x5d, 0xa0, 0x8b, 0xfe, 0x01, 0xdd, 0x16, 0xbc, 0xf7, 0x5c, 0x3e, 0x66, 0x48, 0x91,
+	0x94, 0xd4, 0x8d, 0x4d, 0x5e, 0x9e, 0xef, 0xdc, 0xef, 0x9c, 0x7b, 0x78, 0xee, 0xe5, 0xa7, 0x81,
+	0x43, 0x2e, 0x7d, 0xac, 0xbb, 0xa6, 0xd1, 0xd0, 0x2d, 0x5b, 0x8d, 0x5f, 0x3f, 0xec, 0x50, 0x77,
+	0xb5, 0xda, 0x76, 0x1d, 0xdf, 0x21, 0xfb, 0x62, 0x0f, 0xaa, 0xb1, 0x6b, 0xf9, 0x25, 0xbd, 0x65,
+	0xd9, 0x8e, 0xca, 0xfe, 0xe5, 0xb6, 0xf2, 0x9e, 0xba, 0x53, 0x77, 0xd8, 0xa5, 0x1a, 0x5c, 0xe1,
+	0xe8, 0x2b, 0x75, 0xc7, 0xa9, 0x37, 0xa9, 0xaa, 0xb7, 0x2d, 0x55, 0xb7, 0x6d, 0xc7, 0xd7, 0x7d,
+	0xcb, 0xb1, 0x3d, 0x7c, 0x7a, 0xdc, 0x70, 0xbc, 0x96, 0xe3, 0xa9, 0xcb, 0xba, 0x47, 0xf9, 0xc4,
+	0xea, 0xa3, 0xd3, 0xcb, 0xd4, 0xd7, 0x4f, 0xab, 0x6d, 0xbd, 0x6e, 0xd9, 0xcc, 0x18, 0x6d, 0xa7,
+	0xe2, 0xb6, 0xc2, 0xca, 0x70, 0x2c, 0xf1, 0xfc, 0x58, 0x56, 0x40, 0xba, 0xef, 0x53, 0xcf, 0x8f,
+	0xbb, 0x9a, 0xc9, 0x32, 0x5d, 0x6e, 0x3a, 0xc6, 0x83, 0xa6, 0xe5, 0xf9, 0x68, 0x38, 0x9b, 0x65,
+	0x68, 0x34, 0x2d, 0x6a, 0xfb, 0x35, 0x97, 0xae, 0xe4, 0xb9, 0x6c, 0x5a, 0x0f, 0x3b, 0x96, 0x69,
+	0xf9, 0x98, 0x52, 0xf9, 0x70, 0x96, 0x61, 0x5b, 0x77, 0xf5, 0x96, 0x97, 0x67, 0xd5, 0xa2, 0xad,
+	0x65, 0xea, 0xe6, 0x59, 0x39, 0xae, 0x6e, 0x34, 0x29, 0x5a, 0x1d, 0xd9, 0x60, 0x46, 0xdf, 0xa6,
+	0x6e, 0x9e, 0x99, 0x4b, 0x0d, 0x6a, 0xb5, 0x45, 0x4a, 0x4e, 0x66, 0x9b, 0xb5, 0x1c, 0x9f, 0xd6,
+	0x7c, 0x97, 0xea, 0x5e, 0x47, 0x54, 0x90, 0x7c, 0x3c, 0xdb, 0x3c, 0xb8, 0x6e, 0x3b, 0x96, 0xed,
+	0x7b, 0x79, 0xd9, 0xf6, 0xa8, 0xef, 0x37, 0x69, 0x8b, 0xda, 0x7e, 0xde, 0x5a, 0x7b, 0x6d, 0xc7,
+	0xf6, 0x1c, 0xd7, 0x6b, 0x58, 0x6d, 0x34, 0x3d, 0x9a, 0x65, 0xea, 0xbb, 0xba, 0xed, 0xad, 0x50,
+	0x37, 0xcf, 0xee, 0x11, 0x6d, 0x3a, 0x46, 0xb8, 0x7e, 0xca, 0x1e, 0x20, 0x5f, 0x08, 0x0a, 0x75,
+	0x91, 0x2d, 0x97, 0x46, 0x1f, 0x76, 0xa8, 0xe7, 0x2b, 0x5f, 0x86, 0x97, 0x13, 0xa3, 0x8c, 0x07,
+	0x25, 0x0b, 0x30, 0xc8, 0x97, 0xb5, 0x22, 0x1d, 0x90, 0x66, 0x47, 0xe7, 0xa6, 0xab, 0x19, 0x2f,
+	0x54, 0x95, 0x03, 0x17, 0x46, 0x3e, 0xf9, 0xfb, 0xf4, 0x8e, 0x8f, 0xfe, 0xf5, 0xf4, 0xb8, 0xa4,
+	0x21, 0x52, 0x39, 0x12, 0xb9, 0x0e, 0x56, 0x0b, 0x67, 0x24, 0xe3, 0xd0, 0x67, 0x99, 0xcc, 0xed,
+	0x80, 0xd6, 0x67, 0x99, 0xca, 0x2a, 0xec, 0x49, 0x9a, 0x21, 0x85, 0xcb, 0x30, 0x84, 0xeb, 0x8c,
+	0x1c, 0x0e, 0x6c, 0xc4, 0x21, 0xb0, 0x5b, 0x18, 0x08, 0x48, 0x68, 0x02, 0x46, 0xa6, 0x61, 0x94,
+	0xea, 0xae, 0x5d, 0x6b, 0xeb, 0x1d, 0x8f, 0x9a, 0x95, 0xbe, 0x03, 0xd2, 0xec, 0xb0, 0x06, 0xc1,
+	0xd0, 0x22, 0x1b, 0x51, 0xbe, 0x2d, 0x25, 0xe7, 0x16, 0x59, 0x21, 0xd7, 0x01, 0xa2, 0xd7, 0x18,
+	0xa7, 0x3f, 0x5a, 0xe5, 0xef, 0x71, 0x35, 0x78, 0x8f, 0xab, 0xbc, 0xd9, 0xe0, 0xdb, 0x5c, 0x5d,
+	0xd4, 0xeb, 0x14, 0xb1, 0x5a, 0x0c, 0x49, 0x8e, 0xc1, 0x6e, 0xcb, 0x36, 0x9a, 0x1d, 0x93, 0xd6,
+	0x4c, 0xcb, 0xd3, 0x97, 0x9b, 0x21, 0x8d, 0x09, 0x1c, 0x7f, 0x1b, 0x87, 0x95, 0x9f, 0x4a, 0xf0,
+	0x7f, 0x5d, 0x5c, 0xc2, 0xb5, 0x18, 0xc6, 0x88, 0x82, 0xd5, 0xe8, 0x2f, 0x91, 0x89, 0x10, 0x47,
+	0x6e, 0x24, 0x02, 0xea, 0x63, 0x01, 0xcd, 0xe4, 0x06, 0xc4, 0x09, 0xc4, 0x23, 0x52, 0xbe, 0x25,
+	0xc1, 0x74, 0x9c, 0xe6, 0x52, 0x54, 0xb7, 0x61, 0xf6, 0x5e, 0x0d, 0x26, 0x63, 0x4f, 0x6b, 0xe1,
+	0x4a, 0x8f, 0xe0, 0xc8, 0x2d, 0x93, 0x5c, 0x4f, 0xe1, 0xb2, 0x89, 0xe4, 0x2a, 0x7f, 0x94, 0xe0,
+	0xa5, 0xd8, 0xf4, 0x4b, 0xbe, 0xee, 0x77, 0x3c, 0x72, 0x07, 0x46, 0x63, 0xef, 0x12, 0xae, 0xdd,
+	0xe1, 0xcc, 0x84, 0xc5, 0x1c, 0x60, 0xd2, 0xe2, 0x70, 0x62, 0xc1, 0x88, 0x4b, 0x5b, 0xba, 0x65,
+	0x5b, 0x76, 0xbd, 0xd2, 0xc7, 0x92, 0x3f, 0x99, 0xa0, 0x2a, 0x48, 0x5e, 0x75, 0x2c, 0x7b, 0xe1,
+	0x54, 0xe0, 0xe0, 0xe7, 0xff, 0x98, 0x9e, 0xad, 0x5b, 0x7e, 0xa3, 0xb3, 0x5c, 0x35, 0x9c, 0x96,
+	0x8a, 0xcd, 0x9f, 0xff, 0x77, 0xd2, 0x33, 0x1f, 0xa8, 0xfe, 0x6a, 0x9b, 0x7a, 0x0c, 0xe0, 0x69,
+	0x91, 0x77, 0xe5, 0x59, 0x1f, 0x1c, 0xc8, 0xce, 0x2c, 0xd6, 0xc2, 0x7d, 0x18, 0x8b, 0xd1, 0x13,
+	0xf5, 0x70, 0xbc, 0x48, 0x78, 0x3c, 0x3f, 0x18, 0x64, 0xc2, 0x0b, 0xf1, 0x61, 0xc2, 0x77, 0x7c,
+	0xbd, 0x59, 0xfb, 0x9f, 0xc6, 0x3a, 0xce, 0xe6, 0xd0, 0xc4, 0x14, 0x5d, 0x35, 0xd9, 0xbf, 0xf9,
+	0x9a, 0xd4, 0x60, 0x92, 0x25, 0xee, 0x2e, 0xdb, 0x62, 0x16, 0xf4, 0xa6, 0x6e, 0x1b, 0xb4, 0x60,
+	0x31, 0xee, 0x85, 0x41, 0xbe, 0x33, 0xb1, 0x42, 0x1c, 0xd1, 0xf0, 0x4e, 0x79, 0x02, 0x72, 0x9a,
+	0x4f, 0x5c, 0x86, 0xeb, 0x30, 0xb4, 0xcc, 0x87, 0xc2, 0xe6, 0x90, 0xb5, 0x02, 0x09, 0x07, 0xa2,
+	0x43, 0x21, 0x98, 0x10, 0x18, 0x30, 0xe9, 0xb2, 0x8f, 0x73, 0xb3, 0x6b, 0xe5, 0x75, 0xd8, 0xcb,
+	0x66, 0xd6, 0xf8, 0xee, 0x75, 0x9b, 0xae, 0x16, 0x0b, 0x45, 0xa1, 0xb0, 0xaf, 0x07, 0x88, 0x7c,
+	0xdf, 0x81, 0x51, 0xdc, 0x0c, 0x6b, 0x0f, 0xe8, 0x2a, 0x72, 0x3e, 0x94, 0xc9, 0x39, 0xf2, 0x80,
+	0x84, 0xc1, 0x0d, 0x47, 0x94, 0x77, 0xb1, 0x4f, 0x5d, 0x65, 0x27, 0x09, 0x8d, 0xae, 0x08, 0x7a,
+	0x7b, 0x61, 0xd0, 0xb3, 0xea, 0xa2, 0x5f, 0x8f, 0x68, 0x78, 0x17, 0xd0, 0x8e, 0x4e, 0x1d, 0x18,
+	0xea, 0x88, 0x21, 0xd0, 0xca, 0xd7, 0x60, 0x6f, 0xb7, 0xbf, 0x30, 0xcb, 0x83, 0x2e, 0x35, 0x1c,
+	0xd7, 0x44, 0xc2, 0xb3, 0x99, 0x84, 0x63, 0xd8, 0xc0, 0x1e, 0x59, 0x23, 0x5a, 0x99, 0xc5, 0x19,
+	0x96, 0xc2, 0xdd, 0x38, 0x6b, 0x2f, 0x32, 0x61, 0x5f, 0x8f, 0x25, 0x92, 0xb9, 0x05, 0x10, 0xed,
+	0xe6, 0xb9, 0x19, 0x8c, 0x1c, 0x88, 0x0c, 0x46, 0x60, 0xe5, 0x47, 0x12, 0x4c, 0x25, 0xde, 0xf4,
+	0xf0, 0x91, 0x57, 0xbc, 0x6a, 0x8d, 0xa6, 0x13, 0x6d, 0x6a, 0x78, 0x47, 0xae, 0xa7, 0xbc, 0x52,
+	0x9b, 0x69, 0xad, 0xcf, 0xba, 0xbb, 0x7c, 0x9c, 0x21, 0x26, 0xe4, 0x36, 0x8c, 0x46, 0x31, 0x89,
+	0x4e, 0x54, 0x22, 0x23, 0x71, 0xf4, 0xf6, 0xed, 0x4f, 0x97, 0xe0, 0x95, 0xae, 0x15, 0xbc, 0xe6,
+	0x19, 0xae, 0xf3, 0xb8, 0xe0, 0x3b, 0xf4, 0x1d, 0x09, 0x5e, 0xcd, 0xc0, 0x63, 0xd8, 0x5f, 0x8f,
+	0xbf, 0xfa, 0x39, 0x3d, 0xf2, 0x6c, 0xd9, 0x1e, 0xc9, 0x0f, 0x50, 0x62, 0x02, 0xe5, 0x6d, 0xa8,
+	0x30, 0x32, 0xd7, 0x9e, 0x18, 0x0d, 0xdd, 0xae, 0x53, 0x4d, 0xf7, 0xc3, 0xbe, 0x46, 0x60, 0x20,
+	0x98, 0x10, 0xdf, 0x35, 0x76, 0x4d, 0xf6, 0xc0, 0xce, 0x87, 0x1d, 0xc7, 0xa7, 0xf8, 0x92, 0xf1,
+	0x1b, 0xe5, 0xab, 0x30, 0x99, 0xe2, 0x05, 0xc3, 0x21, 0x30, 0xe0, 0xea, 0x7e, 0xe8, 0x26, 0xb8,
+	0x0e, 0xaa, 0xab, 0x41, 0xad, 0x7a, 0x83, 0xf7, 0xa5, 0x7e, 0x0d, 0xef, 0x02, 0xf7, 0x9e, 0xaf,
+	0x37, 0x29, 0x2b, 0xac, 0x61, 0x8d, 0xdf, 0x28, 0x46, 0x8a, 0xfb, 0xed, 0x3e, 0x48, 0x29, 0x1f,
+	0x49, 0x20, 0xa7, 0xcd, 0x82, 0x51, 0x5c, 0x81, 0x9d, 0x01, 0x73, 0x51, 0x85, 0x47, 0x32, 0xab,
+	0x30, 0x0e, 0xc7, 0x3a, 0xe4, 0xc8, 0xed, 0xab, 0xc0, 0x0b, 0xc8, 0xf4, 0x3e, 0x1e, 0xd3, 0x17,
+	0x9d, 0xa6, 0x65, 0x14, 0xed, 0xe1, 0x26, 0xec, 0x4f, 0x05, 0x63, 0x9c, 0xd7, 0x60, 0xb0, 0xcd,
+	0x46, 0x30, 0x95, 0x33, 0x99, 0x81, 0x26, 0x1d, 0x88, 0x86, 0xc8, 0xc1, 0xca, 0x0f, 0x25, 0x9c,
+	0x86, 0x6f, 0x4e, 0xc2, 0xd6, 0x8b, 0x75, 0x72, 0xdc, 0x14, 0xa5, 0xf8, 0xa6, 0xd8, 0x45, 0xbe,
+	0x6f, 0xe3, 0x83, 0xdd, 0xe6, 0xbb, 0xcf, 0xaf, 0x24, 0x78, 0x25, 0x9d, 0x5e, 0xd8, 0x7a, 0x46,
+	0xc4, 0x47, 0x90, 0x58, 0xf2, 0xec, 0x4c, 0x2c, 0xb2, 0x2f, 0x35, 0xe1, 0x04, 0x33, 0x11, 0xe1,
+	0xb7, 0x7f, 0xe1, 0xdf, 0xc3, 0xef, 0xae, 0x3b, 0x56, 0xcb, 0xf2, 0xbd, 0x92, 0x0b, 0xdf, 0x0d,
+	0x8e, 0x16, 0xbe, 0xc9, 0x46, 0x72, 0x17, 0x3e, 0xe9, 0x40, 0x2c, 0x3c, 0x07, 0x2b, 0x4b, 0x89,
+	0x53, 0x8d, 0x30, 0xdd, 0xe2, 0x51, 0xe9, 0x3f, 0xc9, 0x6a, 0x8a, 0xbc, 0x22, 0xf7, 0x23, 0x30,
+	0xee, 0x52, 0x93, 0xd2, 0x16, 0x35, 0x6b, 0xbe, 0x63, 0xea, 0xab, 0x58, 0x55, 0xbb, 0xc4, 0xe8,
+	0xfd, 0x60, 0x90, 0x9c, 0x00, 0x12, 0x9a, 0x59, 0x76, 0xed, 0xb1, 0x65, 0x9b, 0xce, 0x63, 0x9c,
+	0x6a, 0xb7, 0x78, 0x72, 0xcb, 0xfe, 0x22, 0x1b, 0x27, 0x07, 0x61, 0x8c, 0x4f, 0x5f, 0xf3, 0xac,
+	0xa0, 0x17, 0xf7, 0xb3, 0x4e, 0x35, 0xca, 0xc7, 0x96, 0x82, 0x21, 0x72, 0x08, 0x76, 0x19, 0x8e,
+	0xd3, 0x34, 0x9d, 0xc7, 0x76, 0x8d, 0xda, 0xa6, 0x57, 0x19, 0x60, 0x36, 0x63, 0x62, 0xf0, 0x9a,
+	0x6d, 0x7a, 0x41, 0x50, 0x2b, 0xae, 0xf3, 0x3e, 0xb5, 0x2b, 0x3b, 0xf9, 0x4e, 0xca, 0xef, 0x02,
+	0xf0, 0x8a, 0x4b, 0xe9, 0xfb, 0xb4, 0xe6, 0x52, 0xdd, 0x73, 0xec, 0xca, 0x20, 0x23, 0x32, 0xc6,
+	0x07, 0x35, 0x36, 0xa6, 0xcc, 0x61, 0x7f, 0xbe, 0x12, 0x09, 0x35, 0x79, 0xef, 0x90, 0xf2, 0x0d,
+	0x09, 0x26, 0x53, 0x40, 0x98, 0xab, 0x77, 0x61, 0x2c, 0xa6, 0xfa, 0x88, 0xe2, 0xce, 0xfe, 0x7c,
+	0x89, 0x39, 0x11, 0x27, 0xfb, 0x38, 0x3e, 0x68, 0xd9, 0x4d, 0xfa, 0x88, 0x36, 0x59, 0x1e, 0x77,
+	0x69, 0xfc, 0x26, 0xac, 0xd4, 0xdb, 0xab, 0x46, 0x40, 0xd7, 0x72, 0x13, 0x87, 0xa2, 0x9c, 0x4a,
+	0xb5, 0x61, 0x7f, 0x2a, 0x18, 0x23, 0xb8, 0x17, 0x1c, 0x35, 0xc3, 0xe1, 0xdc, 0x72, 0x4d, 0x7a,
+	0x11, 0x47, 0x83, 0x98, 0x07, 0xe5, 0x1c, 0x92, 0x5d, 0x08, 0x24, 0x2e, 0x6a, 0x5e, 0x31, 0x4d,
+	0x97, 0x7a, 0x61, 0x9a, 0x2b, 0x30, 0xa4, 0xf3, 0x11, 0xcc, 0xb3, 0xb8, 0x55, 0x3e, 0x80, 0xfd,
+	0xa9, 0x38, 0xe4, 0x59, 0x81, 0xa1, 0x65, 0xfe, 0x84, 0x01, 0x87, 0x35, 0x71, 0x4b, 0xae, 0xc2,
+	0x4e, 0x6a, 0xfb, 0xee, 0x6a, 0xd8, 0x0b, 0xb2, 0xb8, 0x27, 0x3d, 0x8b, 0xed, 0x84, 0x61, 0x95,
+	0x15, 0x6c, 0x61, 0x49, 0x9b, 0xed, 0xdf, 0x18, 0x3f, 0x16, 0x07, 0x96, 0xde, 0x89, 0x30, 0xd0,
+	0x1b, 0x30, 0x14, 0x50, 0xb2, 0x68, 0x7e, 0xab, 0x4c, 0x0d, 0x48, 0xa0, 0xb7, 0xaf, 0x51, 0x5e,
+	0xc4, 0x95, 0xb9, 0x23, 0x14, 0x46, 0xfe, 0x69, 0x5a, 0xb0, 0xfe, 0xfe, 0x2d, 0x76, 0x87, 0x1e,
+	0x38, 0x06, 0xac, 0x01, 0xf8, 0x0d, 0x97, 0x7a, 0x0d, 0xa7, 0x69, 0x8a, 0x7e, 0x79, 0x22, 0x33,
+	0xe6, 0xd0, 0xcb, 0xfd, 0x10, 0x23, 0x8e, 0xec, 0x91, 0x97, 0xe0, 0x53, 0xc4, 0x63, 0xb3, 0x54,
+	0xfa, 0x72, 0x3e, 0x45, 0xba, 0x58, 0x89, 0x06, 0xcc, 0xd1, 0x44, 0x85, 0x97, 0xf5, 0x47, 0xba,
+	0xd5, 0x0c, 0x34, 0x9f, 0x5a, 0xa8, 0xb0, 0xb2, 0xee, 0x35, 0xa2, 0x91, 0xf0, 0x51, 0xe8, 0x44,
+	0x69, 0xc0, 0xa1, 0xf8, 0x41, 0x3c, 0x7c, 0x70, 0xd3, 0xf2, 0x7c, 0xc7, 0x2d, 0xda, 0xba, 0x09,
+	0x0c, 0xac, 0xb8, 0x4e, 0x0b, 0xcf, 0x73, 0xec, 0x3a, 0xf8, 0xf6, 0xf1, 0x1d, 0xec, 0x9b, 0x7d,
+	0xbe, 0xa3, 0x7c, 0x00, 0x87, 0x37, 0x9e, 0x29, 0x94, 0x20, 0x46, 0x8d, 0x06, 0x35, 0x1e, 0x70,
+	0x05, 0x14, 0x6b, 0xaa, 0x40, 0x7e, 0xaf, 0x86, 0x20, 0xf1, 0x96, 0xc7, 0xdc, 0x28, 0x93, 0xf8,
+	0xe5, 0xc5, 0x94, 0xb9, 0x44, 0x3d, 0x28, 0x7f, 0x93, 0xa0, 0xd2, 0xfb, 0x2c, 0x2e, 0x54, 0x32,
+	0x79, 0x2f, 0x4f, 0xe9, 0x61, 0xe8, 0xbb, 0xba, 0xef, 0x5a, 0x4f, 0xc2, 0xe3, 0x10, 0x43, 0x92,
+	0x7b, 0xf0, 0x12, 0xbf, 0xaa, 0x39, 0x6d, 0xea, 0x62, 0xe7, 0x0d, 0x04, 0x90, 0xf1, 0x39, 0x25,
+	0xd3, 0xdd, 0x3d, 0x61, 0xaa, 0xed, 0xe6, 0xe0, 0x70, 0xc0, 0x0b, 0x64, 0x3f, 0xda, 0xa2, 0x6e,
+	0x9d, 0xda, 0xc6, 0x6a, 0x4d, 0x37, 0x5b, 0x96, 0xed, 0x55, 0xfa, 0x0f, 0xf4, 0xcf, 0x8e, 0x68,
+	0x13, 0xe1, 0xf8, 0x15, 0x36, 0xac, 0x7c, 0x49, 0xc4, 0xc6, 0xd2, 0xd0, 0xf3, 0xad, 0x12, 0x9c,
+	0x56, 0x6d, 0xda, 0x14, 0x8b, 0x1a, 0x7c, 0x38, 0xf3, 0x91, 0x5b, 0x66, 0xce, 0x69, 0x4c, 0x39,
+	0x03, 0x93, 0x29, 0x9e, 0x31, 0x6d, 0x7b, 0x83, 0x83, 0x24, 0xae, 0x1f, 0xdb, 0x9d, 0x70, 0x19,
+	0xe6, 0x41, 0x8e, 0x81, 0xde, 0x73, 0x3a, 0x46, 0x23, 0x79, 0x2e, 0x0c, 0x5e, 0x87, 0x68, 0x4f,
+	0xe3, 0x77, 0x4a, 0x1d, 0xf6, 0xa7, 0xa2, 0x70, 0xb2, 0x9b, 0x30, 0xfc, 0x08, 0xc7, 0xb0, 0x5c,
+	0x8e, 0xe6, 0x9c, 0xd6, 0xd0, 0x85, 0x90, 0x31, 0x05, 0x3a, 0xdc, 0xb8, 0x34, 0x26, 0xd9, 0xdf,
+	0x47, 0xc5, 0xbe, 0x60, 0xe3, 0x78, 0x2a, 0xce, 0x29, 0xdd, 0xe8, 0xf0, 0x0b, 0x7f, 0x58, 0xfc,
+	0x0d, 0x20, 0x77, 0xdb, 0x4a, 0xba, 0x10, 0x3c, 0x05, 0x9c, 0xcc, 0xc0, 0x84, 0x6e, 0x18, 0x4e,
+	0xc7, 0xf6, 0x6b, 0x62, 0x77, 0xe2, 0x07, 0x99, 0x71, 0x1c, 0xc6, 0x16, 0xdb, 0xb5, 0xc4, 0xfd,
+	0x5d, 0x4b, 0x3c, 0xf7, 0xe1, 0x51, 0xd8, 0xc9, 0x28, 0x93, 0x0f, 0x25, 0x18, 0xe4, 0x52, 0x3b,
+	0x79, 0x2d, 0x93, 0x55, 0xaf, 0xbe, 0x2f, 0x9f, 0x28, 0x66, 0xcc, 0x53, 0xa0, 0xcc, 0x7c, 0xf3,
+	0x2f, 0xff, 0xfc, 0x5e, 0xdf, 0x41, 0x32, 0xad, 0x6e, 0xfc, 0xc7, 0x1e, 0xf2, 0x03, 0x09, 0x86,
+	0xb0, 0x51, 0x90, 0xfc, 0x29, 0x62, 0xf2, 0xbf, 0x7c, 0xb2, 0xa0, 0x35, 0x32, 0xaa, 0x32, 0x46,
+	0xb3, 0xe4, 0xa8, 0x9a, 0xf3, 0xc7, 0x20, 0x4f, 0x5d, 0xb3, 0xcc, 0x75, 0xf2, 0x7d, 0x09, 0x86,
+	0xd1, 0x87, 0x47, 0x8a, 0xcd, 0x15, 0xe6, 0xaa, 0x5a, 0xd4, 0x1c, 0xb9, 0x1d, 0x63, 0xdc, 0x0e,
+	0x91, 0x83, 0xb9, 0xdc, 0xc8, 0x9f, 0x25, 0x78, 0x39, 0x45, 0xd7, 0x25, 0xe7, 0x0b, 0x4d, 0x99,
+	0x22, 0xb2, 0xcb, 0x6f, 0x6c, 0x02, 0x89, 0xbc, 0xaf, 0x32, 0xde, 0x97, 0xc8, 0x85, 0x02, 0x39,
+	0x8d, 0x5e, 0xa8, 0x75, 0x35, 0xa1, 0x19, 0xff, 0x5e, 0x82, 0x5d, 0x09, 0x6d, 0x93, 0xcc, 0x6d,
+	0xcc, 0x28, 0x4d, 0x9d, 0x95, 0xcf, 0x94, 0xc2, 0x20, 0xff, 0x7b, 0x8c, 0xff, 0x2d, 0x72, 0xa3,
+	0x24, 0x7f, 0x7e, 0x02, 0xf7, 0xd4, 0x35, 0x7e, 0xb1, 0xae, 0x0a, 0x19, 0xf6, 0x97, 0x12, 0x40,
+	0xa4, 0x79, 0x12, 0x75, 0x63, 0x52, 0x3d, 0xc2, 0xac, 0x7c, 0xaa, 0x38, 0x00, 0x43, 0x58, 0x60,
+	0x21, 0x5c, 0x24, 0x6f, 0x96, 0x0c, 0x21, 0xa6, 0xe2, 0x92, 0xa7, 0x12, 0x8c, 0x84, 0xc2, 0x27,
+	0xc9, 0x29, 0xde, 0x6e, 0xb5, 0x56, 0x56, 0x0b, 0xdb, 0x17, 0xa6, 0x1c, 0xa9, 0xbc, 0x9e, 0xba,
+	0xc6, 0xc5, 0xdf, 0x75, 0x75, 0x2d, 0x1a, 0x5d, 0x27, 0x3f, 0x91, 0x00, 0x22, 0x65, 0x2d, 0x2f,
+	0xd1, 0x3d, 0x7a, 0xad, 0x7c, 0xaa, 0x38, 0x00, 0x59, 0x9f, 0x66, 0xac, 0x5f, 0x23, 0xc7, 0xd4,
+	0xfc, 0xbf, 0xd1, 0x62, 0x0b, 0xf9, 0x54, 0x02, 0xd2, 0xab, 0x7b, 0x92, 0xd7, 0x8b, 0xbd, 0x70,
+	0x3d, 0x5a, 0xae, 0x7c, 0xbe, 0x3c, 0x70, 0x8b, 0x55, 0x12, 0x57, 0x56, 0x3f, 0x95, 0x60, 0x77,
+	0xb7, 0x98, 0x49, 0xce, 0x16, 0xcd, 0x63, 0xe2, 0x40, 0x22, 0x9f, 0x2b, 0x0b, 0xc3, 0x38, 0x6e,
+	0xb2, 0x38, 0x16, 0xc8, 0xe5, 0x4d, 0xc7, 0x51, 0xa3, 0x9c, 0xf8, 0xaf, 0x25, 0x18, 0x8b, 0x6b,
+	0x78, 0xe4, 0xf4, 0xc6, 0x94, 0x52, 0x94, 0x53, 0x79, 0xae, 0x0c, 0x04, 0x23, 0x78, 0x8b, 0x45,
+	0x70, 0x9e, 0x9c, 0xcb, 0x8c, 0x80, 0x22, 0xac, 0xc6, 0xe4, 0x44, 0x75, 0x2d, 0xf8, 0x20, 0x5a,
+	0x57, 0xd7, 0x98, 0x04, 0xbb, 0x4e, 0x7e, 0x26, 0xc1, 0xae, 0xb8, 0x63, 0x8f, 0x94, 0x60, 0xe1,
+	0x15, 0xec, 0x96, 0xa9, 0xda, 0xa8, 0xa2, 0x32, 0xea, 0xc7, 0xc8, 0x4c, 0x41, 0xea, 0xe4, 0xb7,
+	0x12, 0x8c, 0x27, 0xe5, 0x43, 0x92, 0x33, 0x71, 0xaa, 0xd4, 0x29, 0xcf, 0x97, 0x03, 0x21, 0xdd,
+	0xeb, 0x8c, 0xee, 0x65, 0xf2, 0x56, 0xc9, 0x5a, 0x11, 0x82, 0x5e, 0x8d, 0x6b, 0x9c, 0xe4, 0x37,
+	0x12, 0x4c, 0x74, 0xe9, 0x87, 0x64, 0xbe, 0xc8, 0x6e, 0xd3, 0xad, 0x86, 0xca, 0x67, 0x4b, 0xa2,
+	0x30, 0x90, 0x0b, 0x2c, 0x90, 0xb3, 0xe4, 0x8c, 0xba, 0xf1, 0x4f, 0x62, 0x62, 0xfb, 0x51, 0x24,
+	0x4a, 0x06, 0x6b, 0x90, 0x54, 0xf2, 0xf2, 0xd6, 0x20, 0x55, 0x75, 0x94, 0xe7, 0xcb, 0x81, 0xb6,
+	0xb8, 0x06, 0xe2, 0x17, 0x27, 0x35, 0x2e, 0x37, 0x92, 0x3f, 0x49, 0x30, 0x9e, 0x14, 0x05, 0x49,
+	0xa1, 0x0d, 0xbf, 0x4b, 0x98, 0x94, 0xe7, 0xcb, 0x81, 0x30, 0x8a, 0x45, 0x16, 0xc5, 0x3b, 0xe4,
+	0xe6, 0x56, 0x8f, 0x09, 0x22, 0x2c, 0xd6, 0x7d, 0xe2, 0xb2, 0x5d, 0x5e, 0xf7, 0x49, 0xd1, 0x05,
+	0xe5, 0xb9, 0x32, 0x90, 0xc2, 0xdd, 0xa7, 0x87, 0x73, 0x42, 0x05, 0x0c, 0xaa, 0x29, 0x29, 0xb4,
+	0xe5, 0xad, 0x43, 0xaa, 0x32, 0x28, 0xcf, 0x97, 0x03, 0x6d, 0xb1, 0x9a, 0x1e, 0xac, 0x1a, 0xb5,
+	0x98, 0x10, 0x48, 0x3e, 0x96, 0x60, 0x3c, 0xa9, 0x50, 0xe5, 0x45, 0x91, 0x2a, 0x19, 0xca, 0xf3,
+	0xe5, 0x40, 0x18, 0xc5, 0x3c, 0x8b, 0xa2, 0x4a, 0x4e, 0xa8, 0xb9, 0xbf, 0xc1, 0x53, 0xd7, 0xf0,
+	0xa3, 0x6f, 0x9d, 0xfc, 0x42, 0x82, 0xdd, 0xdd, 0xca, 0x5c, 0xde, 0xee, 0x9b, 0x21, 0x19, 0xca,
+	0xe7, 0xca, 0xc2, 0x90, 0xf9, 0x71, 0xc6, 0xfc, 0x30, 0x51, 0xf2, 0x99, 0x93, 0xdf, 0x49, 0x30,
+	0xd1, 0xa5, 0x60, 0xe5, 0x75, 0xcd, 0x74, 0x15, 0x4f, 0x3e, 0x5b, 0x12, 0x85, 0x64, 0x6f, 0x30,
+	0xb2, 0x57, 0xc8, 0xff, 0x97, 0x2c, 0x96, 0x50, 0x4b, 0xab, 0xa1, 0xd2, 0xf6, 0xb9, 0x04, 0xfb,
+	0x32, 0xa4, 0x2c, 0x72, 0xb1, 0xd0, 0x89, 0x2c, 0x43, 0x6b, 0x93, 0x2f, 0x6d, 0x12, 0xbd, 0xc5,
+	0xc3, 0x50, 0x14, 0x61, 0x03, 0xc3, 0xf8, 0xb1, 0x04, 0xa3, 0x31, 0x4d, 0x8c, 0x9c, 0xca, 0x23,
+	0xd6, 0x2d, 0xad, 0xc9, 0xa7, 0x4b, 0x20, 0x90, 0xfe, 0x49, 0x46, 0x7f, 0x86, 0x1c, 0xd9, 0x80,
+	0x7e, 0xc7, 0xa3, 0x62, 0x19, 0xfe, 0x20, 0xc1, 0x58, 0x5c, 0x81, 0xca, 0x6b, 0x99, 0x29, 0x3a,
+	0x98, 0x3c, 0x57, 0x06, 0x82, 0x34, 0x97, 0x18, 0xcd, 0xbb, 0xe4, 0x76, 0xf6, 0xd7, 0x0a, 0x57,
+	0x59, 0x3c, 0x75, 0x2d, 0x52, 0x60, 0xd6, 0x33, 0x72, 0x8f, 0xa7, 0xcf, 0x67, 0x12, 0x8c, 0x27,
+	0x35, 0xae, 0xbc, 0x0e, 0x94, 0xaa, 0xa3, 0xc9, 0xf3, 0xe5, 0x40, 0x18, 0xd2, 0x9b, 0x2c, 0xa4,
+	0x79, 0x32, 0x97, 0xbf, 0x0b, 0x70, 0x5d, 0x6e, 0x5d, 0x15, 0xc2, 0x19, 0xdb, 0x01, 0x92, 0x9a,
+	0x55, 0x1e, 0xf3, 0x54, 0x89, 0x4d, 0x9e, 0x2f, 0x07, 0xda, 0xe2, 0x0e, 0xd0, 0xf5, 0xcb, 0xdc,
+	0x85, 0x37, 0x3e, 0x79, 0x3e, 0x25, 0x7d, 0xf6, 0x7c, 0x4a, 0xfa, 0xfc, 0xf9, 0x94, 0xf4, 0xdd,
+	0x17, 0x53, 0x3b, 0x3e, 0x7b, 0x31, 0xb5, 0xe3, 0xaf, 0x2f, 0xa6, 0x76, 0x7c, 0x65, 0x3a, 0xee,
+	0xec, 0x49, 0xc2, 0x35, 0xfb, 0x79, 0xc5, 0xf2, 0x20, 0xfb, 0x11, 0xec, 0x99, 0xff, 0x0e, 0x00,
+	0x33, 0x94, 0xe1, 0x0f, 0x3e, 0x2e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Partner queries a single partner by id.
+	Partner(ctx context.Context, in *QueryPartnerRequest, opts ...grpc.CallOption) (*QueryPartnerResponse, error)
+	// Partners lists partners.
+	Partners(ctx context.Context, in *QueryPartnersRequest, opts ...grpc.CallOption) (*QueryPartnersResponse, error)
+	// PartnerSponsorships lists a partner's member sponsorships together with
+	// the fee allowance each member has left.
+	PartnerSponsorships(ctx context.Context, in *QueryPartnerSponsorshipsRequest, opts ...grpc.CallOption) (*QueryPartnerSponsorshipsResponse, error)
+	// MemberBalance queries a member's points balance with a partner.
+	MemberBalance(ctx context.Context, in *QueryMemberBalanceRequest, opts ...grpc.CallOption) (*QueryMemberBalanceResponse, error)
+	// ReceiptKey queries the key a partner signs earn receipts with.
+	ReceiptKey(ctx context.Context, in *QueryReceiptKeyRequest, opts ...grpc.CallOption) (*QueryReceiptKeyResponse, error)
+	// ClientRef queries the stored outcome of a message sent with a client_ref.
+	ClientRef(ctx context.Context, in *QueryClientRefRequest, opts ...grpc.CallOption) (*QueryClientRefResponse, error)
+	// Settlement queries a settlement by id.
+	Settlement(ctx context.Context, in *QuerySettlementRequest, opts ...grpc.CallOption) (*QuerySettlementResponse, error)
+	// PartnerSettlements lists the open or closed settlements a partner pays
+	// or receives.
+	PartnerSettlements(ctx context.Context, in *QueryPartnerSettlementsRequest, opts ...grpc.CallOption) (*QueryPartnerSettlementsResponse, error)
+	// SettlementEscrow queries the coins a partner holds in settlement escrow.
+	SettlementEscrow(ctx context.Context, in *QuerySettlementEscrowRequest, opts ...grpc.CallOption) (*QuerySettlementEscrowResponse, error)
+	// ExchangeRate queries the rate between two currencies, derived from the
+	// inverse pair when only that one is fed.
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRates lists the aggregated exchange rates.
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// TransferPolicy queries a partner's member transfer policy.
+	TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error)
+	// MemberTransfers lists the transfers a member sent or received, oldest
+	// first within each partner. partner_id 0 lists transfers with all
+	// partners.
+	MemberTransfers(ctx context.Context, in *QueryMemberTransfersRequest, opts ...grpc.CallOption) (*QueryMemberTransfersResponse, error)
+	// VelocityLimits queries a partner's redeem velocity limits.
+	VelocityLimits(ctx context.Context, in *QueryVelocityLimitsRequest, opts ...grpc.CallOption) (*QueryVelocityLimitsResponse, error)
+	// MemberVelocity queries how much a member redeemed against a partner's
+	// velocity limits, its cool-down and whether it is frozen.
+	MemberVelocity(ctx context.Context, in *QueryMemberVelocityRequest, opts ...grpc.CallOption) (*QueryMemberVelocityResponse, error)
+	// Attestations lists the attestations recorded for a member.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// KycRequirement queries a partner's KYC requirement for payouts.
+	KycRequirement(ctx context.Context, in *QueryKycRequirementRequest, opts ...grpc.CallOption) (*QueryKycRequirementResponse, error)
+	// BlockedAddress queries whether an address is on the blocklist.
+	BlockedAddress(ctx context.Context, in *QueryBlockedAddressRequest, opts ...grpc.CallOption) (*QueryBlockedAddressResponse, error)
+	// BlockedAddresses lists the blocklist.
+	BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error)
+	// LiquidityStatus queries a partner's liquidity thresholds, current level
+	// and whether earning is paused.
+	LiquidityStatus(ctx context.Context, in *QueryLiquidityStatusRequest, opts ...grpc.CallOption) (*QueryLiquidityStatusResponse, error)
+	// PartnerLiquidityHistory queries the liquidity checkpoints of a partner
+	// between two heights, oldest first.
+	PartnerLiquidityHistory(ctx context.Context, in *QueryPartnerLiquidityHistoryRequest, opts ...grpc.CallOption) (*QueryPartnerLiquidityHistoryResponse, error)
+	// PauseStatus queries the pause matrix.
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
+	// PointsEscrow queries the points escrowed for a partner on a channel.
+	PointsEscrow(ctx context.Context, in *QueryPointsEscrowRequest, opts ...grpc.CallOption) (*QueryPointsEscrowResponse, error)
+	// PointsVouchers lists the points vouchers a member holds.
+	PointsVouchers(ctx context.Context, in *QueryPointsVouchersRequest, opts ...grpc.CallOption) (*QueryPointsVouchersResponse, error)
+	// RemoteTreasury queries a partner's remote treasury and its interchain
+	// account.
+	RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Partner(ctx context.Context, in *QueryPartnerRequest, opts ...grpc.CallOption) (*QueryPartnerResponse, error) {
+	out := new(QueryPartnerResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Partner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Partners(ctx context.Context, in *QueryPartnersRequest, opts ...grpc.CallOption) (*QueryPartnersResponse, error) {
+	out := new(QueryPartnersResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Partners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PartnerSponsorships(ctx context.Context, in *QueryPartnerSponsorshipsRequest, opts ...grpc.CallOption) (*QueryPartnerSponsorshipsResponse, error) {
+	out := new(QueryPartnerSponsorshipsResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/PartnerSponsorships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberBalance(ctx context.Context, in *QueryMemberBalanceRequest, opts ...grpc.CallOption) (*QueryMemberBalanceResponse, error) {
+	out := new(QueryMemberBalanceResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/MemberBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReceiptKey(ctx context.Context, in *QueryReceiptKeyRequest, opts ...grpc.CallOption) (*QueryReceiptKeyResponse, error) {
+	out := new(QueryReceiptKeyResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/ReceiptKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientRef(ctx context.Context, in *QueryClientRefRequest, opts ...grpc.CallOption) (*QueryClientRefResponse, error) {
+	out := new(QueryClientRefResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/ClientRef", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Settlement(ctx context.Context, in *QuerySettlementRequest, opts ...grpc.CallOption) (*QuerySettlementResponse, error) {
+	out := new(QuerySettlementResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Settlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PartnerSettlements(ctx context.Context, in *QueryPartnerSettlementsRequest, opts ...grpc.CallOption) (*QueryPartnerSettlementsResponse, error) {
+	out := new(QueryPartnerSettlementsResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/PartnerSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettlementEscrow(ctx context.Context, in *QuerySettlementEscrowRequest, opts ...grpc.CallOption) (*QuerySettlementEscrowResponse, error) {
+	out := new(QuerySettlementEscrowResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/SettlementEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/ExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPolicy(ctx context.Context, in *QueryTransferPolicyRequest, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error) {
+	out := new(QueryTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/TransferPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberTransfers(ctx context.Context, in *QueryMemberTransfersRequest, opts ...grpc.CallOption) (*QueryMemberTransfersResponse, error) {
+	out := new(QueryMemberTransfersResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/MemberTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VelocityLimits(ctx context.Context, in *QueryVelocityLimitsRequest, opts ...grpc.CallOption) (*QueryVelocityLimitsResponse, error) {
+	out := new(QueryVelocityLimitsResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/VelocityLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MemberVelocity(ctx context.Context, in *QueryMemberVelocityRequest, opts ...grpc.CallOption) (*QueryMemberVelocityResponse, error) {
+	out := new(QueryMemberVelocityResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/MemberVelocity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KycRequirement(ctx context.Context, in *QueryKycRequirementRequest, opts ...grpc.CallOption) (*QueryKycRequirementResponse, error) {
+	out := new(QueryKycRequirementResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/KycRequirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddress(ctx context.Context, in *QueryBlockedAddressRequest, opts ...grpc.CallOption) (*QueryBlockedAddressResponse, error) {
+	out := new(QueryBlockedAddressResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/BlockedAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAddresses(ctx context.Context, in *QueryBlockedAddressesRequest, opts ...grpc.CallOption) (*QueryBlockedAddressesResponse, error) {
+	out := new(QueryBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/BlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityStatus(ctx context.Context, in *QueryLiquidityStatusRequest, opts ...grpc.CallOption) (*QueryLiquidityStatusResponse, error) {
+	out := new(QueryLiquidityStatusResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/LiquidityStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PartnerLiquidityHistory(ctx context.Context, in *QueryPartnerLiquidityHistoryRequest, opts ...grpc.CallOption) (*QueryPartnerLiquidityHistoryResponse, error) {
+	out := new(QueryPartnerLiquidityHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/PartnerLiquidityHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/PauseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PointsEscrow(ctx context.Context, in *QueryPointsEscrowRequest, opts ...grpc.CallOption) (*QueryPointsEscrowResponse, error) {
+	out := new(QueryPointsEscrowResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/PointsEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PointsVouchers(ctx context.Context, in *QueryPointsVouchersRequest, opts ...grpc.CallOption) (*QueryPointsVouchersResponse, error) {
+	out := new(QueryPointsVouchersResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/PointsVouchers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteTreasury(ctx context.Context, in *QueryRemoteTreasuryRequest, opts ...grpc.CallOption) (*QueryRemoteTreasuryResponse, error) {
+	out := new(QueryRemoteTreasuryResponse)
+	err := c.cc.Invoke(ctx, "/rewardchain.rewardchain.Query/RemoteTreasury", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Partner queries a single partner by id.
+	Partner(context.Context, *QueryPartnerRequest) (*QueryPartnerResponse, error)
+	// Partners lists partners.
+	Partners(context.Context, *QueryPartnersRequest) (*QueryPartnersResponse, error)
+	// PartnerSponsorships lists a partner's member sponsorships together with
+	// the fee allowance each member has left.
+	PartnerSponsorships(context.Context, *QueryPartnerSponsorshipsRequest) (*QueryPartnerSponsorshipsResponse, error)
+	// MemberBalance queries a member's points balance with a partner.
+	MemberBalance(context.Context, *QueryMemberBalanceRequest) (*QueryMemberBalanceResponse, error)
+	// ReceiptKey queries the key a partner signs earn receipts with.
+	ReceiptKey(context.Context, *QueryReceiptKeyRequest) (*QueryReceiptKeyResponse, error)
+	// ClientRef queries the stored outcome of a message sent with a client_ref.
+	ClientRef(context.Context, *QueryClientRefRequest) (*QueryClientRefResponse, error)
+	// Settlement queries a settlement by id.
+	Settlement(context.Context, *QuerySettlementRequest) (*QuerySettlementResponse, error)
+	// PartnerSettlements lists the open or closed settlements a partner pays
+	// or receives.
+	PartnerSettlements(context.Context, *QueryPartnerSettlementsRequest) (*QueryPartnerSettlementsResponse, error)
+	// SettlementEscrow queries the coins a partner holds in settlement escrow.
+	SettlementEscrow(context.Context, *QuerySettlementEscrowRequest) (*QuerySettlementEscrowResponse, error)
+	// ExchangeRate queries the rate between two currencies, derived from the
+	// inverse pair when only that one is fed.
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRates lists the aggregated exchange rates.
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// TransferPolicy queries a partner's member transfer policy.
+	TransferPolicy(context.Context, *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error)
+	// MemberTransfers lists the transfers a member sent or received, oldest
+	// first within each partner. partner_id 0 lists transfers with all
+	// partners.
+	MemberTransfers(context.Context, *QueryMemberTransfersRequest) (*QueryMemberTransfersResponse, error)
+	// VelocityLimits queries a partner's redeem velocity limits.
+	VelocityLimits(context.Context, *QueryVelocityLimitsRequest) (*QueryVelocityLimitsResponse, error)
+	// MemberVelocity queries how much a member redeemed against a partner's
+	// velocity limits, its cool-down and whether it is frozen.
+	MemberVelocity(context.Context, *QueryMemberVelocityRequest) (*QueryMemberVelocityResponse, error)
+	// Attestations lists the attestations recorded for a member.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	// KycRequirement queries a partner's KYC requirement for payouts.
+	KycRequirement(context.Context, *QueryKycRequirementRequest) (*QueryKycRequirementResponse, error)
+	// BlockedAddress queries whether an address is on the blocklist.
+	BlockedAddress(context.Context, *QueryBlockedAddressRequest) (*QueryBlockedAddressResponse, error)
+	// BlockedAddresses lists the blocklist.
+	BlockedAddresses(context.Context, *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error)
+	// LiquidityStatus queries a partner's liquidity thresholds, current level
+	// and whether earning is paused.
+	LiquidityStatus(context.Context, *QueryLiquidityStatusRequest) (*QueryLiquidityStatusResponse, error)
+	// PartnerLiquidityHistory queries the liquidity checkpoints of a partner
+	// between two heights, oldest first.
+	PartnerLiquidityHistory(context.Context, *QueryPartnerLiquidityHistoryRequest) (*QueryPartnerLiquidityHistoryResponse, error)
+	// PauseStatus queries the pause matrix.
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
+	// PointsEscrow queries the points escrowed for a partner on a channel.
+	PointsEscrow(context.Context, *QueryPointsEscrowRequest) (*QueryPointsEscrowResponse, error)
+	// PointsVouchers lists the points vouchers a member holds.
+	PointsVouchers(context.Context, *QueryPointsVouchersRequest) (*QueryPointsVouchersResponse, error)
+	// RemoteTreasury queries a partner's remote treasury and its interchain
+	// account.
+	RemoteTreasury(context.Context, *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Partner(ctx context.Context, req *QueryPartnerRequest) (*QueryPartnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Partner not implemented")
+}
+func (*UnimplementedQueryServer) Partners(ctx context.Context, req *QueryPartnersRequest) (*QueryPartnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Partners not implemented")
+}
+func (*UnimplementedQueryServer) PartnerSponsorships(ctx context.Context, req *QueryPartnerSponsorshipsRequest) (*QueryPartnerSponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartnerSponsorships not implemented")
+}
+func (*UnimplementedQueryServer) MemberBalance(ctx context.Context, req *QueryMemberBalanceRequest) (*QueryMemberBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberBalance not implemented")
+}
+func (*UnimplementedQueryServer) ReceiptKey(ctx context.Context, req *QueryReceiptKeyRequest) (*QueryReceiptKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiptKey not implemented")
+}
+func (*UnimplementedQueryServer) ClientRef(ctx context.Context, req *QueryClientRefRequest) (*QueryClientRefResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientRef not implemented")
+}
+func (*UnimplementedQueryServer) Settlement(ctx context.Context, req *QuerySettlementRequest) (*QuerySettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Settlement not implemented")
+}
+func (*UnimplementedQueryServer) PartnerSettlements(ctx context.Context, req *QueryPartnerSettlementsRequest) (*QueryPartnerSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartnerSettlements not implemented")
+}
+func (*UnimplementedQueryServer) SettlementEscrow(ctx context.Context, req *QuerySettlementEscrowRequest) (*QuerySettlementEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlementEscrow not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) TransferPolicy(ctx context.Context, req *QueryTransferPolicyRequest) (*QueryTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicy not implemented")
+}
+func (*UnimplementedQueryServer) MemberTransfers(ctx context.Context, req *QueryMemberTransfersRequest) (*QueryMemberTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberTransfers not implemented")
+}
+func (*UnimplementedQueryServer) VelocityLimits(ctx context.Context, req *QueryVelocityLimitsRequest) (*QueryVelocityLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VelocityLimits not implemented")
+}
+func (*UnimplementedQueryServer) MemberVelocity(ctx context.Context, req *QueryMemberVelocityRequest) (*QueryMemberVelocityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemberVelocity not implemented")
+}
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (*UnimplementedQueryServer) KycRequirement(ctx context.Context, req *QueryKycRequirementRequest) (*QueryKycRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KycRequirement not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAddress(ctx context.Context, req *QueryBlockedAddressRequest) (*QueryBlockedAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddress not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAddresses(ctx context.Context, req *QueryBlockedAddressesRequest) (*QueryBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityStatus(ctx context.Context, req *QueryLiquidityStatusRequest) (*QueryLiquidityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityStatus not implemented")
+}
+func (*UnimplementedQueryServer) PartnerLiquidityHistory(ctx context.Context, req *QueryPartnerLiquidityHistoryRequest) (*QueryPartnerLiquidityHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartnerLiquidityHistory not implemented")
+}
+func (*UnimplementedQueryServer) PauseStatus(ctx context.Context, req *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
+func (*UnimplementedQueryServer) PointsEscrow(ctx context.Context, req *QueryPointsEscrowRequest) (*QueryPointsEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PointsEscrow not implemented")
+}
+func (*UnimplementedQueryServer) PointsVouchers(ctx context.Context, req *QueryPointsVouchersRequest) (*QueryPointsVouchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PointsVouchers not implemented")
+}
+func (*UnimplementedQueryServer) RemoteTreasury(ctx context.Context, req *QueryRemoteTreasuryRequest) (*QueryRemoteTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteTreasury not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Partner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Partner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/Partner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Partner(ctx, req.(*QueryPartnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Partners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Partners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/Partners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Partners(ctx, req.(*QueryPartnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PartnerSponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnerSponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartnerSponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/PartnerSponsorships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartnerSponsorships(ctx, req.(*QueryPartnerSponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/MemberBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberBalance(ctx, req.(*QueryMemberBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReceiptKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReceiptKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/ReceiptKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReceiptKey(ctx, req.(*QueryReceiptKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/ClientRef",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientRef(ctx, req.(*QueryClientRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Settlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Settlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/Settlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Settlement(ctx, req.(*QuerySettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PartnerSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnerSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartnerSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/PartnerSettlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartnerSettlements(ctx, req.(*QueryPartnerSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettlementEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettlementEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettlementEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/SettlementEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettlementEscrow(ctx, req.(*QuerySettlementEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/ExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/ExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/TransferPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPolicy(ctx, req.(*QueryTransferPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/MemberTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberTransfers(ctx, req.(*QueryMemberTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VelocityLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVelocityLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VelocityLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/VelocityLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VelocityLimits(ctx, req.(*QueryVelocityLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MemberVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMemberVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MemberVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/MemberVelocity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MemberVelocity(ctx, req.(*QueryMemberVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KycRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKycRequirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KycRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/KycRequirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KycRequirement(ctx, req.(*QueryKycRequirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/BlockedAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddress(ctx, req.(*QueryBlockedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/BlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAddresses(ctx, req.(*QueryBlockedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/LiquidityStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityStatus(ctx, req.(*QueryLiquidityStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PartnerLiquidityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPartnerLiquidityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PartnerLiquidityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/PartnerLiquidityHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PartnerLiquidityHistory(ctx, req.(*QueryPartnerLiquidityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/PauseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PointsEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPointsEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PointsEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/PointsEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PointsEscrow(ctx, req.(*QueryPointsEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PointsVouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPointsVouchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PointsVouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/PointsVouchers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PointsVouchers(ctx, req.(*QueryPointsVouchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemoteTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rewardchain.rewardchain.Query/RemoteTreasury",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteTreasury(ctx, req.(*QueryRemoteTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rewardchain.rewardchain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Partner",
+			Handler:    _Query_Partner_Handler,
+		},
+		{
+			MethodName: "Partners",
+			Handler:    _Query_Partners_Handler,
+		},
+		{
+			MethodName: "PartnerSponsorships",
+			Handler:    _Query_PartnerSponsorships_Handler,
+		},
+		{
+			MethodName: "MemberBalance",
+			Handler:    _Query_MemberBalance_Handler,
+		},
+		{
+			MethodName: "ReceiptKey",
+			Handler:    _Query_ReceiptKey_Handler,
+		},
+		{
+			MethodName: "ClientRef",
+			Handler:    _Query_ClientRef_Handler,
+		},
+		{
+			MethodName: "Settlement",
+			Handler:    _Query_Settlement_Handler,
+		},
+		{
+			MethodName: "PartnerSettlements",
+			Handler:    _Query_PartnerSettlements_Handler,
+		},
+		{
+			MethodName: "SettlementEscrow",
+			Handler:    _Query_SettlementEscrow_Handler,
+		},
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "TransferPolicy",
+			Handler:    _Query_TransferPolicy_Handler,
+		},
+		{
+			MethodName: "MemberTransfers",
+			Handler:    _Query_MemberTransfers_Handler,
+		},
+		{
+			MethodName: "VelocityLimits",
+			Handler:    _Query_VelocityLimits_Handler,
+		},
+		{
+			MethodName: "MemberVelocity",
+			Handler:    _Query_MemberVelocity_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "KycRequirement",
+			Handler:    _Query_KycRequirement_Handler,
+		},
+		{
+			MethodName: "BlockedAddress",
+			Handler:    _Query_BlockedAddress_Handler,
+		},
+		{
+			MethodName: "BlockedAddresses",
+			Handler:    _Query_BlockedAddresses_Handler,
+		},
+		{
+			MethodName: "LiquidityStatus",
+			Handler:    _Query_LiquidityStatus_Handler,
+		},
+		{
+			MethodName: "PartnerLiquidityHistory",
+			Handler:    _Query_PartnerLiquidityHistory_Handler,
+		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
+		{
+			MethodName: "PointsEscrow",
+			Handler:    _Query_PointsEscrow_Handler,
+		},
+		{
+			MethodName: "PointsVouchers",
+			Handler:    _Query_PointsVouchers_Handler,
+		},
+		{
+			MethodName: "RemoteTreasury",
+			Handler:    _Query_RemoteTreasury_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rewardchain/rewardchain/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EarnPaused {
+		i--
+		if m.EarnPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Partner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeDisabled {
+		i--
+		if m.IncludeDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Partners) > 0 {
+		for iNdEx := len(m.Partners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerSponsorshipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerSponsorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerSponsorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorshipStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerSponsorshipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerSponsorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerSponsorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalRemaining) > 0 {
+		for iNdEx := len(m.TotalRemaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRemaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Debt) > 0 {
+		i -= len(m.Debt)
+		copy(dAtA[i:], m.Debt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Debt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReceiptKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientRefRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientRefRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientRefRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientRef) > 0 {
+		i -= len(m.ClientRef)
+		copy(dAtA[i:], m.ClientRef)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientRef)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientRefResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientRefResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientRefResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerSettlementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerSettlementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerSettlementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Closed {
+		i--
+		if m.Closed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerSettlementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerSettlementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerSettlementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettlementEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettlementEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettlementEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rates) > 0 {
+		for iNdEx := len(m.Rates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVelocityLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVelocityLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVelocityLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVelocityLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVelocityLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVelocityLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberVelocityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberVelocityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberVelocityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMemberVelocityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMemberVelocityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMemberVelocityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FreezeReason) > 0 {
+		i -= len(m.FreezeReason)
+		copy(dAtA[i:], m.FreezeReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FreezeReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CooldownEnds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CooldownEnds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MemberSince != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MemberSince))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RedeemedInWindow) > 0 {
+		i -= len(m.RedeemedInWindow)
+		copy(dAtA[i:], m.RedeemedInWindow)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemedInWindow)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedeemedToday) > 0 {
+		i -= len(m.RedeemedToday)
+		copy(dAtA[i:], m.RedeemedToday)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemedToday)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Level != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKycRequirementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKycRequirementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKycRequirementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKycRequirementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKycRequirementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKycRequirementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Requirement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvailableLiquidity) > 0 {
+		i -= len(m.AvailableLiquidity)
+		copy(dAtA[i:], m.AvailableLiquidity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableLiquidity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Thresholds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerLiquidityHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerLiquidityHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerLiquidityHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPartnerLiquidityHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPartnerLiquidityHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPartnerLiquidityHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EmergencyAdmins) > 0 {
+		for iNdEx := len(m.EmergencyAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EmergencyAdmins[iNdEx])
+			copy(dAtA[i:], m.EmergencyAdmins[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.EmergencyAdmins[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PausedOperations) > 0 {
+		dAtA27 := make([]byte, len(m.PausedOperations)*10)
+		var j26 int
+		for _, num := range m.PausedOperations {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintQuery(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Paused.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPointsEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPointsEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPointsEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPointsEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPointsEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPointsEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		i -= len(m.Points)
+		copy(dAtA[i:], m.Points)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Points)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPointsVouchersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPointsVouchersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPointsVouchersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPointsVouchersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPointsVouchersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPointsVouchersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vouchers) > 0 {
+		for iNdEx := len(m.Vouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemoteTreasuryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemoteTreasuryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemoteTreasuryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemoteTreasuryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemoteTreasuryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemoteTreasuryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Treasury.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPartnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPartnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Partner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EarnPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPartnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPartnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Partners) > 0 {
+		for _, e := range m.Partners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPartnerSponsorshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SponsorshipStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPartnerSponsorshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRemaining) > 0 {
+		for _, e := range m.TotalRemaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Debt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReceiptKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QueryReceiptKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReceiptKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClientRefRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientRef)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientRefResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QuerySettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Settlement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPartnerSettlementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	if m.Closed {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPartnerSettlementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettlementEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QuerySettlementEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rates) > 0 {
+		for _, e := range m.Rates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QueryTransferPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMemberTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVelocityLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QueryVelocityLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMemberVelocityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMemberVelocityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RedeemedToday)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RedeemedInWindow)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MemberSince != 0 {
+		n += 1 + sovQuery(uint64(m.MemberSince))
+	}
+	if m.CooldownEnds != 0 {
+		n += 1 + sovQuery(uint64(m.CooldownEnds))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	l = len(m.FreezeReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Level != 0 {
+		n += 1 + sovQuery(uint64(m.Level))
+	}
+	return n
+}
+
+func (m *QueryKycRequirementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QueryKycRequirementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Requirement.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockedAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocked {
+		n += 2
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Thresholds.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.AvailableLiquidity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPartnerLiquidityHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	if m.From != 0 {
+		n += 1 + sovQuery(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovQuery(uint64(m.To))
+	}
+	return n
+}
+
+func (m *QueryPartnerLiquidityHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPauseStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paused.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PausedOperations) > 0 {
+		l = 0
+		for _, e := range m.PausedOperations {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.EmergencyAdmins) > 0 {
+		for _, s := range m.EmergencyAdmins {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPointsEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QueryPointsEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Points)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPointsVouchersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPointsVouchersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vouchers) > 0 {
+		for _, e := range m.Vouchers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRemoteTreasuryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovQuery(uint64(m.PartnerId))
+	}
+	return n
+}
+
+func (m *QueryRemoteTreasuryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Treasury.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Partner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EarnPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partners = append(m.Partners, Partner{})
+			if err := m.Partners[len(m.Partners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerSponsorshipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerSponsorshipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerSponsorshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorshipStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorshipStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorshipStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerSponsorshipsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerSponsorshipsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerSponsorshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, SponsorshipStatus{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRemaining = append(m.TotalRemaining, types.Coin{})
+			if err := m.TotalRemaining[len(m.TotalRemaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiptKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientRefRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientRefRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientRefRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientRefResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientRefResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientRefResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerSettlementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerSettlementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerSettlementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Closed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerSettlementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerSettlementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerSettlementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, Settlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettlementEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettlementEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettlementEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rates = append(m.Rates, ExchangeRate{})
+			if err := m.Rates[len(m.Rates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, PointsTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVelocityLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVelocityLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVelocityLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVelocityLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVelocityLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVelocityLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberVelocityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberVelocityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberVelocityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMemberVelocityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMemberVelocityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMemberVelocityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedToday", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemedToday = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedInWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemedInWindow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberSince", wireType)
+			}
+			m.MemberSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownEnds", wireType)
+			}
+			m.CooldownEnds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownEnds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezeReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKycRequirementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKycRequirementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKycRequirementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKycRequirementResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKycRequirementResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKycRequirementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Requirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BlockedAddress{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Thresholds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableLiquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerLiquidityHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerLiquidityHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerLiquidityHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPartnerLiquidityHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPartnerLiquidityHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPartnerLiquidityHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, LiquidityCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -845,10 +11291,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -872,7 +11318,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -895,15 +11341,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -930,10 +11376,111 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Operation
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Operation(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PausedOperations = append(m.PausedOperations, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PausedOperations) == 0 {
+					m.PausedOperations = make([]Operation, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Operation
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Operation(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PausedOperations = append(m.PausedOperations, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedOperations", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAdmins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAdmins = append(m.EmergencyAdmins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -955,7 +11502,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPartnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPointsEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -978,17 +11525,49 @@ func (m *QueryPartnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPartnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPointsEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPartnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPointsEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
 			}
-			m.Id = 0
+			m.PartnerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -998,7 +11577,7 @@ func (m *QueryPartnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PartnerId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1024,7 +11603,7 @@ func (m *QueryPartnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPartnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPointsEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1047,17 +11626,17 @@ func (m *QueryPartnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPartnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPointsEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPartnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPointsEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1067,24 +11646,105 @@ func (m *QueryPartnerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Partner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Points = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPointsVouchersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPointsVouchersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPointsVouchersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1107,7 +11767,7 @@ func (m *QueryPartnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPartnersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPointsVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1130,15 +11790,15 @@ func (m *QueryPartnersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPartnersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPointsVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPartnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPointsVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vouchers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1165,18 +11825,66 @@ func (m *QueryPartnersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vouchers = append(m.Vouchers, PointsVoucher{})
+			if err := m.Vouchers[len(m.Vouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemoteTreasuryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemoteTreasuryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemoteTreasuryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDisabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
 			}
-			var v int
+			m.PartnerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1186,12 +11894,11 @@ func (m *QueryPartnersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PartnerId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1213,7 +11920,7 @@ func (m *QueryPartnersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPartnersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemoteTreasuryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1236,15 +11943,15 @@ func (m *QueryPartnersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPartnersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemoteTreasuryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPartnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemoteTreasuryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1271,16 +11978,15 @@ func (m *QueryPartnersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Partners = append(m.Partners, Partner{})
-			if err := m.Partners[len(m.Partners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Treasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1290,27 +11996,55 @@ func (m *QueryPartnersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	math "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ReceiptKeyTypeEd25519 identifies an ed25519 receipt signing key.
+	ReceiptKeyTypeEd25519 = "ed25519"
+	// ReceiptKeyTypeSecp256k1 identifies a secp256k1 receipt signing key.
+	ReceiptKeyTypeSecp256k1 = "secp256k1"
+
+	// ReceiptReferencePrefix prefixes the earn reference id of a claimed
+	// receipt.
+	ReceiptReferencePrefix = "receipt/"
+
+	// MaxReceiptNonceLength bounds the size of a receipt nonce, leaving room
+	// for ReceiptReferencePrefix in the earn reference id.
+	MaxReceiptNonceLength = MaxReferenceIDLength - len(ReceiptReferencePrefix)
+)
+
+// ClaimedReceiptKey returns the replay-store key of a receipt nonce.
+func ClaimedReceiptKey(partnerID uint64, nonce string) []byte {
+	return append(PartnerKey(partnerID), []byte(nonce)...)
+}
+
+// ClaimedReceiptExpiryKey returns the pruning queue key of a claimed receipt.
+func ClaimedReceiptExpiryKey(expiry int64, partnerID uint64, nonce string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expiry)), ClaimedReceiptKey(partnerID, nonce)...)
+}
+
+// ReceiptReferenceID returns the earn reference id a claimed receipt is
+// recorded under. Partners reverse receipt claims by this id.
+func ReceiptReferenceID(nonce string) string {
+	return ReceiptReferencePrefix + nonce
+}
+
+// Validate performs stateless checks on the receipt fields.
+func (r Receipt) Validate() error {
+	if r.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Member); err != nil {
+		return fmt.Errorf("invalid member address: %w", err)
+	}
+	points, err := math.LegacyNewDecFromStr(strings.TrimSpace(r.Points))
+	if err != nil {
+		return fmt.Errorf("invalid points: %w", err)
+	}
+	if !points.IsPositive() {
+		return fmt.Errorf("points must be > 0")
+	}
+	if r.Nonce == "" || len(r.Nonce) > MaxReceiptNonceLength {
+		return fmt.Errorf("nonce must be 1-%d bytes", MaxReceiptNonceLength)
+	}
+	if r.Expiry <= 0 {
+		return fmt.Errorf("expiry is required")
+	}
+	return nil
+}
+
+// ReceiptSignBytes returns the bytes a point of sale signs for a receipt.
+func ReceiptSignBytes(chainID string, r Receipt) ([]byte, error) {
+	doc := ReceiptSignDoc{ChainId: chainID, Receipt: r}
+	return doc.Marshal()
+}
+
+// ReceiptPubKey decodes a receipt signing key of the given type.
+func ReceiptPubKey(keyType string, bz []byte) (cryptotypes.PubKey, error) {
+	switch keyType {
+	case ReceiptKeyTypeEd25519:
+		if len(bz) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("ed25519 public key must be %d bytes", ed25519.PubKeySize)
+		}
+		return &ed25519.PubKey{Key: bz}, nil
+	case ReceiptKeyTypeSecp256k1:
+		if len(bz) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("secp256k1 public key must be %d bytes", secp256k1.PubKeySize)
+		}
+		return &secp256k1.PubKey{Key: bz}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", keyType)
+	}
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+)
+
+// DefaultTopUpTimeout is how long a top-up packet stays valid.
+const DefaultTopUpTimeout = 10 * time.Minute
+
+// remoteTreasuryOwnerPrefix prefixes the interchain account owner of a
+// partner's remote treasury.
+const remoteTreasuryOwnerPrefix = ModuleName + "-partner-"
+
+// RemoteTreasuryKey returns the store key of a partner's remote treasury.
+func RemoteTreasuryKey(partnerID uint64) []byte {
+	return PartnerKey(partnerID)
+}
+
+// RemoteTreasuryOwner returns the interchain account owner of a partner's
+// remote treasury.
+func RemoteTreasuryOwner(partnerID uint64) string {
+	return remoteTreasuryOwnerPrefix + strconv.FormatUint(partnerID, 10)
+}
+
+// ParseRemoteTreasuryPort returns the partner owning an interchain account
+// controller port. ok is false for ports rewardchain did not register.
+func ParseRemoteTreasuryPort(portID string) (partnerID uint64, ok bool) {
+	id, found := strings.CutPrefix(portID, icatypes.ControllerPortPrefix+remoteTreasuryOwnerPrefix)
+	if !found {
+		return 0, false
+	}
+	partnerID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || partnerID == 0 {
+		return 0, false
+	}
+	return partnerID, true
+}
+
+// ParseTopUpAmount parses an amount of a remote treasury's denom.
+func ParseTopUpAmount(s string) (math.Int, error) {
+	amount, ok := math.NewIntFromString(strings.TrimSpace(s))
+	if !ok || !amount.IsPositive() {
+		return math.Int{}, fmt.Errorf("amount %q must be a positive integer", s)
+	}
+	return amount, nil
+}
+
+// AutoTopUp reports whether automatic top-ups are configured.
+func (t RemoteTreasury) AutoTopUp() bool {
+	threshold, err := math.LegacyNewDecFromStr(strings.TrimSpace(t.TopUpThreshold))
+	return err == nil && threshold.IsPositive()
+}
+
+// Validate checks a remote treasury. The escrow address belongs to the host
+// chain, so only its presence is checked.
+func (t RemoteTreasury) Validate() error {
+	if t.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	if !connectiontypes.IsValidConnectionID(t.ConnectionId) {
+		return fmt.Errorf("invalid connection_id %q", t.ConnectionId)
+	}
+	if t.Owner != RemoteTreasuryOwner(t.PartnerId) {
+		return fmt.Errorf("owner must be %s", RemoteTreasuryOwner(t.PartnerId))
+	}
+	if strings.TrimSpace(t.EscrowAddress) == "" {
+		return fmt.Errorf("escrow_address is required")
+	}
+	if err := sdk.ValidateDenom(t.Denom.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if err := ValidateCurrencyCode(t.Denom.Currency); err != nil {
+		return err
+	}
+	if t.Denom.Exponent > math.LegacyPrecision {
+		return fmt.Errorf("exponent must be <= %d", math.LegacyPrecision)
+	}
+	if t.TopUpThreshold != "" {
+		threshold, err := math.LegacyNewDecFromStr(t.TopUpThreshold)
+		if err != nil || threshold.IsNegative() {
+			return fmt.Errorf("top_up_threshold must be a non-negative decimal")
+		}
+		if threshold.IsPositive() {
+			if _, err := ParseTopUpAmount(t.TopUpAmount); err != nil {
+				return fmt.Errorf("invalid top_up_amount: %w", err)
+			}
+		}
+	}
+	if (t.PendingSequence == 0) != (t.PendingAmount == "") {
+		return fmt.Errorf("pending_sequence and pending_amount must be set together")
+	}
+	if t.PendingAmount != "" {
+		if _, err := ParseTopUpAmount(t.PendingAmount); err != nil {
+			return fmt.Errorf("invalid pending_amount: %w", err)
+		}
+	}
+	return nil
+}
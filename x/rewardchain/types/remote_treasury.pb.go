@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/remote_treasury.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RemoteTreasury is a partner treasury kept on another chain through an
+// interchain account rewardchain controls on the partner's behalf. Top-ups
+// send the account's tokens to the escrow address on that chain and credit
+// the partner's liquidity once the transfer is acknowledged.
+type RemoteTreasury struct {
+	PartnerId    uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// owner is the interchain account owner; the controller port is
+	// icacontroller-<owner>.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// escrow_address is the address on the host chain top-ups pay into.
+	EscrowAddress string `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	// denom is the host chain token sent and the currency it is worth.
+	Denom DenomCurrency `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom"`
+	// top_up_threshold is the available liquidity, in points, below which a
+	// top-up is sent automatically. Empty or zero disables automatic top-ups.
+	TopUpThreshold string `protobuf:"bytes,6,opt,name=top_up_threshold,json=topUpThreshold,proto3" json:"top_up_threshold,omitempty"`
+	// top_up_amount is the amount of denom an automatic top-up sends.
+	TopUpAmount string `protobuf:"bytes,7,opt,name=top_up_amount,json=topUpAmount,proto3" json:"top_up_amount,omitempty"`
+	// pending_sequence is the packet sequence of the top-up in flight, or zero.
+	PendingSequence uint64 `protobuf:"varint,8,opt,name=pending_sequence,json=pendingSequence,proto3" json:"pending_sequence,omitempty"`
+	PendingAmount   string `protobuf:"bytes,9,opt,name=pending_amount,json=pendingAmount,proto3" json:"pending_amount,omitempty"`
+}
+
+func (m *RemoteTreasury) Reset()         { *m = RemoteTreasury{} }
+func (m *RemoteTreasury) String() string { return proto.CompactTextString(m) }
+func (*RemoteTreasury) ProtoMessage()    {}
+func (*RemoteTreasury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4c48fb27f128b6, []int{0}
+}
+func (m *RemoteTreasury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteTreasury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteTreasury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteTreasury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteTreasury.Merge(m, src)
+}
+func (m *RemoteTreasury) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteTreasury) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteTreasury.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteTreasury proto.InternalMessageInfo
+
+func (m *RemoteTreasury) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *RemoteTreasury) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RemoteTreasury) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *RemoteTreasury) GetEscrowAddress() string {
+	if m != nil {
+		return m.EscrowAddress
+	}
+	return ""
+}
+
+func (m *RemoteTreasury) GetDenom() DenomCurrency {
+	if m != nil {
+		return m.Denom
+	}
+	return DenomCurrency{}
+}
+
+func (m *RemoteTreasury) GetTopUpThreshold() string {
+	if m != nil {
+		return m.TopUpThreshold
+	}
+	return ""
+}
+
+func (m *RemoteTreasury) GetTopUpAmount() string {
+	if m != nil {
+		return m.TopUpAmount
+	}
+	return ""
+}
+
+func (m *RemoteTreasury) GetPendingSequence() uint64 {
+	if m != nil {
+		return m.PendingSequence
+	}
+	return 0
+}
+
+func (m *RemoteTreasury) GetPendingAmount() string {
+	if m != nil {
+		return m.PendingAmount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RemoteTreasury)(nil), "rewardchain.rewardchain.RemoteTreasury")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/remote_treasury.proto", fileDescriptor_6c4c48fb27f128b6)
+}
+
+var fileDescriptor_6c4c48fb27f128b6 = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x6e, 0xe2, 0x40,
+	0x10, 0x87, 0x6d, 0x0e, 0xb8, 0x63, 0x39, 0x38, 0xb4, 0x42, 0x3a, 0x0b, 0xe9, 0x0c, 0xe2, 0xfe,
+	0xc8, 0x57, 0xc4, 0x48, 0x49, 0x95, 0x12, 0x92, 0x86, 0xd6, 0x21, 0x4d, 0x1a, 0x6b, 0xe3, 0x1d,
+	0x81, 0xa5, 0x78, 0x77, 0xb3, 0xbb, 0x16, 0xe1, 0x2d, 0xf2, 0x58, 0x94, 0x94, 0xa9, 0xa2, 0x08,
+	0x9e, 0x21, 0x7d, 0xe4, 0x5d, 0xa3, 0x40, 0x41, 0x37, 0xfe, 0xe6, 0xf3, 0x8c, 0x66, 0x7f, 0xe8,
+	0x4c, 0xc2, 0x92, 0x48, 0x9a, 0x2c, 0x48, 0xca, 0x46, 0xc7, 0x75, 0xc6, 0x35, 0xc4, 0x5a, 0x02,
+	0x51, 0xb9, 0x5c, 0x85, 0x42, 0x72, 0xcd, 0xf1, 0xcf, 0x03, 0x25, 0x3c, 0xa8, 0x7b, 0xdd, 0x39,
+	0x9f, 0x73, 0xe3, 0x8c, 0x8a, 0xca, 0xea, 0xbd, 0x3f, 0xa7, 0xa6, 0x0b, 0x22, 0x49, 0xa6, 0xac,
+	0x35, 0x7c, 0xaf, 0xa0, 0x76, 0x64, 0xd6, 0xcd, 0xca, 0x6d, 0xf8, 0x17, 0x42, 0x82, 0x48, 0xcd,
+	0x40, 0xc6, 0x29, 0xf5, 0xdc, 0x81, 0x1b, 0x54, 0xa3, 0x46, 0x49, 0xa6, 0x14, 0xff, 0x46, 0xad,
+	0x84, 0x33, 0x06, 0x89, 0x4e, 0x39, 0x2b, 0x8c, 0xca, 0xc0, 0x0d, 0x1a, 0xd1, 0xf7, 0x4f, 0x38,
+	0xa5, 0xb8, 0x8b, 0x6a, 0x7c, 0xc9, 0x40, 0x7a, 0x5f, 0x4c, 0xd3, 0x7e, 0xe0, 0xbf, 0xa8, 0x0d,
+	0x2a, 0x91, 0x7c, 0x19, 0x13, 0x4a, 0x25, 0x28, 0xe5, 0x55, 0x4d, 0xbb, 0x65, 0xe9, 0xd8, 0x42,
+	0x3c, 0x41, 0x35, 0x0a, 0x8c, 0x67, 0x5e, 0x6d, 0xe0, 0x06, 0xcd, 0xf3, 0x7f, 0xe1, 0x89, 0xc3,
+	0xc3, 0xeb, 0xc2, 0xba, 0xca, 0xa5, 0x04, 0x96, 0xac, 0x26, 0xd5, 0xf5, 0x6b, 0xdf, 0x89, 0xec,
+	0xaf, 0x38, 0x40, 0x1d, 0xcd, 0x45, 0x9c, 0x8b, 0x58, 0x2f, 0x24, 0xa8, 0x05, 0x7f, 0xa0, 0x5e,
+	0xdd, 0x2c, 0x6b, 0x6b, 0x2e, 0x6e, 0xc5, 0x6c, 0x4f, 0xf1, 0x10, 0xb5, 0x4a, 0x93, 0x64, 0x3c,
+	0x67, 0xda, 0xfb, 0x6a, 0xb4, 0xa6, 0xd1, 0xc6, 0x06, 0xe1, 0xff, 0xa8, 0x23, 0x80, 0xd1, 0x94,
+	0xcd, 0x63, 0x05, 0x8f, 0x39, 0xb0, 0x04, 0xbc, 0x6f, 0xe6, 0x61, 0x7e, 0x94, 0xfc, 0xa6, 0xc4,
+	0xc5, 0x8d, 0x7b, 0xb5, 0x9c, 0xd7, 0xb0, 0x37, 0x96, 0xd4, 0x4e, 0x9c, 0x5c, 0xae, 0xb7, 0xbe,
+	0xbb, 0xd9, 0xfa, 0xee, 0xdb, 0xd6, 0x77, 0x9f, 0x77, 0xbe, 0xb3, 0xd9, 0xf9, 0xce, 0xcb, 0xce,
+	0x77, 0xee, 0xfa, 0x87, 0x59, 0x3d, 0x1d, 0x25, 0xa7, 0x57, 0x02, 0xd4, 0x7d, 0xdd, 0x24, 0x77,
+	0xf1, 0x31, 0x00, 0xb0, 0x7f, 0x3d, 0xce, 0x3f, 0x02, 0x00, 0x00,
+}
+
+func (m *RemoteTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingAmount) > 0 {
+		i -= len(m.PendingAmount)
+		copy(dAtA[i:], m.PendingAmount)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.PendingAmount)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PendingSequence != 0 {
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(m.PendingSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TopUpAmount) > 0 {
+		i -= len(m.TopUpAmount)
+		copy(dAtA[i:], m.TopUpAmount)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.TopUpAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TopUpThreshold) > 0 {
+		i -= len(m.TopUpThreshold)
+		copy(dAtA[i:], m.TopUpThreshold)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.TopUpThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintRemoteTreasury(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRemoteTreasury(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRemoteTreasury(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RemoteTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovRemoteTreasury(uint64(m.PartnerId))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	l = m.Denom.Size()
+	n += 1 + l + sovRemoteTreasury(uint64(l))
+	l = len(m.TopUpThreshold)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	l = len(m.TopUpAmount)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	if m.PendingSequence != 0 {
+		n += 1 + sovRemoteTreasury(uint64(m.PendingSequence))
+	}
+	l = len(m.PendingAmount)
+	if l > 0 {
+		n += 1 + l + sovRemoteTreasury(uint64(l))
+	}
+	return n
+}
+
+func sovRemoteTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRemoteTreasury(x uint64) (n int) {
+	return sovRemoteTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RemoteTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Denom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSequence", wireType)
+			}
+			m.PendingSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemoteTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemoteTreasury(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemoteTreasury
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRemoteTreasury
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRemoteTreasury
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRemoteTreasury
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRemoteTreasury        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemoteTreasury          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRemoteTreasury = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	math "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultRelativePacketTimeout is how long a rewardpoints packet sent
+// without a timeout stays valid.
+const DefaultRelativePacketTimeout = 10 * time.Minute
+
+// packetCdc encodes rewardpoints packet data as JSON, like ICS-20 does.
+var packetCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+
+// PointsEscrowKey returns the key of a partner's escrow on a channel.
+func PointsEscrowKey(channelID string, partnerID uint64) []byte {
+	return append(lengthPrefixString(channelID), PartnerKey(partnerID)...)
+}
+
+// PointsVoucherHolderPrefix returns the key prefix of a holder's vouchers.
+func PointsVoucherHolderPrefix(holder sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(holder)
+}
+
+// PointsVoucherKey returns the key of a holder's voucher for points of a
+// remote partner received on a channel.
+func PointsVoucherKey(holder sdk.AccAddress, portID, channelID string, partnerID uint64) []byte {
+	key := PointsVoucherHolderPrefix(holder)
+	key = append(key, lengthPrefixString(portID)...)
+	key = append(key, lengthPrefixString(channelID)...)
+	return append(key, PartnerKey(partnerID)...)
+}
+
+func lengthPrefixString(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
+// ValidateBasic performs stateless checks on rewardpoints packet data.
+func (d RewardPointsPacketData) ValidateBasic() error {
+	if d.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	if strings.TrimSpace(d.Sender) == "" {
+		return fmt.Errorf("sender is required")
+	}
+	if strings.TrimSpace(d.Receiver) == "" {
+		return fmt.Errorf("receiver is required")
+	}
+	points, err := math.LegacyNewDecFromStr(d.Points)
+	if err != nil || !points.IsPositive() {
+		return fmt.Errorf("points must be a positive decimal")
+	}
+	if d.SourceRate != "" {
+		if _, err := parseLimit(d.SourceRate); err != nil {
+			return fmt.Errorf("invalid source_rate: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data sent over
+// the channel.
+func (d RewardPointsPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(packetCdc.MustMarshalJSON(&d))
+}
+
+// DecodePacketData decodes packet data received over the channel.
+func DecodePacketData(bz []byte) (RewardPointsPacketData, error) {
+	var d RewardPointsPacketData
+	if err := packetCdc.UnmarshalJSON(bz, &d); err != nil {
+		return RewardPointsPacketData{}, err
+	}
+	return d, nil
+}
+
+// Validate performs stateless checks on an escrow.
+func (e PointsEscrow) Validate() error {
+	if err := host.ChannelIdentifierValidator(e.ChannelId); err != nil {
+		return err
+	}
+	points, err := math.LegacyNewDecFromStr(e.Points)
+	if err != nil || points.IsNegative() {
+		return fmt.Errorf("points must be a non-negative decimal")
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a voucher.
+func (v PointsVoucher) Validate() error {
+	if err := host.PortIdentifierValidator(v.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(v.ChannelId); err != nil {
+		return err
+	}
+	if v.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Holder); err != nil {
+		return fmt.Errorf("invalid holder address: %w", err)
+	}
+	points, err := math.LegacyNewDecFromStr(v.Points)
+	if err != nil || points.IsNegative() {
+		return fmt.Errorf("points must be a non-negative decimal")
+	}
+	return nil
+}
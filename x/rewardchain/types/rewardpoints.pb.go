@@ -0,0 +1,1238 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/rewardpoints.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardPointsPacketData is the packet sent over the rewardpoints port to
+// move a member's points to another rewardchain instance.
+type RewardPointsPacketData struct {
+	// partner_id is the partner on the sending chain the points belong to.
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver  string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Points    string `protobuf:"bytes,4,opt,name=points,proto3" json:"points,omitempty"`
+	// source_rate is the partner's redeem cost per point on the sending chain,
+	// so the receiving chain can value the vouchers.
+	SourceRate string `protobuf:"bytes,5,opt,name=source_rate,json=sourceRate,proto3" json:"source_rate,omitempty"`
+	// voucher marks points held as vouchers going back to the chain of their
+	// partner, where partner_id is local. The receiving chain releases them
+	// from escrow instead of issuing vouchers.
+	Voucher bool `protobuf:"varint,6,opt,name=voucher,proto3" json:"voucher,omitempty"`
+}
+
+func (m *RewardPointsPacketData) Reset()         { *m = RewardPointsPacketData{} }
+func (m *RewardPointsPacketData) String() string { return proto.CompactTextString(m) }
+func (*RewardPointsPacketData) ProtoMessage()    {}
+func (*RewardPointsPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_245f0d969758b8b7, []int{0}
+}
+func (m *RewardPointsPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPointsPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPointsPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardPointsPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPointsPacketData.Merge(m, src)
+}
+func (m *RewardPointsPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPointsPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPointsPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPointsPacketData proto.InternalMessageInfo
+
+func (m *RewardPointsPacketData) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *RewardPointsPacketData) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *RewardPointsPacketData) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *RewardPointsPacketData) GetPoints() string {
+	if m != nil {
+		return m.Points
+	}
+	return ""
+}
+
+func (m *RewardPointsPacketData) GetSourceRate() string {
+	if m != nil {
+		return m.SourceRate
+	}
+	return ""
+}
+
+func (m *RewardPointsPacketData) GetVoucher() bool {
+	if m != nil {
+		return m.Voucher
+	}
+	return false
+}
+
+// PointsEscrow is the points held on the sending chain for a partner while
+// they are out on a channel.
+type PointsEscrow struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PartnerId uint64 `protobuf:"varint,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Points    string `protobuf:"bytes,3,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (m *PointsEscrow) Reset()         { *m = PointsEscrow{} }
+func (m *PointsEscrow) String() string { return proto.CompactTextString(m) }
+func (*PointsEscrow) ProtoMessage()    {}
+func (*PointsEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_245f0d969758b8b7, []int{1}
+}
+func (m *PointsEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointsEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointsEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointsEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointsEscrow.Merge(m, src)
+}
+func (m *PointsEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointsEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointsEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointsEscrow proto.InternalMessageInfo
+
+func (m *PointsEscrow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PointsEscrow) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *PointsEscrow) GetPoints() string {
+	if m != nil {
+		return m.Points
+	}
+	return ""
+}
+
+// PointsVoucher is a member's balance of points received from another
+// rewardchain instance. Vouchers are kept per channel and remote partner.
+type PointsVoucher struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// partner_id is the partner on the sending chain.
+	PartnerId uint64 `protobuf:"varint,3,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Holder    string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	Points    string `protobuf:"bytes,5,opt,name=points,proto3" json:"points,omitempty"`
+	// source_rate is the rate carried by the last packet received.
+	SourceRate string `protobuf:"bytes,6,opt,name=source_rate,json=sourceRate,proto3" json:"source_rate,omitempty"`
+}
+
+func (m *PointsVoucher) Reset()         { *m = PointsVoucher{} }
+func (m *PointsVoucher) String() string { return proto.CompactTextString(m) }
+func (*PointsVoucher) ProtoMessage()    {}
+func (*PointsVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_245f0d969758b8b7, []int{2}
+}
+func (m *PointsVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointsVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointsVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointsVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointsVoucher.Merge(m, src)
+}
+func (m *PointsVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointsVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointsVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointsVoucher proto.InternalMessageInfo
+
+func (m *PointsVoucher) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PointsVoucher) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PointsVoucher) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *PointsVoucher) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *PointsVoucher) GetPoints() string {
+	if m != nil {
+		return m.Points
+	}
+	return ""
+}
+
+func (m *PointsVoucher) GetSourceRate() string {
+	if m != nil {
+		return m.SourceRate
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RewardPointsPacketData)(nil), "rewardchain.rewardchain.RewardPointsPacketData")
+	proto.RegisterType((*PointsEscrow)(nil), "rewardchain.rewardchain.PointsEscrow")
+	proto.RegisterType((*PointsVoucher)(nil), "rewardchain.rewardchain.PointsVoucher")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/rewardpoints.proto", fileDescriptor_245f0d969758b8b7)
+}
+
+var fileDescriptor_245f0d969758b8b7 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbd, 0x4e, 0xe3, 0x40,
+	0x10, 0xc7, 0xb3, 0xf9, 0x70, 0xe2, 0xbd, 0xbb, 0xc6, 0x3a, 0x25, 0x7b, 0x91, 0xce, 0x89, 0x52,
+	0x45, 0x27, 0x5d, 0x72, 0xd2, 0x55, 0x94, 0x44, 0x50, 0xa4, 0x8b, 0x8c, 0x44, 0x41, 0x13, 0x2d,
+	0xbb, 0x23, 0x6c, 0x11, 0xbc, 0xd6, 0xec, 0x26, 0x81, 0xb7, 0xe0, 0x61, 0xa8, 0xa9, 0x29, 0x03,
+	0x15, 0x25, 0x4a, 0x5e, 0x04, 0xd9, 0xeb, 0x80, 0x09, 0x28, 0xdd, 0xfe, 0xe7, 0xc3, 0xf3, 0x9b,
+	0xbf, 0x87, 0xfe, 0x41, 0x58, 0x72, 0x94, 0x22, 0xe4, 0x51, 0x3c, 0xfc, 0xfc, 0x4e, 0x54, 0x14,
+	0x1b, 0x3d, 0x48, 0x50, 0x19, 0xe5, 0xb5, 0x0a, 0xf9, 0x41, 0xe1, 0xdd, 0xfe, 0x25, 0x94, 0xbe,
+	0x52, 0x7a, 0x9a, 0x95, 0x0d, 0xad, 0xb0, 0x3d, 0xbd, 0x7b, 0x42, 0x9b, 0x41, 0x56, 0x3a, 0xc9,
+	0x3e, 0x35, 0xe1, 0xe2, 0x12, 0xcc, 0x11, 0x37, 0xdc, 0xfb, 0x4d, 0x69, 0xc2, 0xd1, 0xc4, 0x80,
+	0xd3, 0x48, 0x32, 0xd2, 0x25, 0xfd, 0x6a, 0xe0, 0xe6, 0x91, 0xb1, 0xf4, 0x9a, 0xd4, 0xd1, 0x10,
+	0x4b, 0x40, 0x56, 0xee, 0x92, 0xbe, 0x1b, 0xe4, 0xca, 0x6b, 0xd3, 0x06, 0x82, 0x80, 0x68, 0x01,
+	0xc8, 0x2a, 0x59, 0xe6, 0x4d, 0xa7, 0x3d, 0x96, 0x98, 0x55, 0x6d, 0x8f, 0x55, 0x5e, 0x87, 0x7e,
+	0xd3, 0x6a, 0x8e, 0x02, 0xa6, 0xc8, 0x0d, 0xb0, 0x5a, 0x96, 0xa4, 0x36, 0x14, 0x70, 0x03, 0x1e,
+	0xa3, 0xf5, 0x85, 0x9a, 0x8b, 0x10, 0x90, 0x39, 0x5d, 0xd2, 0x6f, 0x04, 0x5b, 0xd9, 0x93, 0xf4,
+	0xbb, 0x25, 0x3f, 0xd6, 0x02, 0xd5, 0x32, 0xa5, 0x16, 0x21, 0x8f, 0x63, 0x98, 0x6d, 0xa9, 0xdd,
+	0xc0, 0xcd, 0x23, 0x63, 0xb9, 0xb3, 0x54, 0xf9, 0x8b, 0xa5, 0x72, 0xc0, 0x4a, 0x11, 0xb0, 0xf7,
+	0x48, 0xe8, 0x0f, 0x3b, 0xe6, 0xd4, 0xce, 0xf5, 0x5a, 0xb4, 0x9e, 0x28, 0x34, 0xef, 0x43, 0x9c,
+	0x54, 0xda, 0x09, 0x05, 0x80, 0xf2, 0x7e, 0x80, 0xca, 0x2e, 0xc0, 0x3f, 0xea, 0x84, 0x6a, 0x96,
+	0xba, 0x9a, 0x39, 0x34, 0x62, 0x4f, 0x77, 0x7f, 0x7f, 0xe6, 0x7f, 0xec, 0x50, 0x4a, 0x04, 0xad,
+	0x4f, 0x0c, 0x46, 0xf1, 0x45, 0x90, 0xd7, 0x15, 0x90, 0x6b, 0xfb, 0x3c, 0x75, 0x76, 0x3d, 0x1d,
+	0x1d, 0x3c, 0xac, 0x7d, 0xb2, 0x5a, 0xfb, 0xe4, 0x65, 0xed, 0x93, 0xdb, 0x8d, 0x5f, 0x5a, 0x6d,
+	0xfc, 0xd2, 0xf3, 0xc6, 0x2f, 0x9d, 0x75, 0x8a, 0x87, 0x76, 0xfd, 0xe1, 0xec, 0xcc, 0x4d, 0x02,
+	0xfa, 0xdc, 0xc9, 0x8e, 0xe7, 0xff, 0xeb, 0x00, 0x02, 0xee, 0x27, 0x30, 0x9e, 0x02, 0x00, 0x00,
+}
+
+func (m *RewardPointsPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPointsPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPointsPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Voucher {
+		i--
+		if m.Voucher {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SourceRate) > 0 {
+		i -= len(m.SourceRate)
+		copy(dAtA[i:], m.SourceRate)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.SourceRate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Points) > 0 {
+		i -= len(m.Points)
+		copy(dAtA[i:], m.Points)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.Points)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintRewardpoints(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PointsEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointsEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointsEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		i -= len(m.Points)
+		copy(dAtA[i:], m.Points)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.Points)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintRewardpoints(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PointsVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointsVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointsVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceRate) > 0 {
+		i -= len(m.SourceRate)
+		copy(dAtA[i:], m.SourceRate)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.SourceRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Points) > 0 {
+		i -= len(m.Points)
+		copy(dAtA[i:], m.Points)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.Points)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintRewardpoints(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintRewardpoints(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardpoints(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardpoints(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardPointsPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovRewardpoints(uint64(m.PartnerId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	l = len(m.Points)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	l = len(m.SourceRate)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	if m.Voucher {
+		n += 2
+	}
+	return n
+}
+
+func (m *PointsEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	if m.PartnerId != 0 {
+		n += 1 + sovRewardpoints(uint64(m.PartnerId))
+	}
+	l = len(m.Points)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	return n
+}
+
+func (m *PointsVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	if m.PartnerId != 0 {
+		n += 1 + sovRewardpoints(uint64(m.PartnerId))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	l = len(m.Points)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	l = len(m.SourceRate)
+	if l > 0 {
+		n += 1 + l + sovRewardpoints(uint64(l))
+	}
+	return n
+}
+
+func sovRewardpoints(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardpoints(x uint64) (n int) {
+	return sovRewardpoints(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardPointsPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardpoints
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPointsPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPointsPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Voucher = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardpoints(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PointsEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardpoints
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointsEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointsEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardpoints(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PointsVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardpoints
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointsVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointsVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardpoints(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardpoints
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardpoints(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardpoints
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardpoints
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardpoints
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardpoints
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardpoints
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardpoints        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardpoints          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardpoints = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	math "cosmossdk.io/math"
+)
+
+const (
+	// SettlementMethodOffchain marks a settlement paid outside the chain.
+	SettlementMethodOffchain = "offchain"
+	// SettlementMethodEscrow pays a settlement from the payer's escrow.
+	SettlementMethodEscrow = "escrow"
+)
+
+// SettlementIndexKey returns the per-partner index key of a settlement. Open
+// and closed settlements are kept under separate sub-prefixes.
+func SettlementIndexKey(partnerID uint64, open bool, id uint64) []byte {
+	return append(SettlementIndexPrefix(partnerID, open), PartnerKey(id)...)
+}
+
+// SettlementIndexPrefix returns the index prefix of a partner's open or
+// closed settlements.
+func SettlementIndexPrefix(partnerID uint64, open bool) []byte {
+	state := byte(0)
+	if open {
+		state = 1
+	}
+	return append(PartnerKey(partnerID), state)
+}
+
+// IsOpen reports whether the settlement still awaits payment.
+func (s Settlement) IsOpen() bool {
+	return s.Status == SETTLEMENT_STATUS_OPEN
+}
+
+// Validate performs stateless checks on an obligation.
+func (o Obligation) Validate() error {
+	if o.PayerId == 0 || o.PayeeId == 0 {
+		return fmt.Errorf("payer and payee are required")
+	}
+	if o.PayerId == o.PayeeId {
+		return fmt.Errorf("payer and payee must differ")
+	}
+	amount, err := math.LegacyNewDecFromStr(strings.TrimSpace(o.Amount))
+	if err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("amount must be > 0")
+	}
+	if strings.TrimSpace(o.Currency) == "" {
+		return fmt.Errorf("currency is required")
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a settlement.
+func (s Settlement) Validate() error {
+	if s.Id == 0 {
+		return fmt.Errorf("id must be > 0")
+	}
+	if s.Status == SETTLEMENT_STATUS_UNSPECIFIED {
+		return fmt.Errorf("status is required")
+	}
+	return Obligation{
+		PayerId:  s.PayerId,
+		PayeeId:  s.PayeeId,
+		Amount:   s.Amount,
+		Currency: s.Currency,
+	}.Validate()
+}
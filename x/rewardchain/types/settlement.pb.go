@@ -0,0 +1,1426 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/settlement.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SettlementStatus is the lifecycle state of a settlement.
+type SettlementStatus int32
+
+const (
+	SETTLEMENT_STATUS_UNSPECIFIED      SettlementStatus = 0
+	SETTLEMENT_STATUS_OPEN             SettlementStatus = 1
+	SETTLEMENT_STATUS_SETTLED_OFFCHAIN SettlementStatus = 2
+	SETTLEMENT_STATUS_SETTLED_ESCROW   SettlementStatus = 3
+)
+
+var SettlementStatus_name = map[int32]string{
+	0: "SETTLEMENT_STATUS_UNSPECIFIED",
+	1: "SETTLEMENT_STATUS_OPEN",
+	2: "SETTLEMENT_STATUS_SETTLED_OFFCHAIN",
+	3: "SETTLEMENT_STATUS_SETTLED_ESCROW",
+}
+
+var SettlementStatus_value = map[string]int32{
+	"SETTLEMENT_STATUS_UNSPECIFIED":      0,
+	"SETTLEMENT_STATUS_OPEN":             1,
+	"SETTLEMENT_STATUS_SETTLED_OFFCHAIN": 2,
+	"SETTLEMENT_STATUS_SETTLED_ESCROW":   3,
+}
+
+func (x SettlementStatus) String() string {
+	return proto.EnumName(SettlementStatus_name, int32(x))
+}
+
+func (SettlementStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_11bea3a1af087e9a, []int{0}
+}
+
+// Obligation is an amount one partner owes another that has not been netted
+// into a settlement yet.
+type Obligation struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PayerId  uint64 `protobuf:"varint,2,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	PayeeId  uint64 `protobuf:"varint,3,opt,name=payee_id,json=payeeId,proto3" json:"payee_id,omitempty"`
+	Amount   string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// reference is free text linking the obligation to its origin, such as a
+	// coalition redemption.
+	Reference string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Height    int64  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Obligation) Reset()         { *m = Obligation{} }
+func (m *Obligation) String() string { return proto.CompactTextString(m) }
+func (*Obligation) ProtoMessage()    {}
+func (*Obligation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bea3a1af087e9a, []int{0}
+}
+func (m *Obligation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Obligation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Obligation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Obligation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Obligation.Merge(m, src)
+}
+func (m *Obligation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Obligation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Obligation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Obligation proto.InternalMessageInfo
+
+func (m *Obligation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Obligation) GetPayerId() uint64 {
+	if m != nil {
+		return m.PayerId
+	}
+	return 0
+}
+
+func (m *Obligation) GetPayeeId() uint64 {
+	if m != nil {
+		return m.PayeeId
+	}
+	return 0
+}
+
+func (m *Obligation) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Obligation) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Obligation) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *Obligation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Settlement is the net amount one partner owes another for an epoch and
+// currency, after netting their obligations in both directions.
+type Settlement struct {
+	Id            uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PayerId       uint64           `protobuf:"varint,2,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	PayeeId       uint64           `protobuf:"varint,3,opt,name=payee_id,json=payeeId,proto3" json:"payee_id,omitempty"`
+	Amount        string           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string           `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	EpochHeight   int64            `protobuf:"varint,6,opt,name=epoch_height,json=epochHeight,proto3" json:"epoch_height,omitempty"`
+	Obligations   uint64           `protobuf:"varint,7,opt,name=obligations,proto3" json:"obligations,omitempty"`
+	Status        SettlementStatus `protobuf:"varint,8,opt,name=status,proto3,enum=rewardchain.rewardchain.SettlementStatus" json:"status,omitempty"`
+	SettledHeight int64            `protobuf:"varint,9,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+	OffchainRef   string           `protobuf:"bytes,10,opt,name=offchain_ref,json=offchainRef,proto3" json:"offchain_ref,omitempty"`
+	// payment holds the coins paid from escrow, if settled that way.
+	Payment github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=payment,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"payment"`
+}
+
+func (m *Settlement) Reset()         { *m = Settlement{} }
+func (m *Settlement) String() string { return proto.CompactTextString(m) }
+func (*Settlement) ProtoMessage()    {}
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bea3a1af087e9a, []int{1}
+}
+func (m *Settlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Settlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Settlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Settlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Settlement.Merge(m, src)
+}
+func (m *Settlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Settlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Settlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Settlement proto.InternalMessageInfo
+
+func (m *Settlement) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Settlement) GetPayerId() uint64 {
+	if m != nil {
+		return m.PayerId
+	}
+	return 0
+}
+
+func (m *Settlement) GetPayeeId() uint64 {
+	if m != nil {
+		return m.PayeeId
+	}
+	return 0
+}
+
+func (m *Settlement) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Settlement) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Settlement) GetEpochHeight() int64 {
+	if m != nil {
+		return m.EpochHeight
+	}
+	return 0
+}
+
+func (m *Settlement) GetObligations() uint64 {
+	if m != nil {
+		return m.Obligations
+	}
+	return 0
+}
+
+func (m *Settlement) GetStatus() SettlementStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SETTLEMENT_STATUS_UNSPECIFIED
+}
+
+func (m *Settlement) GetSettledHeight() int64 {
+	if m != nil {
+		return m.SettledHeight
+	}
+	return 0
+}
+
+func (m *Settlement) GetOffchainRef() string {
+	if m != nil {
+		return m.OffchainRef
+	}
+	return ""
+}
+
+func (m *Settlement) GetPayment() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Payment
+	}
+	return nil
+}
+
+// SettlementEscrow holds coins a partner deposited to pay settlements.
+type SettlementEscrow struct {
+	PartnerId uint64                                   `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Balance   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *SettlementEscrow) Reset()         { *m = SettlementEscrow{} }
+func (m *SettlementEscrow) String() string { return proto.CompactTextString(m) }
+func (*SettlementEscrow) ProtoMessage()    {}
+func (*SettlementEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11bea3a1af087e9a, []int{2}
+}
+func (m *SettlementEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementEscrow.Merge(m, src)
+}
+func (m *SettlementEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementEscrow proto.InternalMessageInfo
+
+func (m *SettlementEscrow) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *SettlementEscrow) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("rewardchain.rewardchain.SettlementStatus", SettlementStatus_name, SettlementStatus_value)
+	proto.RegisterType((*Obligation)(nil), "rewardchain.rewardchain.Obligation")
+	proto.RegisterType((*Settlement)(nil), "rewardchain.rewardchain.Settlement")
+	proto.RegisterType((*SettlementEscrow)(nil), "rewardchain.rewardchain.SettlementEscrow")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/settlement.proto", fileDescriptor_11bea3a1af087e9a)
+}
+
+var fileDescriptor_11bea3a1af087e9a = []byte{
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xf6, 0x26, 0x21, 0x6d, 0x26, 0xa5, 0x0a, 0x2b, 0x54, 0xdc, 0x88, 0xba, 0x6e, 0x04, 0xc8,
+	0x54, 0xc2, 0x56, 0x8b, 0x38, 0x70, 0x6c, 0x53, 0x57, 0xb5, 0x04, 0x49, 0x65, 0xa7, 0x42, 0xe2,
+	0x62, 0xad, 0xed, 0x4d, 0x62, 0x68, 0xbc, 0x91, 0xbd, 0xa1, 0xf4, 0x0d, 0x38, 0xf2, 0x02, 0x9c,
+	0x10, 0x12, 0xe2, 0xc4, 0x03, 0x70, 0xe4, 0xd0, 0x63, 0x8f, 0x9c, 0x00, 0x35, 0x07, 0x5e, 0x03,
+	0x79, 0xed, 0xfc, 0x54, 0x55, 0x8f, 0x88, 0x8b, 0x3d, 0xf3, 0x7d, 0xfb, 0xf3, 0xcd, 0xec, 0xa7,
+	0x01, 0x2d, 0xa6, 0x27, 0x24, 0x0e, 0xfc, 0x3e, 0x09, 0x23, 0x63, 0x3e, 0x4e, 0x28, 0xe7, 0xc7,
+	0x74, 0x40, 0x23, 0xae, 0x0f, 0x63, 0xc6, 0x19, 0xbe, 0x33, 0xc7, 0xea, 0x73, 0x71, 0xfd, 0x16,
+	0x19, 0x84, 0x11, 0x33, 0xc4, 0x37, 0x5b, 0x5b, 0x57, 0x7c, 0x96, 0x0c, 0x58, 0x62, 0x78, 0x24,
+	0xa1, 0xc6, 0x9b, 0x2d, 0x8f, 0x72, 0xb2, 0x65, 0xf8, 0x2c, 0x8c, 0x72, 0xfe, 0x76, 0x8f, 0xf5,
+	0x98, 0x08, 0x8d, 0x34, 0xca, 0xd0, 0xc6, 0x37, 0x04, 0xd0, 0xf6, 0x8e, 0xc3, 0x1e, 0xe1, 0x21,
+	0x8b, 0xf0, 0x32, 0x14, 0xc2, 0x40, 0x46, 0x2a, 0xd2, 0x4a, 0x76, 0x21, 0x0c, 0xf0, 0x2a, 0x2c,
+	0x0e, 0xc9, 0x29, 0x8d, 0xdd, 0x30, 0x90, 0x0b, 0x02, 0x5d, 0x10, 0xb9, 0x35, 0xa5, 0x68, 0x4a,
+	0x15, 0x67, 0x14, 0xb5, 0x02, 0xbc, 0x02, 0x65, 0x32, 0x60, 0xa3, 0x88, 0xcb, 0x25, 0x15, 0x69,
+	0x15, 0x3b, 0xcf, 0x70, 0x1d, 0x16, 0xfd, 0x51, 0x1c, 0xd3, 0xc8, 0x3f, 0x95, 0x6f, 0x08, 0x66,
+	0x9a, 0xe3, 0xbb, 0x50, 0x89, 0x69, 0x97, 0xa6, 0x09, 0x95, 0xcb, 0x82, 0x9c, 0x01, 0xe9, 0x89,
+	0x7d, 0x1a, 0xf6, 0xfa, 0x5c, 0x5e, 0x50, 0x91, 0x56, 0xb4, 0xf3, 0xac, 0xf1, 0xbd, 0x08, 0xe0,
+	0x4c, 0xbb, 0xf6, 0x1f, 0xe5, 0x6f, 0xc0, 0x12, 0x1d, 0x32, 0xbf, 0xef, 0xe6, 0x32, 0xcb, 0x42,
+	0x66, 0x55, 0x60, 0x07, 0x02, 0xc2, 0x2a, 0x54, 0xd9, 0xb4, 0xd3, 0x89, 0x28, 0xa4, 0x64, 0xcf,
+	0x43, 0x78, 0x07, 0xca, 0x09, 0x27, 0x7c, 0x94, 0xc8, 0x8b, 0x2a, 0xd2, 0x96, 0xb7, 0x1f, 0xea,
+	0xd7, 0xbc, 0xbf, 0x3e, 0xab, 0xd9, 0x11, 0x1b, 0xec, 0x7c, 0x23, 0xbe, 0x0f, 0xcb, 0x99, 0x8b,
+	0x82, 0x89, 0x92, 0x8a, 0x50, 0x72, 0x33, 0x47, 0x73, 0x2d, 0x1b, 0xb0, 0xc4, 0xba, 0x5d, 0x71,
+	0x96, 0x1b, 0xd3, 0xae, 0x0c, 0xa2, 0x9c, 0xea, 0x04, 0xb3, 0x69, 0x17, 0xbf, 0x82, 0xb4, 0x21,
+	0xe9, 0x15, 0x72, 0x55, 0x2d, 0x6a, 0xd5, 0xed, 0x55, 0x3d, 0x73, 0x98, 0x9e, 0x3a, 0x4c, 0xcf,
+	0x1d, 0xa6, 0x37, 0x59, 0x18, 0xed, 0x3e, 0x39, 0xfb, 0xb9, 0x2e, 0x7d, 0xf9, 0xb5, 0xae, 0xf5,
+	0x42, 0xde, 0x1f, 0x79, 0xba, 0xcf, 0x06, 0x46, 0x6e, 0xc7, 0xec, 0xf7, 0x28, 0x09, 0x5e, 0x1b,
+	0xfc, 0x74, 0x48, 0x13, 0xb1, 0x21, 0xf9, 0xfc, 0xe7, 0xeb, 0x26, 0xb2, 0x27, 0x17, 0x34, 0x3e,
+	0x20, 0xa8, 0xcd, 0x4a, 0x32, 0x13, 0x3f, 0x66, 0x27, 0x78, 0x0d, 0x60, 0x48, 0x62, 0x1e, 0x65,
+	0xcf, 0x97, 0x3d, 0x6a, 0x25, 0x47, 0xac, 0x20, 0xd5, 0xe7, 0x91, 0x63, 0x92, 0xda, 0xa5, 0xf0,
+	0xaf, 0xf4, 0xe5, 0x17, 0x6c, 0x7e, 0xba, 0xa4, 0x2f, 0x6b, 0x39, 0xde, 0x80, 0x35, 0xc7, 0xec,
+	0x74, 0x9e, 0x99, 0xcf, 0xcd, 0x56, 0xc7, 0x75, 0x3a, 0x3b, 0x9d, 0x23, 0xc7, 0x3d, 0x6a, 0x39,
+	0x87, 0x66, 0xd3, 0xda, 0xb7, 0xcc, 0xbd, 0x9a, 0x84, 0xeb, 0xb0, 0x72, 0x75, 0x49, 0xfb, 0xd0,
+	0x6c, 0xd5, 0x10, 0x7e, 0x00, 0x8d, 0xab, 0x5c, 0x86, 0xec, 0xb9, 0xed, 0xfd, 0xfd, 0xe6, 0xc1,
+	0x8e, 0xd5, 0xaa, 0x15, 0xf0, 0x3d, 0x50, 0xaf, 0x5f, 0x67, 0x3a, 0x4d, 0xbb, 0xfd, 0xa2, 0x56,
+	0xac, 0x97, 0xde, 0x7d, 0x54, 0xa4, 0xdd, 0xa7, 0x67, 0x17, 0x0a, 0x3a, 0xbf, 0x50, 0xd0, 0xef,
+	0x0b, 0x05, 0xbd, 0x1f, 0x2b, 0xd2, 0xf9, 0x58, 0x91, 0x7e, 0x8c, 0x15, 0xe9, 0xe5, 0xfa, 0xfc,
+	0x9c, 0x79, 0x7b, 0x69, 0xea, 0x88, 0xb2, 0xbd, 0xb2, 0x98, 0x07, 0x8f, 0xff, 0x0e, 0x00, 0x4f,
+	0x47, 0x1b, 0xa5, 0x9d, 0x04, 0x00, 0x00,
+}
+
+func (m *Obligation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Obligation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Obligation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PayeeId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.PayeeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PayerId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.PayerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Settlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Settlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Settlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payment) > 0 {
+		for iNdEx := len(m.Payment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.OffchainRef) > 0 {
+		i -= len(m.OffchainRef)
+		copy(dAtA[i:], m.OffchainRef)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.OffchainRef)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SettledHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Obligations != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Obligations))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EpochHeight != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.EpochHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Currency) > 0 {
+		i -= len(m.Currency)
+		copy(dAtA[i:], m.Currency)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Currency)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintSettlement(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PayeeId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.PayeeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PayerId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.PayerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettlementEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintSettlement(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSettlement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSettlement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Obligation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	if m.PayerId != 0 {
+		n += 1 + sovSettlement(uint64(m.PayerId))
+	}
+	if m.PayeeId != 0 {
+		n += 1 + sovSettlement(uint64(m.PayeeId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSettlement(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Settlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSettlement(uint64(m.Id))
+	}
+	if m.PayerId != 0 {
+		n += 1 + sovSettlement(uint64(m.PayerId))
+	}
+	if m.PayeeId != 0 {
+		n += 1 + sovSettlement(uint64(m.PayeeId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	l = len(m.Currency)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if m.EpochHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.EpochHeight))
+	}
+	if m.Obligations != 0 {
+		n += 1 + sovSettlement(uint64(m.Obligations))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSettlement(uint64(m.Status))
+	}
+	if m.SettledHeight != 0 {
+		n += 1 + sovSettlement(uint64(m.SettledHeight))
+	}
+	l = len(m.OffchainRef)
+	if l > 0 {
+		n += 1 + l + sovSettlement(uint64(l))
+	}
+	if len(m.Payment) > 0 {
+		for _, e := range m.Payment {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SettlementEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovSettlement(uint64(m.PartnerId))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovSettlement(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSettlement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSettlement(x uint64) (n int) {
+	return sovSettlement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Obligation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Obligation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Obligation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerId", wireType)
+			}
+			m.PayerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeId", wireType)
+			}
+			m.PayeeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayeeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Settlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Settlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Settlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayerId", wireType)
+			}
+			m.PayerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeId", wireType)
+			}
+			m.PayeeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayeeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Currency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Currency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHeight", wireType)
+			}
+			m.EpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Obligations", wireType)
+			}
+			m.Obligations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Obligations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SettlementStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffchainRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payment = append(m.Payment, types.Coin{})
+			if err := m.Payment[len(m.Payment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettlementEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSettlement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSettlement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSettlement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSettlement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSettlement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSettlement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSettlement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSettlement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSettlement = fmt.Errorf("proto: unexpected end of group")
+)
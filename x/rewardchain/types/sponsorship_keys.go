@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// SponsoredMsgTypeURLs lists the messages a sponsored member may pay for with
+// a partner's fee allowance.
+var SponsoredMsgTypeURLs = []string{
+	sdk.MsgTypeURL(&MsgSwap{}),
+	sdk.MsgTypeURL(&MsgRedeemPoints{}),
+	sdk.MsgTypeURL(&MsgTransferPoints{}),
+}
+
+// SponsorshipPartnerPrefix returns the key prefix of all sponsorships of a partner.
+func SponsorshipPartnerPrefix(partnerID uint64) []byte {
+	return PartnerKey(partnerID)
+}
+
+// SponsorshipKey returns the key of a member sponsorship under a partner.
+func SponsorshipKey(partnerID uint64, member sdk.AccAddress) []byte {
+	return append(SponsorshipPartnerPrefix(partnerID), address.MustLengthPrefix(member)...)
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// SecondsPerDay is the length of the day a transfer daily cap applies to.
+const SecondsPerDay = 86_400
+
+// MaxTransferAllowlist bounds the members of a transfer allowlist.
+const MaxTransferAllowlist = 100
+
+// TransferIndexPrefix returns the index prefix of a member's transfers,
+// optionally narrowed to one partner.
+func TransferIndexPrefix(member sdk.AccAddress, partnerID uint64) []byte {
+	key := address.MustLengthPrefix(member)
+	if partnerID != 0 {
+		key = append(key, PartnerKey(partnerID)...)
+	}
+	return key
+}
+
+// TransferIndexKey returns the index key of a transfer under one of its
+// members.
+func TransferIndexKey(member sdk.AccAddress, partnerID, id uint64) []byte {
+	return append(TransferIndexPrefix(member, partnerID), PartnerKey(id)...)
+}
+
+// DefaultTransferPolicy returns the policy of a partner that never set one.
+func DefaultTransferPolicy(partnerID uint64) TransferPolicy {
+	return TransferPolicy{PartnerId: partnerID, Mode: TRANSFER_MODE_DISABLED}
+}
+
+// Allows reports whether addr is on the policy's allowlist.
+func (p TransferPolicy) Allows(addr string) bool {
+	for _, a := range p.Allowlist {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Fee returns the open mode fee rate.
+func (p TransferPolicy) Fee() math.LegacyDec {
+	rate, err := parseFeeRate(p.FeeRate)
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return rate
+}
+
+// Cap returns the open mode daily cap, or zero for no cap.
+func (p TransferPolicy) Cap() math.LegacyDec {
+	if strings.TrimSpace(p.DailyCap) == "" {
+		return math.LegacyZeroDec()
+	}
+	c, err := math.LegacyNewDecFromStr(strings.TrimSpace(p.DailyCap))
+	if err != nil {
+		return math.LegacyZeroDec()
+	}
+	return c
+}
+
+// Validate performs stateless checks on a transfer policy.
+func (p TransferPolicy) Validate() error {
+	if p.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	if _, ok := TransferMode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("unknown transfer mode %d", p.Mode)
+	}
+	if len(p.Allowlist) > MaxTransferAllowlist {
+		return fmt.Errorf("allowlist exceeds %d members", MaxTransferAllowlist)
+	}
+	seen := make(map[string]struct{}, len(p.Allowlist))
+	for _, a := range p.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return fmt.Errorf("invalid allowlist member %q: %w", a, err)
+		}
+		if _, ok := seen[a]; ok {
+			return fmt.Errorf("duplicate allowlist member %q", a)
+		}
+		seen[a] = struct{}{}
+	}
+	if _, err := parseFeeRate(p.FeeRate); err != nil {
+		return fmt.Errorf("invalid fee_rate: %w", err)
+	}
+	if strings.TrimSpace(p.DailyCap) != "" {
+		c, err := math.LegacyNewDecFromStr(strings.TrimSpace(p.DailyCap))
+		if err != nil || c.IsNegative() {
+			return fmt.Errorf("daily_cap must be a non-negative decimal")
+		}
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a transfer record.
+func (t PointsTransfer) Validate() error {
+	if t.Id == 0 || t.PartnerId == 0 {
+		return fmt.Errorf("id and partner_id must be > 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.From); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(t.To); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	amount, err := math.LegacyNewDecFromStr(t.Amount)
+	if err != nil || !amount.IsPositive() {
+		return fmt.Errorf("amount must be a positive decimal")
+	}
+	return nil
+}
@@ -0,0 +1,1316 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/transfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransferMode selects whether members of a partner may send each other
+// points.
+type TransferMode int32
+
+const (
+	// TRANSFER_MODE_DISABLED rejects all transfers. It is the default.
+	TRANSFER_MODE_DISABLED TransferMode = 0
+	// TRANSFER_MODE_ALLOWLIST only lets members on the allowlist send to each
+	// other, without a fee or cap.
+	TRANSFER_MODE_ALLOWLIST TransferMode = 1
+	// TRANSFER_MODE_OPEN lets any member send points, subject to fee_rate and
+	// daily_cap.
+	TRANSFER_MODE_OPEN TransferMode = 2
+)
+
+var TransferMode_name = map[int32]string{
+	0: "TRANSFER_MODE_DISABLED",
+	1: "TRANSFER_MODE_ALLOWLIST",
+	2: "TRANSFER_MODE_OPEN",
+}
+
+var TransferMode_value = map[string]int32{
+	"TRANSFER_MODE_DISABLED":  0,
+	"TRANSFER_MODE_ALLOWLIST": 1,
+	"TRANSFER_MODE_OPEN":      2,
+}
+
+func (x TransferMode) String() string {
+	return proto.EnumName(TransferMode_name, int32(x))
+}
+
+func (TransferMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9574f317ac5ee118, []int{0}
+}
+
+// TransferPolicy is a partner's member-to-member transfer policy.
+type TransferPolicy struct {
+	PartnerId uint64       `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Mode      TransferMode `protobuf:"varint,2,opt,name=mode,proto3,enum=rewardchain.rewardchain.TransferMode" json:"mode,omitempty"`
+	Allowlist []string     `protobuf:"bytes,3,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// fee_rate is the share of the transferred points charged to the sender in
+	// open mode. The fee goes back to the partner's available liquidity.
+	FeeRate string `protobuf:"bytes,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// daily_cap bounds the points a member may send per UTC day in open mode.
+	// Empty or zero means no cap.
+	DailyCap string `protobuf:"bytes,5,opt,name=daily_cap,json=dailyCap,proto3" json:"daily_cap,omitempty"`
+}
+
+func (m *TransferPolicy) Reset()         { *m = TransferPolicy{} }
+func (m *TransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*TransferPolicy) ProtoMessage()    {}
+func (*TransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9574f317ac5ee118, []int{0}
+}
+func (m *TransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferPolicy.Merge(m, src)
+}
+func (m *TransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferPolicy proto.InternalMessageInfo
+
+func (m *TransferPolicy) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *TransferPolicy) GetMode() TransferMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TRANSFER_MODE_DISABLED
+}
+
+func (m *TransferPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *TransferPolicy) GetFeeRate() string {
+	if m != nil {
+		return m.FeeRate
+	}
+	return ""
+}
+
+func (m *TransferPolicy) GetDailyCap() string {
+	if m != nil {
+		return m.DailyCap
+	}
+	return ""
+}
+
+// PointsTransfer records a member-to-member transfer.
+type PointsTransfer struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PartnerId uint64 `protobuf:"varint,2,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	From      string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount    string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee       string `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Height    int64  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time in unix seconds.
+	Time int64 `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *PointsTransfer) Reset()         { *m = PointsTransfer{} }
+func (m *PointsTransfer) String() string { return proto.CompactTextString(m) }
+func (*PointsTransfer) ProtoMessage()    {}
+func (*PointsTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9574f317ac5ee118, []int{1}
+}
+func (m *PointsTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointsTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointsTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointsTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointsTransfer.Merge(m, src)
+}
+func (m *PointsTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointsTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointsTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointsTransfer proto.InternalMessageInfo
+
+func (m *PointsTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PointsTransfer) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *PointsTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *PointsTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *PointsTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *PointsTransfer) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *PointsTransfer) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PointsTransfer) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// TransferUsage tracks the points a member sent on a UTC day, for the daily
+// cap.
+type TransferUsage struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// day is the block time in unix seconds divided by 86400.
+	Day  int64  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Sent string `protobuf:"bytes,4,opt,name=sent,proto3" json:"sent,omitempty"`
+}
+
+func (m *TransferUsage) Reset()         { *m = TransferUsage{} }
+func (m *TransferUsage) String() string { return proto.CompactTextString(m) }
+func (*TransferUsage) ProtoMessage()    {}
+func (*TransferUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9574f317ac5ee118, []int{2}
+}
+func (m *TransferUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUsage.Merge(m, src)
+}
+func (m *TransferUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferUsage proto.InternalMessageInfo
+
+func (m *TransferUsage) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *TransferUsage) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *TransferUsage) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *TransferUsage) GetSent() string {
+	if m != nil {
+		return m.Sent
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("rewardchain.rewardchain.TransferMode", TransferMode_name, TransferMode_value)
+	proto.RegisterType((*TransferPolicy)(nil), "rewardchain.rewardchain.TransferPolicy")
+	proto.RegisterType((*PointsTransfer)(nil), "rewardchain.rewardchain.PointsTransfer")
+	proto.RegisterType((*TransferUsage)(nil), "rewardchain.rewardchain.TransferUsage")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/transfer.proto", fileDescriptor_9574f317ac5ee118)
+}
+
+var fileDescriptor_9574f317ac5ee118 = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0x86, 0x3b, 0x49, 0xec, 0xb6, 0x07, 0x2d, 0x65, 0x58, 0xba, 0xb3, 0x5d, 0x8c, 0xa1, 0xa0,
+	0x04, 0xd1, 0xae, 0x28, 0x08, 0x7b, 0xd9, 0xda, 0x0a, 0x85, 0xee, 0xb6, 0xa4, 0x15, 0xc1, 0x9b,
+	0x30, 0xdb, 0x9c, 0xb6, 0x81, 0x26, 0x53, 0x26, 0x23, 0x6b, 0x5f, 0x40, 0xbc, 0xf4, 0x1d, 0x7c,
+	0x05, 0x1f, 0xc2, 0xcb, 0xc5, 0x2b, 0xf1, 0x4a, 0xda, 0x6b, 0xdf, 0x41, 0x32, 0x4d, 0xb5, 0x5d,
+	0x70, 0x7b, 0xf7, 0x9f, 0xff, 0xfc, 0x33, 0x7c, 0x27, 0x27, 0x03, 0x8f, 0x24, 0x5e, 0x71, 0x19,
+	0x8c, 0xa6, 0x3c, 0x8c, 0x4f, 0xb7, 0xb5, 0x92, 0x3c, 0x4e, 0xc6, 0x28, 0xeb, 0x73, 0x29, 0x94,
+	0xa0, 0x47, 0x5b, 0xbd, 0xfa, 0x96, 0xae, 0x1e, 0x8f, 0x44, 0x12, 0x89, 0xc4, 0xd7, 0xb1, 0xd3,
+	0x75, 0xb1, 0x3e, 0x53, 0x3d, 0x9c, 0x88, 0x89, 0x58, 0xfb, 0xa9, 0x5a, 0xbb, 0xb5, 0x9f, 0x04,
+	0x4a, 0xc3, 0xec, 0xf2, 0xbe, 0x98, 0x85, 0xa3, 0x05, 0xbd, 0x0f, 0x30, 0xe7, 0x52, 0xc5, 0x28,
+	0xfd, 0x30, 0x60, 0xc4, 0x21, 0xae, 0xe5, 0x15, 0x33, 0xa7, 0x13, 0xd0, 0x33, 0xb0, 0x22, 0x11,
+	0x20, 0x33, 0x1c, 0xe2, 0x96, 0x9e, 0x3f, 0xac, 0xff, 0x07, 0xa5, 0xbe, 0xb9, 0xf5, 0x5c, 0x04,
+	0xe8, 0xe9, 0x23, 0xf4, 0x25, 0x14, 0xf9, 0x6c, 0x26, 0xae, 0x66, 0x61, 0xa2, 0x98, 0xe9, 0x98,
+	0x6e, 0xb1, 0xc9, 0xbe, 0x7f, 0x7d, 0x7a, 0x98, 0x71, 0x36, 0x82, 0x40, 0x62, 0x92, 0x0c, 0x94,
+	0x0c, 0xe3, 0x89, 0xf7, 0x2f, 0x4a, 0x8f, 0xa1, 0x30, 0x46, 0xf4, 0x25, 0x57, 0xc8, 0x2c, 0x87,
+	0xb8, 0x45, 0xef, 0x60, 0x8c, 0xe8, 0x71, 0x85, 0xf4, 0x04, 0x8a, 0x01, 0x0f, 0x67, 0x0b, 0x7f,
+	0xc4, 0xe7, 0xec, 0x8e, 0xee, 0x15, 0xb4, 0xf1, 0x8a, 0xcf, 0x6b, 0xbf, 0x09, 0x94, 0xfa, 0x22,
+	0x8c, 0x55, 0xb2, 0x81, 0xa1, 0x25, 0x30, 0xfe, 0x0e, 0x65, 0x84, 0xc1, 0x8d, 0x61, 0x8d, 0x9b,
+	0xc3, 0x3e, 0x01, 0x6b, 0x2c, 0x45, 0xc4, 0x4c, 0x87, 0xdc, 0x0a, 0xab, 0x53, 0xd4, 0x05, 0x43,
+	0x09, 0x66, 0xed, 0xc9, 0x1a, 0x4a, 0xd0, 0x0a, 0xe4, 0x79, 0x24, 0xde, 0xc7, 0x2a, 0x63, 0xce,
+	0x2a, 0x5a, 0x06, 0x73, 0x8c, 0xc8, 0xf2, 0xda, 0x4c, 0x65, 0x9a, 0x9c, 0x62, 0x38, 0x99, 0x2a,
+	0x76, 0xe0, 0x10, 0xd7, 0xf4, 0xb2, 0x8a, 0x52, 0xb0, 0x54, 0x18, 0x21, 0x2b, 0x68, 0x57, 0xeb,
+	0xda, 0x47, 0x02, 0xf7, 0x36, 0x93, 0xbe, 0x49, 0xf8, 0x04, 0xf7, 0xed, 0xf2, 0x19, 0xe4, 0x23,
+	0x8c, 0x2e, 0x51, 0x32, 0x63, 0x0f, 0x74, 0x96, 0x4b, 0x01, 0x03, 0xbe, 0xd0, 0xdf, 0xc3, 0xf4,
+	0x52, 0x99, 0x82, 0x24, 0x18, 0xab, 0x6c, 0x31, 0x5a, 0x3f, 0x46, 0xb8, 0xbb, 0xbd, 0x7e, 0x5a,
+	0x85, 0xca, 0xd0, 0x6b, 0x5c, 0x0c, 0x5e, 0xb7, 0x3d, 0xff, 0xbc, 0xd7, 0x6a, 0xfb, 0xad, 0xce,
+	0xa0, 0xd1, 0xec, 0xb6, 0x5b, 0xe5, 0x1c, 0x3d, 0x81, 0xa3, 0xdd, 0x5e, 0xa3, 0xdb, 0xed, 0xbd,
+	0xed, 0x76, 0x06, 0xc3, 0x32, 0xa1, 0x15, 0xa0, 0xbb, 0xcd, 0x5e, 0xbf, 0x7d, 0x51, 0x36, 0xaa,
+	0xd6, 0xa7, 0x2f, 0x76, 0xae, 0x79, 0xf6, 0x6d, 0x69, 0x93, 0xeb, 0xa5, 0x4d, 0x7e, 0x2d, 0x6d,
+	0xf2, 0x79, 0x65, 0xe7, 0xae, 0x57, 0x76, 0xee, 0xc7, 0xca, 0xce, 0xbd, 0x7b, 0xb0, 0xfd, 0x78,
+	0x3e, 0xec, 0x3e, 0xa5, 0xc5, 0x1c, 0x93, 0xcb, 0xbc, 0xfe, 0xfd, 0x5f, 0xfc, 0x19, 0x00, 0x4a,
+	0xed, 0x0a, 0x13, 0x72, 0x03, 0x00, 0x00,
+}
+
+func (m *TransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DailyCap) > 0 {
+		i -= len(m.DailyCap)
+		copy(dAtA[i:], m.DailyCap)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.DailyCap)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FeeRate) > 0 {
+		i -= len(m.FeeRate)
+		copy(dAtA[i:], m.FeeRate)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.FeeRate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PointsTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointsTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointsTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Height != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sent) > 0 {
+		i -= len(m.Sent)
+		copy(dAtA[i:], m.Sent)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Sent)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Day != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovTransfer(uint64(m.PartnerId))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTransfer(uint64(m.Mode))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	l = len(m.FeeRate)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.DailyCap)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *PointsTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTransfer(uint64(m.Id))
+	}
+	if m.PartnerId != 0 {
+		n += 1 + sovTransfer(uint64(m.PartnerId))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransfer(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTransfer(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *TransferUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovTransfer(uint64(m.PartnerId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovTransfer(uint64(m.Day))
+	}
+	l = len(m.Sent)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func sovTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransfer(x uint64) (n int) {
+	return sovTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TransferPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= TransferMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PointsTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointsTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointsTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransfer = fmt.Errorf("proto: unexpected end of group")
+)
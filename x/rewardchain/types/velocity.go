@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// VelocityBucketSeconds is the granularity of the redeem sliding window.
+	VelocityBucketSeconds = 60
+
+	// MaxVelocityWindowSeconds bounds window_seconds, and so the counters
+	// kept per member.
+	MaxVelocityWindowSeconds = 7 * SecondsPerDay
+)
+
+// VelocityCounterPrefix returns the key prefix of a member's counters with a
+// partner.
+func VelocityCounterPrefix(partnerID uint64, member sdk.AccAddress) []byte {
+	return append(PartnerKey(partnerID), address.MustLengthPrefix(member)...)
+}
+
+// VelocityCounterKey returns the key of a member's counter for a bucket.
+func VelocityCounterKey(partnerID uint64, member sdk.AccAddress, bucket int64) []byte {
+	return append(VelocityCounterPrefix(partnerID, member), sdk.Uint64ToBigEndian(uint64(bucket))...)
+}
+
+// VelocityExpiryKey returns the pruning queue key of a velocity counter.
+func VelocityExpiryKey(expires int64, partnerID uint64, member sdk.AccAddress, bucket int64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expires)), VelocityCounterKey(partnerID, member, bucket)...)
+}
+
+// VelocityBucket returns the bucket a unix time falls into.
+func VelocityBucket(unix int64) int64 {
+	return unix - unix%VelocityBucketSeconds
+}
+
+// parseLimit parses an optional non-negative limit, treating empty as zero.
+func parseLimit(s string) (math.LegacyDec, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	d, err := math.LegacyNewDecFromStr(s)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+	if d.IsNegative() {
+		return math.LegacyZeroDec(), fmt.Errorf("must not be negative")
+	}
+	return d, nil
+}
+
+// PerTx returns the per transaction redeem limit, or zero for none.
+func (l VelocityLimits) PerTx() math.LegacyDec {
+	d, _ := parseLimit(l.MaxRedeemPerTx)
+	return d
+}
+
+// PerDay returns the per UTC day redeem limit, or zero for none.
+func (l VelocityLimits) PerDay() math.LegacyDec {
+	d, _ := parseLimit(l.MaxRedeemPerDay)
+	return d
+}
+
+// PerWindow returns the rolling window redeem limit, or zero for none.
+func (l VelocityLimits) PerWindow() math.LegacyDec {
+	d, _ := parseLimit(l.MaxRedeemPerWindow)
+	return d
+}
+
+// Retention returns how long a redeem counter has to be kept to enforce
+// the limits.
+func (l VelocityLimits) Retention() int64 {
+	if int64(l.WindowSeconds) > SecondsPerDay {
+		return int64(l.WindowSeconds)
+	}
+	return SecondsPerDay
+}
+
+// Validate performs stateless checks on velocity limits.
+func (l VelocityLimits) Validate() error {
+	if l.PartnerId == 0 {
+		return fmt.Errorf("partner_id must be > 0")
+	}
+	for _, f := range []struct{ name, value string }{
+		{"max_redeem_per_tx", l.MaxRedeemPerTx},
+		{"max_redeem_per_day", l.MaxRedeemPerDay},
+		{"max_redeem_per_window", l.MaxRedeemPerWindow},
+	} {
+		if _, err := parseLimit(f.value); err != nil {
+			return fmt.Errorf("invalid %s: %w", f.name, err)
+		}
+	}
+	if l.WindowSeconds > MaxVelocityWindowSeconds {
+		return fmt.Errorf("window_seconds must be <= %d", MaxVelocityWindowSeconds)
+	}
+	if l.PerWindow().IsPositive() && l.WindowSeconds < VelocityBucketSeconds {
+		return fmt.Errorf("window_seconds must be >= %d when max_redeem_per_window is set", VelocityBucketSeconds)
+	}
+	return nil
+}
+
+// Validate performs stateless checks on a frozen member record.
+func (f FrozenMember) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Member); err != nil {
+		return fmt.Errorf("invalid member address: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,1431 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rewardchain/rewardchain/velocity.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VelocityLimits bounds how fast members of a partner may redeem points or
+// transfer them to other members; a transfer counts with its fee. Empty or
+// zero limits are not enforced.
+type VelocityLimits struct {
+	PartnerId      uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	MaxRedeemPerTx string `protobuf:"bytes,2,opt,name=max_redeem_per_tx,json=maxRedeemPerTx,proto3" json:"max_redeem_per_tx,omitempty"`
+	// max_redeem_per_day applies per UTC day.
+	MaxRedeemPerDay string `protobuf:"bytes,3,opt,name=max_redeem_per_day,json=maxRedeemPerDay,proto3" json:"max_redeem_per_day,omitempty"`
+	// max_redeem_per_window applies over the last window_seconds.
+	MaxRedeemPerWindow string `protobuf:"bytes,4,opt,name=max_redeem_per_window,json=maxRedeemPerWindow,proto3" json:"max_redeem_per_window,omitempty"`
+	WindowSeconds      uint64 `protobuf:"varint,5,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// new_member_cooldown_seconds is how long after first receiving points
+	// with the partner a member has to wait before redeeming.
+	NewMemberCooldownSeconds uint64 `protobuf:"varint,6,opt,name=new_member_cooldown_seconds,json=newMemberCooldownSeconds,proto3" json:"new_member_cooldown_seconds,omitempty"`
+}
+
+func (m *VelocityLimits) Reset()         { *m = VelocityLimits{} }
+func (m *VelocityLimits) String() string { return proto.CompactTextString(m) }
+func (*VelocityLimits) ProtoMessage()    {}
+func (*VelocityLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51051ed790895448, []int{0}
+}
+func (m *VelocityLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityLimits.Merge(m, src)
+}
+func (m *VelocityLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityLimits proto.InternalMessageInfo
+
+func (m *VelocityLimits) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *VelocityLimits) GetMaxRedeemPerTx() string {
+	if m != nil {
+		return m.MaxRedeemPerTx
+	}
+	return ""
+}
+
+func (m *VelocityLimits) GetMaxRedeemPerDay() string {
+	if m != nil {
+		return m.MaxRedeemPerDay
+	}
+	return ""
+}
+
+func (m *VelocityLimits) GetMaxRedeemPerWindow() string {
+	if m != nil {
+		return m.MaxRedeemPerWindow
+	}
+	return ""
+}
+
+func (m *VelocityLimits) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+func (m *VelocityLimits) GetNewMemberCooldownSeconds() uint64 {
+	if m != nil {
+		return m.NewMemberCooldownSeconds
+	}
+	return 0
+}
+
+// VelocityCounter holds the points a member redeemed with a partner in one
+// bucket of the sliding window.
+type VelocityCounter struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// bucket is the bucket start in unix seconds.
+	Bucket   int64  `protobuf:"varint,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Redeemed string `protobuf:"bytes,4,opt,name=redeemed,proto3" json:"redeemed,omitempty"`
+	// expires is the unix time after which EndBlock prunes the counter.
+	Expires int64 `protobuf:"varint,5,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (m *VelocityCounter) Reset()         { *m = VelocityCounter{} }
+func (m *VelocityCounter) String() string { return proto.CompactTextString(m) }
+func (*VelocityCounter) ProtoMessage()    {}
+func (*VelocityCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51051ed790895448, []int{1}
+}
+func (m *VelocityCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityCounter.Merge(m, src)
+}
+func (m *VelocityCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityCounter proto.InternalMessageInfo
+
+func (m *VelocityCounter) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *VelocityCounter) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *VelocityCounter) GetBucket() int64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *VelocityCounter) GetRedeemed() string {
+	if m != nil {
+		return m.Redeemed
+	}
+	return ""
+}
+
+func (m *VelocityCounter) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+// MemberSince records when a member first received points with a partner.
+type MemberSince struct {
+	PartnerId uint64 `protobuf:"varint,1,opt,name=partner_id,json=partnerId,proto3" json:"partner_id,omitempty"`
+	Member    string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// since is a unix time in seconds.
+	Since int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (m *MemberSince) Reset()         { *m = MemberSince{} }
+func (m *MemberSince) String() string { return proto.CompactTextString(m) }
+func (*MemberSince) ProtoMessage()    {}
+func (*MemberSince) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51051ed790895448, []int{2}
+}
+func (m *MemberSince) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberSince) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberSince.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberSince) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberSince.Merge(m, src)
+}
+func (m *MemberSince) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberSince) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberSince.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberSince proto.InternalMessageInfo
+
+func (m *MemberSince) GetPartnerId() uint64 {
+	if m != nil {
+		return m.PartnerId
+	}
+	return 0
+}
+
+func (m *MemberSince) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *MemberSince) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+// FrozenMember is a member an admin has blocked from redeeming and sending
+// points with every partner.
+type FrozenMember struct {
+	Member   string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	FrozenBy string `protobuf:"bytes,3,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty"`
+	Height   int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FrozenMember) Reset()         { *m = FrozenMember{} }
+func (m *FrozenMember) String() string { return proto.CompactTextString(m) }
+func (*FrozenMember) ProtoMessage()    {}
+func (*FrozenMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51051ed790895448, []int{3}
+}
+func (m *FrozenMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenMember.Merge(m, src)
+}
+func (m *FrozenMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenMember proto.InternalMessageInfo
+
+func (m *FrozenMember) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *FrozenMember) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FrozenMember) GetFrozenBy() string {
+	if m != nil {
+		return m.FrozenBy
+	}
+	return ""
+}
+
+func (m *FrozenMember) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VelocityLimits)(nil), "rewardchain.rewardchain.VelocityLimits")
+	proto.RegisterType((*VelocityCounter)(nil), "rewardchain.rewardchain.VelocityCounter")
+	proto.RegisterType((*MemberSince)(nil), "rewardchain.rewardchain.MemberSince")
+	proto.RegisterType((*FrozenMember)(nil), "rewardchain.rewardchain.FrozenMember")
+}
+
+func init() {
+	proto.RegisterFile("rewardchain/rewardchain/velocity.proto", fileDescriptor_51051ed790895448)
+}
+
+var fileDescriptor_51051ed790895448 = []byte{
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0x14, 0x31,
+	0x14, 0xc7, 0x37, 0xdd, 0x76, 0xed, 0x3e, 0x75, 0x8b, 0xa1, 0xea, 0x58, 0x71, 0x2c, 0x0b, 0x4a,
+	0x45, 0x6c, 0x15, 0xf1, 0xe0, 0xc1, 0x83, 0x5b, 0x11, 0x04, 0x05, 0x99, 0x15, 0x05, 0x2f, 0x21,
+	0x3b, 0x79, 0x76, 0x83, 0x9d, 0x64, 0x48, 0x52, 0x67, 0xc6, 0x4f, 0xe1, 0x47, 0xf0, 0xe6, 0xd5,
+	0x83, 0x1f, 0xc2, 0x63, 0xf1, 0xe4, 0x51, 0x76, 0xbf, 0x88, 0x34, 0x49, 0xcb, 0xb8, 0x07, 0xf5,
+	0xd0, 0x5b, 0xfe, 0x2f, 0xbf, 0xf7, 0x4f, 0xf2, 0x27, 0x0f, 0x6e, 0x1a, 0xac, 0xb8, 0x11, 0xf9,
+	0x94, 0x4b, 0xb5, 0xd3, 0x5e, 0x7f, 0xc0, 0x7d, 0x9d, 0x4b, 0xd7, 0x6c, 0x97, 0x46, 0x3b, 0x4d,
+	0x2f, 0xb7, 0xf6, 0xb6, 0x5b, 0xeb, 0x8d, 0x2b, 0xb9, 0xb6, 0x85, 0xb6, 0xcc, 0x63, 0x3b, 0x41,
+	0x84, 0x9e, 0xe1, 0xe7, 0x25, 0x18, 0xbc, 0x8e, 0x36, 0xcf, 0x65, 0x21, 0x9d, 0xa5, 0xd7, 0x00,
+	0x4a, 0x6e, 0x9c, 0x42, 0xc3, 0xa4, 0x48, 0xc8, 0x26, 0xd9, 0x5a, 0xce, 0xfa, 0xb1, 0xf2, 0x4c,
+	0xd0, 0x5b, 0x70, 0xa1, 0xe0, 0x35, 0x33, 0x28, 0x10, 0x0b, 0x56, 0xa2, 0x61, 0xae, 0x4e, 0x96,
+	0x36, 0xc9, 0x56, 0x3f, 0x1b, 0x14, 0xbc, 0xce, 0x7c, 0xfd, 0x25, 0x9a, 0x57, 0x35, 0xbd, 0x0d,
+	0x74, 0x01, 0x15, 0xbc, 0x49, 0xba, 0x9e, 0x5d, 0x6b, 0xb3, 0x4f, 0x78, 0x43, 0xef, 0xc1, 0xc5,
+	0x05, 0xb8, 0x92, 0x4a, 0xe8, 0x2a, 0x59, 0xf6, 0x3c, 0x6d, 0xf3, 0x6f, 0xfc, 0x0e, 0xbd, 0x01,
+	0x83, 0xc0, 0x30, 0x8b, 0xb9, 0x56, 0xc2, 0x26, 0x2b, 0xfe, 0xb6, 0xe7, 0x43, 0x75, 0x1c, 0x8a,
+	0xf4, 0x11, 0x5c, 0x55, 0x58, 0xb1, 0x02, 0x8b, 0x09, 0x1a, 0x96, 0x6b, 0xbd, 0x2f, 0x74, 0xa5,
+	0x4e, 0x7a, 0x7a, 0xbe, 0x27, 0x51, 0x58, 0xbd, 0xf0, 0xc4, 0x6e, 0x04, 0x62, 0xfb, 0xf0, 0x2b,
+	0x81, 0xb5, 0xe3, 0x88, 0x76, 0xf5, 0x81, 0x72, 0x68, 0xfe, 0x95, 0xd1, 0x5d, 0xe8, 0x85, 0xd3,
+	0x42, 0x30, 0xa3, 0xe4, 0xc7, 0xb7, 0x3b, 0xeb, 0x31, 0xf7, 0xc7, 0x42, 0x18, 0xb4, 0x76, 0xec,
+	0x8c, 0x54, 0x7b, 0x59, 0xe4, 0xe8, 0x25, 0xe8, 0x4d, 0x0e, 0xf2, 0xf7, 0xe8, 0x7c, 0x3c, 0xdd,
+	0x2c, 0x2a, 0xba, 0x01, 0xab, 0x21, 0x11, 0x14, 0x31, 0x88, 0x13, 0x4d, 0x13, 0x38, 0x83, 0x75,
+	0x29, 0x0d, 0x86, 0x77, 0x77, 0xb3, 0x63, 0x39, 0x74, 0x70, 0x36, 0xbc, 0x65, 0x2c, 0x55, 0x8e,
+	0xa7, 0x7f, 0xdb, 0x75, 0x58, 0xb1, 0x47, 0xce, 0xf1, 0xb2, 0x41, 0x0c, 0xbf, 0x10, 0x38, 0xf7,
+	0xd4, 0xe8, 0x8f, 0xa8, 0xc2, 0xe1, 0x2d, 0x63, 0xf2, 0xff, 0x31, 0x18, 0xe4, 0x56, 0xab, 0xf8,
+	0xa3, 0xa2, 0xa2, 0x0f, 0xa0, 0xff, 0xce, 0x3b, 0xb3, 0x49, 0xfc, 0x40, 0x7f, 0x31, 0x5b, 0x0d,
+	0xe8, 0xa8, 0x39, 0xb2, 0x9b, 0xa2, 0xdc, 0x9b, 0x3a, 0x9f, 0x5d, 0x37, 0x8b, 0x6a, 0xf4, 0xf0,
+	0xfb, 0x2c, 0x25, 0x87, 0xb3, 0x94, 0xfc, 0x9a, 0xa5, 0xe4, 0xd3, 0x3c, 0xed, 0x1c, 0xce, 0xd3,
+	0xce, 0xcf, 0x79, 0xda, 0x79, 0x7b, 0xbd, 0x3d, 0x5f, 0xf5, 0x1f, 0xd3, 0xe6, 0x9a, 0x12, 0xed,
+	0xa4, 0xe7, 0xe7, 0xe6, 0xfe, 0xef, 0x01, 0x00, 0xfc, 0x4d, 0x93, 0x46, 0x95, 0x03, 0x00, 0x00,
+}
+
+func (m *VelocityLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewMemberCooldownSeconds != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.NewMemberCooldownSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WindowSeconds != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MaxRedeemPerWindow) > 0 {
+		i -= len(m.MaxRedeemPerWindow)
+		copy(dAtA[i:], m.MaxRedeemPerWindow)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.MaxRedeemPerWindow)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxRedeemPerDay) > 0 {
+		i -= len(m.MaxRedeemPerDay)
+		copy(dAtA[i:], m.MaxRedeemPerDay)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.MaxRedeemPerDay)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxRedeemPerTx) > 0 {
+		i -= len(m.MaxRedeemPerTx)
+		copy(dAtA[i:], m.MaxRedeemPerTx)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.MaxRedeemPerTx)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VelocityCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expires != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.Expires))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Redeemed) > 0 {
+		i -= len(m.Redeemed)
+		copy(dAtA[i:], m.Redeemed)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Redeemed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberSince) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberSince) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberSince) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Since != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PartnerId != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.PartnerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrozenMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintVelocity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FrozenBy) > 0 {
+		i -= len(m.FrozenBy)
+		copy(dAtA[i:], m.FrozenBy)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.FrozenBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintVelocity(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVelocity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVelocity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VelocityLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovVelocity(uint64(m.PartnerId))
+	}
+	l = len(m.MaxRedeemPerTx)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	l = len(m.MaxRedeemPerDay)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	l = len(m.MaxRedeemPerWindow)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovVelocity(uint64(m.WindowSeconds))
+	}
+	if m.NewMemberCooldownSeconds != 0 {
+		n += 1 + sovVelocity(uint64(m.NewMemberCooldownSeconds))
+	}
+	return n
+}
+
+func (m *VelocityCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovVelocity(uint64(m.PartnerId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	if m.Bucket != 0 {
+		n += 1 + sovVelocity(uint64(m.Bucket))
+	}
+	l = len(m.Redeemed)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	if m.Expires != 0 {
+		n += 1 + sovVelocity(uint64(m.Expires))
+	}
+	return n
+}
+
+func (m *MemberSince) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartnerId != 0 {
+		n += 1 + sovVelocity(uint64(m.PartnerId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovVelocity(uint64(m.Since))
+	}
+	return n
+}
+
+func (m *FrozenMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	l = len(m.FrozenBy)
+	if l > 0 {
+		n += 1 + l + sovVelocity(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVelocity(uint64(m.Height))
+	}
+	return n
+}
+
+func sovVelocity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVelocity(x uint64) (n int) {
+	return sovVelocity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VelocityLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VelocityLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VelocityLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedeemPerTx", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRedeemPerTx = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedeemPerDay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRedeemPerDay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedeemPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRedeemPerWindow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMemberCooldownSeconds", wireType)
+			}
+			m.NewMemberCooldownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewMemberCooldownSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVelocity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VelocityCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VelocityCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VelocityCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redeemed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redeemed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
+			}
+			m.Expires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expires |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVelocity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberSince) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberSince: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberSince: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartnerId", wireType)
+			}
+			m.PartnerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartnerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVelocity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVelocity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVelocity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVelocity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVelocity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVelocity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVelocity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVelocity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVelocity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVelocity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVelocity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVelocity = fmt.Errorf("proto: unexpected end of group")
+)